@@ -0,0 +1,54 @@
+-- +goose Up
+ALTER TABLE roles ADD COLUMN parent_id UUID REFERENCES roles(id) ON DELETE SET NULL;
+ALTER TABLE roles ADD CONSTRAINT roles_parent_id_not_self CHECK (parent_id <> id);
+CREATE INDEX roles_parent_id_idx ON roles (parent_id);
+
+-- +goose StatementBegin
+CREATE FUNCTION roles_prevent_cycle() RETURNS trigger AS $$
+BEGIN
+  IF NEW.parent_id IS NOT NULL AND EXISTS (
+    WITH RECURSIVE ancestors AS (
+      SELECT id, parent_id FROM roles WHERE id = NEW.parent_id
+      UNION
+      SELECT r.id, r.parent_id FROM roles r JOIN ancestors a ON r.id = a.parent_id
+    )
+    SELECT 1 FROM ancestors WHERE id = NEW.id
+  ) THEN
+    RAISE EXCEPTION 'role hierarchy cycle detected'
+      USING ERRCODE = 'check_violation', CONSTRAINT = 'roles_parent_id_acyclic';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER roles_prevent_cycle
+  BEFORE INSERT OR UPDATE OF parent_id ON roles
+  FOR EACH ROW EXECUTE FUNCTION roles_prevent_cycle();
+
+UPDATE roles SET parent_id = (SELECT id FROM roles WHERE name = 'user') WHERE name = 'manager';
+UPDATE roles SET parent_id = (SELECT id FROM roles WHERE name = 'manager') WHERE name = 'admin';
+
+DELETE FROM role_permissions rp
+USING roles r
+WHERE rp.role_id = r.id
+  AND r.parent_id IS NOT NULL
+  AND rp.permission_id IN (SELECT permission_id FROM role_permissions WHERE role_id = r.parent_id);
+
+-- +goose Down
+INSERT INTO role_permissions (role_id, permission_id)
+WITH RECURSIVE ancestors AS (
+  SELECT id AS role_id, parent_id FROM roles WHERE parent_id IS NOT NULL
+  UNION
+  SELECT a.role_id, r.parent_id FROM ancestors a JOIN roles r ON r.id = a.parent_id WHERE r.parent_id IS NOT NULL
+)
+SELECT a.role_id, rp.permission_id
+FROM ancestors a
+JOIN role_permissions rp ON rp.role_id = a.parent_id
+ON CONFLICT (role_id, permission_id) DO NOTHING;
+
+DROP TRIGGER roles_prevent_cycle ON roles;
+DROP FUNCTION roles_prevent_cycle();
+DROP INDEX roles_parent_id_idx;
+ALTER TABLE roles DROP COLUMN parent_id;
@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE organisations (
+  id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  name VARCHAR(100) UNIQUE NOT NULL,
+  description TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE memberships (
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  organisation_id UUID NOT NULL REFERENCES organisations(id) ON DELETE CASCADE,
+  role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (user_id, organisation_id, role_id)
+);
+
+CREATE INDEX memberships_organisation_id_idx ON memberships (organisation_id);
+CREATE INDEX memberships_role_id_idx ON memberships (role_id);
+
+INSERT INTO permissions (name, description)
+VALUES ('read:organisations', 'Read organisations'),
+       ('write:organisations', 'Update organisations');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r, permissions p
+WHERE r.name = 'admin' AND p.name IN ('read:organisations', 'write:organisations');
+
+-- +goose Down
+DELETE FROM permissions WHERE name IN ('read:organisations', 'write:organisations');
+
+DROP TABLE memberships;
+DROP TABLE organisations;
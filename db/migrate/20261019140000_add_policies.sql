@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TYPE policy_effect AS ENUM ('allow', 'deny');
+
+CREATE TABLE policies (
+  id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  name VARCHAR(100) UNIQUE NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  actions TEXT[] NOT NULL,
+  effect policy_effect NOT NULL,
+  condition TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT policies_actions_not_empty CHECK (cardinality(actions) > 0)
+);
+
+-- +goose Down
+DROP TABLE policies;
+DROP TYPE policy_effect;
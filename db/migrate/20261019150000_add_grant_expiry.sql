@@ -0,0 +1,40 @@
+-- +goose Up
+ALTER TABLE user_roles
+  ADD COLUMN expires_at TIMESTAMP,
+  ADD COLUMN granted_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE user_scopes
+  ADD COLUMN expires_at TIMESTAMP,
+  ADD COLUMN granted_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX user_roles_expires_at_idx ON user_roles (expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX user_scopes_expires_at_idx ON user_scopes (expires_at) WHERE expires_at IS NOT NULL;
+
+CREATE TYPE elevation_status AS ENUM ('pending', 'approved', 'rejected');
+
+CREATE TABLE role_elevations (
+  id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+  reason TEXT NOT NULL,
+  duration_minutes INTEGER NOT NULL,
+  status elevation_status NOT NULL DEFAULT 'pending',
+  decided_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  decided_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT role_elevations_duration_positive CHECK (duration_minutes > 0)
+);
+
+CREATE INDEX role_elevations_user_id_idx ON role_elevations (user_id);
+CREATE INDEX role_elevations_status_idx ON role_elevations (status);
+
+-- +goose Down
+DROP TABLE role_elevations;
+DROP TYPE elevation_status;
+
+DROP INDEX user_scopes_expires_at_idx;
+DROP INDEX user_roles_expires_at_idx;
+
+ALTER TABLE user_scopes DROP COLUMN granted_by, DROP COLUMN expires_at;
+ALTER TABLE user_roles DROP COLUMN granted_by, DROP COLUMN expires_at;
@@ -0,0 +1,47 @@
+-- +goose Up
+CREATE TABLE audit_events (
+  id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  action VARCHAR(255) NOT NULL,
+  actor_id UUID,
+  target_type VARCHAR(255) NOT NULL DEFAULT '',
+  target_id VARCHAR(255) NOT NULL DEFAULT '',
+  status VARCHAR(32) NOT NULL,
+  changes JSONB NOT NULL DEFAULT '{}',
+  metadata JSONB NOT NULL DEFAULT '{}',
+  trace_id VARCHAR(255) NOT NULL DEFAULT '',
+  remote_addr VARCHAR(255) NOT NULL DEFAULT '',
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX audit_events_action_idx ON audit_events (action);
+CREATE INDEX audit_events_actor_id_idx ON audit_events (actor_id);
+CREATE INDEX audit_events_target_id_idx ON audit_events (target_id);
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+
+-- +goose StatementBegin
+CREATE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'audit events are append-only'
+    USING ERRCODE = 'insufficient_privilege';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER audit_events_append_only
+  BEFORE UPDATE OR DELETE ON audit_events
+  FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+
+INSERT INTO permissions (name, description)
+VALUES ('read:audit', 'Read audit events');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r, permissions p
+WHERE r.name = 'admin' AND p.name = 'read:audit';
+
+-- +goose Down
+DELETE FROM permissions WHERE name = 'read:audit';
+
+DROP TRIGGER audit_events_append_only ON audit_events;
+DROP FUNCTION audit_events_append_only();
+DROP TABLE audit_events;
@@ -0,0 +1,32 @@
+-- +goose Up
+ALTER TABLE audit_events
+  ADD COLUMN sequence BIGINT GENERATED ALWAYS AS IDENTITY,
+  ADD COLUMN prev_hash VARCHAR(64) NOT NULL DEFAULT '',
+  ADD COLUMN hash VARCHAR(64) NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX audit_events_sequence_idx ON audit_events (sequence);
+
+CREATE TABLE audit_checkpoints (
+  id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  sequence BIGINT NOT NULL,
+  hash VARCHAR(64) NOT NULL,
+  signature TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX audit_checkpoints_sequence_idx ON audit_checkpoints (sequence);
+
+CREATE TRIGGER audit_checkpoints_append_only
+  BEFORE UPDATE OR DELETE ON audit_checkpoints
+  FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+
+-- +goose Down
+DROP TRIGGER audit_checkpoints_append_only ON audit_checkpoints;
+DROP TABLE audit_checkpoints;
+
+DROP INDEX audit_events_sequence_idx;
+
+ALTER TABLE audit_events
+  DROP COLUMN hash,
+  DROP COLUMN prev_hash,
+  DROP COLUMN sequence;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TYPE user_status AS ENUM ('active', 'suspended', 'locked', 'deleted');
+
+ALTER TABLE users
+  ADD COLUMN status user_status NOT NULL DEFAULT 'active',
+  ADD COLUMN status_reason TEXT,
+  ADD COLUMN status_changed_at TIMESTAMP;
+
+CREATE INDEX users_status_idx ON users (status) WHERE status <> 'active';
+
+-- +goose Down
+DROP INDEX users_status_idx;
+
+ALTER TABLE users
+  DROP COLUMN status_changed_at,
+  DROP COLUMN status_reason,
+  DROP COLUMN status;
+
+DROP TYPE user_status;
@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TYPE invitation_status AS ENUM ('pending', 'accepted', 'revoked');
+
+CREATE TABLE invitations (
+  id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  identity_number VARCHAR(20) NOT NULL,
+  personal_code VARCHAR(20) NOT NULL,
+  role_ids UUID[] NOT NULL DEFAULT '{}',
+  scope_ids UUID[] NOT NULL DEFAULT '{}',
+  status invitation_status NOT NULL DEFAULT 'pending',
+  invited_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+  expires_at TIMESTAMP NOT NULL,
+  sent_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  accepted_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX invitations_pending_identity_number_idx ON invitations (identity_number) WHERE status = 'pending';
+CREATE INDEX invitations_status_idx ON invitations (status);
+
+-- +goose Down
+DROP TABLE invitations;
+DROP TYPE invitation_status;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE user_profiles (
+  user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  email VARCHAR(255),
+  email_verified_at TIMESTAMP,
+  email_verification_digest VARCHAR(64),
+  email_verification_expires_at TIMESTAMP,
+  phone_number VARCHAR(20),
+  locale VARCHAR(10),
+  attributes JSONB NOT NULL DEFAULT '{}',
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +goose Down
+DROP TABLE user_profiles;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE user_authenticators (
+  id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  method VARCHAR(20) NOT NULL,
+  identifier VARCHAR(255) NOT NULL,
+  metadata JSONB NOT NULL DEFAULT '{}',
+  first_used_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_used_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX user_authenticators_user_id_method_identifier_idx ON user_authenticators (user_id, method, identifier);
+
+CREATE TABLE user_allowed_methods (
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  method VARCHAR(20) NOT NULL,
+  PRIMARY KEY (user_id, method)
+);
+
+-- +goose Down
+DROP TABLE user_allowed_methods;
+DROP TABLE user_authenticators;
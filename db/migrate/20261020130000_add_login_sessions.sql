@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE login_sessions (
+  id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  provider VARCHAR(20) NOT NULL,
+  ip_address VARCHAR(45),
+  user_agent VARCHAR(512),
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_refreshed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  revoked_at TIMESTAMP
+);
+
+CREATE INDEX login_sessions_user_id_idx ON login_sessions (user_id);
+
+ALTER TABLE tokens ADD COLUMN login_session_id UUID REFERENCES login_sessions(id) ON DELETE CASCADE;
+
+CREATE INDEX tokens_login_session_id_idx ON tokens (login_session_id);
+
+-- +goose Down
+DROP INDEX tokens_login_session_id_idx;
+ALTER TABLE tokens DROP COLUMN login_session_id;
+DROP TABLE login_sessions;
@@ -0,0 +1,6 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE invitation_status ADD VALUE IF NOT EXISTS 'expired';
+
+-- +goose Down
+UPDATE invitations SET status = 'revoked' WHERE status = 'expired';
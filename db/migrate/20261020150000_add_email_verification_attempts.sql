@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE user_profiles ADD COLUMN email_verification_attempts INT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE user_profiles DROP COLUMN email_verification_attempts;
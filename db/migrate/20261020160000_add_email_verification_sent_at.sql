@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE user_profiles ADD COLUMN email_verification_sent_at TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE user_profiles DROP COLUMN email_verification_sent_at;
@@ -30,6 +30,45 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;
 COMMENT ON EXTENSION "uuid-ossp" IS 'generate universally unique identifiers (UUIDs)';
 
 
+--
+-- Name: elevation_status; Type: TYPE; Schema: public; Owner: postgres
+--
+
+CREATE TYPE public.elevation_status AS ENUM (
+    'pending',
+    'approved',
+    'rejected'
+);
+
+
+ALTER TYPE public.elevation_status OWNER TO postgres;
+
+--
+-- Name: invitation_status; Type: TYPE; Schema: public; Owner: postgres
+--
+
+CREATE TYPE public.invitation_status AS ENUM (
+    'pending',
+    'accepted',
+    'revoked',
+    'expired'
+);
+
+
+ALTER TYPE public.invitation_status OWNER TO postgres;
+
+--
+-- Name: policy_effect; Type: TYPE; Schema: public; Owner: postgres
+--
+
+CREATE TYPE public.policy_effect AS ENUM (
+    'allow',
+    'deny'
+);
+
+
+ALTER TYPE public.policy_effect OWNER TO postgres;
+
 --
 -- Name: token_type; Type: TYPE; Schema: public; Owner: postgres
 --
@@ -42,10 +81,190 @@ CREATE TYPE public.token_type AS ENUM (
 
 ALTER TYPE public.token_type OWNER TO postgres;
 
+--
+-- Name: user_status; Type: TYPE; Schema: public; Owner: postgres
+--
+
+CREATE TYPE public.user_status AS ENUM (
+    'active',
+    'suspended',
+    'locked',
+    'deleted'
+);
+
+
+ALTER TYPE public.user_status OWNER TO postgres;
+
+--
+-- Name: audit_events_append_only(); Type: FUNCTION; Schema: public; Owner: postgres
+--
+
+CREATE FUNCTION public.audit_events_append_only() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+  RAISE EXCEPTION 'audit events are append-only'
+    USING ERRCODE = 'insufficient_privilege';
+END;
+$$;
+
+
+ALTER FUNCTION public.audit_events_append_only() OWNER TO postgres;
+
+--
+-- Name: roles_prevent_cycle(); Type: FUNCTION; Schema: public; Owner: postgres
+--
+
+CREATE FUNCTION public.roles_prevent_cycle() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+  IF NEW.parent_id IS NOT NULL AND EXISTS (
+    WITH RECURSIVE ancestors AS (
+      SELECT id, parent_id FROM roles WHERE id = NEW.parent_id
+      UNION
+      SELECT r.id, r.parent_id FROM roles r JOIN ancestors a ON r.id = a.parent_id
+    )
+    SELECT 1 FROM ancestors WHERE id = NEW.id
+  ) THEN
+    RAISE EXCEPTION 'role hierarchy cycle detected'
+      USING ERRCODE = 'check_violation', CONSTRAINT = 'roles_parent_id_acyclic';
+  END IF;
+
+  RETURN NEW;
+END;
+$$;
+
+
+ALTER FUNCTION public.roles_prevent_cycle() OWNER TO postgres;
+
 SET default_tablespace = '';
 
 SET default_table_access_method = heap;
 
+--
+-- Name: audit_checkpoints; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.audit_checkpoints (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    sequence bigint NOT NULL,
+    hash character varying(64) NOT NULL,
+    signature text NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+ALTER TABLE public.audit_checkpoints OWNER TO postgres;
+
+--
+-- Name: audit_events; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.audit_events (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    action character varying(255) NOT NULL,
+    actor_id uuid,
+    target_type character varying(255) DEFAULT ''::character varying NOT NULL,
+    target_id character varying(255) DEFAULT ''::character varying NOT NULL,
+    status character varying(32) NOT NULL,
+    changes jsonb DEFAULT '{}'::jsonb NOT NULL,
+    metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
+    trace_id character varying(255) DEFAULT ''::character varying NOT NULL,
+    remote_addr character varying(255) DEFAULT ''::character varying NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    sequence bigint NOT NULL,
+    prev_hash character varying(64) DEFAULT ''::character varying NOT NULL,
+    hash character varying(64) DEFAULT ''::character varying NOT NULL
+);
+
+
+ALTER TABLE public.audit_events OWNER TO postgres;
+
+--
+-- Name: audit_events_sequence_seq; Type: SEQUENCE; Schema: public; Owner: postgres
+--
+
+ALTER TABLE public.audit_events ALTER COLUMN sequence ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.audit_events_sequence_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+--
+-- Name: invitations; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.invitations (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    identity_number character varying(20) NOT NULL,
+    personal_code character varying(20) NOT NULL,
+    role_ids uuid[] DEFAULT '{}'::uuid[] NOT NULL,
+    scope_ids uuid[] DEFAULT '{}'::uuid[] NOT NULL,
+    status public.invitation_status DEFAULT 'pending'::public.invitation_status NOT NULL,
+    invited_by uuid,
+    user_id uuid,
+    expires_at timestamp without time zone NOT NULL,
+    sent_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    accepted_at timestamp without time zone,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+ALTER TABLE public.invitations OWNER TO postgres;
+
+--
+-- Name: login_sessions; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.login_sessions (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    user_id uuid NOT NULL,
+    provider character varying(20) NOT NULL,
+    ip_address character varying(45),
+    user_agent character varying(512),
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    last_refreshed_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    revoked_at timestamp without time zone
+);
+
+
+ALTER TABLE public.login_sessions OWNER TO postgres;
+
+--
+-- Name: memberships; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.memberships (
+    user_id uuid NOT NULL,
+    organisation_id uuid NOT NULL,
+    role_id uuid NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+ALTER TABLE public.memberships OWNER TO postgres;
+
+--
+-- Name: organisations; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.organisations (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    name character varying(100) NOT NULL,
+    description text NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+ALTER TABLE public.organisations OWNER TO postgres;
+
 --
 -- Name: permissions; Type: TABLE; Schema: public; Owner: postgres
 --
@@ -61,6 +280,46 @@ CREATE TABLE public.permissions (
 
 ALTER TABLE public.permissions OWNER TO postgres;
 
+--
+-- Name: policies; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.policies (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    name character varying(100) NOT NULL,
+    description text DEFAULT ''::text NOT NULL,
+    actions text[] NOT NULL,
+    effect public.policy_effect NOT NULL,
+    condition text NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT policies_actions_not_empty CHECK ((cardinality(actions) > 0))
+);
+
+
+ALTER TABLE public.policies OWNER TO postgres;
+
+--
+-- Name: role_elevations; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.role_elevations (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    user_id uuid NOT NULL,
+    role_id uuid NOT NULL,
+    reason text NOT NULL,
+    duration_minutes integer NOT NULL,
+    status public.elevation_status DEFAULT 'pending'::public.elevation_status NOT NULL,
+    decided_by uuid,
+    decided_at timestamp without time zone,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT role_elevations_duration_positive CHECK ((duration_minutes > 0))
+);
+
+
+ALTER TABLE public.role_elevations OWNER TO postgres;
+
 --
 -- Name: role_permissions; Type: TABLE; Schema: public; Owner: postgres
 --
@@ -84,7 +343,9 @@ CREATE TABLE public.roles (
     name character varying(50) NOT NULL,
     description text NOT NULL,
     created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
-    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
+    parent_id uuid,
+    CONSTRAINT roles_parent_id_not_self CHECK ((parent_id <> id))
 );
 
 
@@ -116,12 +377,64 @@ CREATE TABLE public.tokens (
     value text NOT NULL,
     expires_at timestamp without time zone NOT NULL,
     created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    login_session_id uuid
 );
 
 
 ALTER TABLE public.tokens OWNER TO postgres;
 
+--
+-- Name: user_allowed_methods; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.user_allowed_methods (
+    user_id uuid NOT NULL,
+    method character varying(20) NOT NULL
+);
+
+
+ALTER TABLE public.user_allowed_methods OWNER TO postgres;
+
+--
+-- Name: user_authenticators; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.user_authenticators (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    user_id uuid NOT NULL,
+    method character varying(20) NOT NULL,
+    identifier character varying(255) NOT NULL,
+    metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
+    first_used_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    last_used_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+ALTER TABLE public.user_authenticators OWNER TO postgres;
+
+--
+-- Name: user_profiles; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.user_profiles (
+    user_id uuid NOT NULL,
+    email character varying(255),
+    email_verified_at timestamp without time zone,
+    email_verification_digest character varying(64),
+    email_verification_expires_at timestamp without time zone,
+    phone_number character varying(20),
+    locale character varying(10),
+    attributes jsonb DEFAULT '{}'::jsonb NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    email_verification_attempts integer DEFAULT 0 NOT NULL,
+    email_verification_sent_at timestamp without time zone
+);
+
+
+ALTER TABLE public.user_profiles OWNER TO postgres;
+
 --
 -- Name: user_roles; Type: TABLE; Schema: public; Owner: postgres
 --
@@ -130,7 +443,9 @@ CREATE TABLE public.user_roles (
     user_id uuid NOT NULL,
     role_id uuid NOT NULL,
     created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at timestamp without time zone,
+    granted_by uuid
 );
 
 
@@ -144,7 +459,9 @@ CREATE TABLE public.user_scopes (
     user_id uuid NOT NULL,
     scope_id uuid NOT NULL,
     created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at timestamp without time zone,
+    granted_by uuid
 );
 
 
@@ -162,6 +479,9 @@ CREATE TABLE public.users (
     last_name character varying(50) NOT NULL,
     created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
     updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    status public.user_status DEFAULT 'active'::public.user_status NOT NULL,
+    status_reason text,
+    status_changed_at timestamp without time zone,
     CONSTRAINT users_identity_number_not_empty CHECK ((length(TRIM(BOTH FROM identity_number)) > 10)),
     CONSTRAINT users_personal_code_not_empty CHECK ((length(TRIM(BOTH FROM personal_code)) > 10))
 );
@@ -169,6 +489,62 @@ CREATE TABLE public.users (
 
 ALTER TABLE public.users OWNER TO postgres;
 
+--
+-- Name: audit_checkpoints audit_checkpoints_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.audit_checkpoints
+    ADD CONSTRAINT audit_checkpoints_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: audit_events audit_events_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.audit_events
+    ADD CONSTRAINT audit_events_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: invitations invitations_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.invitations
+    ADD CONSTRAINT invitations_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: login_sessions login_sessions_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.login_sessions
+    ADD CONSTRAINT login_sessions_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: memberships memberships_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.memberships
+    ADD CONSTRAINT memberships_pkey PRIMARY KEY (user_id, organisation_id, role_id);
+
+
+--
+-- Name: organisations organisations_name_key; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.organisations
+    ADD CONSTRAINT organisations_name_key UNIQUE (name);
+
+
+--
+-- Name: organisations organisations_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.organisations
+    ADD CONSTRAINT organisations_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: permissions permissions_name_key; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -185,6 +561,30 @@ ALTER TABLE ONLY public.permissions
     ADD CONSTRAINT permissions_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: policies policies_name_key; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.policies
+    ADD CONSTRAINT policies_name_key UNIQUE (name);
+
+
+--
+-- Name: policies policies_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.policies
+    ADD CONSTRAINT policies_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: role_elevations role_elevations_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.role_elevations
+    ADD CONSTRAINT role_elevations_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: role_permissions role_permissions_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -233,6 +633,30 @@ ALTER TABLE ONLY public.tokens
     ADD CONSTRAINT tokens_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: user_allowed_methods user_allowed_methods_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.user_allowed_methods
+    ADD CONSTRAINT user_allowed_methods_pkey PRIMARY KEY (user_id, method);
+
+
+--
+-- Name: user_authenticators user_authenticators_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.user_authenticators
+    ADD CONSTRAINT user_authenticators_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: user_profiles user_profiles_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.user_profiles
+    ADD CONSTRAINT user_profiles_pkey PRIMARY KEY (user_id);
+
+
 --
 -- Name: user_roles user_roles_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -273,6 +697,97 @@ ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: audit_checkpoints_sequence_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE UNIQUE INDEX audit_checkpoints_sequence_idx ON public.audit_checkpoints USING btree (sequence);
+
+
+--
+-- Name: audit_events_action_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX audit_events_action_idx ON public.audit_events USING btree (action);
+
+
+--
+-- Name: audit_events_actor_id_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX audit_events_actor_id_idx ON public.audit_events USING btree (actor_id);
+
+
+--
+-- Name: audit_events_created_at_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX audit_events_created_at_idx ON public.audit_events USING btree (created_at);
+
+
+--
+-- Name: audit_events_sequence_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE UNIQUE INDEX audit_events_sequence_idx ON public.audit_events USING btree (sequence);
+
+
+--
+-- Name: audit_events_target_id_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX audit_events_target_id_idx ON public.audit_events USING btree (target_id);
+
+
+--
+-- Name: invitations_pending_identity_number_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE UNIQUE INDEX invitations_pending_identity_number_idx ON public.invitations USING btree (identity_number) WHERE (status = 'pending'::public.invitation_status);
+
+
+--
+-- Name: invitations_status_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX invitations_status_idx ON public.invitations USING btree (status);
+
+
+--
+-- Name: login_sessions_user_id_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX login_sessions_user_id_idx ON public.login_sessions USING btree (user_id);
+
+
+--
+-- Name: memberships_organisation_id_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX memberships_organisation_id_idx ON public.memberships USING btree (organisation_id);
+
+
+--
+-- Name: memberships_role_id_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX memberships_role_id_idx ON public.memberships USING btree (role_id);
+
+
+--
+-- Name: role_elevations_status_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX role_elevations_status_idx ON public.role_elevations USING btree (status);
+
+
+--
+-- Name: role_elevations_user_id_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX role_elevations_user_id_idx ON public.role_elevations USING btree (user_id);
+
+
 --
 -- Name: role_permissions_permission_id_idx; Type: INDEX; Schema: public; Owner: postgres
 --
@@ -287,6 +802,13 @@ CREATE INDEX role_permissions_permission_id_idx ON public.role_permissions USING
 CREATE INDEX role_permissions_role_id_idx ON public.role_permissions USING btree (role_id);
 
 
+--
+-- Name: roles_parent_id_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX roles_parent_id_idx ON public.roles USING btree (parent_id);
+
+
 --
 -- Name: tokens_expires_at_idx; Type: INDEX; Schema: public; Owner: postgres
 --
@@ -294,6 +816,13 @@ CREATE INDEX role_permissions_role_id_idx ON public.role_permissions USING btree
 CREATE INDEX tokens_expires_at_idx ON public.tokens USING btree (expires_at);
 
 
+--
+-- Name: tokens_login_session_id_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX tokens_login_session_id_idx ON public.tokens USING btree (login_session_id);
+
+
 --
 -- Name: tokens_user_id_idx; Type: INDEX; Schema: public; Owner: postgres
 --
@@ -301,6 +830,20 @@ CREATE INDEX tokens_expires_at_idx ON public.tokens USING btree (expires_at);
 CREATE INDEX tokens_user_id_idx ON public.tokens USING btree (user_id);
 
 
+--
+-- Name: user_authenticators_user_id_method_identifier_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE UNIQUE INDEX user_authenticators_user_id_method_identifier_idx ON public.user_authenticators USING btree (user_id, method, identifier);
+
+
+--
+-- Name: user_roles_expires_at_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX user_roles_expires_at_idx ON public.user_roles USING btree (expires_at) WHERE (expires_at IS NOT NULL);
+
+
 --
 -- Name: user_roles_role_id_idx; Type: INDEX; Schema: public; Owner: postgres
 --
@@ -315,6 +858,13 @@ CREATE INDEX user_roles_role_id_idx ON public.user_roles USING btree (role_id);
 CREATE INDEX user_roles_user_id_idx ON public.user_roles USING btree (user_id);
 
 
+--
+-- Name: user_scopes_expires_at_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX user_scopes_expires_at_idx ON public.user_scopes USING btree (expires_at) WHERE (expires_at IS NOT NULL);
+
+
 --
 -- Name: user_scopes_scope_id_idx; Type: INDEX; Schema: public; Owner: postgres
 --
@@ -329,6 +879,106 @@ CREATE INDEX user_scopes_scope_id_idx ON public.user_scopes USING btree (scope_i
 CREATE INDEX user_scopes_user_id_idx ON public.user_scopes USING btree (user_id);
 
 
+--
+-- Name: users_status_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX users_status_idx ON public.users USING btree (status) WHERE (status <> 'active'::public.user_status);
+
+
+--
+-- Name: audit_checkpoints audit_checkpoints_append_only; Type: TRIGGER; Schema: public; Owner: postgres
+--
+
+CREATE TRIGGER audit_checkpoints_append_only BEFORE DELETE OR UPDATE ON public.audit_checkpoints FOR EACH ROW EXECUTE FUNCTION public.audit_events_append_only();
+
+
+--
+-- Name: audit_events audit_events_append_only; Type: TRIGGER; Schema: public; Owner: postgres
+--
+
+CREATE TRIGGER audit_events_append_only BEFORE DELETE OR UPDATE ON public.audit_events FOR EACH ROW EXECUTE FUNCTION public.audit_events_append_only();
+
+
+--
+-- Name: roles roles_prevent_cycle; Type: TRIGGER; Schema: public; Owner: postgres
+--
+
+CREATE TRIGGER roles_prevent_cycle BEFORE INSERT OR UPDATE OF parent_id ON public.roles FOR EACH ROW EXECUTE FUNCTION public.roles_prevent_cycle();
+
+
+--
+-- Name: invitations invitations_invited_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.invitations
+    ADD CONSTRAINT invitations_invited_by_fkey FOREIGN KEY (invited_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: invitations invitations_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.invitations
+    ADD CONSTRAINT invitations_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: login_sessions login_sessions_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.login_sessions
+    ADD CONSTRAINT login_sessions_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: memberships memberships_organisation_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.memberships
+    ADD CONSTRAINT memberships_organisation_id_fkey FOREIGN KEY (organisation_id) REFERENCES public.organisations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: memberships memberships_role_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.memberships
+    ADD CONSTRAINT memberships_role_id_fkey FOREIGN KEY (role_id) REFERENCES public.roles(id) ON DELETE CASCADE;
+
+
+--
+-- Name: memberships memberships_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.memberships
+    ADD CONSTRAINT memberships_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: role_elevations role_elevations_decided_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.role_elevations
+    ADD CONSTRAINT role_elevations_decided_by_fkey FOREIGN KEY (decided_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: role_elevations role_elevations_role_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.role_elevations
+    ADD CONSTRAINT role_elevations_role_id_fkey FOREIGN KEY (role_id) REFERENCES public.roles(id) ON DELETE CASCADE;
+
+
+--
+-- Name: role_elevations role_elevations_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.role_elevations
+    ADD CONSTRAINT role_elevations_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- Name: role_permissions role_permissions_permission_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -345,6 +995,22 @@ ALTER TABLE ONLY public.role_permissions
     ADD CONSTRAINT role_permissions_role_id_fkey FOREIGN KEY (role_id) REFERENCES public.roles(id) ON DELETE CASCADE;
 
 
+--
+-- Name: roles roles_parent_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.roles
+    ADD CONSTRAINT roles_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES public.roles(id) ON DELETE SET NULL;
+
+
+--
+-- Name: tokens tokens_login_session_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.tokens
+    ADD CONSTRAINT tokens_login_session_id_fkey FOREIGN KEY (login_session_id) REFERENCES public.login_sessions(id) ON DELETE CASCADE;
+
+
 --
 -- Name: tokens tokens_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -353,6 +1019,38 @@ ALTER TABLE ONLY public.tokens
     ADD CONSTRAINT tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
+--
+-- Name: user_allowed_methods user_allowed_methods_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.user_allowed_methods
+    ADD CONSTRAINT user_allowed_methods_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: user_authenticators user_authenticators_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.user_authenticators
+    ADD CONSTRAINT user_authenticators_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: user_profiles user_profiles_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.user_profiles
+    ADD CONSTRAINT user_profiles_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: user_roles user_roles_granted_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.user_roles
+    ADD CONSTRAINT user_roles_granted_by_fkey FOREIGN KEY (granted_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
 --
 -- Name: user_roles user_roles_role_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -369,6 +1067,14 @@ ALTER TABLE ONLY public.user_roles
     ADD CONSTRAINT user_roles_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
+--
+-- Name: user_scopes user_scopes_granted_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.user_scopes
+    ADD CONSTRAINT user_scopes_granted_by_fkey FOREIGN KEY (granted_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
 --
 -- Name: user_scopes user_scopes_scope_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -0,0 +1,85 @@
+-- name: CreateAuditEvent :one
+INSERT INTO audit_events (id, action, actor_id, target_type, target_id, status, changes, metadata, trace_id, remote_addr, prev_hash, hash, created_at)
+VALUES (
+  @id,
+  @action,
+  NULLIF(@actor_id::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
+  @target_type,
+  @target_id,
+  @status,
+  @changes,
+  @metadata,
+  @trace_id,
+  @remote_addr,
+  @prev_hash,
+  @hash,
+  @created_at
+)
+RETURNING id, action, actor_id, target_type, target_id, status, changes, metadata, trace_id, remote_addr, created_at, sequence, prev_hash, hash;
+
+-- name: LockAuditChain :exec
+SELECT pg_advisory_xact_lock(hashtext('audit_events'));
+
+-- name: FindLastAuditEvent :one
+SELECT id, action, actor_id, target_type, target_id, status, changes, metadata, trace_id, remote_addr, created_at, sequence, prev_hash, hash
+FROM audit_events
+ORDER BY sequence DESC
+LIMIT 1;
+
+-- name: FindAuditEventsAfter :many
+SELECT id, action, actor_id, target_type, target_id, status, changes, metadata, trace_id, remote_addr, created_at, sequence, prev_hash, hash
+FROM audit_events
+WHERE sequence > @after_sequence::bigint
+ORDER BY sequence
+LIMIT @page_limit::bigint;
+
+-- name: FindAuditEvents :many
+WITH filtered AS (
+  SELECT id, action, actor_id, target_type, target_id, status, changes, metadata, trace_id, remote_addr, created_at, sequence, prev_hash, hash
+  FROM audit_events
+  WHERE (@action::varchar = '' OR action = @action::varchar)
+    AND (@actor_id::uuid = '00000000-0000-0000-0000-000000000000'::uuid OR actor_id = @actor_id::uuid)
+    AND (@target_id::varchar = '' OR target_id = @target_id::varchar)
+    AND (sqlc.narg('created_from')::timestamp IS NULL OR created_at >= sqlc.narg('created_from')::timestamp)
+    AND (sqlc.narg('created_to')::timestamp IS NULL OR created_at < sqlc.narg('created_to')::timestamp)
+),
+counter AS (
+  SELECT COUNT(*) AS total
+  FROM filtered
+)
+SELECT
+  e.id,
+  e.action,
+  e.actor_id,
+  e.target_type,
+  e.target_id,
+  e.status,
+  e.changes,
+  e.metadata,
+  e.trace_id,
+  e.remote_addr,
+  e.created_at,
+  e.sequence,
+  e.prev_hash,
+  e.hash,
+  counter.total
+FROM filtered AS e
+RIGHT JOIN counter ON TRUE
+ORDER BY e.sequence DESC LIMIT @page_limit::bigint OFFSET @page_offset::bigint;
+
+-- name: CreateAuditCheckpoint :one
+INSERT INTO audit_checkpoints (sequence, hash, signature)
+VALUES (@sequence, @hash, @signature)
+RETURNING id, sequence, hash, signature, created_at;
+
+-- name: FindLastAuditCheckpoint :one
+SELECT id, sequence, hash, signature, created_at
+FROM audit_checkpoints
+ORDER BY sequence DESC
+LIMIT 1;
+
+-- name: FindAuditCheckpoints :many
+SELECT id, sequence, hash, signature, created_at
+FROM audit_checkpoints
+WHERE sequence > @after_sequence::bigint AND sequence <= @until_sequence::bigint
+ORDER BY sequence;
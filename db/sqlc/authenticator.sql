@@ -0,0 +1,28 @@
+-- name: ListUserAuthenticators :many
+SELECT id, user_id, method, identifier, metadata, first_used_at, last_used_at
+FROM user_authenticators
+WHERE user_id = $1
+ORDER BY last_used_at DESC;
+
+-- name: UpsertUserAuthenticator :one
+INSERT INTO user_authenticators (user_id, method, identifier, metadata)
+VALUES (@user_id::uuid, @method::varchar, @identifier::varchar, @metadata::jsonb)
+ON CONFLICT (user_id, method, identifier) DO UPDATE
+SET
+  metadata = EXCLUDED.metadata,
+  last_used_at = NOW()
+RETURNING id, user_id, method, identifier, metadata, first_used_at, last_used_at;
+
+-- name: FindUserAllowedMethods :many
+SELECT method
+FROM user_allowed_methods
+WHERE user_id = $1
+ORDER BY method;
+
+-- name: CreateUserAllowedMethods :exec
+INSERT INTO user_allowed_methods (user_id, method)
+SELECT @user_id::uuid, unnest(@methods::varchar[]);
+
+-- name: DeleteUserAllowedMethods :exec
+DELETE FROM user_allowed_methods
+WHERE user_id = $1;
@@ -0,0 +1,37 @@
+-- name: FindElevations :many
+WITH counter AS (
+  SELECT COUNT(*) AS total
+  FROM role_elevations
+)
+SELECT
+  e.id,
+  e.user_id,
+  e.role_id,
+  e.reason,
+  e.duration_minutes,
+  e.status,
+  e.decided_by,
+  counter.total
+FROM role_elevations AS e
+RIGHT JOIN counter ON TRUE
+ORDER BY e.created_at DESC LIMIT $1::bigint OFFSET $2::bigint;
+
+-- name: CreateElevation :one
+INSERT INTO role_elevations (user_id, role_id, reason, duration_minutes)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, role_id, reason, duration_minutes, status, decided_by;
+
+-- name: FindElevationById :one
+SELECT id, user_id, role_id, reason, duration_minutes, status, decided_by
+FROM role_elevations
+WHERE id = $1;
+
+-- name: DecideElevation :one
+UPDATE role_elevations
+SET
+  status = @status::elevation_status,
+  decided_by = @decided_by::uuid,
+  decided_at = NOW(),
+  updated_at = NOW()
+WHERE id = @id::uuid AND status = 'pending'
+RETURNING id, user_id, role_id, reason, duration_minutes, status, decided_by;
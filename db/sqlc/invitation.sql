@@ -0,0 +1,69 @@
+-- name: FindInvitations :many
+WITH counter AS (
+  SELECT COUNT(*) AS total
+  FROM invitations
+)
+SELECT
+  i.id,
+  i.identity_number,
+  i.personal_code,
+  i.role_ids,
+  i.scope_ids,
+  i.status,
+  i.invited_by,
+  i.user_id,
+  i.expires_at,
+  i.sent_at,
+  i.accepted_at,
+  counter.total
+FROM invitations AS i
+RIGHT JOIN counter ON TRUE
+ORDER BY i.created_at DESC LIMIT $1::bigint OFFSET $2::bigint;
+
+-- name: CreateInvitation :one
+INSERT INTO invitations (identity_number, personal_code, role_ids, scope_ids, invited_by, expires_at)
+VALUES (@identity_number, @personal_code, @role_ids::uuid[], @scope_ids::uuid[], @invited_by::uuid, @expires_at)
+ON CONFLICT (identity_number) WHERE status = 'pending' DO NOTHING
+RETURNING id, identity_number, personal_code, role_ids, scope_ids, status, invited_by, user_id, expires_at, sent_at, accepted_at;
+
+-- name: ExpireInvitations :exec
+UPDATE invitations
+SET
+  status = 'expired',
+  updated_at = NOW()
+WHERE identity_number = @identity_number AND status = 'pending' AND expires_at <= NOW();
+
+-- name: FindInvitationById :one
+SELECT id, identity_number, personal_code, role_ids, scope_ids, status, invited_by, user_id, expires_at, sent_at, accepted_at
+FROM invitations
+WHERE id = $1;
+
+-- name: ExtendInvitation :one
+UPDATE invitations
+SET
+  expires_at = @expires_at,
+  updated_at = NOW()
+WHERE id = @id::uuid AND status = 'pending'
+RETURNING id, identity_number, personal_code, role_ids, scope_ids, status, invited_by, user_id, expires_at, sent_at, accepted_at;
+
+-- name: RevokeInvitation :one
+UPDATE invitations
+SET
+  status = 'revoked',
+  updated_at = NOW()
+WHERE id = @id::uuid AND status = 'pending'
+RETURNING id, identity_number, personal_code, role_ids, scope_ids, status, invited_by, user_id, expires_at, sent_at, accepted_at;
+
+-- name: AcceptInvitation :one
+UPDATE invitations
+SET
+  status = 'accepted',
+  user_id = @user_id::uuid,
+  accepted_at = NOW(),
+  updated_at = NOW()
+WHERE identity_number = @identity_number AND status = 'pending' AND expires_at > NOW()
+RETURNING
+  id,
+  invited_by,
+  ARRAY(SELECT r.id FROM roles AS r WHERE r.id = ANY(invitations.role_ids))::uuid[] AS role_ids,
+  ARRAY(SELECT s.id FROM scopes AS s WHERE s.id = ANY(invitations.scope_ids))::uuid[] AS scope_ids;
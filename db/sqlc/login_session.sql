@@ -0,0 +1,34 @@
+-- name: CreateLoginSession :one
+INSERT INTO login_sessions (user_id, provider, ip_address, user_agent)
+VALUES (@user_id::uuid, @provider::varchar, @ip_address, @user_agent)
+RETURNING id, user_id, provider, ip_address, user_agent, created_at, last_refreshed_at, revoked_at;
+
+-- name: FindActiveLoginSession :one
+SELECT id, user_id, provider, ip_address, user_agent, created_at, last_refreshed_at, revoked_at
+FROM login_sessions
+WHERE id = @id::uuid AND user_id = @user_id::uuid AND revoked_at IS NULL;
+
+-- name: ListLoginSessions :many
+SELECT id, user_id, provider, ip_address, user_agent, created_at, last_refreshed_at, revoked_at
+FROM login_sessions
+WHERE user_id = @user_id::uuid
+ORDER BY created_at DESC
+LIMIT @page_limit::bigint;
+
+-- name: ListActiveLoginSessions :many
+SELECT id, user_id, provider, ip_address, user_agent, created_at, last_refreshed_at, revoked_at
+FROM login_sessions
+WHERE user_id = @user_id::uuid AND revoked_at IS NULL AND last_refreshed_at > @since::timestamp
+ORDER BY last_refreshed_at DESC;
+
+-- name: RefreshLoginSession :one
+UPDATE login_sessions
+SET last_refreshed_at = NOW()
+WHERE id = @id::uuid AND user_id = @user_id::uuid AND revoked_at IS NULL
+RETURNING id, user_id, provider, ip_address, user_agent, created_at, last_refreshed_at, revoked_at;
+
+-- name: RevokeLoginSession :one
+UPDATE login_sessions
+SET revoked_at = NOW()
+WHERE id = @id::uuid AND user_id = @user_id::uuid AND revoked_at IS NULL
+RETURNING id;
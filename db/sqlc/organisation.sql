@@ -0,0 +1,54 @@
+-- name: FindOrganisations :many
+WITH counter AS (
+  SELECT COUNT(*) AS total
+  FROM organisations
+)
+SELECT
+  o.id,
+  o.name,
+  o.description,
+  counter.total
+FROM organisations AS o
+RIGHT JOIN counter ON TRUE
+ORDER BY o.created_at DESC LIMIT $1::bigint OFFSET $2::bigint;
+
+-- name: CreateOrganisation :one
+INSERT INTO organisations (name, description)
+VALUES ($1, $2)
+RETURNING id, name, description;
+
+-- name: FindOrganisationById :one
+SELECT id, name, description FROM organisations WHERE id = $1;
+
+-- name: UpdateOrganisation :one
+UPDATE organisations
+SET
+  name = $2,
+  description = $3,
+  updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, description;
+
+-- name: DeleteOrganisation :exec
+DELETE FROM organisations WHERE id = $1;
+
+-- name: FindOrganisationMemberships :many
+SELECT user_id, organisation_id, role_id
+FROM memberships
+WHERE organisation_id = $1
+ORDER BY created_at;
+
+-- name: CreateMembership :one
+INSERT INTO memberships (user_id, organisation_id, role_id)
+VALUES ($1, $2, $3)
+  ON CONFLICT (user_id, organisation_id, role_id) DO UPDATE SET updated_at = NOW()
+RETURNING user_id, organisation_id, role_id;
+
+-- name: DeleteMembership :execrows
+DELETE FROM memberships WHERE user_id = $1 AND organisation_id = $2 AND role_id = $3;
+
+-- name: FindUserMembershipRoles :many
+SELECT m.organisation_id, r.name
+FROM memberships m
+JOIN roles r ON r.id = m.role_id
+WHERE m.user_id = $1;
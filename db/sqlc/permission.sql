@@ -47,7 +47,37 @@ WITH
   )
 SELECT role_id, permission_id FROM inserted;
 
--- name: FindUserPermissions :many
-SELECT id, name FROM permissions WHERE id IN (
-  SELECT permission_id FROM role_permissions WHERE role_id IN (
-    SELECT role_id FROM user_roles WHERE user_id = $1));
+-- name: FindUserPermissionPaths :many
+WITH RECURSIVE assigned_roles AS (
+  SELECT ur.role_id, ur.expires_at, '00000000-0000-0000-0000-000000000000'::uuid AS organisation_id
+  FROM user_roles ur
+  WHERE ur.user_id = $1 AND (ur.expires_at IS NULL OR ur.expires_at > NOW())
+  UNION ALL
+  SELECT m.role_id, NULL::timestamp AS expires_at, m.organisation_id
+  FROM memberships m
+  WHERE m.user_id = $1
+), user_role_tree AS (
+  SELECT r.id, r.parent_id, ARRAY[r.id]::uuid[] AS role_ids, ARRAY[r.name]::text[] AS role_names, a.expires_at, a.organisation_id
+  FROM roles r
+  JOIN assigned_roles a ON a.role_id = r.id
+  UNION ALL
+  SELECT r.id, r.parent_id, t.role_ids || r.id, t.role_names || r.name::text, t.expires_at, t.organisation_id
+  FROM roles r
+  JOIN user_role_tree t ON r.id = t.parent_id
+  WHERE NOT r.id = ANY(t.role_ids)
+)
+SELECT p.id, p.name, t.role_ids::uuid[] AS role_ids, t.role_names::text[] AS role_names, t.expires_at, t.organisation_id::uuid AS organisation_id
+FROM user_role_tree t
+JOIN role_permissions rp ON rp.role_id = t.id
+JOIN permissions p ON p.id = rp.permission_id
+ORDER BY p.name, t.organisation_id, array_length(t.role_ids, 1), t.role_names;
+
+-- name: AddRolePermissions :exec
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT @role_id::uuid, permission_id
+FROM unnest(@permission_ids::uuid[]) AS permission_id
+ON CONFLICT (role_id, permission_id) DO NOTHING;
+
+-- name: RemoveRolePermissions :exec
+DELETE FROM role_permissions
+WHERE role_id = @role_id::uuid AND permission_id = ANY(@permission_ids::uuid[]);
@@ -0,0 +1,4 @@
+-- name: FindPolicies :many
+SELECT id, name, description, actions, effect, condition
+FROM policies
+ORDER BY name;
@@ -0,0 +1,67 @@
+-- name: FindUserProfile :one
+SELECT user_id, email, email_verified_at, phone_number, locale, attributes
+FROM user_profiles
+WHERE user_id = $1;
+
+-- name: UpsertUserProfile :one
+INSERT INTO user_profiles (user_id, locale, attributes)
+VALUES (@user_id::uuid, @locale, @attributes::jsonb)
+ON CONFLICT (user_id) DO UPDATE
+SET
+  locale = EXCLUDED.locale,
+  attributes = EXCLUDED.attributes,
+  updated_at = NOW()
+RETURNING user_id, email, email_verified_at, phone_number, locale, attributes;
+
+-- name: RecordUserEmailVerificationAttempt :one
+UPDATE user_profiles
+SET email_verification_attempts = email_verification_attempts + 1
+WHERE user_id = @user_id::uuid AND email_verification_digest IS NOT NULL
+RETURNING email_verification_attempts;
+
+-- name: UpsertUserEmail :execrows
+INSERT INTO user_profiles (user_id, email, email_verification_digest, email_verification_expires_at, email_verification_sent_at)
+VALUES (
+  @user_id::uuid,
+  @email,
+  @email_verification_digest,
+  CASE WHEN @email_verification_digest IS NULL THEN NULL ELSE NOW() + make_interval(secs => @email_verification_ttl_seconds::int) END,
+  CASE WHEN @email_verification_digest IS NULL THEN NULL ELSE NOW() END
+)
+ON CONFLICT (user_id) DO UPDATE
+SET
+  email = EXCLUDED.email,
+  email_verified_at = NULL,
+  email_verification_digest = EXCLUDED.email_verification_digest,
+  email_verification_expires_at = EXCLUDED.email_verification_expires_at,
+  email_verification_sent_at = COALESCE(EXCLUDED.email_verification_sent_at, user_profiles.email_verification_sent_at),
+  email_verification_attempts = CASE
+    WHEN user_profiles.email IS DISTINCT FROM EXCLUDED.email THEN 0
+    ELSE user_profiles.email_verification_attempts
+  END,
+  updated_at = NOW()
+WHERE EXCLUDED.email_verification_digest IS NULL
+  OR user_profiles.email_verification_sent_at IS NULL
+  OR user_profiles.email_verification_sent_at <= NOW() - make_interval(secs => @email_verification_cooldown_seconds::int);
+
+-- name: UpsertUserPhoneNumber :exec
+INSERT INTO user_profiles (user_id, phone_number)
+VALUES (@user_id::uuid, @phone_number::varchar)
+ON CONFLICT (user_id) DO UPDATE
+SET
+  phone_number = EXCLUDED.phone_number,
+  updated_at = NOW();
+
+-- name: VerifyUserEmail :one
+UPDATE user_profiles
+SET
+  email_verified_at = NOW(),
+  email_verification_digest = NULL,
+  email_verification_expires_at = NULL,
+  email_verification_attempts = 0,
+  updated_at = NOW()
+WHERE user_id = @user_id::uuid
+  AND email_verification_digest = @digest::varchar
+  AND email_verification_expires_at > NOW()
+  AND email_verification_attempts <= @max_attempts::int
+RETURNING user_id, email, email_verified_at, phone_number, locale, attributes;
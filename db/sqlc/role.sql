@@ -7,53 +7,88 @@ SELECT
   r.id,
   r.name,
   r.description,
+  r.parent_id,
   counter.total
 FROM roles AS r
 RIGHT JOIN counter ON TRUE
 ORDER BY r.created_at DESC LIMIT $1::bigint OFFSET $2::bigint;
 
 -- name: CreateRole :one
-INSERT INTO roles (name, description)
-VALUES ($1, $2)
-  RETURNING id, name, description;
+INSERT INTO roles (name, description, parent_id)
+VALUES (@name, @description, NULLIF(@parent_id::uuid, '00000000-0000-0000-0000-000000000000'::uuid))
+  RETURNING id, name, description, parent_id;
 
 -- name: FindRoleById :one
-SELECT id, name, description FROM roles WHERE id = $1;
+SELECT id, name, description, parent_id FROM roles WHERE id = $1;
+
+-- name: FindRoleAncestorIds :many
+WITH RECURSIVE ancestors AS (
+  SELECT r.id, r.parent_id FROM roles r WHERE r.id = $1
+  UNION
+  SELECT r.id, r.parent_id FROM roles r JOIN ancestors a ON r.id = a.parent_id
+)
+SELECT id FROM ancestors;
 
 -- name: FindRoleByName :one
 SELECT id, name FROM roles WHERE name = $1;
 
 -- name: FindRoleDetailsById :one
+WITH RECURSIVE ancestors AS (
+  SELECT r.parent_id AS id FROM roles r WHERE r.id = @id::uuid AND r.parent_id IS NOT NULL
+  UNION
+  SELECT r.parent_id FROM roles r JOIN ancestors a ON r.id = a.id WHERE r.parent_id IS NOT NULL
+)
 SELECT
   r.id,
   r.name,
   r.description,
-  COALESCE(rp.permissions, ARRAY[]::uuid[]) AS permission_ids
-FROM roles r
-  LEFT JOIN (
-    SELECT
-      rp.role_id,
-      ARRAY_AGG(rp.permission_id) AS permissions
+  r.parent_id,
+  COALESCE((
+    SELECT ARRAY_AGG(rp.permission_id)
+    FROM role_permissions rp
+    WHERE rp.role_id = r.id
+  ), ARRAY[]::uuid[])::uuid[] AS permission_ids,
+  COALESCE((
+    SELECT ARRAY_AGG(DISTINCT rp.permission_id)
     FROM role_permissions rp
-    GROUP BY rp.role_id
-  ) rp ON r.id = rp.role_id
+    WHERE rp.role_id IN (SELECT id FROM ancestors)
+      AND rp.permission_id NOT IN (SELECT permission_id FROM role_permissions WHERE role_id = r.id)
+  ), ARRAY[]::uuid[])::uuid[] AS inherited_permission_ids
+FROM roles r
 WHERE
-  r.id = $1;
+  r.id = @id::uuid;
+
+-- name: FindRolePermissionNames :many
+WITH RECURSIVE lineage AS (
+  SELECT r.id, r.parent_id FROM roles r WHERE r.id = $1
+  UNION
+  SELECT r.id, r.parent_id FROM roles r JOIN lineage l ON r.id = l.parent_id
+)
+SELECT DISTINCT p.name
+FROM role_permissions rp
+JOIN permissions p ON p.id = rp.permission_id
+WHERE rp.role_id IN (SELECT id FROM lineage)
+ORDER BY p.name;
 
 -- name: UpdateRole :one
 UPDATE roles
 SET
-  name = $2,
-  description = $3,
+  name = CASE WHEN @update_name::bool THEN @name::text ELSE name END,
+  description = CASE WHEN @update_description::bool THEN @description::text ELSE description END,
+  parent_id = CASE
+    WHEN @update_parent_id::bool THEN NULLIF(@parent_id::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
+    ELSE parent_id
+  END,
   updated_at = NOW()
-WHERE id = $1
-RETURNING id, name, description;
+WHERE id = @id
+RETURNING id, name, description, parent_id;
 
 -- name: DeleteRole :exec
 DELETE FROM roles WHERE id = $1;
 
 -- name: FindUserRoles :many
-SELECT id, name FROM roles WHERE id IN (SELECT role_id FROM user_roles WHERE user_id = $1);
+SELECT id, name FROM roles WHERE id IN (
+  SELECT role_id FROM user_roles WHERE user_id = $1 AND (expires_at IS NULL OR expires_at > NOW()));
 
 -- name: CreateUserRole :one
 INSERT INTO user_roles (user_id, role_id)
@@ -68,10 +103,13 @@ WITH
     WHERE user_id = @user_id::uuid AND role_id NOT IN (SELECT unnest(@role_ids::uuid[]))
   ),
   inserted AS (
-    INSERT INTO user_roles (user_id, role_id)
-    SELECT @user_id::uuid, role_id
+    INSERT INTO user_roles (user_id, role_id, granted_by)
+    SELECT @user_id::uuid, role_id, NULLIF(@granted_by::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
     FROM unnest(@role_ids::uuid[]) AS role_id
-    ON CONFLICT (user_id, role_id) DO NOTHING
+    ON CONFLICT (user_id, role_id) DO UPDATE SET
+      expires_at = NULL,
+      granted_by = EXCLUDED.granted_by,
+      updated_at = NOW()
       RETURNING user_id, role_id
   )
 SELECT user_id, role_id FROM inserted;
@@ -82,3 +120,33 @@ VALUES ($1, (SELECT id FROM roles WHERE name = $2))
   ON CONFLICT (user_id, role_id) DO UPDATE SET role_id = EXCLUDED.role_id, user_id = EXCLUDED.user_id
 RETURNING user_id, role_id;
 
+
+-- name: GrantUserRole :one
+INSERT INTO user_roles (user_id, role_id, expires_at, granted_by)
+VALUES (@user_id::uuid, @role_id::uuid, NOW() + make_interval(mins => @duration_minutes::int), NULLIF(@granted_by::uuid, '00000000-0000-0000-0000-000000000000'::uuid))
+  ON CONFLICT (user_id, role_id) DO UPDATE SET
+    expires_at = CASE WHEN user_roles.expires_at IS NULL THEN NULL ELSE GREATEST(user_roles.expires_at, EXCLUDED.expires_at) END,
+    granted_by = CASE WHEN user_roles.expires_at IS NULL THEN user_roles.granted_by ELSE EXCLUDED.granted_by END,
+    updated_at = NOW()
+RETURNING user_id, role_id, expires_at, granted_by;
+
+-- name: DeleteExpiredUserRoles :many
+DELETE FROM user_roles
+WHERE expires_at IS NOT NULL AND expires_at <= NOW()
+RETURNING user_id, role_id, expires_at, granted_by;
+
+-- name: AddUserRoles :exec
+INSERT INTO user_roles (user_id, role_id, granted_by)
+SELECT @user_id::uuid, role_id, NULLIF(@granted_by::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
+FROM unnest(@role_ids::uuid[]) AS role_id
+ON CONFLICT (user_id, role_id) DO UPDATE SET
+  expires_at = NULL,
+  granted_by = EXCLUDED.granted_by,
+  updated_at = NOW();
+
+-- name: RemoveUserRoles :exec
+DELETE FROM user_roles
+WHERE user_id = @user_id::uuid AND role_id = ANY(@role_ids::uuid[]);
+
+-- name: FindRolesByNames :many
+SELECT id, name FROM roles WHERE name = ANY(@names::text[]);
@@ -48,10 +48,13 @@ WITH
     WHERE user_id = @user_id::uuid AND scope_id NOT IN (SELECT unnest(@scope_ids::uuid[]))
   ),
   inserted AS (
-    INSERT INTO user_scopes (user_id, scope_id)
-    SELECT @user_id::uuid, scope_id
+    INSERT INTO user_scopes (user_id, scope_id, granted_by)
+    SELECT @user_id::uuid, scope_id, NULLIF(@granted_by::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
     FROM unnest(@scope_ids::uuid[]) AS scope_id
-    ON CONFLICT (user_id, scope_id) DO NOTHING
+    ON CONFLICT (user_id, scope_id) DO UPDATE SET
+      expires_at = NULL,
+      granted_by = EXCLUDED.granted_by,
+      updated_at = NOW()
       RETURNING user_id, scope_id
   )
 SELECT user_id, scope_id FROM inserted;
@@ -63,4 +66,26 @@ VALUES ($1, (SELECT id FROM scopes WHERE name = $2))
 RETURNING user_id, scope_id;
 
 -- name: FindUserScopes :many
-SELECT id, name FROM scopes WHERE id IN (SELECT scope_id FROM user_scopes WHERE user_id = $1);
+SELECT id, name FROM scopes WHERE id IN (
+  SELECT scope_id FROM user_scopes WHERE user_id = $1 AND (expires_at IS NULL OR expires_at > NOW()));
+
+-- name: DeleteExpiredUserScopes :many
+DELETE FROM user_scopes
+WHERE expires_at IS NOT NULL AND expires_at <= NOW()
+RETURNING user_id, scope_id, expires_at, granted_by;
+
+-- name: AddUserScopes :exec
+INSERT INTO user_scopes (user_id, scope_id, granted_by)
+SELECT @user_id::uuid, scope_id, NULLIF(@granted_by::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
+FROM unnest(@scope_ids::uuid[]) AS scope_id
+ON CONFLICT (user_id, scope_id) DO UPDATE SET
+  expires_at = NULL,
+  granted_by = EXCLUDED.granted_by,
+  updated_at = NOW();
+
+-- name: RemoveUserScopes :exec
+DELETE FROM user_scopes
+WHERE user_id = @user_id::uuid AND scope_id = ANY(@scope_ids::uuid[]);
+
+-- name: FindScopesByNames :many
+SELECT id, name FROM scopes WHERE name = ANY(@names::text[]);
@@ -22,10 +22,10 @@ VALUES ($1, $2, $3, $4)
   RETURNING id, type, value, expires_at;
 
 -- name: CreateTokens :many
-INSERT INTO tokens (user_id, type, value, expires_at)
+INSERT INTO tokens (user_id, type, value, expires_at, login_session_id)
 VALUES
-  (@user_id::uuid, 'access_token'::token_type, @access_token_value::text, @access_token_expires_at::timestamp),
-  (@user_id::uuid, 'refresh_token'::token_type, @refresh_token_value::text, @refresh_token_expires_at::timestamp)
+  (@user_id::uuid, 'access_token'::token_type, @access_token_value::text, @access_token_expires_at::timestamp, NULLIF(@login_session_id::uuid, '00000000-0000-0000-0000-000000000000'::uuid)),
+  (@user_id::uuid, 'refresh_token'::token_type, @refresh_token_value::text, @refresh_token_expires_at::timestamp, NULLIF(@login_session_id::uuid, '00000000-0000-0000-0000-000000000000'::uuid))
   RETURNING id, type, value, expires_at;
 
 -- name: FindTokenById :one
@@ -33,3 +33,9 @@ SELECT id, user_id, type, value, expires_at FROM tokens WHERE id = $1;
 
 -- name: DeleteToken :exec
 DELETE FROM tokens WHERE id = $1;
+
+-- name: DeleteTokensByUserId :exec
+DELETE FROM tokens WHERE user_id = $1;
+
+-- name: DeleteTokensByLoginSessionId :exec
+DELETE FROM tokens WHERE login_session_id = $1;
@@ -9,6 +9,9 @@ SELECT
   u.personal_code,
   u.first_name,
   u.last_name,
+  u.status,
+  u.status_reason,
+  u.status_changed_at,
   counter.total
 FROM users AS u
 RIGHT JOIN counter ON TRUE
@@ -18,13 +21,13 @@ ORDER BY u.created_at DESC LIMIT $1::bigint OFFSET $2::bigint;
 INSERT INTO users (identity_number, personal_code, first_name, last_name)
 VALUES ($1, $2, $3, $4)
   ON CONFLICT (identity_number) DO UPDATE SET first_name = EXCLUDED.first_name, last_name = EXCLUDED.last_name
-RETURNING id, identity_number, personal_code, first_name, last_name;
+RETURNING id, identity_number, personal_code, first_name, last_name, status, status_reason, status_changed_at;
 
 -- name: FindUserById :one
-SELECT id, identity_number, personal_code, first_name, last_name FROM users WHERE id = $1;
+SELECT id, identity_number, personal_code, first_name, last_name, status, status_reason, status_changed_at FROM users WHERE id = $1;
 
 -- name: FindUserByIdentityNumber :one
-SELECT id, identity_number, personal_code, first_name, last_name FROM users WHERE identity_number = $1;
+SELECT id, identity_number, personal_code, first_name, last_name, status, status_reason, status_changed_at FROM users WHERE identity_number = $1;
 
 -- name: FindUserDetailsById :one
 SELECT
@@ -33,6 +36,9 @@ SELECT
   u.personal_code,
   u.first_name,
   u.last_name,
+  u.status,
+  u.status_reason,
+  u.status_changed_at,
   COALESCE(ur.roles, ARRAY[]::uuid[]) AS role_ids,
   COALESCE(us.scopes, ARRAY[]::uuid[]) AS scope_ids
 FROM users u
@@ -41,6 +47,7 @@ FROM users u
       ur.user_id,
       ARRAY_AGG(ur.role_id) AS roles
     FROM user_roles ur
+    WHERE ur.expires_at IS NULL OR ur.expires_at > NOW()
     GROUP BY ur.user_id
   ) ur ON u.id = ur.user_id
   LEFT JOIN (
@@ -48,6 +55,7 @@ FROM users u
       us.user_id,
       ARRAY_AGG(us.scope_id) AS scopes
     FROM user_scopes us
+    WHERE us.expires_at IS NULL OR us.expires_at > NOW()
     GROUP BY us.user_id
   ) us ON u.id = us.user_id
 WHERE
@@ -56,13 +64,67 @@ WHERE
 -- name: UpdateUser :one
 UPDATE users
 SET
-  identity_number = $2,
-  personal_code = $3,
-  first_name = $4,
-  last_name = $5,
+  identity_number = CASE WHEN @update_identity_number::bool THEN @identity_number::text ELSE identity_number END,
+  personal_code = CASE WHEN @update_personal_code::bool THEN @personal_code::text ELSE personal_code END,
+  first_name = CASE WHEN @update_first_name::bool THEN @first_name::text ELSE first_name END,
+  last_name = CASE WHEN @update_last_name::bool THEN @last_name::text ELSE last_name END,
   updated_at = NOW()
-WHERE id = $1
-RETURNING id, identity_number, personal_code, first_name, last_name;
+WHERE id = @id
+RETURNING id, identity_number, personal_code, first_name, last_name, status, status_reason, status_changed_at;
+
+-- name: UpdateUserStatus :one
+UPDATE users
+SET
+  status = @status,
+  status_reason = @status_reason,
+  status_changed_at = NOW(),
+  updated_at = NOW()
+WHERE id = @id
+RETURNING id, identity_number, personal_code, first_name, last_name, status, status_reason, status_changed_at;
 
 -- name: DeleteUser :exec
 DELETE FROM users WHERE id = $1;
+
+-- name: UpsertUser :one
+INSERT INTO users (identity_number, personal_code, first_name, last_name)
+VALUES (@identity_number, @personal_code, @first_name, @last_name)
+  ON CONFLICT (identity_number) DO UPDATE SET
+    personal_code = EXCLUDED.personal_code,
+    first_name = EXCLUDED.first_name,
+    last_name = EXCLUDED.last_name,
+    updated_at = NOW()
+RETURNING id, identity_number, personal_code, first_name, last_name;
+
+-- name: FindUsersByIdentityNumbers :many
+SELECT id, identity_number, personal_code, first_name, last_name FROM users WHERE identity_number = ANY(@identity_numbers::text[]);
+
+-- name: FindUserRecords :many
+SELECT
+  u.identity_number,
+  u.personal_code,
+  u.first_name,
+  u.last_name,
+  COALESCE(ur.names, ARRAY[]::text[])::text[] AS role_names,
+  COALESCE(us.names, ARRAY[]::text[])::text[] AS scope_names
+FROM users u
+  LEFT JOIN (
+    SELECT
+      ur.user_id,
+      ARRAY_AGG(r.name::text ORDER BY r.name) AS names
+    FROM user_roles ur
+      JOIN roles r ON r.id = ur.role_id
+    WHERE ur.expires_at IS NULL
+    GROUP BY ur.user_id
+  ) ur ON u.id = ur.user_id
+  LEFT JOIN (
+    SELECT
+      us.user_id,
+      ARRAY_AGG(s.name::text ORDER BY s.name) AS names
+    FROM user_scopes us
+      JOIN scopes s ON s.id = us.scope_id
+    WHERE us.expires_at IS NULL
+    GROUP BY us.user_id
+  ) us ON u.id = us.user_id
+WHERE u.identity_number > @after_identity_number::text
+ORDER BY u.identity_number
+LIMIT @page_limit::bigint;
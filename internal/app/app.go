@@ -16,21 +16,31 @@ import (
 	"loki/internal/app/services/authentication"
 	"loki/internal/app/workers"
 	"loki/internal/config"
+	"loki/internal/config/locales"
 	"loki/internal/config/logger"
 	"loki/internal/config/middlewares"
 	"loki/internal/config/router"
 	"loki/internal/config/server"
 	"loki/internal/config/telemetry"
+	"loki/pkg/geoip"
 	"loki/pkg/jwt"
+	"loki/pkg/mailer"
+	"loki/pkg/signing"
+	"loki/pkg/webhook"
 )
 
 var Module = fx.Options(
 	logger.Module,
+	locales.Module,
 
 	authentication.Module,
 	controllers.Module,
 	repositories.Module,
+	geoip.Module,
 	jwt.Module,
+	mailer.Module,
+	signing.Module,
+	webhook.Module,
 	services.Module,
 	workers.Module,
 
@@ -44,6 +54,7 @@ var Module = fx.Options(
 
 	fx.Invoke(registerWebServer),
 	fx.Invoke(registerGrpcServer),
+	fx.Invoke(registerMetricsServer),
 	fx.Invoke(registerWorkers),
 	fx.Invoke(registerTelemetry),
 )
@@ -102,11 +113,46 @@ func registerGrpcServer(
 	})
 }
 
+func registerMetricsServer(
+	lifecycle fx.Lifecycle,
+	cfg *config.Config,
+	server server.MetricsServer,
+	metrics telemetry.Metrics,
+	log *logger.Logger,
+) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Info().Msgf("Starting metrics server in %s environment at %s", cfg.AppEnv, cfg.MetricsAddr)
+			go func() {
+				if err := server.Run(); err != nil && err != http.ErrServerClosed {
+					log.Error().Err(err).Msg("Metrics server start failed")
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+			defer cancel()
+
+			log.Info().Msg("Shutting down metrics server...")
+			if err := server.Shutdown(shutdownCtx); err != nil {
+				return err
+			}
+			return metrics.Shutdown(shutdownCtx)
+		},
+	})
+}
+
 func registerWorkers(
 	lifecycle fx.Lifecycle,
 	cfg *config.Config,
 	smartId smartid.Worker,
 	mobileId mobileid.Worker,
+	confirmations workers.ConfirmationWorker,
+	grants workers.GrantsWorker,
+	checkpoints workers.CheckpointsWorker,
+	policies workers.PoliciesWorker,
 	log *logger.Logger,
 ) {
 	var ctx, cancel = context.WithCancel(context.Background())
@@ -117,6 +163,10 @@ func registerWorkers(
 			log.Info().Msgf("Starting workers in %s environment", cfg.AppEnv)
 			smartId.Start(ctx)
 			mobileId.Start(ctx)
+			confirmations.Start(ctx)
+			grants.Start(ctx)
+			checkpoints.Start(ctx)
+			policies.Start(ctx)
 
 			return nil
 		},
@@ -126,6 +176,10 @@ func registerWorkers(
 			cancel()
 			smartId.Stop()
 			mobileId.Stop()
+			confirmations.Stop()
+			grants.Stop()
+			checkpoints.Stop()
+			policies.Stop()
 
 			return nil
 		},
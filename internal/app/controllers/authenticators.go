@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/models/dto"
+	"loki/internal/app/serializers"
+	"loki/internal/app/services"
+	"loki/internal/config/middlewares"
+	"loki/pkg/validator"
+)
+
+type AuthenticatorsController interface {
+	List(w http.ResponseWriter, r *http.Request)
+	SetAllowedMethods(w http.ResponseWriter, r *http.Request)
+}
+
+type authenticatorsController struct {
+	authenticators services.Authenticators
+}
+
+func NewAuthenticatorsController(authenticators services.Authenticators) AuthenticatorsController {
+	return &authenticatorsController{
+		authenticators: authenticators,
+	}
+}
+
+func (c *authenticatorsController) List(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	user, ok := middlewares.CurrentUserFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()})
+		return
+	}
+
+	methods, err := c.authenticators.AllowedMethods(r.Context(), user.ID)
+	if err != nil {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	c.render(w, r, user, methods)
+}
+
+func (c *authenticatorsController) SetAllowedMethods(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	user, ok := middlewares.CurrentUserFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()})
+		return
+	}
+
+	var params dto.SetAllowedMethodsRequest
+	if err := params.Validate(r.Body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error(), Fields: validator.Fields(err)})
+		return
+	}
+
+	methods, err := c.authenticators.SetAllowedMethods(r.Context(), user.ID, params.Methods)
+	if err != nil {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	c.render(w, r, user, methods)
+}
+
+// render writes the authenticators of the user together with the methods they have allowed
+func (c *authenticatorsController) render(w http.ResponseWriter, r *http.Request, user *models.User, methods []string) {
+	rows, err := c.authenticators.List(r.Context(), user.ID)
+	if err != nil {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	response := serializers.AuthenticatorsSerializer{
+		Data:           make([]serializers.AuthenticatorSerializer, 0, len(rows)),
+		AllowedMethods: methods,
+	}
+
+	for _, row := range rows {
+		response.Data = append(response.Data, serializers.AuthenticatorSerializer{
+			ID:          row.ID,
+			Method:      row.Method,
+			Identifier:  row.Identifier,
+			Metadata:    row.Metadata,
+			FirstUsedAt: row.FirstUsedAt,
+			LastUsedAt:  row.LastUsedAt,
+		})
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/controllers/authenticators.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/controllers/authenticators.go -destination=internal/app/controllers/authenticators_mock.go -package=controllers
+//
+
+// Package controllers is a generated GoMock package.
+package controllers
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthenticatorsController is a mock of AuthenticatorsController interface.
+type MockAuthenticatorsController struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthenticatorsControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthenticatorsControllerMockRecorder is the mock recorder for MockAuthenticatorsController.
+type MockAuthenticatorsControllerMockRecorder struct {
+	mock *MockAuthenticatorsController
+}
+
+// NewMockAuthenticatorsController creates a new mock instance.
+func NewMockAuthenticatorsController(ctrl *gomock.Controller) *MockAuthenticatorsController {
+	mock := &MockAuthenticatorsController{ctrl: ctrl}
+	mock.recorder = &MockAuthenticatorsControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthenticatorsController) EXPECT() *MockAuthenticatorsControllerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockAuthenticatorsController) List(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "List", w, r)
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuthenticatorsControllerMockRecorder) List(w, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuthenticatorsController)(nil).List), w, r)
+}
+
+// SetAllowedMethods mocks base method.
+func (m *MockAuthenticatorsController) SetAllowedMethods(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAllowedMethods", w, r)
+}
+
+// SetAllowedMethods indicates an expected call of SetAllowedMethods.
+func (mr *MockAuthenticatorsControllerMockRecorder) SetAllowedMethods(w, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllowedMethods", reflect.TypeOf((*MockAuthenticatorsController)(nil).SetAllowedMethods), w, r)
+}
@@ -0,0 +1,197 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/serializers"
+	"loki/internal/app/services"
+	"loki/internal/config/middlewares"
+)
+
+func Test_AuthenticatorsController_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authenticators := services.NewMockAuthenticators(ctrl)
+	controller := NewAuthenticatorsController(authenticators)
+
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	authenticatorId := uuid.MustParse("10000000-1000-1000-4000-000000000001")
+	usedAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	type result struct {
+		response serializers.AuthenticatorsSerializer
+		error    serializers.ErrorSerializer
+		code     int
+	}
+
+	tests := []struct {
+		name        string
+		before      func()
+		currentUser *models.User
+		expected    result
+	}{
+		{
+			name: "Success",
+			before: func() {
+				authenticators.EXPECT().AllowedMethods(gomock.Any(), userId).Return([]string{models.AuthMethodMobileId}, nil)
+				authenticators.EXPECT().List(gomock.Any(), userId).Return([]models.Authenticator{
+					{
+						ID:          authenticatorId,
+						UserID:      userId,
+						Method:      models.AuthMethodMobileId,
+						Identifier:  "+37268000769",
+						Metadata:    map[string]string{"identity_number": "PNOEE-60001017869"},
+						FirstUsedAt: usedAt,
+						LastUsedAt:  usedAt,
+					},
+				}, nil)
+			},
+			currentUser: &models.User{ID: userId},
+			expected: result{
+				response: serializers.AuthenticatorsSerializer{
+					Data: []serializers.AuthenticatorSerializer{
+						{
+							ID:          authenticatorId,
+							Method:      models.AuthMethodMobileId,
+							Identifier:  "+37268000769",
+							Metadata:    map[string]string{"identity_number": "PNOEE-60001017869"},
+							FirstUsedAt: usedAt,
+							LastUsedAt:  usedAt,
+						},
+					},
+					AllowedMethods: []string{models.AuthMethodMobileId},
+				},
+				code: http.StatusOK,
+			},
+		},
+		{
+			name:        "Unauthorized",
+			before:      func() {},
+			currentUser: nil,
+			expected: result{
+				error: serializers.ErrorSerializer{Error: "unauthorized"},
+				code:  http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "Failed to list authenticators",
+			before: func() {
+				authenticators.EXPECT().AllowedMethods(gomock.Any(), userId).Return([]string{}, nil)
+				authenticators.EXPECT().List(gomock.Any(), userId).Return(nil, errors.ErrFailedToFetchResults)
+			},
+			currentUser: &models.User{ID: userId},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrFailedToFetchResults.Error()},
+				code:  http.StatusUnprocessableEntity,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/me/authenticators", nil)
+			if tt.currentUser != nil {
+				req = req.WithContext(context.WithValue(req.Context(), middlewares.CurrentUser{}, tt.currentUser))
+			}
+			w := httptest.NewRecorder()
+
+			r := chi.NewRouter()
+			r.Get("/api/me/authenticators", controller.List)
+			r.ServeHTTP(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expected.code, resp.StatusCode)
+
+			if tt.expected.code == http.StatusOK {
+				var response serializers.AuthenticatorsSerializer
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.response, response)
+			} else {
+				var response serializers.ErrorSerializer
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.error, response)
+			}
+		})
+	}
+}
+
+func Test_AuthenticatorsController_SetAllowedMethods(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authenticators := services.NewMockAuthenticators(ctrl)
+	controller := NewAuthenticatorsController(authenticators)
+
+	currentUser := &models.User{ID: uuid.MustParse("10000000-1000-1000-3000-000000000001")}
+
+	tests := []struct {
+		name   string
+		body   io.Reader
+		before func()
+		code   int
+	}{
+		{
+			name: "Success",
+			body: strings.NewReader(`{"methods": ["smart_id"]}`),
+			before: func() {
+				authenticators.EXPECT().SetAllowedMethods(gomock.Any(), currentUser.ID, []string{models.AuthMethodSmartId}).Return([]string{models.AuthMethodSmartId}, nil)
+				authenticators.EXPECT().List(gomock.Any(), currentUser.ID).Return([]models.Authenticator{}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:   "Invalid method",
+			body:   strings.NewReader(`{"methods": ["password"]}`),
+			before: func() {},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name: "Error",
+			body: strings.NewReader(`{"methods": []}`),
+			before: func() {
+				authenticators.EXPECT().SetAllowedMethods(gomock.Any(), currentUser.ID, []string{}).Return(nil, errors.ErrFailedToUpdateRecord)
+			},
+			code: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			req := httptest.NewRequest(http.MethodPut, "/api/me/authenticators/allowed_methods", tt.body)
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.CurrentUser{}, currentUser))
+			w := httptest.NewRecorder()
+
+			r := chi.NewRouter()
+			r.Put("/api/me/authenticators/allowed_methods", controller.SetAllowedMethods)
+			r.ServeHTTP(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.code, resp.StatusCode)
+		})
+	}
+}
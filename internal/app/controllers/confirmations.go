@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/models/dto"
+	"loki/internal/app/serializers"
+	"loki/internal/app/services"
+	"loki/internal/app/services/authentication"
+	"loki/internal/config/middlewares"
+	"loki/pkg/validator"
+)
+
+type ConfirmationsController interface {
+	Create(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request)
+}
+
+type confirmationsController struct {
+	confirmations services.Confirmations
+	provider      authentication.ConfirmationProvider
+	throttle      services.Throttle
+}
+
+func NewConfirmationsController(
+	confirmations services.Confirmations,
+	provider authentication.ConfirmationProvider,
+	throttle services.Throttle,
+) ConfirmationsController {
+	return &confirmationsController{
+		confirmations: confirmations,
+		provider:      provider,
+		throttle:      throttle,
+	}
+}
+
+// Create starts a Smart-ID or Mobile-ID signature of the payload hash by the current user
+func (c *confirmationsController) Create(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	user, ok := middlewares.CurrentUserFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()})
+		return
+	}
+
+	var params dto.CreateConfirmationRequest
+	if err := params.Validate(r.Body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error(), Fields: validator.Fields(err)})
+		return
+	}
+
+	retryAfter, err := c.throttle.Allow(r.Context(), &models.ThrottleParams{
+		Endpoint:     params.Method,
+		IPAddress:    clientIP(r),
+		PersonalCode: user.PersonalCode,
+		PhoneNumber:  params.PhoneNumber,
+	})
+	if err != nil {
+		tooManyRequests(w, retryAfter, err)
+		return
+	}
+
+	confirmation, err := c.provider.CreateSession(r.Context(), user, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.ErrUnsupportedLocale):
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(confirmationSerializer(confirmation))
+}
+
+// Get returns the status of the confirmation, with the signature and certificate once it has succeeded
+func (c *confirmationsController) Get(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	user, ok := middlewares.CurrentUserFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()})
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrConfirmationNotFound.Error()})
+		return
+	}
+
+	confirmation, err := c.confirmations.FindByUser(r.Context(), user.ID, id)
+	if err != nil {
+		if errors.Is(err, errors.ErrConfirmationNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(confirmationSerializer(confirmation))
+}
+
+func confirmationSerializer(confirmation *models.Confirmation) serializers.ConfirmationSerializer {
+	return serializers.ConfirmationSerializer{
+		ID:          confirmation.ID,
+		Method:      confirmation.Method,
+		Code:        confirmation.Code,
+		Status:      confirmation.Status,
+		Error:       confirmation.Error,
+		Hash:        confirmation.Hash,
+		HashType:    confirmation.HashType,
+		Signature:   confirmation.Signature,
+		Algorithm:   confirmation.Algorithm,
+		Certificate: confirmation.Certificate,
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/controllers/confirmations.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/controllers/confirmations.go -destination=internal/app/controllers/confirmations_mock.go -package=controllers
+//
+
+// Package controllers is a generated GoMock package.
+package controllers
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfirmationsController is a mock of ConfirmationsController interface.
+type MockConfirmationsController struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfirmationsControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockConfirmationsControllerMockRecorder is the mock recorder for MockConfirmationsController.
+type MockConfirmationsControllerMockRecorder struct {
+	mock *MockConfirmationsController
+}
+
+// NewMockConfirmationsController creates a new mock instance.
+func NewMockConfirmationsController(ctrl *gomock.Controller) *MockConfirmationsController {
+	mock := &MockConfirmationsController{ctrl: ctrl}
+	mock.recorder = &MockConfirmationsControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfirmationsController) EXPECT() *MockConfirmationsControllerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockConfirmationsController) Create(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Create", w, r)
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockConfirmationsControllerMockRecorder) Create(w, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockConfirmationsController)(nil).Create), w, r)
+}
+
+// Get mocks base method.
+func (m *MockConfirmationsController) Get(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", w, r)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockConfirmationsControllerMockRecorder) Get(w, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockConfirmationsController)(nil).Get), w, r)
+}
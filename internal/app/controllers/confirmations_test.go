@@ -0,0 +1,302 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/models/dto"
+	"loki/internal/app/serializers"
+	"loki/internal/app/services"
+	"loki/internal/app/services/authentication"
+	"loki/internal/config/middlewares"
+)
+
+func Test_ConfirmationsController_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := gomock.Any()
+	provider := authentication.NewMockConfirmationProvider(ctrl)
+	throttle := services.NewMockThrottle(ctrl)
+	controller := NewConfirmationsController(services.NewMockConfirmations(ctrl), provider, throttle)
+
+	id := uuid.MustParse("8fdb516d-1a82-43ba-b82d-be63df569b86")
+	user := &models.User{
+		ID:             uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac"),
+		IdentityNumber: "PNOEE-30303039914",
+		PersonalCode:   "30303039914",
+	}
+
+	type result struct {
+		response   serializers.ConfirmationSerializer
+		error      serializers.ErrorSerializer
+		code       int
+		retryAfter string
+	}
+
+	tests := []struct {
+		name        string
+		body        io.Reader
+		currentUser *models.User
+		before      func()
+		expected    result
+	}{
+		{
+			name:        "Success",
+			body:        strings.NewReader(`{"method": "smart_id", "payload": "transfer:100.00", "display_text": "Pay 100.00 EUR"}`),
+			currentUser: user,
+			before: func() {
+				throttle.EXPECT().Allow(ctx, &models.ThrottleParams{
+					Endpoint:     models.AuthMethodSmartId,
+					IPAddress:    "192.0.2.1",
+					PersonalCode: "30303039914",
+				}).Return(time.Duration(0), nil)
+				provider.EXPECT().CreateSession(ctx, user, dto.CreateConfirmationRequest{
+					Method:      models.AuthMethodSmartId,
+					Payload:     "transfer:100.00",
+					DisplayText: "Pay 100.00 EUR",
+				}).Return(&models.Confirmation{
+					ID:       id,
+					Method:   models.AuthMethodSmartId,
+					Code:     "5463",
+					Status:   models.SessionRunning,
+					Hash:     "OmuIu6Kw",
+					HashType: "SHA512",
+				}, nil)
+			},
+			expected: result{
+				response: serializers.ConfirmationSerializer{
+					ID:       id,
+					Method:   models.AuthMethodSmartId,
+					Code:     "5463",
+					Status:   models.SessionRunning,
+					Hash:     "OmuIu6Kw",
+					HashType: "SHA512",
+				},
+				code: http.StatusCreated,
+			},
+		},
+		{
+			name:        "Unauthorized",
+			body:        strings.NewReader(`{"method": "smart_id", "payload": "transfer:100.00", "display_text": "Pay 100.00 EUR"}`),
+			currentUser: nil,
+			before:      func() {},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()},
+				code:  http.StatusUnauthorized,
+			},
+		},
+		{
+			name:        "Bad request",
+			body:        strings.NewReader(`{"method": "smart_id", "payload": "", "display_text": "Pay 100.00 EUR"}`),
+			currentUser: user,
+			before:      func() {},
+			expected: result{
+				error: serializers.ErrorSerializer{
+					Error:  errors.ErrEmptyPayload.Error(),
+					Fields: map[string]string{"payload": errors.ErrEmptyPayload.Error()},
+				},
+				code: http.StatusBadRequest,
+			},
+		},
+		{
+			name:        "Provider error",
+			body:        strings.NewReader(`{"method": "mobile_id", "payload": "transfer:100.00", "display_text": "Pay 100.00 EUR"}`),
+			currentUser: user,
+			before: func() {
+				throttle.EXPECT().Allow(ctx, gomock.Any()).Return(time.Duration(0), nil)
+				provider.EXPECT().CreateSession(ctx, user, gomock.Any()).Return(nil, errors.ErrEmptyPhoneNumber)
+			},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrEmptyPhoneNumber.Error()},
+				code:  http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name:        "Too many requests",
+			body:        strings.NewReader(`{"method": "smart_id", "payload": "transfer:100.00", "display_text": "Pay 100.00 EUR"}`),
+			currentUser: user,
+			before: func() {
+				throttle.EXPECT().Allow(ctx, gomock.Any()).Return(30*time.Second, errors.ErrTooManyRequests)
+			},
+			expected: result{
+				error:      serializers.ErrorSerializer{Error: errors.ErrTooManyRequests.Error()},
+				code:       http.StatusTooManyRequests,
+				retryAfter: "30",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/me/confirmations", tt.body)
+			if tt.currentUser != nil {
+				req = req.WithContext(middlewares.NewContextModifier(req.Context()).WithCurrentUser(tt.currentUser).Context())
+			}
+			w := httptest.NewRecorder()
+
+			controller.Create(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			if tt.expected.error.Error != "" {
+				var response serializers.ErrorSerializer
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.error, response)
+			} else {
+				var response serializers.ConfirmationSerializer
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.response, response)
+			}
+
+			assert.Equal(t, tt.expected.code, resp.StatusCode)
+			assert.Equal(t, tt.expected.retryAfter, resp.Header.Get("Retry-After"))
+		})
+	}
+}
+
+func Test_ConfirmationsController_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := gomock.Any()
+	confirmations := services.NewMockConfirmations(ctrl)
+	controller := NewConfirmationsController(confirmations, authentication.NewMockConfirmationProvider(ctrl), services.NewMockThrottle(ctrl))
+
+	id := uuid.MustParse("8fdb516d-1a82-43ba-b82d-be63df569b86")
+	user := &models.User{
+		ID:             uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac"),
+		IdentityNumber: "PNOEE-30303039914",
+		PersonalCode:   "30303039914",
+	}
+
+	type result struct {
+		response serializers.ConfirmationSerializer
+		error    serializers.ErrorSerializer
+		code     int
+	}
+
+	tests := []struct {
+		name        string
+		id          string
+		currentUser *models.User
+		before      func()
+		expected    result
+	}{
+		{
+			name:        "Success",
+			id:          id.String(),
+			currentUser: user,
+			before: func() {
+				confirmations.EXPECT().FindByUser(ctx, user.ID, id).Return(&models.Confirmation{
+					ID:          id,
+					UserID:      user.ID,
+					Method:      models.AuthMethodSmartId,
+					Code:        "5463",
+					Status:      models.SessionSuccess,
+					Hash:        "OmuIu6Kw",
+					HashType:    "SHA512",
+					Signature:   "c2lnbmF0dXJl",
+					Algorithm:   "sha512WithRSAEncryption",
+					Certificate: "MIID",
+				}, nil)
+			},
+			expected: result{
+				response: serializers.ConfirmationSerializer{
+					ID:          id,
+					Method:      models.AuthMethodSmartId,
+					Code:        "5463",
+					Status:      models.SessionSuccess,
+					Hash:        "OmuIu6Kw",
+					HashType:    "SHA512",
+					Signature:   "c2lnbmF0dXJl",
+					Algorithm:   "sha512WithRSAEncryption",
+					Certificate: "MIID",
+				},
+				code: http.StatusOK,
+			},
+		},
+		{
+			name:        "Unauthorized",
+			id:          id.String(),
+			currentUser: nil,
+			before:      func() {},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()},
+				code:  http.StatusUnauthorized,
+			},
+		},
+		{
+			name:        "Invalid id",
+			id:          "invalid",
+			currentUser: user,
+			before:      func() {},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrConfirmationNotFound.Error()},
+				code:  http.StatusNotFound,
+			},
+		},
+		{
+			name:        "Not found",
+			id:          id.String(),
+			currentUser: user,
+			before: func() {
+				confirmations.EXPECT().FindByUser(ctx, user.ID, id).Return(nil, errors.ErrConfirmationNotFound)
+			},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrConfirmationNotFound.Error()},
+				code:  http.StatusNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/me/confirmations/"+tt.id, nil)
+			if tt.currentUser != nil {
+				req = req.WithContext(middlewares.NewContextModifier(req.Context()).WithCurrentUser(tt.currentUser).Context())
+			}
+			w := httptest.NewRecorder()
+
+			r := chi.NewRouter()
+			r.Get("/api/me/confirmations/{id}", controller.Get)
+			r.ServeHTTP(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			if tt.expected.error.Error != "" {
+				var response serializers.ErrorSerializer
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.error, response)
+			} else {
+				var response serializers.ConfirmationSerializer
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.response, response)
+			}
+
+			assert.Equal(t, tt.expected.code, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/serializers"
+	"loki/internal/app/services"
+	"loki/internal/config/middlewares"
+)
+
+type LoginSessionsController interface {
+	List(w http.ResponseWriter, r *http.Request)
+	Revoke(w http.ResponseWriter, r *http.Request)
+}
+
+type loginSessionsController struct {
+	loginSessions services.LoginSessions
+}
+
+func NewLoginSessionsController(loginSessions services.LoginSessions) LoginSessionsController {
+	return &loginSessionsController{
+		loginSessions: loginSessions,
+	}
+}
+
+// List returns the sessions the current user is still logged in with
+func (c *loginSessionsController) List(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	user, ok := middlewares.CurrentUserFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()})
+		return
+	}
+
+	rows, err := c.loginSessions.ListActive(r.Context(), user.ID)
+	if err != nil {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	response := serializers.LoginSessionsSerializer{
+		Data: make([]serializers.LoginSessionSerializer, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		response.Data = append(response.Data, serializers.LoginSessionSerializer{
+			ID:              row.ID,
+			Provider:        row.Provider,
+			IPAddress:       row.IPAddress,
+			UserAgent:       row.UserAgent,
+			Status:          row.Status(),
+			CreatedAt:       row.CreatedAt,
+			LastRefreshedAt: row.LastRefreshedAt,
+		})
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// Revoke logs the current user out of one of their sessions
+func (c *loginSessionsController) Revoke(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	user, ok := middlewares.CurrentUserFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()})
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrRecordNotFound.Error()})
+		return
+	}
+
+	if err = c.loginSessions.Revoke(r.Context(), user.ID, id); err != nil {
+		if errors.Is(err, errors.ErrRecordNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/controllers/login_sessions.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/controllers/login_sessions.go -destination=internal/app/controllers/login_sessions_mock.go -package=controllers
+//
+
+// Package controllers is a generated GoMock package.
+package controllers
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginSessionsController is a mock of LoginSessionsController interface.
+type MockLoginSessionsController struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginSessionsControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginSessionsControllerMockRecorder is the mock recorder for MockLoginSessionsController.
+type MockLoginSessionsControllerMockRecorder struct {
+	mock *MockLoginSessionsController
+}
+
+// NewMockLoginSessionsController creates a new mock instance.
+func NewMockLoginSessionsController(ctrl *gomock.Controller) *MockLoginSessionsController {
+	mock := &MockLoginSessionsController{ctrl: ctrl}
+	mock.recorder = &MockLoginSessionsControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginSessionsController) EXPECT() *MockLoginSessionsControllerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockLoginSessionsController) List(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "List", w, r)
+}
+
+// List indicates an expected call of List.
+func (mr *MockLoginSessionsControllerMockRecorder) List(w, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoginSessionsController)(nil).List), w, r)
+}
+
+// Revoke mocks base method.
+func (m *MockLoginSessionsController) Revoke(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Revoke", w, r)
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockLoginSessionsControllerMockRecorder) Revoke(w, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockLoginSessionsController)(nil).Revoke), w, r)
+}
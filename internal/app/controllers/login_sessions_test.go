@@ -0,0 +1,215 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/serializers"
+	"loki/internal/app/services"
+	"loki/internal/config/middlewares"
+)
+
+func Test_LoginSessionsController_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loginSessions := services.NewMockLoginSessions(ctrl)
+	controller := NewLoginSessionsController(loginSessions)
+
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	sessionId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	refreshedAt := time.Now().UTC().Truncate(time.Second)
+
+	type result struct {
+		response serializers.LoginSessionsSerializer
+		error    serializers.ErrorSerializer
+		code     int
+	}
+
+	tests := []struct {
+		name        string
+		before      func()
+		currentUser *models.User
+		expected    result
+	}{
+		{
+			name: "Success",
+			before: func() {
+				loginSessions.EXPECT().ListActive(gomock.Any(), userId).Return([]models.LoginSession{
+					{
+						ID:              sessionId,
+						UserID:          userId,
+						Provider:        models.AuthMethodSmartId,
+						IPAddress:       "192.0.2.1",
+						UserAgent:       "Mozilla/5.0",
+						CreatedAt:       refreshedAt,
+						LastRefreshedAt: refreshedAt,
+					},
+				}, nil)
+			},
+			currentUser: &models.User{ID: userId},
+			expected: result{
+				response: serializers.LoginSessionsSerializer{
+					Data: []serializers.LoginSessionSerializer{
+						{
+							ID:              sessionId,
+							Provider:        models.AuthMethodSmartId,
+							IPAddress:       "192.0.2.1",
+							UserAgent:       "Mozilla/5.0",
+							Status:          models.LoginSessionActive,
+							CreatedAt:       refreshedAt,
+							LastRefreshedAt: refreshedAt,
+						},
+					},
+				},
+				code: http.StatusOK,
+			},
+		},
+		{
+			name:        "Unauthorized",
+			before:      func() {},
+			currentUser: nil,
+			expected: result{
+				error: serializers.ErrorSerializer{Error: "unauthorized"},
+				code:  http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "Failed to list sessions",
+			before: func() {
+				loginSessions.EXPECT().ListActive(gomock.Any(), userId).Return(nil, errors.ErrFailedToFetchResults)
+			},
+			currentUser: &models.User{ID: userId},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrFailedToFetchResults.Error()},
+				code:  http.StatusUnprocessableEntity,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/me/sessions", nil)
+			if tt.currentUser != nil {
+				req = req.WithContext(context.WithValue(req.Context(), middlewares.CurrentUser{}, tt.currentUser))
+			}
+			w := httptest.NewRecorder()
+
+			r := chi.NewRouter()
+			r.Get("/api/me/sessions", controller.List)
+			r.ServeHTTP(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expected.code, resp.StatusCode)
+
+			if tt.expected.code == http.StatusOK {
+				var response serializers.LoginSessionsSerializer
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.response, response)
+			} else {
+				var response serializers.ErrorSerializer
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.error, response)
+			}
+		})
+	}
+}
+
+func Test_LoginSessionsController_Revoke(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	loginSessions := services.NewMockLoginSessions(ctrl)
+	controller := NewLoginSessionsController(loginSessions)
+
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	sessionId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+
+	tests := []struct {
+		name        string
+		id          string
+		before      func()
+		currentUser *models.User
+		code        int
+	}{
+		{
+			name: "Success",
+			id:   sessionId.String(),
+			before: func() {
+				loginSessions.EXPECT().Revoke(gomock.Any(), userId, sessionId).Return(nil)
+			},
+			currentUser: &models.User{ID: userId},
+			code:        http.StatusNoContent,
+		},
+		{
+			name:        "Unauthorized",
+			id:          sessionId.String(),
+			before:      func() {},
+			currentUser: nil,
+			code:        http.StatusUnauthorized,
+		},
+		{
+			name:        "Invalid id",
+			id:          "invalid",
+			before:      func() {},
+			currentUser: &models.User{ID: userId},
+			code:        http.StatusNotFound,
+		},
+		{
+			name: "Not found",
+			id:   sessionId.String(),
+			before: func() {
+				loginSessions.EXPECT().Revoke(gomock.Any(), userId, sessionId).Return(errors.ErrRecordNotFound)
+			},
+			currentUser: &models.User{ID: userId},
+			code:        http.StatusNotFound,
+		},
+		{
+			name: "Failed to revoke session",
+			id:   sessionId.String(),
+			before: func() {
+				loginSessions.EXPECT().Revoke(gomock.Any(), userId, sessionId).Return(errors.ErrFailedToUpdateRecord)
+			},
+			currentUser: &models.User{ID: userId},
+			code:        http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/me/sessions/"+tt.id, nil)
+			if tt.currentUser != nil {
+				req = req.WithContext(context.WithValue(req.Context(), middlewares.CurrentUser{}, tt.currentUser))
+			}
+			w := httptest.NewRecorder()
+
+			r := chi.NewRouter()
+			r.Delete("/api/me/sessions/{id}", controller.Revoke)
+			r.ServeHTTP(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.code, resp.StatusCode)
+		})
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
 	"loki/internal/app/models/dto"
 	"loki/internal/app/serializers"
+	"loki/internal/app/services"
 	"loki/internal/app/services/authentication"
+	"loki/pkg/validator"
 )
 
 type MobileIdController interface {
@@ -15,13 +19,16 @@ type MobileIdController interface {
 
 type mobileIdController struct {
 	provider authentication.MobileIdProvider
+	throttle services.Throttle
 }
 
 func NewMobileIdController(
 	provider authentication.MobileIdProvider,
+	throttle services.Throttle,
 ) MobileIdController {
 	return &mobileIdController{
 		provider: provider,
+		throttle: throttle,
 	}
 }
 
@@ -31,16 +38,34 @@ func (c *mobileIdController) CreateSession(w http.ResponseWriter, r *http.Reques
 	var params dto.CreateMobileIdSessionRequest
 	if err := params.Validate(r.Body); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error(), Fields: validator.Fields(err)})
+		return
+	}
+
+	retryAfter, err := c.throttle.Allow(r.Context(), &models.ThrottleParams{
+		Endpoint:     models.AuthMethodMobileId,
+		IPAddress:    clientIP(r),
+		PersonalCode: params.PersonalCode,
+		PhoneNumber:  params.PhoneNumber,
+	})
+	if err != nil {
+		tooManyRequests(w, retryAfter, err)
 		return
 	}
 
 	session, err := c.provider.CreateSession(r.Context(), dto.CreateMobileIdSessionRequest{
 		PersonalCode: params.PersonalCode,
 		PhoneNumber:  params.PhoneNumber,
+		Locale:       params.Locale,
+		Purpose:      params.Purpose,
 	})
 	if err != nil {
-		w.WriteHeader(http.StatusUnprocessableEntity)
+		switch {
+		case errors.Is(err, errors.ErrUnsupportedLocale), errors.Is(err, errors.ErrUnsupportedPurpose):
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}
 		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
 		return
 	}
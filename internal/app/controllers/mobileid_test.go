@@ -7,14 +7,17 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/models/dto"
 	"loki/internal/app/serializers"
+	"loki/internal/app/services"
 	"loki/internal/app/services/authentication"
 )
 
@@ -24,15 +27,17 @@ func Test_MobileIdController_CreateSession(t *testing.T) {
 
 	ctx := gomock.Any()
 	provider := authentication.NewMockMobileIdProvider(ctrl)
-	controller := NewMobileIdController(provider)
+	throttle := services.NewMockThrottle(ctrl)
+	controller := NewMobileIdController(provider, throttle)
 
 	sessionId := uuid.MustParse("8fdb516d-1a82-43ba-b82d-be63df569b86")
 
 	type result struct {
-		response serializers.SessionSerializer
-		error    serializers.ErrorSerializer
-		status   string
-		code     int
+		response   serializers.SessionSerializer
+		error      serializers.ErrorSerializer
+		status     string
+		code       int
+		retryAfter string
 	}
 
 	tests := []struct {
@@ -43,11 +48,18 @@ func Test_MobileIdController_CreateSession(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			body: strings.NewReader(`{"locale": "ENG", "phone_number": "+37268000769", "personal_code": "60001017869"}`),
+			body: strings.NewReader(`{"locale": "en", "phone_number": "+37268000769", "personal_code": "60001017869"}`),
 			before: func() {
+				throttle.EXPECT().Allow(ctx, &models.ThrottleParams{
+					Endpoint:     models.AuthMethodMobileId,
+					IPAddress:    "192.0.2.1",
+					PersonalCode: "60001017869",
+					PhoneNumber:  "+37268000769",
+				}).Return(time.Duration(0), nil)
 				provider.EXPECT().CreateSession(ctx, dto.CreateMobileIdSessionRequest{
 					PhoneNumber:  "+37268000769",
 					PersonalCode: "60001017869",
+					Locale:       "en",
 				}).Return(&models.Session{
 					ID:   sessionId,
 					Code: "1234",
@@ -64,21 +76,53 @@ func Test_MobileIdController_CreateSession(t *testing.T) {
 		},
 		{
 			name:   "Bad request",
-			body:   strings.NewReader(`{"locale": "ENG", "personal_code": "60001017869"}`),
+			body:   strings.NewReader(`{"locale": "en", "personal_code": "60001017869"}`),
 			before: func() {},
 			expected: result{
-				error:  serializers.ErrorSerializer{Error: "empty phone number"},
+				error: serializers.ErrorSerializer{
+					Error:  "empty phone number",
+					Fields: map[string]string{"phone_number": "empty phone number"},
+				},
+				status: "400 Bad Request",
+				code:   http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Unsupported locale",
+			body: strings.NewReader(`{"locale": "fi", "phone_number": "+37268000769", "personal_code": "60001017869"}`),
+			before: func() {
+				throttle.EXPECT().Allow(ctx, &models.ThrottleParams{
+					Endpoint:     models.AuthMethodMobileId,
+					IPAddress:    "192.0.2.1",
+					PersonalCode: "60001017869",
+					PhoneNumber:  "+37268000769",
+				}).Return(time.Duration(0), nil)
+				provider.EXPECT().CreateSession(ctx, dto.CreateMobileIdSessionRequest{
+					PhoneNumber:  "+37268000769",
+					PersonalCode: "60001017869",
+					Locale:       "fi",
+				}).Return(nil, errors.ErrUnsupportedLocale)
+			},
+			expected: result{
+				error:  serializers.ErrorSerializer{Error: "unsupported locale"},
 				status: "400 Bad Request",
 				code:   http.StatusBadRequest,
 			},
 		},
 		{
 			name: "Unprocessable entity",
-			body: strings.NewReader(`{"locale": "ENG", "phone_number": "+37268000769", "personal_code": "60001017869"}`),
+			body: strings.NewReader(`{"locale": "en", "phone_number": "+37268000769", "personal_code": "60001017869"}`),
 			before: func() {
+				throttle.EXPECT().Allow(ctx, &models.ThrottleParams{
+					Endpoint:     models.AuthMethodMobileId,
+					IPAddress:    "192.0.2.1",
+					PersonalCode: "60001017869",
+					PhoneNumber:  "+37268000769",
+				}).Return(time.Duration(0), nil)
 				provider.EXPECT().CreateSession(ctx, dto.CreateMobileIdSessionRequest{
 					PhoneNumber:  "+37268000769",
 					PersonalCode: "60001017869",
+					Locale:       "en",
 				}).Return(nil, assert.AnError)
 			},
 			expected: result{
@@ -87,6 +131,24 @@ func Test_MobileIdController_CreateSession(t *testing.T) {
 				code:   http.StatusUnprocessableEntity,
 			},
 		},
+		{
+			name: "Too many requests",
+			body: strings.NewReader(`{"locale": "en", "phone_number": "+37268000769", "personal_code": "60001017869"}`),
+			before: func() {
+				throttle.EXPECT().Allow(ctx, &models.ThrottleParams{
+					Endpoint:     models.AuthMethodMobileId,
+					IPAddress:    "192.0.2.1",
+					PersonalCode: "60001017869",
+					PhoneNumber:  "+37268000769",
+				}).Return(1500*time.Millisecond, errors.ErrTooManyRequests)
+			},
+			expected: result{
+				error:      serializers.ErrorSerializer{Error: "too many requests"},
+				status:     "429 Too Many Requests",
+				code:       http.StatusTooManyRequests,
+				retryAfter: "2",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -106,7 +168,7 @@ func Test_MobileIdController_CreateSession(t *testing.T) {
 				err := json.NewDecoder(resp.Body).Decode(&response)
 				assert.NoError(t, err)
 
-				assert.Equal(t, tt.expected.error.Error, response.Error)
+				assert.Equal(t, tt.expected.error, response)
 			} else {
 				var response serializers.SessionSerializer
 				err := json.NewDecoder(resp.Body).Decode(&response)
@@ -117,6 +179,7 @@ func Test_MobileIdController_CreateSession(t *testing.T) {
 
 			assert.Equal(t, tt.expected.code, resp.StatusCode)
 			assert.Equal(t, tt.expected.status, resp.Status)
+			assert.Equal(t, tt.expected.retryAfter, resp.Header.Get("Retry-After"))
 		})
 	}
 }
@@ -3,10 +3,14 @@ package controllers
 import "go.uber.org/fx"
 
 var Module = fx.Options(
+	fx.Provide(NewAuthenticatorsController),
+	fx.Provide(NewConfirmationsController),
 	fx.Provide(NewHealthController),
+	fx.Provide(NewLoginSessionsController),
 	fx.Provide(NewMobileIdController),
 	fx.Provide(NewSmartIdController),
 	fx.Provide(NewSessionsController),
+	fx.Provide(NewStepUpController),
 	fx.Provide(NewTokensController),
 	fx.Provide(NewUsersController),
 )
@@ -7,8 +7,10 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	"loki/internal/app/errors"
+	"loki/internal/app/models"
 	"loki/internal/app/serializers"
 	"loki/internal/app/services"
+	"loki/internal/config/middlewares"
 )
 
 type SessionsController interface {
@@ -17,15 +19,18 @@ type SessionsController interface {
 }
 
 type sessionsController struct {
+	audit          services.Audit
 	authentication services.Authentication
 	sessions       services.Sessions
 }
 
 func NewSessionsController(
+	audit services.Audit,
 	authentication services.Authentication,
 	sessions services.Sessions,
 ) SessionsController {
 	return &sessionsController{
+		audit:          audit,
 		authentication: authentication,
 		sessions:       sessions,
 	}
@@ -64,9 +69,25 @@ func (c *sessionsController) Authenticate(w http.ResponseWriter, r *http.Request
 
 	id := chi.URLParam(r, "id")
 
-	user, err := c.authentication.Complete(r.Context(), id)
+	user, err := c.authentication.Complete(r.Context(), &models.CompleteSessionParams{
+		SessionId: id,
+		IPAddress: clientIP(r),
+		UserAgent: r.UserAgent(),
+	})
+	c.audit.Record(r.Context(), tokenAuditEvent(r, models.AuditTokenIssued, user, err))
 	if err != nil {
-		w.WriteHeader(http.StatusUnprocessableEntity)
+		switch {
+		case errors.Is(err, errors.ErrSessionNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errors.Is(err, errors.ErrSessionRunning), errors.Is(err, errors.ErrSessionFailed):
+			w.WriteHeader(http.StatusConflict)
+		case errors.Is(err, errors.ErrSessionCancelled), errors.Is(err, errors.ErrSessionExpired):
+			w.WriteHeader(http.StatusGone)
+		case errors.Is(err, errors.ErrSessionRejected), errors.Is(err, errors.ErrSuspiciousLogin):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}
 		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
 		return
 	}
@@ -84,3 +105,25 @@ func (c *sessionsController) Authenticate(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(response)
 }
+
+// tokenAuditEvent describes the outcome of a token request for the audit log
+func tokenAuditEvent(r *http.Request, action string, user *models.User, err error) *models.AuditEvent {
+	event := &models.AuditEvent{
+		Action:     action,
+		TargetType: "user",
+		Status:     models.AuditSuccess,
+		RemoteAddr: r.RemoteAddr,
+	}
+	event.TraceID, _ = middlewares.CurrentTraceIdFromContext(r.Context())
+
+	if err != nil {
+		event.Status = models.AuditFailure
+		event.Metadata = map[string]string{"error": err.Error()}
+		return event
+	}
+
+	event.ActorID = user.ID
+	event.TargetID = user.ID.String()
+
+	return event
+}
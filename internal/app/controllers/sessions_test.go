@@ -25,7 +25,10 @@ func Test_SessionsController_GetStatus(t *testing.T) {
 	ctx := gomock.Any()
 	authentication := services.NewMockAuthentication(ctrl)
 	sessions := services.NewMockSessions(ctrl)
-	controller := NewSessionsController(authentication, sessions)
+	audit := services.NewMockAudit(ctrl)
+	audit.EXPECT().Record(gomock.Any(), gomock.Any()).AnyTimes()
+
+	controller := NewSessionsController(audit, authentication, sessions)
 
 	sessionId := "8fdb516d-1a82-43ba-b82d-be63df569b86"
 	id := uuid.MustParse(sessionId)
@@ -122,10 +125,18 @@ func Test_SessionsController_Authenticate(t *testing.T) {
 	ctx := gomock.Any()
 	authentication := services.NewMockAuthentication(ctrl)
 	sessions := services.NewMockSessions(ctrl)
-	controller := NewSessionsController(authentication, sessions)
+	audit := services.NewMockAudit(ctrl)
+	audit.EXPECT().Record(gomock.Any(), gomock.Any()).AnyTimes()
+
+	controller := NewSessionsController(audit, authentication, sessions)
 
 	sessionId := "8fdb516d-1a82-43ba-b82d-be63df569b86"
 	id := uuid.MustParse(sessionId)
+	params := &models.CompleteSessionParams{
+		SessionId: sessionId,
+		IPAddress: "192.0.2.1",
+		UserAgent: "Mozilla/5.0",
+	}
 
 	type result struct {
 		response serializers.UserSerializer
@@ -142,7 +153,7 @@ func Test_SessionsController_Authenticate(t *testing.T) {
 		{
 			name: "Success",
 			before: func() {
-				authentication.EXPECT().Complete(ctx, sessionId).Return(&models.User{
+				authentication.EXPECT().Complete(ctx, params).Return(&models.User{
 					ID:             id,
 					IdentityNumber: "PNOEE-30303039914",
 					PersonalCode:   "30303039914",
@@ -166,10 +177,87 @@ func Test_SessionsController_Authenticate(t *testing.T) {
 				code:   http.StatusOK,
 			},
 		},
+		{
+			name: "Not found",
+			before: func() {
+				authentication.EXPECT().Complete(ctx, params).Return(nil, errors.ErrSessionNotFound)
+			},
+			expected: result{
+				error:  serializers.ErrorSerializer{Error: "session not found"},
+				status: "404 Not Found",
+				code:   http.StatusNotFound,
+			},
+		},
+		{
+			name: "Session is running",
+			before: func() {
+				authentication.EXPECT().Complete(ctx, params).Return(nil, errors.ErrSessionRunning)
+			},
+			expected: result{
+				error:  serializers.ErrorSerializer{Error: "session is still running"},
+				status: "409 Conflict",
+				code:   http.StatusConflict,
+			},
+		},
+		{
+			name: "Session failed",
+			before: func() {
+				authentication.EXPECT().Complete(ctx, params).Return(nil, errors.ErrSessionFailed)
+			},
+			expected: result{
+				error:  serializers.ErrorSerializer{Error: "session failed"},
+				status: "409 Conflict",
+				code:   http.StatusConflict,
+			},
+		},
+		{
+			name: "Session cancelled",
+			before: func() {
+				authentication.EXPECT().Complete(ctx, params).Return(nil, errors.ErrSessionCancelled)
+			},
+			expected: result{
+				error:  serializers.ErrorSerializer{Error: "session cancelled"},
+				status: "410 Gone",
+				code:   http.StatusGone,
+			},
+		},
+		{
+			name: "Session expired",
+			before: func() {
+				authentication.EXPECT().Complete(ctx, params).Return(nil, errors.ErrSessionExpired)
+			},
+			expected: result{
+				error:  serializers.ErrorSerializer{Error: "session expired"},
+				status: "410 Gone",
+				code:   http.StatusGone,
+			},
+		},
+		{
+			name: "Session rejected",
+			before: func() {
+				authentication.EXPECT().Complete(ctx, params).Return(nil, errors.ErrSessionRejected)
+			},
+			expected: result{
+				error:  serializers.ErrorSerializer{Error: "session rejected"},
+				status: "403 Forbidden",
+				code:   http.StatusForbidden,
+			},
+		},
+		{
+			name: "Suspicious login",
+			before: func() {
+				authentication.EXPECT().Complete(ctx, params).Return(nil, errors.ErrSuspiciousLogin)
+			},
+			expected: result{
+				error:  serializers.ErrorSerializer{Error: "suspicious login"},
+				status: "403 Forbidden",
+				code:   http.StatusForbidden,
+			},
+		},
 		{
 			name: "Error",
 			before: func() {
-				authentication.EXPECT().Complete(ctx, sessionId).Return(nil, fmt.Errorf("Failed to complete session"))
+				authentication.EXPECT().Complete(ctx, params).Return(nil, fmt.Errorf("Failed to complete session"))
 			},
 			expected: result{
 				error:  serializers.ErrorSerializer{Error: "Failed to complete session"},
@@ -184,6 +272,7 @@ func Test_SessionsController_Authenticate(t *testing.T) {
 			tt.before()
 
 			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/sessions/%s", sessionId), nil)
+			req.Header.Set("User-Agent", "Mozilla/5.0")
 			w := httptest.NewRecorder()
 
 			r := chi.NewRouter()
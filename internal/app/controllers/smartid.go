@@ -4,9 +4,13 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
 	"loki/internal/app/models/dto"
 	"loki/internal/app/serializers"
+	"loki/internal/app/services"
 	"loki/internal/app/services/authentication"
+	"loki/pkg/validator"
 )
 
 type SmartIdController interface {
@@ -15,11 +19,13 @@ type SmartIdController interface {
 
 type smartIdController struct {
 	provider authentication.SmartIdProvider
+	throttle services.Throttle
 }
 
-func NewSmartIdController(provider authentication.SmartIdProvider) SmartIdController {
+func NewSmartIdController(provider authentication.SmartIdProvider, throttle services.Throttle) SmartIdController {
 	return &smartIdController{
 		provider: provider,
+		throttle: throttle,
 	}
 }
 
@@ -29,16 +35,33 @@ func (c *smartIdController) CreateSession(w http.ResponseWriter, r *http.Request
 	var params dto.CreateSmartIdSessionRequest
 	if err := params.Validate(r.Body); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error(), Fields: validator.Fields(err)})
+		return
+	}
+
+	retryAfter, err := c.throttle.Allow(r.Context(), &models.ThrottleParams{
+		Endpoint:     models.AuthMethodSmartId,
+		IPAddress:    clientIP(r),
+		PersonalCode: params.PersonalCode,
+	})
+	if err != nil {
+		tooManyRequests(w, retryAfter, err)
 		return
 	}
 
 	session, err := c.provider.CreateSession(r.Context(), dto.CreateSmartIdSessionRequest{
 		Country:      params.Country,
 		PersonalCode: params.PersonalCode,
+		Locale:       params.Locale,
+		Purpose:      params.Purpose,
 	})
 	if err != nil {
-		w.WriteHeader(http.StatusUnprocessableEntity)
+		switch {
+		case errors.Is(err, errors.ErrUnsupportedLocale), errors.Is(err, errors.ErrUnsupportedPurpose):
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}
 		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
 		return
 	}
@@ -7,14 +7,17 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/models/dto"
 	"loki/internal/app/serializers"
+	"loki/internal/app/services"
 	"loki/internal/app/services/authentication"
 )
 
@@ -24,15 +27,17 @@ func Test_SmartIdController_CreateSession(t *testing.T) {
 
 	ctx := gomock.Any()
 	provider := authentication.NewMockSmartIdProvider(ctrl)
-	controller := NewSmartIdController(provider)
+	throttle := services.NewMockThrottle(ctrl)
+	controller := NewSmartIdController(provider, throttle)
 
 	sessionId := uuid.MustParse("8fdb516d-1a82-43ba-b82d-be63df569b86")
 
 	type result struct {
-		response serializers.SessionSerializer
-		error    serializers.ErrorSerializer
-		status   string
-		code     int
+		response   serializers.SessionSerializer
+		error      serializers.ErrorSerializer
+		status     string
+		code       int
+		retryAfter string
 	}
 
 	tests := []struct {
@@ -45,6 +50,11 @@ func Test_SmartIdController_CreateSession(t *testing.T) {
 			name: "Success",
 			body: strings.NewReader(`{"country": "EE", "personal_code": "30303039914"}`),
 			before: func() {
+				throttle.EXPECT().Allow(ctx, &models.ThrottleParams{
+					Endpoint:     models.AuthMethodSmartId,
+					IPAddress:    "192.0.2.1",
+					PersonalCode: "30303039914",
+				}).Return(time.Duration(0), nil)
 				provider.EXPECT().CreateSession(ctx, dto.CreateSmartIdSessionRequest{
 					Country:      "EE",
 					PersonalCode: "30303039914",
@@ -67,7 +77,31 @@ func Test_SmartIdController_CreateSession(t *testing.T) {
 			body:   strings.NewReader(`{"personal_code": "30303039914"}`),
 			before: func() {},
 			expected: result{
-				error:  serializers.ErrorSerializer{Error: "empty country, should be 'EE', 'LV' or 'LT'"},
+				error: serializers.ErrorSerializer{
+					Error:  "empty country, should be 'EE', 'LV' or 'LT'",
+					Fields: map[string]string{"country": "empty country, should be 'EE', 'LV' or 'LT'"},
+				},
+				status: "400 Bad Request",
+				code:   http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Unsupported locale",
+			body: strings.NewReader(`{"country": "EE", "personal_code": "30303039914", "locale": "FI"}`),
+			before: func() {
+				throttle.EXPECT().Allow(ctx, &models.ThrottleParams{
+					Endpoint:     models.AuthMethodSmartId,
+					IPAddress:    "192.0.2.1",
+					PersonalCode: "30303039914",
+				}).Return(time.Duration(0), nil)
+				provider.EXPECT().CreateSession(ctx, dto.CreateSmartIdSessionRequest{
+					Country:      "EE",
+					PersonalCode: "30303039914",
+					Locale:       "fi",
+				}).Return(nil, errors.ErrUnsupportedLocale)
+			},
+			expected: result{
+				error:  serializers.ErrorSerializer{Error: "unsupported locale"},
 				status: "400 Bad Request",
 				code:   http.StatusBadRequest,
 			},
@@ -76,6 +110,11 @@ func Test_SmartIdController_CreateSession(t *testing.T) {
 			name: "Unprocessable entity",
 			body: strings.NewReader(`{"country": "EE", "personal_code": "30303039914"}`),
 			before: func() {
+				throttle.EXPECT().Allow(ctx, &models.ThrottleParams{
+					Endpoint:     models.AuthMethodSmartId,
+					IPAddress:    "192.0.2.1",
+					PersonalCode: "30303039914",
+				}).Return(time.Duration(0), nil)
 				provider.EXPECT().CreateSession(ctx, dto.CreateSmartIdSessionRequest{
 					Country:      "EE",
 					PersonalCode: "30303039914",
@@ -87,6 +126,23 @@ func Test_SmartIdController_CreateSession(t *testing.T) {
 				code:   http.StatusUnprocessableEntity,
 			},
 		},
+		{
+			name: "Too many requests",
+			body: strings.NewReader(`{"country": "EE", "personal_code": "30303039914"}`),
+			before: func() {
+				throttle.EXPECT().Allow(ctx, &models.ThrottleParams{
+					Endpoint:     models.AuthMethodSmartId,
+					IPAddress:    "192.0.2.1",
+					PersonalCode: "30303039914",
+				}).Return(30*time.Second, errors.ErrTooManyRequests)
+			},
+			expected: result{
+				error:      serializers.ErrorSerializer{Error: "too many requests"},
+				status:     "429 Too Many Requests",
+				code:       http.StatusTooManyRequests,
+				retryAfter: "30",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -106,7 +162,7 @@ func Test_SmartIdController_CreateSession(t *testing.T) {
 				err := json.NewDecoder(resp.Body).Decode(&response)
 				assert.NoError(t, err)
 
-				assert.Equal(t, tt.expected.error.Error, response.Error)
+				assert.Equal(t, tt.expected.error, response)
 			} else {
 				var response serializers.SessionSerializer
 				err := json.NewDecoder(resp.Body).Decode(&response)
@@ -117,6 +173,7 @@ func Test_SmartIdController_CreateSession(t *testing.T) {
 
 			assert.Equal(t, tt.expected.code, resp.StatusCode)
 			assert.Equal(t, tt.expected.status, resp.Status)
+			assert.Equal(t, tt.expected.retryAfter, resp.Header.Get("Retry-After"))
 		})
 	}
 }
@@ -0,0 +1,153 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/models/dto"
+	"loki/internal/app/serializers"
+	"loki/internal/app/services"
+	"loki/internal/app/services/authentication"
+	"loki/internal/config/middlewares"
+	"loki/pkg/validator"
+)
+
+type StepUpController interface {
+	CreateSession(w http.ResponseWriter, r *http.Request)
+	Complete(w http.ResponseWriter, r *http.Request)
+}
+
+type stepUpController struct {
+	audit          services.Audit
+	authentication services.Authentication
+	provider       authentication.StepUpProvider
+	throttle       services.Throttle
+}
+
+func NewStepUpController(
+	audit services.Audit,
+	authentication services.Authentication,
+	provider authentication.StepUpProvider,
+	throttle services.Throttle,
+) StepUpController {
+	return &stepUpController{
+		audit:          audit,
+		authentication: authentication,
+		provider:       provider,
+		throttle:       throttle,
+	}
+}
+
+// CreateSession starts a Smart-ID or Mobile-ID re-authentication of the current user
+func (c *stepUpController) CreateSession(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	user, ok := middlewares.CurrentUserFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()})
+		return
+	}
+
+	var params dto.CreateStepUpSessionRequest
+	if err := params.Validate(r.Body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error(), Fields: validator.Fields(err)})
+		return
+	}
+
+	retryAfter, err := c.throttle.Allow(r.Context(), &models.ThrottleParams{
+		Endpoint:     params.Method,
+		IPAddress:    clientIP(r),
+		PersonalCode: user.PersonalCode,
+		PhoneNumber:  params.PhoneNumber,
+	})
+	if err != nil {
+		tooManyRequests(w, retryAfter, err)
+		return
+	}
+
+	session, err := c.provider.CreateSession(r.Context(), user, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.ErrUnsupportedLocale), errors.Is(err, errors.ErrUnsupportedPurpose):
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	response := serializers.SessionSerializer{
+		ID:   session.ID,
+		Code: session.Code,
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// Complete exchanges a successful step-up session for a short-lived elevated access token
+func (c *stepUpController) Complete(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	user, ok := middlewares.CurrentUserFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()})
+		return
+	}
+
+	claim, ok := middlewares.CurrentClaimFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()})
+		return
+	}
+
+	loginSessionId, err := uuid.Parse(claim.SessionID)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrInvalidToken.Error()})
+		return
+	}
+
+	result, err := c.authentication.StepUp(r.Context(), &models.CompleteStepUpParams{
+		SessionId:      chi.URLParam(r, "id"),
+		User:           user,
+		LoginSessionID: loginSessionId,
+	})
+	c.audit.Record(r.Context(), tokenAuditEvent(r, models.AuditStepUpIssued, result, err))
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.ErrSessionNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errors.Is(err, errors.ErrSessionRunning), errors.Is(err, errors.ErrSessionFailed):
+			w.WriteHeader(http.StatusConflict)
+		case errors.Is(err, errors.ErrSessionCancelled), errors.Is(err, errors.ErrSessionExpired):
+			w.WriteHeader(http.StatusGone)
+		case errors.Is(err, errors.ErrSessionRejected), errors.Is(err, errors.ErrStepUpIdentityMismatch):
+			w.WriteHeader(http.StatusForbidden)
+		case errors.Is(err, errors.ErrLoginSessionRevoked):
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	response := serializers.StepUpSerializer{
+		AccessToken: result.AccessToken,
+		ExpiresIn:   int(models.StepUpTokenExp.Seconds()),
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/controllers/step_up.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/controllers/step_up.go -destination=internal/app/controllers/step_up_mock.go -package=controllers
+//
+
+// Package controllers is a generated GoMock package.
+package controllers
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStepUpController is a mock of StepUpController interface.
+type MockStepUpController struct {
+	ctrl     *gomock.Controller
+	recorder *MockStepUpControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockStepUpControllerMockRecorder is the mock recorder for MockStepUpController.
+type MockStepUpControllerMockRecorder struct {
+	mock *MockStepUpController
+}
+
+// NewMockStepUpController creates a new mock instance.
+func NewMockStepUpController(ctrl *gomock.Controller) *MockStepUpController {
+	mock := &MockStepUpController{ctrl: ctrl}
+	mock.recorder = &MockStepUpControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStepUpController) EXPECT() *MockStepUpControllerMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockStepUpController) Complete(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Complete", w, r)
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockStepUpControllerMockRecorder) Complete(w, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockStepUpController)(nil).Complete), w, r)
+}
+
+// CreateSession mocks base method.
+func (m *MockStepUpController) CreateSession(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateSession", w, r)
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStepUpControllerMockRecorder) CreateSession(w, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStepUpController)(nil).CreateSession), w, r)
+}
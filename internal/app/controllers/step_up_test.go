@@ -0,0 +1,321 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/models/dto"
+	"loki/internal/app/serializers"
+	"loki/internal/app/services"
+	"loki/internal/app/services/authentication"
+	"loki/internal/config/middlewares"
+	"loki/pkg/jwt"
+)
+
+func Test_StepUpController_CreateSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := gomock.Any()
+	provider := authentication.NewMockStepUpProvider(ctrl)
+	throttle := services.NewMockThrottle(ctrl)
+	controller := NewStepUpController(services.NewMockAudit(ctrl), services.NewMockAuthentication(ctrl), provider, throttle)
+
+	sessionId := uuid.MustParse("8fdb516d-1a82-43ba-b82d-be63df569b86")
+	user := &models.User{
+		ID:             uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac"),
+		IdentityNumber: "PNOEE-30303039914",
+		PersonalCode:   "30303039914",
+	}
+
+	type result struct {
+		response   serializers.SessionSerializer
+		error      serializers.ErrorSerializer
+		code       int
+		retryAfter string
+	}
+
+	tests := []struct {
+		name        string
+		body        io.Reader
+		currentUser *models.User
+		before      func()
+		expected    result
+	}{
+		{
+			name:        "Success",
+			body:        strings.NewReader(`{"method": "smart_id"}`),
+			currentUser: user,
+			before: func() {
+				throttle.EXPECT().Allow(ctx, &models.ThrottleParams{
+					Endpoint:     models.AuthMethodSmartId,
+					IPAddress:    "192.0.2.1",
+					PersonalCode: "30303039914",
+				}).Return(time.Duration(0), nil)
+				provider.EXPECT().CreateSession(ctx, user, dto.CreateStepUpSessionRequest{
+					Method: models.AuthMethodSmartId,
+				}).Return(&models.Session{ID: sessionId, Code: "1234"}, nil)
+			},
+			expected: result{
+				response: serializers.SessionSerializer{ID: sessionId, Code: "1234"},
+				code:     http.StatusCreated,
+			},
+		},
+		{
+			name:        "Unauthorized",
+			body:        strings.NewReader(`{"method": "smart_id"}`),
+			currentUser: nil,
+			before:      func() {},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()},
+				code:  http.StatusUnauthorized,
+			},
+		},
+		{
+			name:        "Bad request",
+			body:        strings.NewReader(`{"method": "password"}`),
+			currentUser: user,
+			before:      func() {},
+			expected: result{
+				error: serializers.ErrorSerializer{
+					Error:  errors.ErrInvalidAuthenticationMethod.Error(),
+					Fields: map[string]string{"method": errors.ErrInvalidAuthenticationMethod.Error()},
+				},
+				code: http.StatusBadRequest,
+			},
+		},
+		{
+			name:        "Without phone number",
+			body:        strings.NewReader(`{"method": "mobile_id"}`),
+			currentUser: user,
+			before: func() {
+				throttle.EXPECT().Allow(ctx, gomock.Any()).Return(time.Duration(0), nil)
+				provider.EXPECT().CreateSession(ctx, user, gomock.Any()).Return(nil, errors.ErrStepUpPhoneNumberRequired)
+			},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrStepUpPhoneNumberRequired.Error()},
+				code:  http.StatusUnprocessableEntity,
+			},
+		},
+		{
+			name:        "Too many requests",
+			body:        strings.NewReader(`{"method": "mobile_id", "phone_number": "+37268000769"}`),
+			currentUser: user,
+			before: func() {
+				throttle.EXPECT().Allow(ctx, &models.ThrottleParams{
+					Endpoint:     models.AuthMethodMobileId,
+					IPAddress:    "192.0.2.1",
+					PersonalCode: "30303039914",
+					PhoneNumber:  "+37268000769",
+				}).Return(30*time.Second, errors.ErrTooManyRequests)
+			},
+			expected: result{
+				error:      serializers.ErrorSerializer{Error: errors.ErrTooManyRequests.Error()},
+				code:       http.StatusTooManyRequests,
+				retryAfter: "30",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/me/step_up", tt.body)
+			if tt.currentUser != nil {
+				req = req.WithContext(middlewares.NewContextModifier(req.Context()).WithCurrentUser(tt.currentUser).Context())
+			}
+			w := httptest.NewRecorder()
+
+			controller.CreateSession(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			if tt.expected.error.Error != "" {
+				var response serializers.ErrorSerializer
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.error, response)
+			} else {
+				var response serializers.SessionSerializer
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.response, response)
+			}
+
+			assert.Equal(t, tt.expected.code, resp.StatusCode)
+			assert.Equal(t, tt.expected.retryAfter, resp.Header.Get("Retry-After"))
+		})
+	}
+}
+
+func Test_StepUpController_Complete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := gomock.Any()
+	audit := services.NewMockAudit(ctrl)
+	authenticationService := services.NewMockAuthentication(ctrl)
+	controller := NewStepUpController(audit, authenticationService, authentication.NewMockStepUpProvider(ctrl), services.NewMockThrottle(ctrl))
+
+	sessionId := "8fdb516d-1a82-43ba-b82d-be63df569b86"
+	loginSessionId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	user := &models.User{
+		ID:             uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac"),
+		IdentityNumber: "PNOEE-30303039914",
+	}
+	claim := &jwt.Payload{ID: user.IdentityNumber, SessionID: loginSessionId.String()}
+
+	type result struct {
+		response serializers.StepUpSerializer
+		error    serializers.ErrorSerializer
+		code     int
+	}
+
+	tests := []struct {
+		name        string
+		currentUser *models.User
+		claim       *jwt.Payload
+		before      func()
+		expected    result
+	}{
+		{
+			name:        "Success",
+			currentUser: user,
+			claim:       claim,
+			before: func() {
+				authenticationService.EXPECT().StepUp(ctx, &models.CompleteStepUpParams{
+					SessionId:      sessionId,
+					User:           user,
+					LoginSessionID: loginSessionId,
+				}).Return(&models.User{ID: user.ID, AccessToken: "step-up-token"}, nil)
+				audit.EXPECT().Record(ctx, gomock.Any())
+			},
+			expected: result{
+				response: serializers.StepUpSerializer{AccessToken: "step-up-token", ExpiresIn: 300},
+				code:     http.StatusOK,
+			},
+		},
+		{
+			name:   "Unauthorized",
+			before: func() {},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()},
+				code:  http.StatusUnauthorized,
+			},
+		},
+		{
+			name:        "Token without login session",
+			currentUser: user,
+			claim:       &jwt.Payload{ID: user.IdentityNumber},
+			before:      func() {},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrInvalidToken.Error()},
+				code:  http.StatusUnauthorized,
+			},
+		},
+		{
+			name:        "Session is running",
+			currentUser: user,
+			claim:       claim,
+			before: func() {
+				authenticationService.EXPECT().StepUp(ctx, gomock.Any()).Return(nil, errors.ErrSessionRunning)
+				audit.EXPECT().Record(ctx, gomock.Any())
+			},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrSessionRunning.Error()},
+				code:  http.StatusConflict,
+			},
+		},
+		{
+			name:        "Another identity",
+			currentUser: user,
+			claim:       claim,
+			before: func() {
+				authenticationService.EXPECT().StepUp(ctx, gomock.Any()).Return(nil, errors.ErrStepUpIdentityMismatch)
+				audit.EXPECT().Record(ctx, gomock.Any())
+			},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrStepUpIdentityMismatch.Error()},
+				code:  http.StatusForbidden,
+			},
+		},
+		{
+			name:        "Login session revoked",
+			currentUser: user,
+			claim:       claim,
+			before: func() {
+				authenticationService.EXPECT().StepUp(ctx, gomock.Any()).Return(nil, errors.ErrLoginSessionRevoked)
+				audit.EXPECT().Record(ctx, gomock.Any())
+			},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrLoginSessionRevoked.Error()},
+				code:  http.StatusUnauthorized,
+			},
+		},
+		{
+			name:        "Session not found",
+			currentUser: user,
+			claim:       claim,
+			before: func() {
+				authenticationService.EXPECT().StepUp(ctx, gomock.Any()).Return(nil, errors.ErrSessionNotFound)
+				audit.EXPECT().Record(ctx, gomock.Any())
+			},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrSessionNotFound.Error()},
+				code:  http.StatusNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/me/step_up/"+sessionId, nil)
+			modifier := middlewares.NewContextModifier(req.Context())
+			if tt.currentUser != nil {
+				modifier = modifier.WithCurrentUser(tt.currentUser)
+			}
+			if tt.claim != nil {
+				modifier = modifier.WithClaim(tt.claim)
+			}
+			req = req.WithContext(modifier.Context())
+			w := httptest.NewRecorder()
+
+			r := chi.NewRouter()
+			r.Post("/api/me/step_up/{id}", controller.Complete)
+			r.ServeHTTP(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			if tt.expected.error.Error != "" {
+				var response serializers.ErrorSerializer
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.error, response)
+			} else {
+				var response serializers.StepUpSerializer
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.response, response)
+			}
+
+			assert.Equal(t, tt.expected.code, resp.StatusCode)
+		})
+	}
+}
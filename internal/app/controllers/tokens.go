@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
 	"loki/internal/app/models/dto"
 	"loki/internal/app/serializers"
 	"loki/internal/app/services"
@@ -14,11 +16,15 @@ type TokensController interface {
 }
 
 type tokensController struct {
+	audit  services.Audit
 	tokens services.Tokens
 }
 
-func NewTokensController(tokens services.Tokens) TokensController {
-	return &tokensController{tokens: tokens}
+func NewTokensController(audit services.Audit, tokens services.Tokens) TokensController {
+	return &tokensController{
+		audit:  audit,
+		tokens: tokens,
+	}
 }
 
 func (c *tokensController) Refresh(w http.ResponseWriter, r *http.Request) {
@@ -31,9 +37,21 @@ func (c *tokensController) Refresh(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := c.tokens.Update(r.Context(), params.RefreshToken)
+	user, err := c.tokens.Update(r.Context(), &models.RefreshTokenParams{
+		RefreshToken: params.RefreshToken,
+		IPAddress:    clientIP(r),
+		UserAgent:    r.UserAgent(),
+	})
+	c.audit.Record(r.Context(), tokenAuditEvent(r, models.AuditTokenRefreshed, user, err))
 	if err != nil {
-		w.WriteHeader(http.StatusUnprocessableEntity)
+		switch {
+		case errors.Is(err, errors.ErrReauthenticationRequired):
+			w.WriteHeader(http.StatusUnauthorized)
+		case errors.Is(err, errors.ErrSuspiciousLogin):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}
 		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
 		return
 	}
@@ -23,7 +23,10 @@ func Test_TokensController_Refresh(t *testing.T) {
 	defer ctrl.Finish()
 
 	tokens := services.NewMockTokens(ctrl)
-	controller := NewTokensController(tokens)
+	audit := services.NewMockAudit(ctrl)
+	audit.EXPECT().Record(gomock.Any(), gomock.Any()).AnyTimes()
+
+	controller := NewTokensController(audit, tokens)
 
 	type result struct {
 		response serializers.TokensSerializer
@@ -42,7 +45,11 @@ func Test_TokensController_Refresh(t *testing.T) {
 		{
 			name: "Success",
 			before: func() {
-				tokens.EXPECT().Update(gomock.Any(), "refresh-token").Return(&models.User{
+				tokens.EXPECT().Update(gomock.Any(), &models.RefreshTokenParams{
+					RefreshToken: "refresh-token",
+					IPAddress:    "192.0.2.1",
+					UserAgent:    "Mozilla/5.0",
+				}).Return(&models.User{
 					AccessToken:  "new-access-token",
 					RefreshToken: "new-refresh-token",
 				}, nil)
@@ -73,7 +80,11 @@ func Test_TokensController_Refresh(t *testing.T) {
 		{
 			name: "Invalid refresh token",
 			before: func() {
-				tokens.EXPECT().Update(gomock.Any(), "invalid-token").Return(nil, errors.ErrInvalidToken)
+				tokens.EXPECT().Update(gomock.Any(), &models.RefreshTokenParams{
+					RefreshToken: "invalid-token",
+					IPAddress:    "192.0.2.1",
+					UserAgent:    "Mozilla/5.0",
+				}).Return(nil, errors.ErrInvalidToken)
 			},
 			body: strings.NewReader(`{"refresh_token": "invalid-token"}`),
 			expected: result{
@@ -83,10 +94,40 @@ func Test_TokensController_Refresh(t *testing.T) {
 			},
 			error: true,
 		},
+		{
+			name: "Re-authentication required",
+			before: func() {
+				tokens.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, errors.ErrReauthenticationRequired)
+			},
+			body: strings.NewReader(`{"refresh_token": "refresh-token"}`),
+			expected: result{
+				error:  serializers.ErrorSerializer{Error: errors.ErrReauthenticationRequired.Error()},
+				status: "401 Unauthorized",
+				code:   http.StatusUnauthorized,
+			},
+			error: true,
+		},
+		{
+			name: "Suspicious refresh",
+			before: func() {
+				tokens.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, errors.ErrSuspiciousLogin)
+			},
+			body: strings.NewReader(`{"refresh_token": "refresh-token"}`),
+			expected: result{
+				error:  serializers.ErrorSerializer{Error: errors.ErrSuspiciousLogin.Error()},
+				status: "403 Forbidden",
+				code:   http.StatusForbidden,
+			},
+			error: true,
+		},
 		{
 			name: "Error",
 			before: func() {
-				tokens.EXPECT().Update(gomock.Any(), "refresh-token").Return(nil, assert.AnError)
+				tokens.EXPECT().Update(gomock.Any(), &models.RefreshTokenParams{
+					RefreshToken: "refresh-token",
+					IPAddress:    "192.0.2.1",
+					UserAgent:    "Mozilla/5.0",
+				}).Return(nil, assert.AnError)
 			},
 			body: strings.NewReader(`{"refresh_token": "refresh-token"}`),
 			expected: result{
@@ -103,6 +144,7 @@ func Test_TokensController_Refresh(t *testing.T) {
 			tt.before()
 
 			req := httptest.NewRequest(http.MethodPost, "/api/tokens/refresh", tt.body)
+			req.Header.Set("User-Agent", "Mozilla/5.0")
 			w := httptest.NewRecorder()
 
 			r := chi.NewRouter()
@@ -5,22 +5,29 @@ import (
 	"net/http"
 
 	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/models/dto"
 	"loki/internal/app/serializers"
 	"loki/internal/app/services"
 	"loki/internal/config/middlewares"
+	"loki/pkg/validator"
 )
 
 type UsersController interface {
 	Me(w http.ResponseWriter, r *http.Request)
+	UpdateMe(w http.ResponseWriter, r *http.Request)
+	VerifyEmail(w http.ResponseWriter, r *http.Request)
 }
 
 type usersController struct {
-	users services.Users
+	profiles services.Profiles
+	users    services.Users
 }
 
-func NewUsersController(users services.Users) UsersController {
+func NewUsersController(profiles services.Profiles, users services.Users) UsersController {
 	return &usersController{
-		users: users,
+		profiles: profiles,
+		users:    users,
 	}
 }
 
@@ -34,14 +41,97 @@ func (c *usersController) Me(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response := serializers.UserSerializer{
+	profile, err := c.profiles.FindByUserId(r.Context(), user.ID)
+	if err != nil {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(toUserSerializer(user, profile))
+}
+
+func (c *usersController) UpdateMe(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	user, ok := middlewares.CurrentUserFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()})
+		return
+	}
+
+	var params dto.UpdateProfileRequest
+	if err := params.Validate(r.Body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error(), Fields: validator.Fields(err)})
+		return
+	}
+
+	profile, err := c.profiles.Update(r.Context(), user.ID, &models.UpdateProfileParams{
+		Email:      params.Email,
+		Locale:     params.Locale,
+		Attributes: params.Attributes,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.ErrInvalidAttributes):
+			w.WriteHeader(http.StatusBadRequest)
+		case errors.Is(err, errors.ErrEmailVerificationCooldown):
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(toUserSerializer(user, profile))
+}
+
+func (c *usersController) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	user, ok := middlewares.CurrentUserFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()})
+		return
+	}
+
+	var params dto.VerifyEmailRequest
+	if err := params.Validate(r.Body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	profile, err := c.profiles.VerifyEmail(r.Context(), user.ID, params.Code)
+	if err != nil {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(toUserSerializer(user, profile))
+}
+
+func toUserSerializer(user *models.User, profile *models.Profile) serializers.UserSerializer {
+	return serializers.UserSerializer{
 		ID:             user.ID,
 		IdentityNumber: user.IdentityNumber,
 		PersonalCode:   user.PersonalCode,
 		FirstName:      user.FirstName,
 		LastName:       user.LastName,
+		Profile: &serializers.ProfileSerializer{
+			Email:         profile.Email,
+			EmailVerified: profile.EmailVerified(),
+			PhoneNumber:   profile.PhoneNumber,
+			Locale:        profile.Locale,
+			Attributes:    profile.Attributes,
+		},
 	}
-
-	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(response)
 }
@@ -51,3 +51,27 @@ func (mr *MockUsersControllerMockRecorder) Me(w, r any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Me", reflect.TypeOf((*MockUsersController)(nil).Me), w, r)
 }
+
+// UpdateMe mocks base method.
+func (m *MockUsersController) UpdateMe(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateMe", w, r)
+}
+
+// UpdateMe indicates an expected call of UpdateMe.
+func (mr *MockUsersControllerMockRecorder) UpdateMe(w, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMe", reflect.TypeOf((*MockUsersController)(nil).UpdateMe), w, r)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockUsersController) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VerifyEmail", w, r)
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUsersControllerMockRecorder) VerifyEmail(w, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUsersController)(nil).VerifyEmail), w, r)
+}
@@ -3,15 +3,19 @@ package controllers
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/serializers"
 	"loki/internal/app/services"
@@ -22,8 +26,9 @@ func Test_UsersController_Me(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	profiles := services.NewMockProfiles(ctrl)
 	users := services.NewMockUsers(ctrl)
-	controller := NewUsersController(users)
+	controller := NewUsersController(profiles, users)
 
 	identityNumber := "PNOEE-123456789"
 
@@ -39,11 +44,22 @@ func Test_UsersController_Me(t *testing.T) {
 
 	tests := []struct {
 		name        string
+		before      func()
 		currentUser *models.User
 		expected    result
 	}{
 		{
 			name: "Success",
+			before: func() {
+				profiles.EXPECT().FindByUserId(gomock.Any(), id).Return(&models.Profile{
+					UserID:          id,
+					Email:           "john.doe@example.com",
+					EmailVerifiedAt: time.Now(),
+					PhoneNumber:     "+37268000769",
+					Locale:          "et",
+					Attributes:      map[string]any{"department": "IT"},
+				}, nil)
+			},
 			currentUser: &models.User{
 				ID:             id,
 				IdentityNumber: identityNumber,
@@ -58,6 +74,13 @@ func Test_UsersController_Me(t *testing.T) {
 					PersonalCode:   "123456789",
 					FirstName:      "John",
 					LastName:       "Doe",
+					Profile: &serializers.ProfileSerializer{
+						Email:         "john.doe@example.com",
+						EmailVerified: true,
+						PhoneNumber:   "+37268000769",
+						Locale:        "et",
+						Attributes:    map[string]any{"department": "IT"},
+					},
 				},
 				status: "200 OK",
 				code:   http.StatusOK,
@@ -65,6 +88,7 @@ func Test_UsersController_Me(t *testing.T) {
 		},
 		{
 			name:        "Unauthorized",
+			before:      func() {},
 			currentUser: nil,
 			expected: result{
 				error:  serializers.ErrorSerializer{Error: "unauthorized"},
@@ -72,10 +96,24 @@ func Test_UsersController_Me(t *testing.T) {
 				code:   http.StatusUnauthorized,
 			},
 		},
+		{
+			name: "Failed to find profile",
+			before: func() {
+				profiles.EXPECT().FindByUserId(gomock.Any(), id).Return(nil, errors.ErrFailedToFetchResults)
+			},
+			currentUser: &models.User{ID: id},
+			expected: result{
+				error:  serializers.ErrorSerializer{Error: errors.ErrFailedToFetchResults.Error()},
+				status: "422 Unprocessable Entity",
+				code:   http.StatusUnprocessableEntity,
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
 			req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
 			if tt.currentUser != nil {
 				ctx := context.WithValue(req.Context(), middlewares.CurrentUser{}, tt.currentUser)
@@ -90,7 +128,7 @@ func Test_UsersController_Me(t *testing.T) {
 			resp := w.Result()
 			defer resp.Body.Close()
 
-			if tt.currentUser != nil {
+			if tt.expected.code == http.StatusOK {
 				var response serializers.UserSerializer
 				err = json.NewDecoder(resp.Body).Decode(&response)
 				assert.NoError(t, err)
@@ -107,3 +145,192 @@ func Test_UsersController_Me(t *testing.T) {
 		})
 	}
 }
+
+func Test_UsersController_UpdateMe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	profiles := services.NewMockProfiles(ctrl)
+	controller := NewUsersController(profiles, services.NewMockUsers(ctrl))
+
+	id := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	currentUser := &models.User{ID: id, IdentityNumber: "PNOEE-123456789"}
+	email := "john.doe@example.com"
+
+	type result struct {
+		response serializers.UserSerializer
+		error    serializers.ErrorSerializer
+		code     int
+	}
+
+	tests := []struct {
+		name     string
+		body     io.Reader
+		before   func()
+		expected result
+	}{
+		{
+			name: "Success",
+			body: strings.NewReader(`{"email": "john.doe@example.com", "attributes": {"department": "IT"}}`),
+			before: func() {
+				profiles.EXPECT().Update(gomock.Any(), id, &models.UpdateProfileParams{
+					Email:      &email,
+					Attributes: map[string]any{"department": "IT"},
+				}).Return(&models.Profile{
+					UserID:     id,
+					Email:      email,
+					Attributes: map[string]any{"department": "IT"},
+				}, nil)
+			},
+			expected: result{
+				response: serializers.UserSerializer{
+					ID:             id,
+					IdentityNumber: "PNOEE-123456789",
+					Profile: &serializers.ProfileSerializer{
+						Email:      email,
+						Attributes: map[string]any{"department": "IT"},
+					},
+				},
+				code: http.StatusOK,
+			},
+		},
+		{
+			name:   "Invalid email",
+			body:   strings.NewReader(`{"email": "john.doe"}`),
+			before: func() {},
+			expected: result{
+				error: serializers.ErrorSerializer{
+					Error:  errors.ErrInvalidEmail.Error(),
+					Fields: map[string]string{"email": errors.ErrInvalidEmail.Error()},
+				},
+				code: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Attributes too large",
+			body: strings.NewReader(`{"attributes": {"notes": "..."}}`),
+			before: func() {
+				profiles.EXPECT().Update(gomock.Any(), id, gomock.Any()).Return(nil, errors.ErrInvalidAttributes)
+			},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrInvalidAttributes.Error()},
+				code:  http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Code sent recently",
+			body: strings.NewReader(`{"email": "john.doe@example.com"}`),
+			before: func() {
+				profiles.EXPECT().Update(gomock.Any(), id, gomock.Any()).Return(nil, errors.ErrEmailVerificationCooldown)
+			},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrEmailVerificationCooldown.Error()},
+				code:  http.StatusTooManyRequests,
+			},
+		},
+		{
+			name: "Failed to send email",
+			body: strings.NewReader(`{"email": "john.doe@example.com"}`),
+			before: func() {
+				profiles.EXPECT().Update(gomock.Any(), id, gomock.Any()).Return(nil, errors.ErrFailedToSendEmail)
+			},
+			expected: result{
+				error: serializers.ErrorSerializer{Error: errors.ErrFailedToSendEmail.Error()},
+				code:  http.StatusUnprocessableEntity,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/me", tt.body)
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.CurrentUser{}, currentUser))
+			w := httptest.NewRecorder()
+
+			r := chi.NewRouter()
+			r.Patch("/api/me", controller.UpdateMe)
+			r.ServeHTTP(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			if tt.expected.code == http.StatusOK {
+				var response serializers.UserSerializer
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+				assert.Equal(t, tt.expected.response, response)
+			} else {
+				var response serializers.ErrorSerializer
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+				assert.Equal(t, tt.expected.error, response)
+			}
+
+			assert.Equal(t, tt.expected.code, resp.StatusCode)
+		})
+	}
+}
+
+func Test_UsersController_VerifyEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	profiles := services.NewMockProfiles(ctrl)
+	controller := NewUsersController(profiles, services.NewMockUsers(ctrl))
+
+	id := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	currentUser := &models.User{ID: id}
+
+	tests := []struct {
+		name   string
+		body   io.Reader
+		before func()
+		code   int
+	}{
+		{
+			name: "Success",
+			body: strings.NewReader(`{"code": "123456"}`),
+			before: func() {
+				profiles.EXPECT().VerifyEmail(gomock.Any(), id, "123456").Return(&models.Profile{
+					UserID:          id,
+					Email:           "john.doe@example.com",
+					EmailVerifiedAt: time.Now(),
+				}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:   "Empty code",
+			body:   strings.NewReader(`{}`),
+			before: func() {},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name: "Invalid code",
+			body: strings.NewReader(`{"code": "654321"}`),
+			before: func() {
+				profiles.EXPECT().VerifyEmail(gomock.Any(), id, "654321").Return(nil, errors.ErrInvalidVerificationCode)
+			},
+			code: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/me/email/verify", tt.body)
+			req = req.WithContext(context.WithValue(req.Context(), middlewares.CurrentUser{}, currentUser))
+			w := httptest.NewRecorder()
+
+			r := chi.NewRouter()
+			r.Post("/api/me/email/verify", controller.VerifyEmail)
+			r.ServeHTTP(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.code, resp.StatusCode)
+		})
+	}
+}
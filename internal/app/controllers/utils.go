@@ -0,0 +1,34 @@
+package controllers
+
+import (
+	"encoding/json"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"loki/internal/app/serializers"
+)
+
+// clientIP returns the host part of the remote address of the request
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// tooManyRequests rejects a throttled request, Retry-After is rounded up to whole seconds
+func tooManyRequests(w http.ResponseWriter, retryAfter time.Duration, err error) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: err.Error()})
+}
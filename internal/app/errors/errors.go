@@ -18,12 +18,18 @@ var (
 	// ErrEmptyCountry indicates that the country code is empty or invalid
 	ErrEmptyCountry = errors.New("empty country, should be 'EE', 'LV' or 'LT'")
 
+	// ErrInvalidCountry indicates that the country code is not supported
+	ErrInvalidCountry = errors.New("invalid country, should be 'EE', 'LV' or 'LT'")
+
 	// ErrEmptyIdentityNumber indicates that the identity number is empty or invalid
 	ErrEmptyIdentityNumber = errors.New("empty identity number")
 
 	// ErrEmptyPersonalCode indicates that the personal code is empty or invalid
 	ErrEmptyPersonalCode = errors.New("empty personal code")
 
+	// ErrInvalidPersonalCode indicates that the personal code has an invalid format, birth date or checksum
+	ErrInvalidPersonalCode = errors.New("invalid personal code")
+
 	// ErrEmptyFirstName indicates that the first name is empty or invalid
 	ErrEmptyFirstName = errors.New("empty first name")
 
@@ -33,9 +39,72 @@ var (
 	// ErrEmptyPhoneNumber indicates that the phone number is empty or invalid
 	ErrEmptyPhoneNumber = errors.New("empty phone number")
 
+	// ErrInvalidPhoneNumber indicates that the phone number is not a valid E.164 number of a Mobile-ID country
+	ErrInvalidPhoneNumber = errors.New("invalid phone number, should be in E.164 format with '+372' or '+370' prefix")
+
+	// ErrInvalidEmail indicates that the email is not a single plain address such as 'john@example.com'
+	ErrInvalidEmail = errors.New("invalid email address")
+
+	// ErrInvalidLocale indicates that the locale is not a language tag such as 'et' or 'en-GB'
+	ErrInvalidLocale = errors.New("invalid locale, should be a language tag such as 'et' or 'en-GB'")
+
+	// ErrInvalidAttributes indicates that the profile attributes are not a JSON object within the size limit
+	ErrInvalidAttributes = errors.New("invalid attributes, should be a JSON object of at most 4 KB")
+
 	// ErrEmptyLocale indicates that the locale is empty or invalid
 	ErrEmptyLocale = errors.New("empty locale")
 
+	// ErrUnsupportedLocale indicates that no translations are configured for the requested locale
+	ErrUnsupportedLocale = errors.New("unsupported locale")
+
+	// ErrUnsupportedPurpose indicates that no display text is configured for the requested purpose
+	ErrUnsupportedPurpose = errors.New("unsupported purpose")
+
+	// ErrInvalidDisplayText indicates that the display text does not satisfy the Smart-ID and Mobile-ID limits
+	ErrInvalidDisplayText = errors.New("invalid display text")
+
+	// ErrEmptyDisplayText indicates that the text shown on the device of the user is empty
+	ErrEmptyDisplayText = errors.New("empty display text")
+
+	// ErrEmptyPayload indicates that the payload to confirm is empty
+	ErrEmptyPayload = errors.New("empty payload")
+
+	// ErrInvalidPayload indicates that the payload to confirm exceeds the size limit
+	ErrInvalidPayload = errors.New("invalid payload, should be at most 65536 bytes")
+
+	// ErrInvalidMobileIdLanguage indicates that the Mobile-ID language is not one of 'EST', 'ENG', 'RUS' or 'LIT'
+	ErrInvalidMobileIdLanguage = errors.New("invalid mobile-id language, should be 'EST', 'ENG', 'RUS' or 'LIT'")
+
+	// ErrInvalidMailerDriver indicates that the mailer driver is not 'file' or 'smtp'
+	ErrInvalidMailerDriver = errors.New("invalid mailer driver, should be 'file' or 'smtp'")
+
+	// ErrInvalidGeoIPDatabase indicates that a row of the GeoIP database is not 'network,country,latitude,longitude'
+	ErrInvalidGeoIPDatabase = errors.New("invalid geoip database, rows should be 'network,country,latitude,longitude'")
+
+	// ErrWebhookFailed indicates that the webhook endpoint did not accept the event
+	ErrWebhookFailed = errors.New("webhook failed")
+
+	// ErrSigningProviderError indicates that the Smart-ID or Mobile-ID signature service refused the request
+	ErrSigningProviderError = errors.New("signature provider error")
+
+	// ErrSigningSessionNotFound indicates that the signature service does not know the session
+	ErrSigningSessionNotFound = errors.New("signature session not found")
+
+	// ErrInvalidSignature indicates that the signature does not verify against the hash with the signer certificate
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrInvalidSigningCertificate indicates that the signer certificate is missing or cannot be parsed
+	ErrInvalidSigningCertificate = errors.New("invalid signing certificate")
+
+	// ErrUntrustedSigningCertificate indicates that the signer certificate does not chain to a trust anchor
+	ErrUntrustedSigningCertificate = errors.New("untrusted signing certificate")
+
+	// ErrSignerMismatch indicates that the signer certificate was issued to another person than the user
+	ErrSignerMismatch = errors.New("signer does not match the user")
+
+	// ErrConfirmationNotFound indicates that the confirmation does not exist, has expired or belongs to another user
+	ErrConfirmationNotFound = errors.New("confirmation not found")
+
 	// ErrEmptyName indicates that the name is empty or invalid
 	ErrEmptyName = errors.New("empty name")
 
@@ -45,12 +114,39 @@ var (
 	// ErrInvalidIdentityNumber indicates that the provided identity number is invalid
 	ErrInvalidIdentityNumber = errors.New("invalid identity number")
 
+	// ErrUnsupportedFormat indicates that the import or export format is not 'csv' or 'jsonl'
+	ErrUnsupportedFormat = errors.New("unsupported format, should be 'csv' or 'jsonl'")
+
+	// ErrMalformedRecord indicates that an import record could not be decoded
+	ErrMalformedRecord = errors.New("malformed record")
+
+	// ErrDuplicateRecord indicates that the identity number appears more than once in the same import
+	ErrDuplicateRecord = errors.New("duplicate record")
+
 	// ErrInvalidCertificate indicates that the provided certificate is invalid
 	ErrInvalidCertificate = errors.New("invalid certificate")
 
 	// ErrSessionNotFound indicates that the requested session could not be found
 	ErrSessionNotFound = errors.New("session not found")
 
+	// ErrSessionRunning indicates that the session has not been completed yet
+	ErrSessionRunning = errors.New("session is still running")
+
+	// ErrSessionFailed indicates that the session ended with an error
+	ErrSessionFailed = errors.New("session failed")
+
+	// ErrSessionCancelled indicates that the session was cancelled by the user
+	ErrSessionCancelled = errors.New("session cancelled")
+
+	// ErrSessionExpired indicates that the session timed out before it was completed
+	ErrSessionExpired = errors.New("session expired")
+
+	// ErrSessionRejected indicates that the user was refused by the provisioning policy or is not active
+	ErrSessionRejected = errors.New("session rejected")
+
+	// ErrInvalidSessionTransition indicates that the session cannot move to the requested status
+	ErrInvalidSessionTransition = errors.New("invalid session status transition")
+
 	// ErrInvalidArguments indicates that the provided request arguments are invalid
 	ErrInvalidArguments = errors.New("invalid arguments")
 
@@ -75,12 +171,102 @@ var (
 	// ErrRoleNotFound indicates that the requested role could not be found
 	ErrRoleNotFound = errors.New("role not found")
 
+	// ErrRoleHierarchyCycle indicates that the parent role would make the role hierarchy cyclic
+	ErrRoleHierarchyCycle = errors.New("role hierarchy must not contain cycles")
+
+	// ErrRoleExceedsCallerPermissions indicates that the role grants permissions the caller does not hold itself
+	ErrRoleExceedsCallerPermissions = errors.New("role grants permissions the caller does not hold")
+
 	// ErrScopeNotFound indicates that the requested scope could not be found
 	ErrScopeNotFound = errors.New("scope not found")
 
+	// ErrOrganisationNotFound indicates that the requested organisation could not be found
+	ErrOrganisationNotFound = errors.New("organisation not found")
+
+	// ErrMembershipNotFound indicates that the user does not hold the role in the organisation
+	ErrMembershipNotFound = errors.New("membership not found")
+
+	// ErrElevationNotFound indicates that the requested role elevation could not be found
+	ErrElevationNotFound = errors.New("elevation not found")
+
+	// ErrElevationAlreadyDecided indicates that the role elevation has already been approved or rejected
+	ErrElevationAlreadyDecided = errors.New("elevation already decided")
+
+	// ErrSelfApproval indicates that the user tried to decide their own role elevation
+	ErrSelfApproval = errors.New("elevation cannot be decided by the requester")
+
 	// ErrUserNotFound indicates that the requested user could not be found
 	ErrUserNotFound = errors.New("user not found")
 
+	// ErrUserInactive indicates that the user is suspended, locked or deleted and may not authenticate
+	ErrUserInactive = errors.New("user is not active")
+
+	// ErrInvitationNotFound indicates that the requested invitation could not be found
+	ErrInvitationNotFound = errors.New("invitation not found")
+
+	// ErrInvitationAlreadyExists indicates that the person already has a pending invitation
+	ErrInvitationAlreadyExists = errors.New("invitation already exists")
+
+	// ErrInvitationNotPending indicates that the invitation has already been accepted or revoked
+	ErrInvitationNotPending = errors.New("invitation is not pending")
+
+	// ErrUserAlreadyRegistered indicates that the invited person already has an account
+	ErrUserAlreadyRegistered = errors.New("user already registered")
+
+	// ErrInvalidVerificationCode indicates that the email verification code is wrong or has expired
+	ErrInvalidVerificationCode = errors.New("invalid or expired verification code")
+
+	// ErrEmailVerificationCooldown indicates that a verification code was sent too recently to send another one
+	ErrEmailVerificationCooldown = errors.New("verification code was sent recently, try again later")
+
+	// ErrFailedToSendEmail indicates that the mailer could not deliver the message
+	ErrFailedToSendEmail = errors.New("failed to send email")
+
+	// ErrInvalidAuthenticationMethod indicates that the authentication method is not 'smart_id' or 'mobile_id'
+	ErrInvalidAuthenticationMethod = errors.New("invalid authentication method, should be 'smart_id' or 'mobile_id'")
+
+	// ErrAuthenticationMethodNotAllowed indicates that the user has restricted their account to other authentication methods
+	ErrAuthenticationMethodNotAllowed = errors.New("authentication method not allowed")
+
+	// ErrLoginSessionRevoked indicates that the login session of a refresh token was revoked or no longer exists
+	ErrLoginSessionRevoked = errors.New("login session revoked")
+
+	// ErrReauthenticationRequired indicates that the risk of a token refresh requires the user to log in again
+	ErrReauthenticationRequired = errors.New("re-authentication required")
+
+	// ErrSuspiciousLogin indicates that the login or token refresh was blocked by the risk evaluation
+	ErrSuspiciousLogin = errors.New("suspicious login")
+
+	// ErrStepUpIdentityMismatch indicates that the step-up session was completed by another identity than the current user
+	ErrStepUpIdentityMismatch = errors.New("step-up identity mismatch")
+
+	// ErrStepUpRequired indicates that the access token is too old or its authentication context is too weak
+	ErrStepUpRequired = errors.New("step-up authentication required")
+
+	// ErrStepUpPhoneNumberRequired indicates that a Mobile-ID step-up needs a phone number from the request or the profile
+	ErrStepUpPhoneNumberRequired = errors.New("phone number is required for Mobile-ID step-up")
+
+	// ErrProvisioningRejected indicates that the provisioning policy does not allow the person to be registered
+	ErrProvisioningRejected = errors.New("registration not allowed")
+
+	// ErrInvalidProvisioningMode indicates that the provisioning mode is not 'open' or 'invite'
+	ErrInvalidProvisioningMode = errors.New("invalid provisioning mode, should be 'open' or 'invite'")
+
+	// ErrInvalidUserStatus indicates that the user status is not 'active', 'suspended', 'locked' or 'deleted'
+	ErrInvalidUserStatus = errors.New("invalid user status, should be 'active', 'suspended', 'locked' or 'deleted'")
+
+	// ErrInvalidPolicy indicates that the policy has no actions or its condition is not a valid boolean CEL expression
+	ErrInvalidPolicy = errors.New("invalid policy")
+
+	// ErrInvalidPolicyEffect indicates that the policy effect is not 'allow' or 'deny'
+	ErrInvalidPolicyEffect = errors.New("invalid policy effect, should be 'allow' or 'deny'")
+
+	// ErrPolicyEvaluation indicates that the policy condition could not be evaluated for the request
+	ErrPolicyEvaluation = errors.New("failed to evaluate policy")
+
+	// ErrPoliciesNotLoaded indicates that no policy set has been loaded yet, requests are denied until one is
+	ErrPoliciesNotLoaded = errors.New("policies not loaded")
+
 	// ErrSmartIdProviderError indicates an error originating from the Smart-ID provider
 	ErrSmartIdProviderError = errors.New("smart-id provider error")
 
@@ -92,6 +278,9 @@ var (
 
 	// ErrUnauthorized indicates that the user is not authorized to perform the requested action
 	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrTooManyRequests indicates that the client or the person has exceeded the rate limit of the endpoint
+	ErrTooManyRequests = errors.New("too many requests")
 )
 
 var (
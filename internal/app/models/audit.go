@@ -0,0 +1,142 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditSuccess = "success"
+	AuditFailure = "failure"
+
+	AuditLoginSucceeded = "login_succeeded"
+	AuditLoginFailed    = "login_failed"
+	AuditTokenIssued    = "token_issued"
+	AuditTokenRefreshed = "token_refreshed"
+	AuditGrantExpired   = "grant_expired"
+	AuditRiskDetected   = "login_risk_detected"
+	AuditStepUpIssued   = "step_up_issued"
+
+	AuditProfileUpdated = "profile_updated"
+	AuditEmailVerified  = "email_verified"
+
+	AuditAllowedMethodsUpdated = "allowed_methods_updated"
+	AuditLoginSessionRevoked   = "login_session_revoked"
+
+	AuditInvitationCreated  = "invitation_created"
+	AuditInvitationExtended = "invitation_extended"
+	AuditInvitationRevoked  = "invitation_revoked"
+
+	AuditConfirmationSigned = "confirmation_signed"
+	AuditConfirmationFailed = "confirmation_failed"
+
+	AuditProviderSmartId  = "smart_id"
+	AuditProviderMobileId = "mobile_id"
+)
+
+// AuditEvent is an append-only record of an authentication or administrative event
+type AuditEvent struct {
+	ID         uuid.UUID
+	Action     string
+	ActorID    uuid.UUID
+	TargetType string
+	TargetID   string
+	Status     string
+	Changes    map[string]any
+	Metadata   map[string]string
+	TraceID    string
+	RemoteAddr string
+	CreatedAt  time.Time
+	Sequence   int64
+	PrevHash   string
+	Hash       string
+}
+
+// auditDigest is the canonical form of an event covered by the chain hash
+type auditDigest struct {
+	PrevHash   string            `json:"prev_hash"`
+	ID         string            `json:"id"`
+	Action     string            `json:"action"`
+	ActorID    string            `json:"actor_id"`
+	TargetType string            `json:"target_type"`
+	TargetID   string            `json:"target_id"`
+	Status     string            `json:"status"`
+	Changes    map[string]any    `json:"changes"`
+	Metadata   map[string]string `json:"metadata"`
+	TraceID    string            `json:"trace_id"`
+	RemoteAddr string            `json:"remote_addr"`
+	CreatedAt  string            `json:"created_at"`
+}
+
+// Digest returns the hex encoded SHA-256 of the event linked to the hash of the previous event
+func (e *AuditEvent) Digest() (string, error) {
+	digest := auditDigest{
+		PrevHash:   e.PrevHash,
+		ID:         e.ID.String(),
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		Status:     e.Status,
+		Changes:    e.Changes,
+		Metadata:   e.Metadata,
+		TraceID:    e.TraceID,
+		RemoteAddr: e.RemoteAddr,
+		CreatedAt:  e.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+
+	if e.ActorID != uuid.Nil {
+		digest.ActorID = e.ActorID.String()
+	}
+	if digest.Changes == nil {
+		digest.Changes = map[string]any{}
+	}
+	if digest.Metadata == nil {
+		digest.Metadata = map[string]string{}
+	}
+
+	payload, err := json.Marshal(digest)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// AuditCheckpoint is a signed statement of the chain hash at a given event sequence
+type AuditCheckpoint struct {
+	ID        uuid.UUID
+	Sequence  int64
+	Hash      string
+	Signature string
+	CreatedAt time.Time
+}
+
+// Payload returns the data covered by the checkpoint signature
+func (c *AuditCheckpoint) Payload() []byte {
+	return []byte(fmt.Sprintf("%d:%s", c.Sequence, c.Hash))
+}
+
+// AuditVerification is the result of walking the audit chain, the broken fields describe the first invalid link
+type AuditVerification struct {
+	Valid          bool
+	Events         uint64
+	Checkpoints    uint64
+	BrokenSequence int64
+	BrokenEventID  uuid.UUID
+	Reason         string
+}
+
+// AuditFilter narrows the listed audit events, zero values match every event
+type AuditFilter struct {
+	Action   string
+	ActorID  uuid.UUID
+	TargetID string
+	From     time.Time
+	To       time.Time
+}
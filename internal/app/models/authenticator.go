@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuthMethodSmartId  = "smart_id"
+	AuthMethodMobileId = "mobile_id"
+)
+
+// AuthMethods lists the authentication methods a user can restrict their account to
+var AuthMethods = []string{AuthMethodSmartId, AuthMethodMobileId}
+
+// Authenticator is a method and identifier, such as a Mobile-ID phone number, a user has logged in with
+type Authenticator struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Method      string
+	Identifier  string
+	Metadata    map[string]string
+	FirstUsedAt time.Time
+	LastUsedAt  time.Time
+}
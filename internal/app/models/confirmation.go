@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Confirmation is an operation the user confirms with a Smart-ID or Mobile-ID signature of its hash,
+// it moves through the same statuses as an authentication session
+type Confirmation struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Method      string
+	Code        string
+	Status      string
+	Error       string
+	Hash        string
+	HashType    string
+	Signature   string
+	Algorithm   string
+	Certificate string
+	CreatedAt   time.Time
+}
+
+// CanTransitionTo reports whether the confirmation is allowed to move to the given status
+func (c *Confirmation) CanTransitionTo(status string) bool {
+	session := Session{Status: c.Status}
+	return session.CanTransitionTo(status)
+}
+
+// UpdateConfirmationParams records the outcome of the signature session
+type UpdateConfirmationParams struct {
+	ID          uuid.UUID
+	Status      string
+	Error       string
+	Signature   string
+	Algorithm   string
+	Certificate string
+}
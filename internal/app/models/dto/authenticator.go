@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/pkg/validator"
+)
+
+// SetAllowedMethodsRequest replaces the methods a user can log in with, an empty list allows every method
+type SetAllowedMethodsRequest struct {
+	Methods []string `json:"methods"`
+}
+
+func (params *SetAllowedMethodsRequest) Validate(body io.Reader) error {
+	if err := json.NewDecoder(body).Decode(params); err != nil {
+		return err
+	}
+
+	var errs validator.Errors
+
+	for i, method := range params.Methods {
+		params.Methods[i] = strings.TrimSpace(method)
+
+		if !slices.Contains(models.AuthMethods, params.Methods[i]) {
+			errs.Add("methods", errors.ErrInvalidAuthenticationMethod)
+			break
+		}
+	}
+
+	return errs.Err()
+}
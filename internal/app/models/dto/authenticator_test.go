@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+	"loki/pkg/validator"
+)
+
+func Test_ValidateSetAllowedMethodsParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     io.Reader
+		expected error
+		fields   map[string]string
+	}{
+		{
+			name:     "Success",
+			body:     strings.NewReader(`{"methods": ["smart_id", " mobile_id "]}`),
+			expected: nil,
+		},
+		{
+			name:     "Empty list",
+			body:     strings.NewReader(`{"methods": []}`),
+			expected: nil,
+		},
+		{
+			name:     "Invalid method",
+			body:     strings.NewReader(`{"methods": ["smart_id", "password"]}`),
+			expected: errors.ErrInvalidAuthenticationMethod,
+			fields:   map[string]string{"methods": "invalid authentication method, should be 'smart_id' or 'mobile_id'"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var params SetAllowedMethodsRequest
+			err := params.Validate(tt.body)
+
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+				assert.Equal(t, tt.fields, validator.Fields(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/config/locales"
+	"loki/pkg/validator"
+)
+
+// MaxConfirmationPayloadLength limits the payload that is hashed and signed
+const MaxConfirmationPayloadLength = 64 * 1024
+
+// CreateConfirmationRequest asks the current user to sign the hash of the payload,
+// the display text is shown on the device and should describe the operation
+type CreateConfirmationRequest struct {
+	Method      string `json:"method"`
+	Payload     string `json:"payload"`
+	DisplayText string `json:"display_text"`
+	PhoneNumber string `json:"phone_number,omitempty"`
+	Locale      string `json:"locale,omitempty"`
+}
+
+func (params *CreateConfirmationRequest) Validate(body io.Reader) error {
+	if err := json.NewDecoder(body).Decode(params); err != nil {
+		return err
+	}
+
+	params.Method = strings.TrimSpace(params.Method)
+	params.DisplayText = strings.TrimSpace(params.DisplayText)
+	params.PhoneNumber = strings.TrimSpace(params.PhoneNumber)
+	params.Locale = strings.ToLower(strings.TrimSpace(params.Locale))
+
+	var errs validator.Errors
+
+	if !slices.Contains(models.AuthMethods, params.Method) {
+		errs.Add("method", errors.ErrInvalidAuthenticationMethod)
+	}
+
+	switch {
+	case params.Payload == "":
+		errs.Add("payload", errors.ErrEmptyPayload)
+	case len(params.Payload) > MaxConfirmationPayloadLength:
+		errs.Add("payload", errors.ErrInvalidPayload)
+	}
+
+	if params.DisplayText == "" {
+		errs.Add("display_text", errors.ErrEmptyDisplayText)
+	} else if displayTextLength(params.Method, params.DisplayText) > maxDisplayTextLength(params.Method, params.DisplayText) {
+		errs.Add("display_text", errors.ErrInvalidDisplayText)
+	}
+
+	if params.PhoneNumber != "" {
+		if _, err := validator.PhoneNumber(params.PhoneNumber); err != nil {
+			errs.Add("phone_number", err)
+		}
+	}
+
+	return errs.Err()
+}
+
+// displayTextLength counts the confirmation message as the method does, Mobile-ID sends GSM-7 extension characters as two
+func displayTextLength(method, text string) int {
+	if method != models.AuthMethodMobileId {
+		return utf8.RuneCountInString(text)
+	}
+
+	return locales.TextLength(text)
+}
+
+// maxDisplayTextLength returns the limit of the confirmation message of the method
+func maxDisplayTextLength(method, text string) int {
+	if method != models.AuthMethodMobileId {
+		return locales.MaxConfirmationTextLength
+	}
+
+	if locales.TextFormat(text) == locales.Ucs2 {
+		return locales.MaxMobileIdUcs2TextLength
+	}
+
+	return locales.MaxMobileIdGsm7TextLength
+}
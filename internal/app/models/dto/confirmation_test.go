@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+	"loki/pkg/validator"
+)
+
+func Test_ValidateCreateConfirmationParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     io.Reader
+		expected error
+		fields   map[string]string
+	}{
+		{
+			name:     "Smart-ID",
+			body:     strings.NewReader(`{"method": "smart_id", "payload": "{\"amount\":\"100.00\"}", "display_text": "Pay 100.00 EUR to Example OU"}`),
+			expected: nil,
+		},
+		{
+			name:     "Mobile-ID",
+			body:     strings.NewReader(`{"method": "mobile_id", "payload": "transfer", "display_text": "Pay 100.00 EUR", "phone_number": "+37268000769", "locale": "ET"}`),
+			expected: nil,
+		},
+		{
+			name:     "Invalid method",
+			body:     strings.NewReader(`{"method": "password", "payload": "transfer", "display_text": "Pay 100.00 EUR"}`),
+			expected: errors.ErrInvalidAuthenticationMethod,
+			fields:   map[string]string{"method": errors.ErrInvalidAuthenticationMethod.Error()},
+		},
+		{
+			name:     "Empty payload and display text",
+			body:     strings.NewReader(`{"method": "smart_id", "payload": "", "display_text": " "}`),
+			expected: errors.ErrEmptyPayload,
+			fields: map[string]string{
+				"payload":      errors.ErrEmptyPayload.Error(),
+				"display_text": errors.ErrEmptyDisplayText.Error(),
+			},
+		},
+		{
+			name:     "Payload too large",
+			body:     strings.NewReader(`{"method": "smart_id", "payload": "` + strings.Repeat("a", MaxConfirmationPayloadLength+1) + `", "display_text": "Pay"}`),
+			expected: errors.ErrInvalidPayload,
+			fields:   map[string]string{"payload": errors.ErrInvalidPayload.Error()},
+		},
+		{
+			name:     "Display text too long for Mobile-ID",
+			body:     strings.NewReader(`{"method": "mobile_id", "payload": "transfer", "display_text": "Pay 100.00 EUR to Example OU for invoice 2026-001"}`),
+			expected: errors.ErrInvalidDisplayText,
+			fields:   map[string]string{"display_text": errors.ErrInvalidDisplayText.Error()},
+		},
+		{
+			name:     "Mobile-ID display text with GSM-7 extension characters",
+			body:     strings.NewReader(`{"method": "mobile_id", "payload": "transfer", "display_text": "Pay €100.00 to Example OU"}`),
+			expected: nil,
+		},
+		{
+			name:     "Display text with GSM-7 extension characters too long for Mobile-ID",
+			body:     strings.NewReader(`{"method": "mobile_id", "payload": "transfer", "display_text": "Pay €100.00 to Example OU {invoice 2026}"}`),
+			expected: errors.ErrInvalidDisplayText,
+			fields:   map[string]string{"display_text": errors.ErrInvalidDisplayText.Error()},
+		},
+		{
+			name:     "Invalid phone number",
+			body:     strings.NewReader(`{"method": "mobile_id", "payload": "transfer", "display_text": "Pay", "phone_number": "123"}`),
+			expected: errors.ErrInvalidPhoneNumber,
+			fields:   map[string]string{"phone_number": errors.ErrInvalidPhoneNumber.Error()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var params CreateConfirmationRequest
+			err := params.Validate(tt.body)
+
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+				assert.Equal(t, tt.fields, validator.Fields(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
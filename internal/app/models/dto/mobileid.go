@@ -6,11 +6,14 @@ import (
 	"strings"
 
 	"loki/internal/app/errors"
+	"loki/pkg/validator"
 )
 
 type CreateMobileIdSessionRequest struct {
 	PersonalCode string `json:"personal_code"`
 	PhoneNumber  string `json:"phone_number"`
+	Locale       string `json:"locale,omitempty"`
+	Purpose      string `json:"purpose,omitempty"`
 }
 
 func (params *CreateMobileIdSessionRequest) Validate(body io.Reader) error {
@@ -19,14 +22,22 @@ func (params *CreateMobileIdSessionRequest) Validate(body io.Reader) error {
 	}
 
 	params.PersonalCode = strings.TrimSpace(params.PersonalCode)
-	if params.PersonalCode == "" {
-		return errors.ErrEmptyPersonalCode
-	}
-
 	params.PhoneNumber = strings.TrimSpace(params.PhoneNumber)
-	if params.PhoneNumber == "" {
-		return errors.ErrEmptyPhoneNumber
+	params.Locale = strings.ToLower(strings.TrimSpace(params.Locale))
+	params.Purpose = strings.ToLower(strings.TrimSpace(params.Purpose))
+
+	var errs validator.Errors
+
+	country, err := validator.PhoneNumber(params.PhoneNumber)
+	if err != nil {
+		errs.Add("phone_number", err)
+
+		if params.PersonalCode == "" {
+			errs.Add("personal_code", errors.ErrEmptyPersonalCode)
+		}
+	} else {
+		errs.Add("personal_code", validator.PersonalCode(country, params.PersonalCode))
 	}
 
-	return nil
+	return errs.Err()
 }
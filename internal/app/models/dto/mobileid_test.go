@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"loki/internal/app/errors"
+	"loki/pkg/validator"
 )
 
 func Test_ValidateMobileIdParams(t *testing.T) {
@@ -15,21 +16,50 @@ func Test_ValidateMobileIdParams(t *testing.T) {
 		name     string
 		body     io.Reader
 		expected error
+		fields   map[string]string
 	}{
 		{
 			name:     "Success",
 			body:     strings.NewReader(`{"phone_number": "+37268000769", "personal_code": "60001017869"}`),
 			expected: nil,
 		},
+		{
+			name:     "Success with locale",
+			body:     strings.NewReader(`{"phone_number": "+37268000769", "personal_code": "60001017869", "locale": "lt"}`),
+			expected: nil,
+		},
 		{
 			name:     "Empty personal code",
 			body:     strings.NewReader(`{"phone_number": "+37268000769"}`),
 			expected: errors.ErrEmptyPersonalCode,
+			fields:   map[string]string{"personal_code": "empty personal code"},
 		},
 		{
 			name:     "Empty phone number",
 			body:     strings.NewReader(`{"personal_code": "60001017869"}`),
 			expected: errors.ErrEmptyPhoneNumber,
+			fields:   map[string]string{"phone_number": "empty phone number"},
+		},
+		{
+			name:     "Invalid phone number",
+			body:     strings.NewReader(`{"phone_number": "+37168000769", "personal_code": "60001017869"}`),
+			expected: errors.ErrInvalidPhoneNumber,
+			fields:   map[string]string{"phone_number": "invalid phone number, should be in E.164 format with '+372' or '+370' prefix"},
+		},
+		{
+			name:     "Invalid personal code",
+			body:     strings.NewReader(`{"phone_number": "+37268000769", "personal_code": "60013017869"}`),
+			expected: errors.ErrInvalidPersonalCode,
+			fields:   map[string]string{"personal_code": "invalid personal code"},
+		},
+		{
+			name:     "Empty body fields",
+			body:     strings.NewReader(`{}`),
+			expected: errors.ErrEmptyPhoneNumber,
+			fields: map[string]string{
+				"phone_number":  "empty phone number",
+				"personal_code": "empty personal code",
+			},
 		},
 	}
 
@@ -38,7 +68,12 @@ func Test_ValidateMobileIdParams(t *testing.T) {
 			var params CreateMobileIdSessionRequest
 			err := params.Validate(tt.body)
 
-			assert.Equal(t, tt.expected, err)
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+				assert.Equal(t, tt.fields, validator.Fields(err))
+			} else {
+				assert.NoError(t, err)
+			}
 		})
 	}
 }
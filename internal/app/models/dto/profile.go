@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"loki/internal/app/errors"
+	"loki/pkg/validator"
+)
+
+// UpdateProfileRequest is a partial update, omitted fields are left unchanged and an empty string clears the value
+type UpdateProfileRequest struct {
+	Email      *string        `json:"email,omitempty"`
+	Locale     *string        `json:"locale,omitempty"`
+	Attributes map[string]any `json:"attributes,omitempty"`
+}
+
+func (params *UpdateProfileRequest) Validate(body io.Reader) error {
+	if err := json.NewDecoder(body).Decode(params); err != nil {
+		return err
+	}
+
+	var errs validator.Errors
+
+	if params.Email != nil {
+		email := strings.TrimSpace(*params.Email)
+		params.Email = &email
+
+		if email != "" {
+			errs.Add("email", validator.Email(email))
+		}
+	}
+
+	if params.Locale != nil {
+		locale := strings.TrimSpace(*params.Locale)
+		params.Locale = &locale
+
+		if locale != "" {
+			errs.Add("locale", validator.Locale(locale))
+		}
+	}
+
+	for key := range params.Attributes {
+		if strings.TrimSpace(key) == "" {
+			errs.Add("attributes", errors.ErrInvalidAttributes)
+			break
+		}
+	}
+
+	return errs.Err()
+}
+
+type VerifyEmailRequest struct {
+	Code string `json:"code"`
+}
+
+func (params *VerifyEmailRequest) Validate(body io.Reader) error {
+	if err := json.NewDecoder(body).Decode(params); err != nil {
+		return err
+	}
+
+	params.Code = strings.TrimSpace(params.Code)
+	if params.Code == "" {
+		return errors.ErrInvalidVerificationCode
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+	"loki/pkg/validator"
+)
+
+func Test_ValidateUpdateProfileParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     io.Reader
+		expected error
+		fields   map[string]string
+	}{
+		{
+			name:     "Success",
+			body:     strings.NewReader(`{"email": " john.doe@example.com ", "locale": "et", "attributes": {"department": "IT", "nickname": null}}`),
+			expected: nil,
+		},
+		{
+			name:     "Clear email and locale",
+			body:     strings.NewReader(`{"email": "", "locale": ""}`),
+			expected: nil,
+		},
+		{
+			name:     "Invalid email",
+			body:     strings.NewReader(`{"email": "john.doe"}`),
+			expected: errors.ErrInvalidEmail,
+			fields:   map[string]string{"email": "invalid email address"},
+		},
+		{
+			name:     "Invalid locale",
+			body:     strings.NewReader(`{"locale": "en_GB"}`),
+			expected: errors.ErrInvalidLocale,
+			fields:   map[string]string{"locale": "invalid locale, should be a language tag such as 'et' or 'en-GB'"},
+		},
+		{
+			name:     "Empty attribute key",
+			body:     strings.NewReader(`{"attributes": {"": "IT"}}`),
+			expected: errors.ErrInvalidAttributes,
+			fields:   map[string]string{"attributes": "invalid attributes, should be a JSON object of at most 4 KB"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var params UpdateProfileRequest
+			err := params.Validate(tt.body)
+
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+				assert.Equal(t, tt.fields, validator.Fields(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_ValidateVerifyEmailParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     io.Reader
+		expected error
+	}{
+		{
+			name:     "Success",
+			body:     strings.NewReader(`{"code": "123456"}`),
+			expected: nil,
+		},
+		{
+			name:     "Empty code",
+			body:     strings.NewReader(`{"code": " "}`),
+			expected: errors.ErrInvalidVerificationCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var params VerifyEmailRequest
+			err := params.Validate(tt.body)
+
+			assert.Equal(t, tt.expected, err)
+		})
+	}
+}
@@ -6,11 +6,14 @@ import (
 	"strings"
 
 	"loki/internal/app/errors"
+	"loki/pkg/validator"
 )
 
 type CreateSmartIdSessionRequest struct {
 	Country      string `json:"country"`
 	PersonalCode string `json:"personal_code"`
+	Locale       string `json:"locale,omitempty"`
+	Purpose      string `json:"purpose,omitempty"`
 }
 
 func (params *CreateSmartIdSessionRequest) Validate(body io.Reader) error {
@@ -21,14 +24,20 @@ func (params *CreateSmartIdSessionRequest) Validate(body io.Reader) error {
 	params.PersonalCode = strings.TrimSpace(params.PersonalCode)
 	params.Country = strings.TrimSpace(params.Country)
 	params.Country = strings.ToUpper(params.Country)
+	params.Locale = strings.ToLower(strings.TrimSpace(params.Locale))
+	params.Purpose = strings.ToLower(strings.TrimSpace(params.Purpose))
 
-	if params.Country == "" {
-		return errors.ErrEmptyCountry
-	}
+	var errs validator.Errors
+
+	if err := validator.Country(params.Country); err != nil {
+		errs.Add("country", err)
 
-	if params.PersonalCode == "" {
-		return errors.ErrEmptyPersonalCode
+		if params.PersonalCode == "" {
+			errs.Add("personal_code", errors.ErrEmptyPersonalCode)
+		}
+	} else {
+		errs.Add("personal_code", validator.PersonalCode(params.Country, params.PersonalCode))
 	}
 
-	return nil
+	return errs.Err()
 }
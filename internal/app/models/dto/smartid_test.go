@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"loki/internal/app/errors"
+	"loki/pkg/validator"
 )
 
 func Test_ValidateSmartIdParams(t *testing.T) {
@@ -15,21 +16,49 @@ func Test_ValidateSmartIdParams(t *testing.T) {
 		name     string
 		body     io.Reader
 		expected error
+		fields   map[string]string
 	}{
 		{
 			name:     "Success",
 			body:     strings.NewReader(`{"country": "EE", "personal_code": "30303039914"}`),
 			expected: nil,
 		},
+		{
+			name:     "Success with locale",
+			body:     strings.NewReader(`{"country": "EE", "personal_code": "30303039914", "locale": " LV ", "purpose": "Login"}`),
+			expected: nil,
+		},
+		{
+			name:     "Success with lowercase country",
+			body:     strings.NewReader(`{"country": "lv", "personal_code": "030403-10075"}`),
+			expected: nil,
+		},
 		{
 			name:     "Empty personal code",
 			body:     strings.NewReader(`{"country": "EE"}`),
 			expected: errors.ErrEmptyPersonalCode,
+			fields:   map[string]string{"personal_code": "empty personal code"},
 		},
 		{
 			name:     "Empty country",
 			body:     strings.NewReader(`{"personal_code": "30303039914"}`),
 			expected: errors.ErrEmptyCountry,
+			fields:   map[string]string{"country": "empty country, should be 'EE', 'LV' or 'LT'"},
+		},
+		{
+			name:     "Invalid country",
+			body:     strings.NewReader(`{"country": "FI"}`),
+			expected: errors.ErrInvalidCountry,
+			fields: map[string]string{
+				"country":       "invalid country, should be 'EE', 'LV' or 'LT'",
+				"personal_code": "empty personal code",
+			},
+		},
+		{
+			name:     "Invalid personal code checksum",
+			body:     strings.NewReader(`{"country": "LT", "personal_code": "30303039915"}`),
+			expected: errors.ErrInvalidPersonalCode,
+			fields:   map[string]string{"personal_code": "invalid personal code"},
 		},
 	}
 
@@ -38,7 +67,12 @@ func Test_ValidateSmartIdParams(t *testing.T) {
 			var params CreateSmartIdSessionRequest
 			err := params.Validate(tt.body)
 
-			assert.Equal(t, tt.expected, err)
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+				assert.Equal(t, tt.fields, validator.Fields(err))
+			} else {
+				assert.NoError(t, err)
+			}
 		})
 	}
 }
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/pkg/validator"
+)
+
+// CreateStepUpSessionRequest starts a re-authentication of the current user, the identity is taken from the user
+type CreateStepUpSessionRequest struct {
+	Method      string `json:"method"`
+	PhoneNumber string `json:"phone_number,omitempty"`
+	Locale      string `json:"locale,omitempty"`
+	Purpose     string `json:"purpose,omitempty"`
+}
+
+func (params *CreateStepUpSessionRequest) Validate(body io.Reader) error {
+	if err := json.NewDecoder(body).Decode(params); err != nil {
+		return err
+	}
+
+	params.Method = strings.TrimSpace(params.Method)
+	params.PhoneNumber = strings.TrimSpace(params.PhoneNumber)
+	params.Locale = strings.ToLower(strings.TrimSpace(params.Locale))
+	params.Purpose = strings.ToLower(strings.TrimSpace(params.Purpose))
+
+	var errs validator.Errors
+
+	if !slices.Contains(models.AuthMethods, params.Method) {
+		errs.Add("method", errors.ErrInvalidAuthenticationMethod)
+	}
+
+	if params.PhoneNumber != "" {
+		if _, err := validator.PhoneNumber(params.PhoneNumber); err != nil {
+			errs.Add("phone_number", err)
+		}
+	}
+
+	return errs.Err()
+}
@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+	"loki/pkg/validator"
+)
+
+func Test_ValidateCreateStepUpSessionParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     io.Reader
+		expected error
+		fields   map[string]string
+	}{
+		{
+			name:     "Smart-ID",
+			body:     strings.NewReader(`{"method": "smart_id", "locale": "ET"}`),
+			expected: nil,
+		},
+		{
+			name:     "Mobile-ID with phone number",
+			body:     strings.NewReader(`{"method": "mobile_id", "phone_number": " +37268000769 "}`),
+			expected: nil,
+		},
+		{
+			name:     "Mobile-ID without phone number",
+			body:     strings.NewReader(`{"method": "mobile_id"}`),
+			expected: nil,
+		},
+		{
+			name:     "Invalid method",
+			body:     strings.NewReader(`{"method": "password"}`),
+			expected: errors.ErrInvalidAuthenticationMethod,
+			fields:   map[string]string{"method": errors.ErrInvalidAuthenticationMethod.Error()},
+		},
+		{
+			name:     "Invalid phone number",
+			body:     strings.NewReader(`{"method": "mobile_id", "phone_number": "+15555550100"}`),
+			expected: errors.ErrInvalidPhoneNumber,
+			fields:   map[string]string{"phone_number": errors.ErrInvalidPhoneNumber.Error()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var params CreateStepUpSessionRequest
+			err := params.Validate(tt.body)
+
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+				assert.Equal(t, tt.fields, validator.Fields(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ElevationPending  = "pending"
+	ElevationApproved = "approved"
+	ElevationRejected = "rejected"
+)
+
+// Elevation is a request for a temporary role that has to be decided by another user
+type Elevation struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	RoleID    uuid.UUID
+	Reason    string
+	Duration  time.Duration
+	Status    string
+	DecidedBy uuid.UUID
+}
+
+// ExpiredGrant is a time-bound role or scope assignment removed after its expiry
+type ExpiredGrant struct {
+	UserID    uuid.UUID
+	RoleID    uuid.UUID
+	ScopeID   uuid.UUID
+	GrantedBy uuid.UUID
+	ExpiresAt time.Time
+}
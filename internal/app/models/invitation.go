@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	InvitationPending  = "pending"
+	InvitationAccepted = "accepted"
+	InvitationRevoked  = "revoked"
+	InvitationExpired  = "expired"
+)
+
+// Invitation pre-registers a person by personal code, the roles and scopes are granted on their first login
+type Invitation struct {
+	ID             uuid.UUID
+	IdentityNumber string
+	PersonalCode   string
+	RoleIDs        []uuid.UUID
+	ScopeIDs       []uuid.UUID
+	Status         string
+	InvitedBy      uuid.UUID
+	UserID         uuid.UUID
+	ExpiresAt      time.Time
+	SentAt         time.Time
+	AcceptedAt     time.Time
+}
+
+// State returns the status, a pending invitation past its expiry is reported as expired
+func (i *Invitation) State() string {
+	if i.Status == InvitationPending && !i.ExpiresAt.After(time.Now()) {
+		return InvitationExpired
+	}
+
+	return i.Status
+}
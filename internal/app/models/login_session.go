@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	LoginSessionActive  = "active"
+	LoginSessionExpired = "expired"
+	LoginSessionRevoked = "revoked"
+)
+
+// LoginSession is a completed login and the family of tokens issued and refreshed from it
+type LoginSession struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	Provider        string
+	IPAddress       string
+	UserAgent       string
+	CreatedAt       time.Time
+	LastRefreshedAt time.Time
+	RevokedAt       time.Time
+}
+
+// Status reports whether the session can still be refreshed, it expires with its last refresh token
+func (s *LoginSession) Status() string {
+	switch {
+	case !s.RevokedAt.IsZero():
+		return LoginSessionRevoked
+	case time.Since(s.LastRefreshedAt) > RefreshTokenExp:
+		return LoginSessionExpired
+	default:
+		return LoginSessionActive
+	}
+}
+
+// CreateLoginSessionParams describes the login the tokens are issued for
+type CreateLoginSessionParams struct {
+	UserID    uuid.UUID
+	Provider  string
+	IPAddress string
+	UserAgent string
+}
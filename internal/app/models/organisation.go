@@ -0,0 +1,22 @@
+package models
+
+import "github.com/google/uuid"
+
+type Organisation struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+}
+
+type Membership struct {
+	UserID         uuid.UUID
+	OrganisationID uuid.UUID
+	RoleID         uuid.UUID
+}
+
+// OrganisationGrant holds the role and permission names a user has within a single organisation
+type OrganisationGrant struct {
+	OrganisationID uuid.UUID
+	Roles          []string
+	Permissions    []string
+}
@@ -1,6 +1,10 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 const (
 	ReadSelfType  = "read:self"
@@ -12,3 +16,17 @@ type Permission struct {
 	Name        string
 	Description string
 }
+
+// PermissionPath is a chain of roles from the one assigned to the user to the one holding the permission,
+// OrganisationID is set when the first role is held through an organisation membership
+type PermissionPath struct {
+	Roles          []Role
+	ExpiresAt      time.Time
+	OrganisationID uuid.UUID
+}
+
+// EffectivePermission is a permission held by a user with every path that grants it
+type EffectivePermission struct {
+	Permission
+	Paths []PermissionPath
+}
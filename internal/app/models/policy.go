@@ -0,0 +1,12 @@
+package models
+
+import "github.com/google/uuid"
+
+type Policy struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	Actions     []string
+	Effect      string
+	Condition   string
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Profile holds the contact data and preferences a user manages about themselves
+type Profile struct {
+	UserID          uuid.UUID
+	Email           string
+	EmailVerifiedAt time.Time
+	PhoneNumber     string
+	Locale          string
+	Attributes      map[string]any
+}
+
+// EmailVerified reports whether the current email address has been confirmed by the user
+func (p *Profile) EmailVerified() bool {
+	return p.Email != "" && !p.EmailVerifiedAt.IsZero()
+}
+
+// UpdateProfileParams is a partial profile update, nil fields are left unchanged and a nil attribute value removes the key
+type UpdateProfileParams struct {
+	Email      *string
+	Locale     *string
+	Attributes map[string]any
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	RiskStageLogin   = "login"
+	RiskStageRefresh = "refresh"
+
+	RiskActionAllow          = "allow"
+	RiskActionReauthenticate = "reauthenticate"
+	RiskActionBlock          = "block"
+
+	RiskNewCountry       = "new_country"
+	RiskImpossibleTravel = "impossible_travel"
+	RiskNewUserAgent     = "new_user_agent"
+	RiskRefreshSpike     = "refresh_spike"
+)
+
+// RiskParams describes the login or token refresh compared with the login history of the user
+type RiskParams struct {
+	Stage          string
+	UserID         uuid.UUID
+	LoginSessionID uuid.UUID
+	IPAddress      string
+	UserAgent      string
+}
+
+// RiskAssessment is the score of the anomalies found and the action it leads to
+type RiskAssessment struct {
+	Score   int
+	Signals []string
+	Country string
+	Action  string
+}
+
+// RiskEvent is the body posted to the risk webhook when a login or token refresh scores above zero
+type RiskEvent struct {
+	Event      string    `json:"event"`
+	UserID     uuid.UUID `json:"user_id"`
+	Stage      string    `json:"stage"`
+	Score      int       `json:"score"`
+	Signals    []string  `json:"signals"`
+	Action     string    `json:"action"`
+	Country    string    `json:"country,omitempty"`
+	IPAddress  string    `json:"ip_address,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
@@ -12,8 +12,10 @@ type Role struct {
 	ID          uuid.UUID
 	Name        string
 	Description string
+	ParentID    uuid.UUID
 
-	PermissionIDs []uuid.UUID
+	PermissionIDs          []uuid.UUID
+	InheritedPermissionIDs []uuid.UUID
 }
 
 type RolePermission struct {
@@ -3,22 +3,53 @@ package models
 import "github.com/google/uuid"
 
 const (
-	SessionRunning  = "RUNNING"
-	SessionComplete = "COMPLETE"
-	SessionResultOK = "OK"
+	SessionRunning   = "RUNNING"
+	SessionSuccess   = "SUCCESS"
+	SessionError     = "ERROR"
+	SessionCancelled = "CANCELLED"
+	SessionExpired   = "EXPIRED"
+	SessionRejected  = "REJECTED"
 )
 
+var sessionTransitions = map[string][]string{
+	SessionRunning: {SessionSuccess, SessionError, SessionCancelled, SessionExpired, SessionRejected},
+}
+
 type Session struct {
-	ID     uuid.UUID
-	UserId uuid.UUID
-	Code   string
-	Status string
-	Error  string
+	ID           uuid.UUID
+	UserId       uuid.UUID
+	Code         string
+	Status       string
+	Error        string
+	PersonalCode string
+	PhoneNumber  string
+	Provider     string
+}
+
+// CanTransitionTo reports whether the session is allowed to move to the given status
+func (s *Session) CanTransitionTo(status string) bool {
+	for _, next := range sessionTransitions[s.Status] {
+		if next == status {
+			return true
+		}
+	}
+
+	return false
 }
 
 type CreateSessionParams struct {
+	SessionId    string
+	Code         string
+	PersonalCode string
+	PhoneNumber  string
+	Provider     string
+}
+
+// CompleteSessionParams identifies the client exchanging a successful session for tokens
+type CompleteSessionParams struct {
 	SessionId string
-	Code      string
+	IPAddress string
+	UserAgent string
 }
 
 type UpdateSessionParams struct {
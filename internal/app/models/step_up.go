@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// AcrLogin is the authentication context of tokens issued for a login session
+	AcrLogin = "login"
+	// AcrStepUp is the authentication context of tokens issued after a fresh re-authentication
+	AcrStepUp = "step_up"
+
+	StepUpTokenExp = time.Minute * 5
+)
+
+// acrLevels orders the authentication contexts from the weakest to the strongest
+var acrLevels = map[string]int{
+	AcrLogin:  1,
+	AcrStepUp: 2,
+}
+
+// AcrSatisfies reports whether a token issued at the given context meets the required one
+func AcrSatisfies(acr, required string) bool {
+	if required == "" {
+		return true
+	}
+
+	level, ok := acrLevels[acr]
+	return ok && level >= acrLevels[required]
+}
+
+// CompleteStepUpParams identifies the step-up session and the user and login session it elevates
+type CompleteStepUpParams struct {
+	SessionId      string
+	User           *User
+	LoginSessionID uuid.UUID
+}
+
+// StepUpTokenParams describes the re-authentication an elevated access token is issued for
+type StepUpTokenParams struct {
+	UserID         uuid.UUID
+	IdentityNumber string
+	LoginSessionID uuid.UUID
+	Provider       string
+}
@@ -0,0 +1,9 @@
+package models
+
+// ThrottleParams identifies who starts an authentication, empty values are not counted
+type ThrottleParams struct {
+	Endpoint     string
+	IPAddress    string
+	PersonalCode string
+	PhoneNumber  string
+}
@@ -21,3 +21,10 @@ type Token struct {
 	Value     string
 	ExpiresAt time.Time
 }
+
+// RefreshTokenParams identifies the client exchanging a refresh token for a new token pair
+type RefreshTokenParams struct {
+	RefreshToken string
+	IPAddress    string
+	UserAgent    string
+}
@@ -1,6 +1,17 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	UserActive    = "active"
+	UserSuspended = "suspended"
+	UserLocked    = "locked"
+	UserDeleted   = "deleted"
+)
 
 type User struct {
 	ID             uuid.UUID
@@ -9,9 +20,55 @@ type User struct {
 	FirstName      string
 	LastName       string
 
+	Status          string
+	StatusReason    string
+	StatusChangedAt time.Time
+
 	AccessToken  string
 	RefreshToken string
 
 	RoleIDs  []uuid.UUID
 	ScopeIDs []uuid.UUID
 }
+
+const (
+	UserRecordFormatCSV   = "csv"
+	UserRecordFormatJSONL = "jsonl"
+)
+
+const (
+	ImportStatusCreated = "created"
+	ImportStatusUpdated = "updated"
+	ImportStatusValid   = "valid"
+	ImportStatusInvalid = "invalid"
+	ImportStatusFailed  = "failed"
+)
+
+// CanAuthenticate reports whether the user may log in and use the issued tokens
+func (u *User) CanAuthenticate() bool {
+	switch u.Status {
+	case UserSuspended, UserLocked, UserDeleted:
+		return false
+	default:
+		return true
+	}
+}
+
+// UserRecord is the portable representation of a user used by bulk import and export
+type UserRecord struct {
+	IdentityNumber string   `json:"identity_number"`
+	PersonalCode   string   `json:"personal_code,omitempty"`
+	FirstName      string   `json:"first_name"`
+	LastName       string   `json:"last_name"`
+	Roles          []string `json:"roles"`
+	Scopes         []string `json:"scopes"`
+}
+
+// ImportResult is the outcome of importing a single record
+type ImportResult struct {
+	Line           uint64
+	IdentityNumber string
+	Status         string
+	UserID         uuid.UUID
+	Error          string
+}
@@ -0,0 +1,254 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+)
+
+type AuditRepository interface {
+	List(ctx context.Context, filter models.AuditFilter, limit, offset uint64) ([]models.AuditEvent, uint64, error)
+	Create(ctx context.Context, params db.CreateAuditEventParams) (*models.AuditEvent, error)
+	ListAfter(ctx context.Context, sequence int64, limit uint64) ([]models.AuditEvent, error)
+	FindLast(ctx context.Context) (*models.AuditEvent, error)
+
+	CreateCheckpoint(ctx context.Context, params db.CreateAuditCheckpointParams) (*models.AuditCheckpoint, error)
+	FindLastCheckpoint(ctx context.Context) (*models.AuditCheckpoint, error)
+	ListCheckpoints(ctx context.Context, after, until int64) ([]models.AuditCheckpoint, error)
+}
+
+type audit struct {
+	client postgres.Postgres
+}
+
+func NewAuditRepository(client postgres.Postgres) AuditRepository {
+	return &audit{client: client}
+}
+
+func (a *audit) List(ctx context.Context, filter models.AuditFilter, limit, offset uint64) ([]models.AuditEvent, uint64, error) {
+	rows, err := a.client.Queries().FindAuditEvents(ctx, db.FindAuditEventsParams{
+		Action:      filter.Action,
+		ActorID:     filter.ActorID,
+		TargetID:    filter.TargetID,
+		CreatedFrom: pgtype.Timestamp{Time: filter.From, Valid: !filter.From.IsZero()},
+		CreatedTo:   pgtype.Timestamp{Time: filter.To, Valid: !filter.To.IsZero()},
+		PageLimit:   limit,
+		PageOffset:  offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	events := make([]models.AuditEvent, 0, len(rows))
+	var total uint64
+
+	if len(rows) > 0 {
+		total = rows[0].Total
+	}
+
+	for _, row := range rows {
+		// the counter row has no event when the filter matches nothing
+		if !row.Action.Valid {
+			continue
+		}
+
+		event, err := toAuditEvent(db.AuditEvent{
+			ID:         row.ID,
+			Action:     row.Action.String,
+			ActorID:    row.ActorID,
+			TargetType: row.TargetType.String,
+			TargetID:   row.TargetID.String,
+			Status:     row.Status.String,
+			Changes:    row.Changes,
+			Metadata:   row.Metadata,
+			TraceID:    row.TraceID.String,
+			RemoteAddr: row.RemoteAddr.String,
+			CreatedAt:  row.CreatedAt,
+			Sequence:   row.Sequence.Int64,
+			PrevHash:   row.PrevHash.String,
+			Hash:       row.Hash.String,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		events = append(events, *event)
+	}
+
+	return events, total, err
+}
+
+// Create appends the event to the chain, the lock serialises writers so every event links to its predecessor
+func (a *audit) Create(ctx context.Context, params db.CreateAuditEventParams) (*models.AuditEvent, error) {
+	tx, err := a.client.Db().Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := a.client.Queries().WithTx(tx)
+
+	if err = q.LockAuditChain(ctx); err != nil {
+		return nil, err
+	}
+
+	last, err := q.FindLastAuditEvent(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	params.ID = uuid.New()
+	params.PrevHash = last.Hash
+	params.CreatedAt = pgtype.Timestamp{Time: time.Now().UTC().Truncate(time.Microsecond), Valid: true}
+
+	event, err := toAuditEvent(db.AuditEvent{
+		ID:         params.ID,
+		Action:     params.Action,
+		ActorID:    params.ActorID,
+		TargetType: params.TargetType,
+		TargetID:   params.TargetID,
+		Status:     params.Status,
+		Changes:    params.Changes,
+		Metadata:   params.Metadata,
+		TraceID:    params.TraceID,
+		RemoteAddr: params.RemoteAddr,
+		CreatedAt:  params.CreatedAt,
+		PrevHash:   params.PrevHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	params.Hash, err = event.Digest()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := q.CreateAuditEvent(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err = toAuditEvent(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, tx.Commit(ctx)
+}
+
+// ListAfter returns the events following the sequence in chain order
+func (a *audit) ListAfter(ctx context.Context, sequence int64, limit uint64) ([]models.AuditEvent, error) {
+	rows, err := a.client.Queries().FindAuditEventsAfter(ctx, db.FindAuditEventsAfterParams{
+		AfterSequence: sequence,
+		PageLimit:     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]models.AuditEvent, 0, len(rows))
+	for _, row := range rows {
+		event, err := toAuditEvent(row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+
+	return events, nil
+}
+
+func (a *audit) FindLast(ctx context.Context) (*models.AuditEvent, error) {
+	result, err := a.client.Queries().FindLastAuditEvent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAuditEvent(result)
+}
+
+func (a *audit) CreateCheckpoint(ctx context.Context, params db.CreateAuditCheckpointParams) (*models.AuditCheckpoint, error) {
+	result, err := a.client.Queries().CreateAuditCheckpoint(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAuditCheckpoint(result), nil
+}
+
+func (a *audit) FindLastCheckpoint(ctx context.Context) (*models.AuditCheckpoint, error) {
+	result, err := a.client.Queries().FindLastAuditCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAuditCheckpoint(result), nil
+}
+
+// ListCheckpoints returns the checkpoints with a sequence in the (after, until] range
+func (a *audit) ListCheckpoints(ctx context.Context, after, until int64) ([]models.AuditCheckpoint, error) {
+	rows, err := a.client.Queries().FindAuditCheckpoints(ctx, db.FindAuditCheckpointsParams{
+		AfterSequence: after,
+		UntilSequence: until,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoints := make([]models.AuditCheckpoint, 0, len(rows))
+	for _, row := range rows {
+		checkpoints = append(checkpoints, *toAuditCheckpoint(row))
+	}
+
+	return checkpoints, nil
+}
+
+func toAuditEvent(row db.AuditEvent) (*models.AuditEvent, error) {
+	event := &models.AuditEvent{
+		ID:         row.ID,
+		Action:     row.Action,
+		ActorID:    row.ActorID,
+		TargetType: row.TargetType,
+		TargetID:   row.TargetID,
+		Status:     row.Status,
+		TraceID:    row.TraceID,
+		RemoteAddr: row.RemoteAddr,
+		CreatedAt:  row.CreatedAt.Time,
+		Sequence:   row.Sequence,
+		PrevHash:   row.PrevHash,
+		Hash:       row.Hash,
+	}
+
+	if len(row.Changes) > 0 {
+		if err := json.Unmarshal(row.Changes, &event.Changes); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(row.Metadata) > 0 {
+		if err := json.Unmarshal(row.Metadata, &event.Metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	return event, nil
+}
+
+func toAuditCheckpoint(row db.AuditCheckpoint) *models.AuditCheckpoint {
+	return &models.AuditCheckpoint{
+		ID:        row.ID,
+		Sequence:  row.Sequence,
+		Hash:      row.Hash,
+		Signature: row.Signature,
+		CreatedAt: row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/repositories/audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/repositories/audit.go -destination=internal/app/repositories/audit_mock.go -package=repositories
+//
+
+// Package repositories is a generated GoMock package.
+package repositories
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	db "loki/internal/app/repositories/db"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
+}
+
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAuditRepository) Create(ctx context.Context, params db.CreateAuditEventParams) (*models.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, params)
+	ret0, _ := ret[0].(*models.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuditRepositoryMockRecorder) Create(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditRepository)(nil).Create), ctx, params)
+}
+
+// CreateCheckpoint mocks base method.
+func (m *MockAuditRepository) CreateCheckpoint(ctx context.Context, params db.CreateAuditCheckpointParams) (*models.AuditCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCheckpoint", ctx, params)
+	ret0, _ := ret[0].(*models.AuditCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCheckpoint indicates an expected call of CreateCheckpoint.
+func (mr *MockAuditRepositoryMockRecorder) CreateCheckpoint(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCheckpoint", reflect.TypeOf((*MockAuditRepository)(nil).CreateCheckpoint), ctx, params)
+}
+
+// FindLast mocks base method.
+func (m *MockAuditRepository) FindLast(ctx context.Context) (*models.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLast", ctx)
+	ret0, _ := ret[0].(*models.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLast indicates an expected call of FindLast.
+func (mr *MockAuditRepositoryMockRecorder) FindLast(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLast", reflect.TypeOf((*MockAuditRepository)(nil).FindLast), ctx)
+}
+
+// FindLastCheckpoint mocks base method.
+func (m *MockAuditRepository) FindLastCheckpoint(ctx context.Context) (*models.AuditCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastCheckpoint", ctx)
+	ret0, _ := ret[0].(*models.AuditCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastCheckpoint indicates an expected call of FindLastCheckpoint.
+func (mr *MockAuditRepositoryMockRecorder) FindLastCheckpoint(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastCheckpoint", reflect.TypeOf((*MockAuditRepository)(nil).FindLastCheckpoint), ctx)
+}
+
+// List mocks base method.
+func (m *MockAuditRepository) List(ctx context.Context, filter models.AuditFilter, limit, offset uint64) ([]models.AuditEvent, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter, limit, offset)
+	ret0, _ := ret[0].([]models.AuditEvent)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditRepositoryMockRecorder) List(ctx, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditRepository)(nil).List), ctx, filter, limit, offset)
+}
+
+// ListAfter mocks base method.
+func (m *MockAuditRepository) ListAfter(ctx context.Context, sequence int64, limit uint64) ([]models.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAfter", ctx, sequence, limit)
+	ret0, _ := ret[0].([]models.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAfter indicates an expected call of ListAfter.
+func (mr *MockAuditRepositoryMockRecorder) ListAfter(ctx, sequence, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAfter", reflect.TypeOf((*MockAuditRepository)(nil).ListAfter), ctx, sequence, limit)
+}
+
+// ListCheckpoints mocks base method.
+func (m *MockAuditRepository) ListCheckpoints(ctx context.Context, after, until int64) ([]models.AuditCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCheckpoints", ctx, after, until)
+	ret0, _ := ret[0].([]models.AuditCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCheckpoints indicates an expected call of ListCheckpoints.
+func (mr *MockAuditRepositoryMockRecorder) ListCheckpoints(ctx, after, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCheckpoints", reflect.TypeOf((*MockAuditRepository)(nil).ListCheckpoints), ctx, after, until)
+}
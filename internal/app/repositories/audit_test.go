@@ -0,0 +1,198 @@
+package repositories
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+	"loki/internal/config"
+)
+
+func Test_AuditRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	repository := NewAuditRepository(client)
+
+	actorId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+
+	result, err := repository.Create(ctx, db.CreateAuditEventParams{
+		Action:     "/sso.v1.RoleService/Update",
+		ActorID:    actorId,
+		TargetType: "sso.v1.RoleService",
+		TargetID:   "10000000-1000-1000-1000-000000000001",
+		Status:     models.AuditSuccess,
+		Changes:    []byte(`{"name":{"after":"owner","before":"admin"}}`),
+		Metadata:   []byte(`{}`),
+		TraceID:    "trace-id",
+		RemoteAddr: "127.0.0.1:50000",
+	})
+	assert.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, result.ID)
+	assert.Equal(t, actorId, result.ActorID)
+	assert.Equal(t, map[string]any{"name": map[string]any{"before": "admin", "after": "owner"}}, result.Changes)
+	assert.Equal(t, map[string]string{}, result.Metadata)
+
+	digest, err := result.Digest()
+	assert.NoError(t, err)
+	assert.Equal(t, digest, result.Hash)
+
+	next, err := repository.Create(ctx, db.CreateAuditEventParams{
+		Action:   models.AuditTokenIssued,
+		Status:   models.AuditSuccess,
+		Changes:  []byte(`{}`),
+		Metadata: []byte(`{"provider":"smart_id"}`),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, result.Hash, next.PrevHash)
+	assert.Greater(t, next.Sequence, result.Sequence)
+
+	last, err := repository.FindLast(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, next.ID, last.ID)
+
+	digest, err = last.Digest()
+	assert.NoError(t, err)
+	assert.Equal(t, last.Hash, digest)
+
+	_, err = client.Db().Exec(ctx, "UPDATE audit_events SET status = 'failure' WHERE id = $1", result.ID)
+	assert.Error(t, err)
+
+	_, err = client.Db().Exec(ctx, "DELETE FROM audit_events WHERE id = $1", result.ID)
+	assert.Error(t, err)
+}
+
+func Test_AuditRepository_List(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	repository := NewAuditRepository(client)
+
+	actorId := uuid.New()
+
+	for _, action := range []string{models.AuditLoginSucceeded, models.AuditTokenIssued, models.AuditTokenRefreshed} {
+		_, err = repository.Create(ctx, db.CreateAuditEventParams{
+			Action:     action,
+			ActorID:    actorId,
+			TargetType: "user",
+			TargetID:   actorId.String(),
+			Status:     models.AuditSuccess,
+			Changes:    []byte(`{}`),
+			Metadata:   []byte(`{}`),
+		})
+		assert.NoError(t, err)
+	}
+
+	tests := []struct {
+		name    string
+		filter  models.AuditFilter
+		limit   uint64
+		offset  uint64
+		total   uint64
+		actions []string
+	}{
+		{
+			name:    "Filter by actor",
+			filter:  models.AuditFilter{ActorID: actorId},
+			limit:   10,
+			total:   3,
+			actions: []string{models.AuditTokenRefreshed, models.AuditTokenIssued, models.AuditLoginSucceeded},
+		},
+		{
+			name:    "Filter by actor and action",
+			filter:  models.AuditFilter{ActorID: actorId, Action: models.AuditTokenIssued},
+			limit:   10,
+			total:   1,
+			actions: []string{models.AuditTokenIssued},
+		},
+		{
+			name:    "With offset",
+			filter:  models.AuditFilter{TargetID: actorId.String()},
+			limit:   1,
+			offset:  1,
+			total:   3,
+			actions: []string{models.AuditTokenIssued},
+		},
+		{
+			name:    "No matches",
+			filter:  models.AuditFilter{ActorID: uuid.New()},
+			limit:   10,
+			total:   0,
+			actions: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, total, err := repository.List(ctx, tt.filter, tt.limit, tt.offset)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.total, total)
+
+			actions := make([]string, 0, len(results))
+			for _, result := range results {
+				actions = append(actions, result.Action)
+			}
+			assert.Equal(t, tt.actions, actions)
+		})
+	}
+}
+
+func Test_AuditRepository_Checkpoints(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	repository := NewAuditRepository(client)
+
+	event, err := repository.Create(ctx, db.CreateAuditEventParams{
+		Action:   models.AuditLoginSucceeded,
+		Status:   models.AuditSuccess,
+		Changes:  []byte(`{}`),
+		Metadata: []byte(`{}`),
+	})
+	assert.NoError(t, err)
+
+	checkpoint, err := repository.CreateCheckpoint(ctx, db.CreateAuditCheckpointParams{
+		Sequence:  event.Sequence,
+		Hash:      event.Hash,
+		Signature: "signature",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, event.Sequence, checkpoint.Sequence)
+
+	last, err := repository.FindLastCheckpoint(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, checkpoint.ID, last.ID)
+
+	checkpoints, err := repository.ListCheckpoints(ctx, event.Sequence-1, event.Sequence)
+	assert.NoError(t, err)
+	assert.Len(t, checkpoints, 1)
+
+	events, err := repository.ListAfter(ctx, event.Sequence-1, 10)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, event.ID, events[0].ID)
+
+	_, err = client.Db().Exec(ctx, "DELETE FROM audit_checkpoints WHERE id = $1", checkpoint.ID)
+	assert.Error(t, err)
+}
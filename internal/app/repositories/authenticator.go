@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+)
+
+type AuthenticatorRepository interface {
+	List(ctx context.Context, userId uuid.UUID) ([]models.Authenticator, error)
+	Upsert(ctx context.Context, params db.UpsertUserAuthenticatorParams) (*models.Authenticator, error)
+	FindAllowedMethods(ctx context.Context, userId uuid.UUID) ([]string, error)
+	SetAllowedMethods(ctx context.Context, userId uuid.UUID, methods []string) error
+}
+
+type authenticator struct {
+	client postgres.Postgres
+}
+
+func NewAuthenticatorRepository(client postgres.Postgres) AuthenticatorRepository {
+	return &authenticator{client: client}
+}
+
+func (a *authenticator) List(ctx context.Context, userId uuid.UUID) ([]models.Authenticator, error) {
+	rows, err := a.client.Queries().ListUserAuthenticators(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Authenticator, 0, len(rows))
+	for _, row := range rows {
+		item, err := toAuthenticator(row)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *item)
+	}
+
+	return result, nil
+}
+
+// Upsert records a login with the authenticator, a known one only gets its metadata and last use refreshed
+func (a *authenticator) Upsert(ctx context.Context, params db.UpsertUserAuthenticatorParams) (*models.Authenticator, error) {
+	row, err := a.client.Queries().UpsertUserAuthenticator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAuthenticator(row)
+}
+
+func (a *authenticator) FindAllowedMethods(ctx context.Context, userId uuid.UUID) ([]string, error) {
+	methods, err := a.client.Queries().FindUserAllowedMethods(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return methods, nil
+}
+
+// SetAllowedMethods replaces the allowed methods of the user, an empty list lifts the restriction
+func (a *authenticator) SetAllowedMethods(ctx context.Context, userId uuid.UUID, methods []string) error {
+	tx, err := a.client.Db().Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	q := a.client.Queries().WithTx(tx)
+
+	if err = q.DeleteUserAllowedMethods(ctx, userId); err != nil {
+		return err
+	}
+
+	if len(methods) > 0 {
+		err = q.CreateUserAllowedMethods(ctx, db.CreateUserAllowedMethodsParams{
+			UserID:  userId,
+			Methods: methods,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func toAuthenticator(row db.UserAuthenticator) (*models.Authenticator, error) {
+	result := &models.Authenticator{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		Method:      row.Method,
+		Identifier:  row.Identifier,
+		Metadata:    map[string]string{},
+		FirstUsedAt: row.FirstUsedAt.Time,
+		LastUsedAt:  row.LastUsedAt.Time,
+	}
+
+	if len(row.Metadata) > 0 {
+		if err := json.Unmarshal(row.Metadata, &result.Metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
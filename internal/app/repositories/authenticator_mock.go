@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/repositories/authenticator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/repositories/authenticator.go -destination=internal/app/repositories/authenticator_mock.go -package=repositories
+//
+
+// Package repositories is a generated GoMock package.
+package repositories
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	db "loki/internal/app/repositories/db"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthenticatorRepository is a mock of AuthenticatorRepository interface.
+type MockAuthenticatorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthenticatorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthenticatorRepositoryMockRecorder is the mock recorder for MockAuthenticatorRepository.
+type MockAuthenticatorRepositoryMockRecorder struct {
+	mock *MockAuthenticatorRepository
+}
+
+// NewMockAuthenticatorRepository creates a new mock instance.
+func NewMockAuthenticatorRepository(ctrl *gomock.Controller) *MockAuthenticatorRepository {
+	mock := &MockAuthenticatorRepository{ctrl: ctrl}
+	mock.recorder = &MockAuthenticatorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthenticatorRepository) EXPECT() *MockAuthenticatorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAllowedMethods mocks base method.
+func (m *MockAuthenticatorRepository) FindAllowedMethods(ctx context.Context, userId uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllowedMethods", ctx, userId)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllowedMethods indicates an expected call of FindAllowedMethods.
+func (mr *MockAuthenticatorRepositoryMockRecorder) FindAllowedMethods(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllowedMethods", reflect.TypeOf((*MockAuthenticatorRepository)(nil).FindAllowedMethods), ctx, userId)
+}
+
+// List mocks base method.
+func (m *MockAuthenticatorRepository) List(ctx context.Context, userId uuid.UUID) ([]models.Authenticator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userId)
+	ret0, _ := ret[0].([]models.Authenticator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuthenticatorRepositoryMockRecorder) List(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuthenticatorRepository)(nil).List), ctx, userId)
+}
+
+// SetAllowedMethods mocks base method.
+func (m *MockAuthenticatorRepository) SetAllowedMethods(ctx context.Context, userId uuid.UUID, methods []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAllowedMethods", ctx, userId, methods)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAllowedMethods indicates an expected call of SetAllowedMethods.
+func (mr *MockAuthenticatorRepositoryMockRecorder) SetAllowedMethods(ctx, userId, methods any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllowedMethods", reflect.TypeOf((*MockAuthenticatorRepository)(nil).SetAllowedMethods), ctx, userId, methods)
+}
+
+// Upsert mocks base method.
+func (m *MockAuthenticatorRepository) Upsert(ctx context.Context, params db.UpsertUserAuthenticatorParams) (*models.Authenticator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, params)
+	ret0, _ := ret[0].(*models.Authenticator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockAuthenticatorRepositoryMockRecorder) Upsert(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockAuthenticatorRepository)(nil).Upsert), ctx, params)
+}
@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+	"loki/internal/config"
+)
+
+func Test_AuthenticatorRepository(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	authenticatorRepository := NewAuthenticatorRepository(client)
+	userRepository := NewUserRepository(client)
+
+	user, err := userRepository.Create(ctx, db.CreateUserParams{
+		IdentityNumber: "PNOEE-39001010110",
+		PersonalCode:   "39001010110",
+		FirstName:      "JOHN",
+		LastName:       "DOE",
+	})
+	assert.NoError(t, err)
+
+	t.Run("Upsert", func(t *testing.T) {
+		first, err := authenticatorRepository.Upsert(ctx, db.UpsertUserAuthenticatorParams{
+			UserID:     user.ID,
+			Method:     models.AuthMethodMobileId,
+			Identifier: "+37268000769",
+			Metadata:   []byte(`{"identity_number":"PNOEE-39001010110"}`),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, models.AuthMethodMobileId, first.Method)
+		assert.Equal(t, "+37268000769", first.Identifier)
+		assert.Equal(t, map[string]string{"identity_number": "PNOEE-39001010110"}, first.Metadata)
+
+		second, err := authenticatorRepository.Upsert(ctx, db.UpsertUserAuthenticatorParams{
+			UserID:     user.ID,
+			Method:     models.AuthMethodMobileId,
+			Identifier: "+37268000769",
+			Metadata:   []byte(`{}`),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, first.ID, second.ID)
+		assert.Equal(t, first.FirstUsedAt, second.FirstUsedAt)
+		assert.False(t, second.LastUsedAt.Before(first.LastUsedAt))
+	})
+
+	t.Run("List", func(t *testing.T) {
+		_, err := authenticatorRepository.Upsert(ctx, db.UpsertUserAuthenticatorParams{
+			UserID:     user.ID,
+			Method:     models.AuthMethodSmartId,
+			Identifier: "PNOEE-39001010110",
+			Metadata:   []byte(`{}`),
+		})
+		assert.NoError(t, err)
+
+		result, err := authenticatorRepository.List(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, models.AuthMethodSmartId, result[0].Method)
+	})
+
+	t.Run("Allowed methods", func(t *testing.T) {
+		methods, err := authenticatorRepository.FindAllowedMethods(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, methods)
+
+		err = authenticatorRepository.SetAllowedMethods(ctx, user.ID, []string{models.AuthMethodSmartId})
+		assert.NoError(t, err)
+
+		methods, err = authenticatorRepository.FindAllowedMethods(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{models.AuthMethodSmartId}, methods)
+
+		err = authenticatorRepository.SetAllowedMethods(ctx, user.ID, []string{})
+		assert.NoError(t, err)
+
+		methods, err = authenticatorRepository.FindAllowedMethods(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, methods)
+	})
+}
@@ -25,10 +25,21 @@ func TestMain(m *testing.M) {
 	}
 
 	tables := []string{
+		"audit_checkpoints",
+		"audit_events",
+		"invitations",
+		"login_sessions",
+		"memberships",
+		"organisations",
+		"role_elevations",
 		"role_permissions",
+		"user_allowed_methods",
+		"user_authenticators",
+		"user_profiles",
 		"user_roles",
 		"user_scopes",
 		"permissions",
+		"policies",
 		"roles",
 		"scopes",
 		"tokens",
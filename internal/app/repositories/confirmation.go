@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/redis"
+)
+
+const (
+	// ConfirmationPrefix namespaces the confirmations in Redis
+	ConfirmationPrefix = "confirmation:"
+	// ConfirmationTTL keeps the signature for the caller to fetch after the session has ended
+	ConfirmationTTL = 15 * time.Minute
+)
+
+type ConfirmationRepository interface {
+	Save(ctx context.Context, confirmation *models.Confirmation) error
+	FindById(ctx context.Context, id uuid.UUID) (*models.Confirmation, error)
+}
+
+type confirmation struct {
+	client redis.Redis
+}
+
+func NewConfirmationRepository(client redis.Redis) ConfirmationRepository {
+	return &confirmation{client: client}
+}
+
+func (c *confirmation) Save(ctx context.Context, confirmation *models.Confirmation) error {
+	data, err := json.Marshal(confirmation)
+	if err != nil {
+		return err
+	}
+
+	return c.client.Connection().Set(ctx, ConfirmationPrefix+confirmation.ID.String(), data, ConfirmationTTL).Err()
+}
+
+func (c *confirmation) FindById(ctx context.Context, id uuid.UUID) (*models.Confirmation, error) {
+	data, err := c.client.Connection().Get(ctx, ConfirmationPrefix+id.String()).Result()
+	if err != nil {
+		return nil, errors.ErrConfirmationNotFound
+	}
+
+	var result models.Confirmation
+	if err = json.Unmarshal([]byte(data), &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/repositories/confirmation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/repositories/confirmation.go -destination=internal/app/repositories/confirmation_mock.go -package=repositories
+//
+
+// Package repositories is a generated GoMock package.
+package repositories
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfirmationRepository is a mock of ConfirmationRepository interface.
+type MockConfirmationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfirmationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockConfirmationRepositoryMockRecorder is the mock recorder for MockConfirmationRepository.
+type MockConfirmationRepositoryMockRecorder struct {
+	mock *MockConfirmationRepository
+}
+
+// NewMockConfirmationRepository creates a new mock instance.
+func NewMockConfirmationRepository(ctrl *gomock.Controller) *MockConfirmationRepository {
+	mock := &MockConfirmationRepository{ctrl: ctrl}
+	mock.recorder = &MockConfirmationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfirmationRepository) EXPECT() *MockConfirmationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockConfirmationRepository) FindById(ctx context.Context, id uuid.UUID) (*models.Confirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*models.Confirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockConfirmationRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockConfirmationRepository)(nil).FindById), ctx, id)
+}
+
+// Save mocks base method.
+func (m *MockConfirmationRepository) Save(ctx context.Context, confirmation *models.Confirmation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, confirmation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockConfirmationRepositoryMockRecorder) Save(ctx, confirmation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockConfirmationRepository)(nil).Save), ctx, confirmation)
+}
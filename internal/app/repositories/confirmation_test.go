@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/redis"
+	"loki/internal/config"
+)
+
+func Test_ConfirmationRepository_Save(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		RedisURI: os.Getenv("REDIS_URI"),
+	}
+
+	client, err := redis.NewRedisClient(cfg)
+	assert.NoError(t, err)
+
+	repo := NewConfirmationRepository(client)
+
+	confirmation := &models.Confirmation{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+		Method: models.AuthMethodSmartId,
+		Code:   "1234",
+		Status: models.SessionRunning,
+	}
+
+	err = repo.Save(ctx, confirmation)
+	assert.NoError(t, err)
+
+	confirmation.Status = models.SessionSuccess
+	confirmation.Signature = "c2lnbmF0dXJl"
+	err = repo.Save(ctx, confirmation)
+	assert.NoError(t, err)
+
+	result, err := repo.FindById(ctx, confirmation.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.SessionSuccess, result.Status)
+	assert.Equal(t, "c2lnbmF0dXJl", result.Signature)
+}
+
+func Test_ConfirmationRepository_FindById(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		RedisURI: os.Getenv("REDIS_URI"),
+	}
+
+	client, err := redis.NewRedisClient(cfg)
+	assert.NoError(t, err)
+
+	repo := NewConfirmationRepository(client)
+
+	result, err := repo.FindById(ctx, uuid.New())
+	assert.ErrorIs(t, err, errors.ErrConfirmationNotFound)
+	assert.Nil(t, result)
+}
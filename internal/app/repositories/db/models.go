@@ -12,6 +12,135 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ElevationStatus string
+
+const (
+	ElevationStatusPending  ElevationStatus = "pending"
+	ElevationStatusApproved ElevationStatus = "approved"
+	ElevationStatusRejected ElevationStatus = "rejected"
+)
+
+func (e *ElevationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ElevationStatus(s)
+	case string:
+		*e = ElevationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ElevationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullElevationStatus struct {
+	ElevationStatus ElevationStatus
+	Valid           bool // Valid is true if ElevationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullElevationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ElevationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ElevationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullElevationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ElevationStatus), nil
+}
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+	InvitationStatusExpired  InvitationStatus = "expired"
+)
+
+func (e *InvitationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InvitationStatus(s)
+	case string:
+		*e = InvitationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InvitationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullInvitationStatus struct {
+	InvitationStatus InvitationStatus
+	Valid            bool // Valid is true if InvitationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInvitationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.InvitationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InvitationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInvitationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InvitationStatus), nil
+}
+
+type PolicyEffect string
+
+const (
+	PolicyEffectAllow PolicyEffect = "allow"
+	PolicyEffectDeny  PolicyEffect = "deny"
+)
+
+func (e *PolicyEffect) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PolicyEffect(s)
+	case string:
+		*e = PolicyEffect(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PolicyEffect: %T", src)
+	}
+	return nil
+}
+
+type NullPolicyEffect struct {
+	PolicyEffect PolicyEffect
+	Valid        bool // Valid is true if PolicyEffect is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPolicyEffect) Scan(value interface{}) error {
+	if value == nil {
+		ns.PolicyEffect, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PolicyEffect.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPolicyEffect) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PolicyEffect), nil
+}
+
 type TokenType string
 
 const (
@@ -54,6 +183,118 @@ func (ns NullTokenType) Value() (driver.Value, error) {
 	return string(ns.TokenType), nil
 }
 
+type UserStatus string
+
+const (
+	UserStatusActive    UserStatus = "active"
+	UserStatusSuspended UserStatus = "suspended"
+	UserStatusLocked    UserStatus = "locked"
+	UserStatusDeleted   UserStatus = "deleted"
+)
+
+func (e *UserStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserStatus(s)
+	case string:
+		*e = UserStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserStatus: %T", src)
+	}
+	return nil
+}
+
+type NullUserStatus struct {
+	UserStatus UserStatus
+	Valid      bool // Valid is true if UserStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserStatus), nil
+}
+
+type AuditCheckpoint struct {
+	ID        uuid.UUID
+	Sequence  int64
+	Hash      string
+	Signature string
+	CreatedAt pgtype.Timestamp
+}
+
+type AuditEvent struct {
+	ID         uuid.UUID
+	Action     string
+	ActorID    uuid.UUID
+	TargetType string
+	TargetID   string
+	Status     string
+	Changes    []byte
+	Metadata   []byte
+	TraceID    string
+	RemoteAddr string
+	CreatedAt  pgtype.Timestamp
+	Sequence   int64
+	PrevHash   string
+	Hash       string
+}
+
+type Invitation struct {
+	ID             uuid.UUID
+	IdentityNumber string
+	PersonalCode   string
+	RoleIds        []uuid.UUID
+	ScopeIds       []uuid.UUID
+	Status         InvitationStatus
+	InvitedBy      uuid.UUID
+	UserID         uuid.UUID
+	ExpiresAt      pgtype.Timestamp
+	SentAt         pgtype.Timestamp
+	AcceptedAt     pgtype.Timestamp
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+}
+
+type LoginSession struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	Provider        string
+	IpAddress       pgtype.Text
+	UserAgent       pgtype.Text
+	CreatedAt       pgtype.Timestamp
+	LastRefreshedAt pgtype.Timestamp
+	RevokedAt       pgtype.Timestamp
+}
+
+type Membership struct {
+	UserID         uuid.UUID
+	OrganisationID uuid.UUID
+	RoleID         uuid.UUID
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+}
+
+type Organisation struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+}
+
 type Permission struct {
 	ID          uuid.UUID
 	Name        string
@@ -62,12 +303,37 @@ type Permission struct {
 	UpdatedAt   pgtype.Timestamp
 }
 
+type Policy struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	Actions     []string
+	Effect      PolicyEffect
+	Condition   string
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+}
+
 type Role struct {
 	ID          uuid.UUID
 	Name        string
 	Description string
 	CreatedAt   pgtype.Timestamp
 	UpdatedAt   pgtype.Timestamp
+	ParentID    uuid.UUID
+}
+
+type RoleElevation struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	RoleID          uuid.UUID
+	Reason          string
+	DurationMinutes int32
+	Status          ElevationStatus
+	DecidedBy       uuid.UUID
+	DecidedAt       pgtype.Timestamp
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
 }
 
 type RolePermission struct {
@@ -86,23 +352,57 @@ type Scope struct {
 }
 
 type Token struct {
-	ID        uuid.UUID
-	UserID    uuid.UUID
-	Type      TokenType
-	Value     string
-	ExpiresAt pgtype.Timestamp
-	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-}
-
-type User struct {
 	ID             uuid.UUID
-	IdentityNumber string
-	PersonalCode   string
-	FirstName      string
-	LastName       string
+	UserID         uuid.UUID
+	Type           TokenType
+	Value          string
+	ExpiresAt      pgtype.Timestamp
 	CreatedAt      pgtype.Timestamp
 	UpdatedAt      pgtype.Timestamp
+	LoginSessionID uuid.UUID
+}
+
+type User struct {
+	ID              uuid.UUID
+	IdentityNumber  string
+	PersonalCode    string
+	FirstName       string
+	LastName        string
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	Status          UserStatus
+	StatusReason    pgtype.Text
+	StatusChangedAt pgtype.Timestamp
+}
+
+type UserAllowedMethod struct {
+	UserID uuid.UUID
+	Method string
+}
+
+type UserAuthenticator struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Method      string
+	Identifier  string
+	Metadata    []byte
+	FirstUsedAt pgtype.Timestamp
+	LastUsedAt  pgtype.Timestamp
+}
+
+type UserProfile struct {
+	UserID                     uuid.UUID
+	Email                      pgtype.Text
+	EmailVerifiedAt            pgtype.Timestamp
+	EmailVerificationDigest    pgtype.Text
+	EmailVerificationExpiresAt pgtype.Timestamp
+	PhoneNumber                pgtype.Text
+	Locale                     pgtype.Text
+	Attributes                 []byte
+	CreatedAt                  pgtype.Timestamp
+	UpdatedAt                  pgtype.Timestamp
+	EmailVerificationAttempts  int32
+	EmailVerificationSentAt    pgtype.Timestamp
 }
 
 type UserRole struct {
@@ -110,6 +410,8 @@ type UserRole struct {
 	RoleID    uuid.UUID
 	CreatedAt pgtype.Timestamp
 	UpdatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+	GrantedBy uuid.UUID
 }
 
 type UserScope struct {
@@ -117,4 +419,6 @@ type UserScope struct {
 	ScopeID   uuid.UUID
 	CreatedAt pgtype.Timestamp
 	UpdatedAt pgtype.Timestamp
+	ExpiresAt pgtype.Timestamp
+	GrantedBy uuid.UUID
 }
@@ -12,6 +12,24 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addRolePermissions = `-- name: AddRolePermissions :exec
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT $1::uuid, permission_id
+FROM unnest($2::uuid[]) AS permission_id
+ON CONFLICT (role_id, permission_id) DO NOTHING
+`
+
+
+type AddRolePermissionsParams struct {
+	RoleID        uuid.UUID
+	PermissionIds []uuid.UUID
+}
+
+func (q *Queries) AddRolePermissions(ctx context.Context, arg AddRolePermissionsParams) error {
+	_, err := q.db.Exec(ctx, addRolePermissions, arg.RoleID, arg.PermissionIds)
+	return err
+}
+
 const createPermission = `-- name: CreatePermission :one
 INSERT INTO permissions (name, description)
 VALUES ($1, $2)
@@ -160,27 +178,58 @@ func (q *Queries) FindPermissions(ctx context.Context, arg FindPermissionsParams
 	return items, nil
 }
 
-const findUserPermissions = `-- name: FindUserPermissions :many
-SELECT id, name FROM permissions WHERE id IN (
-  SELECT permission_id FROM role_permissions WHERE role_id IN (
-    SELECT role_id FROM user_roles WHERE user_id = $1))
+const findUserPermissionPaths = `-- name: FindUserPermissionPaths :many
+WITH RECURSIVE assigned_roles AS (
+  SELECT ur.role_id, ur.expires_at, '00000000-0000-0000-0000-000000000000'::uuid AS organisation_id
+  FROM user_roles ur
+  WHERE ur.user_id = $1 AND (ur.expires_at IS NULL OR ur.expires_at > NOW())
+  UNION ALL
+  SELECT m.role_id, NULL::timestamp AS expires_at, m.organisation_id
+  FROM memberships m
+  WHERE m.user_id = $1
+), user_role_tree AS (
+  SELECT r.id, r.parent_id, ARRAY[r.id]::uuid[] AS role_ids, ARRAY[r.name]::text[] AS role_names, a.expires_at, a.organisation_id
+  FROM roles r
+  JOIN assigned_roles a ON a.role_id = r.id
+  UNION ALL
+  SELECT r.id, r.parent_id, t.role_ids || r.id, t.role_names || r.name::text, t.expires_at, t.organisation_id
+  FROM roles r
+  JOIN user_role_tree t ON r.id = t.parent_id
+  WHERE NOT r.id = ANY(t.role_ids)
+)
+SELECT p.id, p.name, t.role_ids::uuid[] AS role_ids, t.role_names::text[] AS role_names, t.expires_at, t.organisation_id::uuid AS organisation_id
+FROM user_role_tree t
+JOIN role_permissions rp ON rp.role_id = t.id
+JOIN permissions p ON p.id = rp.permission_id
+ORDER BY p.name, t.organisation_id, array_length(t.role_ids, 1), t.role_names
 `
 
-type FindUserPermissionsRow struct {
-	ID   uuid.UUID
-	Name string
+type FindUserPermissionPathsRow struct {
+	ID             uuid.UUID
+	Name           string
+	RoleIds        []uuid.UUID
+	RoleNames      []string
+	ExpiresAt      pgtype.Timestamp
+	OrganisationID uuid.UUID
 }
 
-func (q *Queries) FindUserPermissions(ctx context.Context, userID uuid.UUID) ([]FindUserPermissionsRow, error) {
-	rows, err := q.db.Query(ctx, findUserPermissions, userID)
+func (q *Queries) FindUserPermissionPaths(ctx context.Context, userID uuid.UUID) ([]FindUserPermissionPathsRow, error) {
+	rows, err := q.db.Query(ctx, findUserPermissionPaths, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []FindUserPermissionsRow
+	var items []FindUserPermissionPathsRow
 	for rows.Next() {
-		var i FindUserPermissionsRow
-		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+		var i FindUserPermissionPathsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.RoleIds,
+			&i.RoleNames,
+			&i.ExpiresAt,
+			&i.OrganisationID,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -191,6 +240,22 @@ func (q *Queries) FindUserPermissions(ctx context.Context, userID uuid.UUID) ([]
 	return items, nil
 }
 
+const removeRolePermissions = `-- name: RemoveRolePermissions :exec
+DELETE FROM role_permissions
+WHERE role_id = $1::uuid AND permission_id = ANY($2::uuid[])
+`
+
+
+type RemoveRolePermissionsParams struct {
+	RoleID        uuid.UUID
+	PermissionIds []uuid.UUID
+}
+
+func (q *Queries) RemoveRolePermissions(ctx context.Context, arg RemoveRolePermissionsParams) error {
+	_, err := q.db.Exec(ctx, removeRolePermissions, arg.RoleID, arg.PermissionIds)
+	return err
+}
+
 const updatePermission = `-- name: UpdatePermission :one
 UPDATE permissions
 SET
@@ -12,15 +12,38 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addUserRoles = `-- name: AddUserRoles :exec
+INSERT INTO user_roles (user_id, role_id, granted_by)
+SELECT $1::uuid, role_id, NULLIF($2::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
+FROM unnest($3::uuid[]) AS role_id
+ON CONFLICT (user_id, role_id) DO UPDATE SET
+  expires_at = NULL,
+  granted_by = EXCLUDED.granted_by,
+  updated_at = NOW()
+`
+
+
+type AddUserRolesParams struct {
+	UserID    uuid.UUID
+	GrantedBy uuid.UUID
+	RoleIds   []uuid.UUID
+}
+
+func (q *Queries) AddUserRoles(ctx context.Context, arg AddUserRolesParams) error {
+	_, err := q.db.Exec(ctx, addUserRoles, arg.UserID, arg.GrantedBy, arg.RoleIds)
+	return err
+}
+
 const createRole = `-- name: CreateRole :one
-INSERT INTO roles (name, description)
-VALUES ($1, $2)
-  RETURNING id, name, description
+INSERT INTO roles (name, description, parent_id)
+VALUES ($1, $2, NULLIF($3::uuid, '00000000-0000-0000-0000-000000000000'::uuid))
+  RETURNING id, name, description, parent_id
 `
 
 type CreateRoleParams struct {
 	Name        string
 	Description string
+	ParentID    uuid.UUID
 	PermissionIDs []uuid.UUID
 }
 
@@ -28,12 +51,18 @@ type CreateRoleRow struct {
 	ID          uuid.UUID
 	Name        string
 	Description string
+	ParentID    uuid.UUID
 }
 
 func (q *Queries) CreateRole(ctx context.Context, arg CreateRoleParams) (CreateRoleRow, error) {
-	row := q.db.QueryRow(ctx, createRole, arg.Name, arg.Description)
+	row := q.db.QueryRow(ctx, createRole, arg.Name, arg.Description, arg.ParentID)
 	var i CreateRoleRow
-	err := row.Scan(&i.ID, &i.Name, &i.Description)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.ParentID,
+	)
 	return i, err
 }
 
@@ -68,18 +97,22 @@ WITH
     WHERE user_id = $1::uuid AND role_id NOT IN (SELECT unnest($2::uuid[]))
   ),
   inserted AS (
-    INSERT INTO user_roles (user_id, role_id)
-    SELECT $1::uuid, role_id
+    INSERT INTO user_roles (user_id, role_id, granted_by)
+    SELECT $1::uuid, role_id, NULLIF($3::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
     FROM unnest($2::uuid[]) AS role_id
-    ON CONFLICT (user_id, role_id) DO NOTHING
+    ON CONFLICT (user_id, role_id) DO UPDATE SET
+      expires_at = NULL,
+      granted_by = EXCLUDED.granted_by,
+      updated_at = NOW()
       RETURNING user_id, role_id
   )
 SELECT user_id, role_id FROM inserted
 `
 
 type CreateUserRolesParams struct {
-	UserID  uuid.UUID
-	RoleIds []uuid.UUID
+	UserID    uuid.UUID
+	RoleIds   []uuid.UUID
+	GrantedBy uuid.UUID
 }
 
 type CreateUserRolesRow struct {
@@ -88,7 +121,7 @@ type CreateUserRolesRow struct {
 }
 
 func (q *Queries) CreateUserRoles(ctx context.Context, arg CreateUserRolesParams) ([]CreateUserRolesRow, error) {
-	rows, err := q.db.Query(ctx, createUserRoles, arg.UserID, arg.RoleIds)
+	rows, err := q.db.Query(ctx, createUserRoles, arg.UserID, arg.RoleIds, arg.GrantedBy)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +140,44 @@ func (q *Queries) CreateUserRoles(ctx context.Context, arg CreateUserRolesParams
 	return items, nil
 }
 
+const deleteExpiredUserRoles = `-- name: DeleteExpiredUserRoles :many
+DELETE FROM user_roles
+WHERE expires_at IS NOT NULL AND expires_at <= NOW()
+RETURNING user_id, role_id, expires_at, granted_by
+`
+
+type DeleteExpiredUserRolesRow struct {
+	UserID    uuid.UUID
+	RoleID    uuid.UUID
+	ExpiresAt pgtype.Timestamp
+	GrantedBy uuid.UUID
+}
+
+func (q *Queries) DeleteExpiredUserRoles(ctx context.Context) ([]DeleteExpiredUserRolesRow, error) {
+	rows, err := q.db.Query(ctx, deleteExpiredUserRoles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeleteExpiredUserRolesRow
+	for rows.Next() {
+		var i DeleteExpiredUserRolesRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.RoleID,
+			&i.ExpiresAt,
+			&i.GrantedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteRole = `-- name: DeleteRole :exec
 DELETE FROM roles WHERE id = $1
 `
@@ -116,20 +187,55 @@ func (q *Queries) DeleteRole(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const findRoleAncestorIds = `-- name: FindRoleAncestorIds :many
+WITH RECURSIVE ancestors AS (
+  SELECT r.id, r.parent_id FROM roles r WHERE r.id = $1
+  UNION
+  SELECT r.id, r.parent_id FROM roles r JOIN ancestors a ON r.id = a.parent_id
+)
+SELECT id FROM ancestors
+`
+
+func (q *Queries) FindRoleAncestorIds(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, findRoleAncestorIds, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findRoleById = `-- name: FindRoleById :one
-SELECT id, name, description FROM roles WHERE id = $1
+SELECT id, name, description, parent_id FROM roles WHERE id = $1
 `
 
 type FindRoleByIdRow struct {
 	ID          uuid.UUID
 	Name        string
 	Description string
+	ParentID    uuid.UUID
 }
 
 func (q *Queries) FindRoleById(ctx context.Context, id uuid.UUID) (FindRoleByIdRow, error) {
 	row := q.db.QueryRow(ctx, findRoleById, id)
 	var i FindRoleByIdRow
-	err := row.Scan(&i.ID, &i.Name, &i.Description)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.ParentID,
+	)
 	return i, err
 }
 
@@ -150,28 +256,39 @@ func (q *Queries) FindRoleByName(ctx context.Context, name string) (FindRoleByNa
 }
 
 const findRoleDetailsById = `-- name: FindRoleDetailsById :one
+WITH RECURSIVE ancestors AS (
+  SELECT r.parent_id AS id FROM roles r WHERE r.id = $1::uuid AND r.parent_id IS NOT NULL
+  UNION
+  SELECT r.parent_id FROM roles r JOIN ancestors a ON r.id = a.id WHERE r.parent_id IS NOT NULL
+)
 SELECT
   r.id,
   r.name,
   r.description,
-  COALESCE(rp.permissions, ARRAY[]::uuid[]) AS permission_ids
-FROM roles r
-  LEFT JOIN (
-    SELECT
-      rp.role_id,
-      ARRAY_AGG(rp.permission_id) AS permissions
+  r.parent_id,
+  COALESCE((
+    SELECT ARRAY_AGG(rp.permission_id)
+    FROM role_permissions rp
+    WHERE rp.role_id = r.id
+  ), ARRAY[]::uuid[])::uuid[] AS permission_ids,
+  COALESCE((
+    SELECT ARRAY_AGG(DISTINCT rp.permission_id)
     FROM role_permissions rp
-    GROUP BY rp.role_id
-  ) rp ON r.id = rp.role_id
+    WHERE rp.role_id IN (SELECT id FROM ancestors)
+      AND rp.permission_id NOT IN (SELECT permission_id FROM role_permissions WHERE role_id = r.id)
+  ), ARRAY[]::uuid[])::uuid[] AS inherited_permission_ids
+FROM roles r
 WHERE
-  r.id = $1
+  r.id = $1::uuid
 `
 
 type FindRoleDetailsByIdRow struct {
-	ID            uuid.UUID
-	Name          string
-	Description   string
-	PermissionIds []uuid.UUID
+	ID                     uuid.UUID
+	Name                   string
+	Description            string
+	ParentID               uuid.UUID
+	PermissionIds          []uuid.UUID
+	InheritedPermissionIds []uuid.UUID
 }
 
 func (q *Queries) FindRoleDetailsById(ctx context.Context, id uuid.UUID) (FindRoleDetailsByIdRow, error) {
@@ -181,11 +298,46 @@ func (q *Queries) FindRoleDetailsById(ctx context.Context, id uuid.UUID) (FindRo
 		&i.ID,
 		&i.Name,
 		&i.Description,
+		&i.ParentID,
 		&i.PermissionIds,
+		&i.InheritedPermissionIds,
 	)
 	return i, err
 }
 
+const findRolePermissionNames = `-- name: FindRolePermissionNames :many
+WITH RECURSIVE lineage AS (
+  SELECT r.id, r.parent_id FROM roles r WHERE r.id = $1
+  UNION
+  SELECT r.id, r.parent_id FROM roles r JOIN lineage l ON r.id = l.parent_id
+)
+SELECT DISTINCT p.name
+FROM role_permissions rp
+JOIN permissions p ON p.id = rp.permission_id
+WHERE rp.role_id IN (SELECT id FROM lineage)
+ORDER BY p.name
+`
+
+func (q *Queries) FindRolePermissionNames(ctx context.Context, id uuid.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, findRolePermissionNames, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findRoles = `-- name: FindRoles :many
 WITH counter AS (
   SELECT COUNT(*) AS total
@@ -195,6 +347,7 @@ SELECT
   r.id,
   r.name,
   r.description,
+  r.parent_id,
   counter.total
 FROM roles AS r
 RIGHT JOIN counter ON TRUE
@@ -210,6 +363,7 @@ type FindRolesRow struct {
 	ID          uuid.UUID
 	Name        pgtype.Text
 	Description pgtype.Text
+	ParentID    uuid.UUID
 	Total       uint64
 }
 
@@ -226,6 +380,7 @@ func (q *Queries) FindRoles(ctx context.Context, arg FindRolesParams) ([]FindRol
 			&i.ID,
 			&i.Name,
 			&i.Description,
+			&i.ParentID,
 			&i.Total,
 		); err != nil {
 			return nil, err
@@ -238,8 +393,38 @@ func (q *Queries) FindRoles(ctx context.Context, arg FindRolesParams) ([]FindRol
 	return items, nil
 }
 
+const findRolesByNames = `-- name: FindRolesByNames :many
+SELECT id, name FROM roles WHERE name = ANY($1::text[])
+`
+
+type FindRolesByNamesRow struct {
+	ID   uuid.UUID
+	Name string
+}
+
+func (q *Queries) FindRolesByNames(ctx context.Context, names []string) ([]FindRolesByNamesRow, error) {
+	rows, err := q.db.Query(ctx, findRolesByNames, names)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindRolesByNamesRow
+	for rows.Next() {
+		var i FindRolesByNamesRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findUserRoles = `-- name: FindUserRoles :many
-SELECT id, name FROM roles WHERE id IN (SELECT role_id FROM user_roles WHERE user_id = $1)
+SELECT id, name FROM roles WHERE id IN (
+  SELECT role_id FROM user_roles WHERE user_id = $1 AND (expires_at IS NULL OR expires_at > NOW()))
 `
 
 type FindUserRolesRow struct {
@@ -267,20 +452,86 @@ func (q *Queries) FindUserRoles(ctx context.Context, userID uuid.UUID) ([]FindUs
 	return items, nil
 }
 
+const grantUserRole = `-- name: GrantUserRole :one
+INSERT INTO user_roles (user_id, role_id, expires_at, granted_by)
+VALUES ($1::uuid, $2::uuid, NOW() + make_interval(mins => $3::int), NULLIF($4::uuid, '00000000-0000-0000-0000-000000000000'::uuid))
+  ON CONFLICT (user_id, role_id) DO UPDATE SET
+    expires_at = CASE WHEN user_roles.expires_at IS NULL THEN NULL ELSE GREATEST(user_roles.expires_at, EXCLUDED.expires_at) END,
+    granted_by = CASE WHEN user_roles.expires_at IS NULL THEN user_roles.granted_by ELSE EXCLUDED.granted_by END,
+    updated_at = NOW()
+RETURNING user_id, role_id, expires_at, granted_by
+`
+
+type GrantUserRoleParams struct {
+	UserID          uuid.UUID
+	RoleID          uuid.UUID
+	DurationMinutes int32
+	GrantedBy       uuid.UUID
+}
+
+type GrantUserRoleRow struct {
+	UserID    uuid.UUID
+	RoleID    uuid.UUID
+	ExpiresAt pgtype.Timestamp
+	GrantedBy uuid.UUID
+}
+
+func (q *Queries) GrantUserRole(ctx context.Context, arg GrantUserRoleParams) (GrantUserRoleRow, error) {
+	row := q.db.QueryRow(ctx, grantUserRole,
+		arg.UserID,
+		arg.RoleID,
+		arg.DurationMinutes,
+		arg.GrantedBy,
+	)
+	var i GrantUserRoleRow
+	err := row.Scan(
+		&i.UserID,
+		&i.RoleID,
+		&i.ExpiresAt,
+		&i.GrantedBy,
+	)
+	return i, err
+}
+
+const removeUserRoles = `-- name: RemoveUserRoles :exec
+DELETE FROM user_roles
+WHERE user_id = $1::uuid AND role_id = ANY($2::uuid[])
+`
+
+
+type RemoveUserRolesParams struct {
+	UserID  uuid.UUID
+	RoleIds []uuid.UUID
+}
+
+func (q *Queries) RemoveUserRoles(ctx context.Context, arg RemoveUserRolesParams) error {
+	_, err := q.db.Exec(ctx, removeUserRoles, arg.UserID, arg.RoleIds)
+	return err
+}
+
 const updateRole = `-- name: UpdateRole :one
 UPDATE roles
 SET
-  name = $2,
-  description = $3,
+  name = CASE WHEN $1::bool THEN $2::text ELSE name END,
+  description = CASE WHEN $3::bool THEN $4::text ELSE description END,
+  parent_id = CASE
+    WHEN $5::bool THEN NULLIF($6::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
+    ELSE parent_id
+  END,
   updated_at = NOW()
-WHERE id = $1
-RETURNING id, name, description
+WHERE id = $7
+RETURNING id, name, description, parent_id
 `
 
 type UpdateRoleParams struct {
-	ID          uuid.UUID
-	Name        string
-	Description string
+	UpdateName        bool
+	Name              string
+	UpdateDescription bool
+	Description       string
+	UpdateParentID    bool
+	ParentID          uuid.UUID
+	ID                uuid.UUID
+	UpdatePermissionIDs bool
 	PermissionIDs []uuid.UUID
 }
 
@@ -288,12 +539,26 @@ type UpdateRoleRow struct {
 	ID          uuid.UUID
 	Name        string
 	Description string
+	ParentID    uuid.UUID
 }
 
 func (q *Queries) UpdateRole(ctx context.Context, arg UpdateRoleParams) (UpdateRoleRow, error) {
-	row := q.db.QueryRow(ctx, updateRole, arg.ID, arg.Name, arg.Description)
+	row := q.db.QueryRow(ctx, updateRole,
+		arg.UpdateName,
+		arg.Name,
+		arg.UpdateDescription,
+		arg.Description,
+		arg.UpdateParentID,
+		arg.ParentID,
+		arg.ID,
+	)
 	var i UpdateRoleRow
-	err := row.Scan(&i.ID, &i.Name, &i.Description)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.ParentID,
+	)
 	return i, err
 }
 
@@ -12,6 +12,28 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addUserScopes = `-- name: AddUserScopes :exec
+INSERT INTO user_scopes (user_id, scope_id, granted_by)
+SELECT $1::uuid, scope_id, NULLIF($2::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
+FROM unnest($3::uuid[]) AS scope_id
+ON CONFLICT (user_id, scope_id) DO UPDATE SET
+  expires_at = NULL,
+  granted_by = EXCLUDED.granted_by,
+  updated_at = NOW()
+`
+
+
+type AddUserScopesParams struct {
+	UserID    uuid.UUID
+	GrantedBy uuid.UUID
+	ScopeIds  []uuid.UUID
+}
+
+func (q *Queries) AddUserScopes(ctx context.Context, arg AddUserScopesParams) error {
+	_, err := q.db.Exec(ctx, addUserScopes, arg.UserID, arg.GrantedBy, arg.ScopeIds)
+	return err
+}
+
 const createScope = `-- name: CreateScope :one
 INSERT INTO scopes (name, description)
 VALUES ($1, $2)
@@ -67,18 +89,22 @@ WITH
     WHERE user_id = $1::uuid AND scope_id NOT IN (SELECT unnest($2::uuid[]))
   ),
   inserted AS (
-    INSERT INTO user_scopes (user_id, scope_id)
-    SELECT $1::uuid, scope_id
+    INSERT INTO user_scopes (user_id, scope_id, granted_by)
+    SELECT $1::uuid, scope_id, NULLIF($3::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
     FROM unnest($2::uuid[]) AS scope_id
-    ON CONFLICT (user_id, scope_id) DO NOTHING
+    ON CONFLICT (user_id, scope_id) DO UPDATE SET
+      expires_at = NULL,
+      granted_by = EXCLUDED.granted_by,
+      updated_at = NOW()
       RETURNING user_id, scope_id
   )
 SELECT user_id, scope_id FROM inserted
 `
 
 type CreateUserScopesParams struct {
-	UserID   uuid.UUID
-	ScopeIds []uuid.UUID
+	UserID    uuid.UUID
+	ScopeIds  []uuid.UUID
+	GrantedBy uuid.UUID
 }
 
 type CreateUserScopesRow struct {
@@ -87,7 +113,7 @@ type CreateUserScopesRow struct {
 }
 
 func (q *Queries) CreateUserScopes(ctx context.Context, arg CreateUserScopesParams) ([]CreateUserScopesRow, error) {
-	rows, err := q.db.Query(ctx, createUserScopes, arg.UserID, arg.ScopeIds)
+	rows, err := q.db.Query(ctx, createUserScopes, arg.UserID, arg.ScopeIds, arg.GrantedBy)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +132,44 @@ func (q *Queries) CreateUserScopes(ctx context.Context, arg CreateUserScopesPara
 	return items, nil
 }
 
+const deleteExpiredUserScopes = `-- name: DeleteExpiredUserScopes :many
+DELETE FROM user_scopes
+WHERE expires_at IS NOT NULL AND expires_at <= NOW()
+RETURNING user_id, scope_id, expires_at, granted_by
+`
+
+type DeleteExpiredUserScopesRow struct {
+	UserID    uuid.UUID
+	ScopeID   uuid.UUID
+	ExpiresAt pgtype.Timestamp
+	GrantedBy uuid.UUID
+}
+
+func (q *Queries) DeleteExpiredUserScopes(ctx context.Context) ([]DeleteExpiredUserScopesRow, error) {
+	rows, err := q.db.Query(ctx, deleteExpiredUserScopes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeleteExpiredUserScopesRow
+	for rows.Next() {
+		var i DeleteExpiredUserScopesRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.ScopeID,
+			&i.ExpiresAt,
+			&i.GrantedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteScope = `-- name: DeleteScope :exec
 DELETE FROM scopes WHERE id = $1
 `
@@ -200,8 +264,38 @@ func (q *Queries) FindScopes(ctx context.Context, arg FindScopesParams) ([]FindS
 	return items, nil
 }
 
+const findScopesByNames = `-- name: FindScopesByNames :many
+SELECT id, name FROM scopes WHERE name = ANY($1::text[])
+`
+
+type FindScopesByNamesRow struct {
+	ID   uuid.UUID
+	Name string
+}
+
+func (q *Queries) FindScopesByNames(ctx context.Context, names []string) ([]FindScopesByNamesRow, error) {
+	rows, err := q.db.Query(ctx, findScopesByNames, names)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindScopesByNamesRow
+	for rows.Next() {
+		var i FindScopesByNamesRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findUserScopes = `-- name: FindUserScopes :many
-SELECT id, name FROM scopes WHERE id IN (SELECT scope_id FROM user_scopes WHERE user_id = $1)
+SELECT id, name FROM scopes WHERE id IN (
+  SELECT scope_id FROM user_scopes WHERE user_id = $1 AND (expires_at IS NULL OR expires_at > NOW()))
 `
 
 type FindUserScopesRow struct {
@@ -229,6 +323,22 @@ func (q *Queries) FindUserScopes(ctx context.Context, userID uuid.UUID) ([]FindU
 	return items, nil
 }
 
+const removeUserScopes = `-- name: RemoveUserScopes :exec
+DELETE FROM user_scopes
+WHERE user_id = $1::uuid AND scope_id = ANY($2::uuid[])
+`
+
+
+type RemoveUserScopesParams struct {
+	UserID   uuid.UUID
+	ScopeIds []uuid.UUID
+}
+
+func (q *Queries) RemoveUserScopes(ctx context.Context, arg RemoveUserScopesParams) error {
+	_, err := q.db.Exec(ctx, removeUserScopes, arg.UserID, arg.ScopeIds)
+	return err
+}
+
 const updateScope = `-- name: UpdateScope :one
 UPDATE scopes
 SET
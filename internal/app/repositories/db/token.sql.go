@@ -50,10 +50,10 @@ func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) (Creat
 }
 
 const createTokens = `-- name: CreateTokens :many
-INSERT INTO tokens (user_id, type, value, expires_at)
+INSERT INTO tokens (user_id, type, value, expires_at, login_session_id)
 VALUES
-  ($1::uuid, 'access_token'::token_type, $2::text, $3::timestamp),
-  ($1::uuid, 'refresh_token'::token_type, $4::text, $5::timestamp)
+  ($1::uuid, 'access_token'::token_type, $2::text, $3::timestamp, NULLIF($4::uuid, '00000000-0000-0000-0000-000000000000'::uuid)),
+  ($1::uuid, 'refresh_token'::token_type, $5::text, $6::timestamp, NULLIF($4::uuid, '00000000-0000-0000-0000-000000000000'::uuid))
   RETURNING id, type, value, expires_at
 `
 
@@ -61,6 +61,7 @@ type CreateTokensParams struct {
 	UserID                uuid.UUID
 	AccessTokenValue      string
 	AccessTokenExpiresAt  pgtype.Timestamp
+	LoginSessionID        uuid.UUID
 	RefreshTokenValue     string
 	RefreshTokenExpiresAt pgtype.Timestamp
 }
@@ -77,6 +78,7 @@ func (q *Queries) CreateTokens(ctx context.Context, arg CreateTokensParams) ([]C
 		arg.UserID,
 		arg.AccessTokenValue,
 		arg.AccessTokenExpiresAt,
+		arg.LoginSessionID,
 		arg.RefreshTokenValue,
 		arg.RefreshTokenExpiresAt,
 	)
@@ -112,6 +114,24 @@ func (q *Queries) DeleteToken(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const deleteTokensByLoginSessionId = `-- name: DeleteTokensByLoginSessionId :exec
+DELETE FROM tokens WHERE login_session_id = $1
+`
+
+func (q *Queries) DeleteTokensByLoginSessionId(ctx context.Context, loginSessionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTokensByLoginSessionId, loginSessionID)
+	return err
+}
+
+const deleteTokensByUserId = `-- name: DeleteTokensByUserId :exec
+DELETE FROM tokens WHERE user_id = $1
+`
+
+func (q *Queries) DeleteTokensByUserId(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTokensByUserId, userID)
+	return err
+}
+
 const findTokenById = `-- name: FindTokenById :one
 SELECT id, user_id, type, value, expires_at FROM tokens WHERE id = $1
 `
@@ -16,7 +16,7 @@ const createUser = `-- name: CreateUser :one
 INSERT INTO users (identity_number, personal_code, first_name, last_name)
 VALUES ($1, $2, $3, $4)
   ON CONFLICT (identity_number) DO UPDATE SET first_name = EXCLUDED.first_name, last_name = EXCLUDED.last_name
-RETURNING id, identity_number, personal_code, first_name, last_name
+RETURNING id, identity_number, personal_code, first_name, last_name, status, status_reason, status_changed_at
 `
 
 type CreateUserParams struct {
@@ -27,11 +27,14 @@ type CreateUserParams struct {
 }
 
 type CreateUserRow struct {
-	ID             uuid.UUID
-	IdentityNumber string
-	PersonalCode   string
-	FirstName      string
-	LastName       string
+	ID              uuid.UUID
+	IdentityNumber  string
+	PersonalCode    string
+	FirstName       string
+	LastName        string
+	Status          UserStatus
+	StatusReason    pgtype.Text
+	StatusChangedAt pgtype.Timestamp
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error) {
@@ -48,6 +51,9 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateU
 		&i.PersonalCode,
 		&i.FirstName,
 		&i.LastName,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
 	)
 	return i, err
 }
@@ -62,15 +68,18 @@ func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) error {
 }
 
 const findUserById = `-- name: FindUserById :one
-SELECT id, identity_number, personal_code, first_name, last_name FROM users WHERE id = $1
+SELECT id, identity_number, personal_code, first_name, last_name, status, status_reason, status_changed_at FROM users WHERE id = $1
 `
 
 type FindUserByIdRow struct {
-	ID             uuid.UUID
-	IdentityNumber string
-	PersonalCode   string
-	FirstName      string
-	LastName       string
+	ID              uuid.UUID
+	IdentityNumber  string
+	PersonalCode    string
+	FirstName       string
+	LastName        string
+	Status          UserStatus
+	StatusReason    pgtype.Text
+	StatusChangedAt pgtype.Timestamp
 }
 
 func (q *Queries) FindUserById(ctx context.Context, id uuid.UUID) (FindUserByIdRow, error) {
@@ -82,20 +91,26 @@ func (q *Queries) FindUserById(ctx context.Context, id uuid.UUID) (FindUserByIdR
 		&i.PersonalCode,
 		&i.FirstName,
 		&i.LastName,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
 	)
 	return i, err
 }
 
 const findUserByIdentityNumber = `-- name: FindUserByIdentityNumber :one
-SELECT id, identity_number, personal_code, first_name, last_name FROM users WHERE identity_number = $1
+SELECT id, identity_number, personal_code, first_name, last_name, status, status_reason, status_changed_at FROM users WHERE identity_number = $1
 `
 
 type FindUserByIdentityNumberRow struct {
-	ID             uuid.UUID
-	IdentityNumber string
-	PersonalCode   string
-	FirstName      string
-	LastName       string
+	ID              uuid.UUID
+	IdentityNumber  string
+	PersonalCode    string
+	FirstName       string
+	LastName        string
+	Status          UserStatus
+	StatusReason    pgtype.Text
+	StatusChangedAt pgtype.Timestamp
 }
 
 func (q *Queries) FindUserByIdentityNumber(ctx context.Context, identityNumber string) (FindUserByIdentityNumberRow, error) {
@@ -107,6 +122,9 @@ func (q *Queries) FindUserByIdentityNumber(ctx context.Context, identityNumber s
 		&i.PersonalCode,
 		&i.FirstName,
 		&i.LastName,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
 	)
 	return i, err
 }
@@ -118,6 +136,9 @@ SELECT
   u.personal_code,
   u.first_name,
   u.last_name,
+  u.status,
+  u.status_reason,
+  u.status_changed_at,
   COALESCE(ur.roles, ARRAY[]::uuid[]) AS role_ids,
   COALESCE(us.scopes, ARRAY[]::uuid[]) AS scope_ids
 FROM users u
@@ -126,6 +147,7 @@ FROM users u
       ur.user_id,
       ARRAY_AGG(ur.role_id) AS roles
     FROM user_roles ur
+    WHERE ur.expires_at IS NULL OR ur.expires_at > NOW()
     GROUP BY ur.user_id
   ) ur ON u.id = ur.user_id
   LEFT JOIN (
@@ -133,6 +155,7 @@ FROM users u
       us.user_id,
       ARRAY_AGG(us.scope_id) AS scopes
     FROM user_scopes us
+    WHERE us.expires_at IS NULL OR us.expires_at > NOW()
     GROUP BY us.user_id
   ) us ON u.id = us.user_id
 WHERE
@@ -140,13 +163,16 @@ WHERE
 `
 
 type FindUserDetailsByIdRow struct {
-	ID             uuid.UUID
-	IdentityNumber string
-	PersonalCode   string
-	FirstName      string
-	LastName       string
-	RoleIds        []uuid.UUID
-	ScopeIds       []uuid.UUID
+	ID              uuid.UUID
+	IdentityNumber  string
+	PersonalCode    string
+	FirstName       string
+	LastName        string
+	Status          UserStatus
+	StatusReason    pgtype.Text
+	StatusChangedAt pgtype.Timestamp
+	RoleIds         []uuid.UUID
+	ScopeIds        []uuid.UUID
 }
 
 func (q *Queries) FindUserDetailsById(ctx context.Context, id uuid.UUID) (FindUserDetailsByIdRow, error) {
@@ -158,12 +184,88 @@ func (q *Queries) FindUserDetailsById(ctx context.Context, id uuid.UUID) (FindUs
 		&i.PersonalCode,
 		&i.FirstName,
 		&i.LastName,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
 		&i.RoleIds,
 		&i.ScopeIds,
 	)
 	return i, err
 }
 
+const findUserRecords = `-- name: FindUserRecords :many
+SELECT
+  u.identity_number,
+  u.personal_code,
+  u.first_name,
+  u.last_name,
+  COALESCE(ur.names, ARRAY[]::text[])::text[] AS role_names,
+  COALESCE(us.names, ARRAY[]::text[])::text[] AS scope_names
+FROM users u
+  LEFT JOIN (
+    SELECT
+      ur.user_id,
+      ARRAY_AGG(r.name::text ORDER BY r.name) AS names
+    FROM user_roles ur
+      JOIN roles r ON r.id = ur.role_id
+    WHERE ur.expires_at IS NULL
+    GROUP BY ur.user_id
+  ) ur ON u.id = ur.user_id
+  LEFT JOIN (
+    SELECT
+      us.user_id,
+      ARRAY_AGG(s.name::text ORDER BY s.name) AS names
+    FROM user_scopes us
+      JOIN scopes s ON s.id = us.scope_id
+    WHERE us.expires_at IS NULL
+    GROUP BY us.user_id
+  ) us ON u.id = us.user_id
+WHERE u.identity_number > $1::text
+ORDER BY u.identity_number
+LIMIT $2::bigint
+`
+
+type FindUserRecordsParams struct {
+	AfterIdentityNumber string
+	PageLimit           uint64
+}
+
+type FindUserRecordsRow struct {
+	IdentityNumber string
+	PersonalCode   string
+	FirstName      string
+	LastName       string
+	RoleNames      []string
+	ScopeNames     []string
+}
+
+func (q *Queries) FindUserRecords(ctx context.Context, arg FindUserRecordsParams) ([]FindUserRecordsRow, error) {
+	rows, err := q.db.Query(ctx, findUserRecords, arg.AfterIdentityNumber, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindUserRecordsRow
+	for rows.Next() {
+		var i FindUserRecordsRow
+		if err := rows.Scan(
+			&i.IdentityNumber,
+			&i.PersonalCode,
+			&i.FirstName,
+			&i.LastName,
+			&i.RoleNames,
+			&i.ScopeNames,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findUsers = `-- name: FindUsers :many
 WITH counter AS (
   SELECT COUNT(*) AS total
@@ -175,6 +277,9 @@ SELECT
   u.personal_code,
   u.first_name,
   u.last_name,
+  u.status,
+  u.status_reason,
+  u.status_changed_at,
   counter.total
 FROM users AS u
 RIGHT JOIN counter ON TRUE
@@ -182,17 +287,20 @@ ORDER BY u.created_at DESC LIMIT $1::bigint OFFSET $2::bigint
 `
 
 type FindUsersParams struct {
-	Limit uint64
+	Limit  uint64
 	Offset uint64
 }
 
 type FindUsersRow struct {
-	ID             uuid.UUID
-	IdentityNumber pgtype.Text
-	PersonalCode   pgtype.Text
-	FirstName      pgtype.Text
-	LastName       pgtype.Text
-	Total          uint64
+	ID              uuid.UUID
+	IdentityNumber  pgtype.Text
+	PersonalCode    pgtype.Text
+	FirstName       pgtype.Text
+	LastName        pgtype.Text
+	Status          NullUserStatus
+	StatusReason    pgtype.Text
+	StatusChangedAt pgtype.Timestamp
+	Total           uint64
 }
 
 func (q *Queries) FindUsers(ctx context.Context, arg FindUsersParams) ([]FindUsersRow, error) {
@@ -210,6 +318,9 @@ func (q *Queries) FindUsers(ctx context.Context, arg FindUsersParams) ([]FindUse
 			&i.PersonalCode,
 			&i.FirstName,
 			&i.LastName,
+			&i.Status,
+			&i.StatusReason,
+			&i.StatusChangedAt,
 			&i.Total,
 		); err != nil {
 			return nil, err
@@ -222,29 +333,172 @@ func (q *Queries) FindUsers(ctx context.Context, arg FindUsersParams) ([]FindUse
 	return items, nil
 }
 
+const findUsersByIdentityNumbers = `-- name: FindUsersByIdentityNumbers :many
+SELECT id, identity_number, personal_code, first_name, last_name FROM users WHERE identity_number = ANY($1::text[])
+`
+
+type FindUsersByIdentityNumbersRow struct {
+	ID             uuid.UUID
+	IdentityNumber string
+	PersonalCode   string
+	FirstName      string
+	LastName       string
+}
+
+func (q *Queries) FindUsersByIdentityNumbers(ctx context.Context, identityNumbers []string) ([]FindUsersByIdentityNumbersRow, error) {
+	rows, err := q.db.Query(ctx, findUsersByIdentityNumbers, identityNumbers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindUsersByIdentityNumbersRow
+	for rows.Next() {
+		var i FindUsersByIdentityNumbersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.IdentityNumber,
+			&i.PersonalCode,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE users
 SET
-  identity_number = $2,
-  personal_code = $3,
-  first_name = $4,
-  last_name = $5,
+  identity_number = CASE WHEN $1::bool THEN $2::text ELSE identity_number END,
+  personal_code = CASE WHEN $3::bool THEN $4::text ELSE personal_code END,
+  first_name = CASE WHEN $5::bool THEN $6::text ELSE first_name END,
+  last_name = CASE WHEN $7::bool THEN $8::text ELSE last_name END,
+  updated_at = NOW()
+WHERE id = $9
+RETURNING id, identity_number, personal_code, first_name, last_name, status, status_reason, status_changed_at
+`
+
+type UpdateUserParams struct {
+	UpdateIdentityNumber bool
+	IdentityNumber       string
+	UpdatePersonalCode   bool
+	PersonalCode         string
+	UpdateFirstName      bool
+	FirstName            string
+	UpdateLastName       bool
+	LastName             string
+	ID                   uuid.UUID
+	UpdateRoleIDs        bool
+	RoleIDs              []uuid.UUID
+	UpdateScopeIDs       bool
+	ScopeIDs             []uuid.UUID
+}
+
+type UpdateUserRow struct {
+	ID              uuid.UUID
+	IdentityNumber  string
+	PersonalCode    string
+	FirstName       string
+	LastName        string
+	Status          UserStatus
+	StatusReason    pgtype.Text
+	StatusChangedAt pgtype.Timestamp
+}
+
+func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (UpdateUserRow, error) {
+	row := q.db.QueryRow(ctx, updateUser,
+		arg.UpdateIdentityNumber,
+		arg.IdentityNumber,
+		arg.UpdatePersonalCode,
+		arg.PersonalCode,
+		arg.UpdateFirstName,
+		arg.FirstName,
+		arg.UpdateLastName,
+		arg.LastName,
+		arg.ID,
+	)
+	var i UpdateUserRow
+	err := row.Scan(
+		&i.ID,
+		&i.IdentityNumber,
+		&i.PersonalCode,
+		&i.FirstName,
+		&i.LastName,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
+	)
+	return i, err
+}
+
+const updateUserStatus = `-- name: UpdateUserStatus :one
+UPDATE users
+SET
+  status = $2,
+  status_reason = $3,
+  status_changed_at = NOW(),
   updated_at = NOW()
 WHERE id = $1
+RETURNING id, identity_number, personal_code, first_name, last_name, status, status_reason, status_changed_at
+`
+
+type UpdateUserStatusParams struct {
+	ID           uuid.UUID
+	Status       UserStatus
+	StatusReason pgtype.Text
+}
+
+type UpdateUserStatusRow struct {
+	ID              uuid.UUID
+	IdentityNumber  string
+	PersonalCode    string
+	FirstName       string
+	LastName        string
+	Status          UserStatus
+	StatusReason    pgtype.Text
+	StatusChangedAt pgtype.Timestamp
+}
+
+func (q *Queries) UpdateUserStatus(ctx context.Context, arg UpdateUserStatusParams) (UpdateUserStatusRow, error) {
+	row := q.db.QueryRow(ctx, updateUserStatus, arg.ID, arg.Status, arg.StatusReason)
+	var i UpdateUserStatusRow
+	err := row.Scan(
+		&i.ID,
+		&i.IdentityNumber,
+		&i.PersonalCode,
+		&i.FirstName,
+		&i.LastName,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
+	)
+	return i, err
+}
+
+const upsertUser = `-- name: UpsertUser :one
+INSERT INTO users (identity_number, personal_code, first_name, last_name)
+VALUES ($1, $2, $3, $4)
+  ON CONFLICT (identity_number) DO UPDATE SET
+    personal_code = EXCLUDED.personal_code,
+    first_name = EXCLUDED.first_name,
+    last_name = EXCLUDED.last_name,
+    updated_at = NOW()
 RETURNING id, identity_number, personal_code, first_name, last_name
 `
 
-type UpdateUserParams struct {
-	ID             uuid.UUID
+type UpsertUserParams struct {
 	IdentityNumber string
 	PersonalCode   string
 	FirstName      string
 	LastName       string
-	RoleIDs				[]uuid.UUID
-	ScopeIDs			[]uuid.UUID
 }
 
-type UpdateUserRow struct {
+type UpsertUserRow struct {
 	ID             uuid.UUID
 	IdentityNumber string
 	PersonalCode   string
@@ -252,15 +506,14 @@ type UpdateUserRow struct {
 	LastName       string
 }
 
-func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (UpdateUserRow, error) {
-	row := q.db.QueryRow(ctx, updateUser,
-		arg.ID,
+func (q *Queries) UpsertUser(ctx context.Context, arg UpsertUserParams) (UpsertUserRow, error) {
+	row := q.db.QueryRow(ctx, upsertUser,
 		arg.IdentityNumber,
 		arg.PersonalCode,
 		arg.FirstName,
 		arg.LastName,
 	)
-	var i UpdateUserRow
+	var i UpsertUserRow
 	err := row.Scan(
 		&i.ID,
 		&i.IdentityNumber,
@@ -0,0 +1,179 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+)
+
+type ElevationRepository interface {
+	List(ctx context.Context, limit, offset uint64) ([]models.Elevation, uint64, error)
+	Create(ctx context.Context, params db.CreateElevationParams) (*models.Elevation, error)
+	FindById(ctx context.Context, id uuid.UUID) (*models.Elevation, error)
+	Approve(ctx context.Context, id, approverId uuid.UUID) (*models.Elevation, error)
+	Reject(ctx context.Context, id, approverId uuid.UUID) (*models.Elevation, error)
+
+	DeleteExpiredGrants(ctx context.Context) ([]models.ExpiredGrant, error)
+}
+
+type elevation struct {
+	client postgres.Postgres
+}
+
+func NewElevationRepository(client postgres.Postgres) ElevationRepository {
+	return &elevation{client: client}
+}
+
+func (e *elevation) List(ctx context.Context, limit, offset uint64) ([]models.Elevation, uint64, error) {
+	rows, err := e.client.Queries().FindElevations(ctx, db.FindElevationsParams{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	elevations := make([]models.Elevation, 0, len(rows))
+	var total uint64
+
+	if len(rows) > 0 {
+		total = rows[0].Total
+	}
+
+	for _, row := range rows {
+		elevations = append(elevations, models.Elevation{
+			ID:        row.ID,
+			UserID:    row.UserID,
+			RoleID:    row.RoleID,
+			Reason:    row.Reason.String,
+			Duration:  time.Duration(row.DurationMinutes.Int32) * time.Minute,
+			Status:    string(row.Status.ElevationStatus),
+			DecidedBy: row.DecidedBy,
+		})
+	}
+
+	return elevations, total, err
+}
+
+func (e *elevation) Create(ctx context.Context, params db.CreateElevationParams) (*models.Elevation, error) {
+	result, err := e.client.Queries().CreateElevation(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return toElevation(db.FindElevationByIdRow(result)), nil
+}
+
+func (e *elevation) FindById(ctx context.Context, id uuid.UUID) (*models.Elevation, error) {
+	result, err := e.client.Queries().FindElevationById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toElevation(result), nil
+}
+
+// Approve marks the pending elevation as approved and grants the role until the requested duration elapses,
+// the expiry is computed by the database clock the grants worker compares it with
+func (e *elevation) Approve(ctx context.Context, id, approverId uuid.UUID) (*models.Elevation, error) {
+	tx, err := e.client.Db().Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := e.client.Queries().WithTx(tx)
+
+	result, err := q.DecideElevation(ctx, db.DecideElevationParams{
+		ID:        id,
+		Status:    db.ElevationStatusApproved,
+		DecidedBy: approverId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = q.GrantUserRole(ctx, db.GrantUserRoleParams{
+		UserID:          result.UserID,
+		RoleID:          result.RoleID,
+		DurationMinutes: result.DurationMinutes,
+		GrantedBy:       approverId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toElevation(db.FindElevationByIdRow(result)), tx.Commit(ctx)
+}
+
+func (e *elevation) Reject(ctx context.Context, id, approverId uuid.UUID) (*models.Elevation, error) {
+	result, err := e.client.Queries().DecideElevation(ctx, db.DecideElevationParams{
+		ID:        id,
+		Status:    db.ElevationStatusRejected,
+		DecidedBy: approverId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toElevation(db.FindElevationByIdRow(result)), nil
+}
+
+// DeleteExpiredGrants removes role and scope assignments past their expiry and returns them
+func (e *elevation) DeleteExpiredGrants(ctx context.Context) ([]models.ExpiredGrant, error) {
+	tx, err := e.client.Db().Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := e.client.Queries().WithTx(tx)
+
+	roles, err := q.DeleteExpiredUserRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := q.DeleteExpiredUserScopes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make([]models.ExpiredGrant, 0, len(roles)+len(scopes))
+	for _, row := range roles {
+		grants = append(grants, models.ExpiredGrant{
+			UserID:    row.UserID,
+			RoleID:    row.RoleID,
+			GrantedBy: row.GrantedBy,
+			ExpiresAt: row.ExpiresAt.Time,
+		})
+	}
+
+	for _, row := range scopes {
+		grants = append(grants, models.ExpiredGrant{
+			UserID:    row.UserID,
+			ScopeID:   row.ScopeID,
+			GrantedBy: row.GrantedBy,
+			ExpiresAt: row.ExpiresAt.Time,
+		})
+	}
+
+	return grants, tx.Commit(ctx)
+}
+
+func toElevation(row db.FindElevationByIdRow) *models.Elevation {
+	return &models.Elevation{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		RoleID:    row.RoleID,
+		Reason:    row.Reason,
+		Duration:  time.Duration(row.DurationMinutes) * time.Minute,
+		Status:    string(row.Status),
+		DecidedBy: row.DecidedBy,
+	}
+}
@@ -0,0 +1,135 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/repositories/elevation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/repositories/elevation.go -destination=internal/app/repositories/elevation_mock.go -package=repositories
+//
+
+// Package repositories is a generated GoMock package.
+package repositories
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	db "loki/internal/app/repositories/db"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockElevationRepository is a mock of ElevationRepository interface.
+type MockElevationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockElevationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockElevationRepositoryMockRecorder is the mock recorder for MockElevationRepository.
+type MockElevationRepositoryMockRecorder struct {
+	mock *MockElevationRepository
+}
+
+// NewMockElevationRepository creates a new mock instance.
+func NewMockElevationRepository(ctrl *gomock.Controller) *MockElevationRepository {
+	mock := &MockElevationRepository{ctrl: ctrl}
+	mock.recorder = &MockElevationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockElevationRepository) EXPECT() *MockElevationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockElevationRepository) Approve(ctx context.Context, id, approverId uuid.UUID) (*models.Elevation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, id, approverId)
+	ret0, _ := ret[0].(*models.Elevation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockElevationRepositoryMockRecorder) Approve(ctx, id, approverId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockElevationRepository)(nil).Approve), ctx, id, approverId)
+}
+
+// Create mocks base method.
+func (m *MockElevationRepository) Create(ctx context.Context, params db.CreateElevationParams) (*models.Elevation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, params)
+	ret0, _ := ret[0].(*models.Elevation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockElevationRepositoryMockRecorder) Create(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockElevationRepository)(nil).Create), ctx, params)
+}
+
+// DeleteExpiredGrants mocks base method.
+func (m *MockElevationRepository) DeleteExpiredGrants(ctx context.Context) ([]models.ExpiredGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredGrants", ctx)
+	ret0, _ := ret[0].([]models.ExpiredGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredGrants indicates an expected call of DeleteExpiredGrants.
+func (mr *MockElevationRepositoryMockRecorder) DeleteExpiredGrants(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredGrants", reflect.TypeOf((*MockElevationRepository)(nil).DeleteExpiredGrants), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockElevationRepository) FindById(ctx context.Context, id uuid.UUID) (*models.Elevation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*models.Elevation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockElevationRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockElevationRepository)(nil).FindById), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockElevationRepository) List(ctx context.Context, limit, offset uint64) ([]models.Elevation, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret0, _ := ret[0].([]models.Elevation)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockElevationRepositoryMockRecorder) List(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockElevationRepository)(nil).List), ctx, limit, offset)
+}
+
+// Reject mocks base method.
+func (m *MockElevationRepository) Reject(ctx context.Context, id, approverId uuid.UUID) (*models.Elevation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, id, approverId)
+	ret0, _ := ret[0].(*models.Elevation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockElevationRepositoryMockRecorder) Reject(ctx, id, approverId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockElevationRepository)(nil).Reject), ctx, id, approverId)
+}
@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+	"loki/internal/config"
+)
+
+func Test_ElevationRepository(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	elevationRepository := NewElevationRepository(client)
+	roleRepository := NewRoleRepository(client)
+	userRepository := NewUserRepository(client)
+
+	adminRoleId := uuid.MustParse("10000000-1000-1000-1000-000000000001")
+
+	requester, err := userRepository.Create(ctx, db.CreateUserParams{
+		IdentityNumber: "PNOEE-60001017869",
+		PersonalCode:   "60001017869",
+		FirstName:      "EID2016",
+		LastName:       "TESTNUMBER",
+	})
+	assert.NoError(t, err)
+	defer userRepository.Delete(ctx, requester.ID)
+
+	approver, err := userRepository.Create(ctx, db.CreateUserParams{
+		IdentityNumber: "PNOEE-50001029996",
+		PersonalCode:   "50001029996",
+		FirstName:      "TESTNUMBER",
+		LastName:       "OK",
+	})
+	assert.NoError(t, err)
+	defer userRepository.Delete(ctx, approver.ID)
+
+	t.Run("Approve grants the role temporarily", func(t *testing.T) {
+		created, err := elevationRepository.Create(ctx, db.CreateElevationParams{
+			UserID:          requester.ID,
+			RoleID:          adminRoleId,
+			Reason:          "Incident response",
+			DurationMinutes: 60,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, models.ElevationPending, created.Status)
+		assert.Equal(t, time.Hour, created.Duration)
+
+		result, err := elevationRepository.Approve(ctx, created.ID, approver.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, models.ElevationApproved, result.Status)
+		assert.Equal(t, approver.ID, result.DecidedBy)
+
+		roles, err := roleRepository.FindByUserId(ctx, requester.ID)
+		assert.NoError(t, err)
+		assert.Len(t, roles, 1)
+		assert.Equal(t, adminRoleId, roles[0].ID)
+
+		_, err = elevationRepository.Reject(ctx, created.ID, approver.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("Reject does not grant the role", func(t *testing.T) {
+		created, err := elevationRepository.Create(ctx, db.CreateElevationParams{
+			UserID:          approver.ID,
+			RoleID:          adminRoleId,
+			Reason:          "Maintenance",
+			DurationMinutes: 30,
+		})
+		assert.NoError(t, err)
+
+		result, err := elevationRepository.Reject(ctx, created.ID, requester.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, models.ElevationRejected, result.Status)
+
+		roles, err := roleRepository.FindByUserId(ctx, approver.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, roles)
+	})
+
+	t.Run("Expired grants are ignored and deleted", func(t *testing.T) {
+		_, err := client.Queries().GrantUserRole(ctx, db.GrantUserRoleParams{
+			UserID:          approver.ID,
+			RoleID:          adminRoleId,
+			DurationMinutes: -1,
+			GrantedBy:       requester.ID,
+		})
+		assert.NoError(t, err)
+
+		roles, err := roleRepository.FindByUserId(ctx, approver.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, roles)
+
+		grants, err := elevationRepository.DeleteExpiredGrants(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, grants, 1)
+		assert.Equal(t, approver.ID, grants[0].UserID)
+		assert.Equal(t, adminRoleId, grants[0].RoleID)
+		assert.Equal(t, requester.ID, grants[0].GrantedBy)
+	})
+
+	t.Run("Find missing elevation", func(t *testing.T) {
+		_, err := elevationRepository.FindById(ctx, uuid.New())
+		assert.Error(t, err)
+	})
+}
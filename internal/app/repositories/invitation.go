@@ -0,0 +1,212 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+)
+
+type InvitationRepository interface {
+	List(ctx context.Context, limit, offset uint64) ([]models.Invitation, uint64, error)
+	Create(ctx context.Context, params db.CreateInvitationParams) (*models.Invitation, error)
+	FindById(ctx context.Context, id uuid.UUID) (*models.Invitation, error)
+	Extend(ctx context.Context, id uuid.UUID, expiresAt time.Time) (*models.Invitation, error)
+	Revoke(ctx context.Context, id uuid.UUID) (*models.Invitation, error)
+
+	Accept(ctx context.Context, params *models.User) (*models.User, error)
+}
+
+type invitation struct {
+	client postgres.Postgres
+}
+
+func NewInvitationRepository(client postgres.Postgres) InvitationRepository {
+	return &invitation{client: client}
+}
+
+func (i *invitation) List(ctx context.Context, limit, offset uint64) ([]models.Invitation, uint64, error) {
+	rows, err := i.client.Queries().FindInvitations(ctx, db.FindInvitationsParams{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	invitations := make([]models.Invitation, 0, len(rows))
+	var total uint64
+
+	if len(rows) > 0 {
+		total = rows[0].Total
+	}
+
+	for _, row := range rows {
+		invitations = append(invitations, models.Invitation{
+			ID:             row.ID,
+			IdentityNumber: row.IdentityNumber.String,
+			PersonalCode:   row.PersonalCode.String,
+			RoleIDs:        row.RoleIds,
+			ScopeIDs:       row.ScopeIds,
+			Status:         string(row.Status.InvitationStatus),
+			InvitedBy:      row.InvitedBy,
+			UserID:         row.UserID,
+			ExpiresAt:      row.ExpiresAt.Time,
+			SentAt:         row.SentAt.Time,
+			AcceptedAt:     row.AcceptedAt.Time,
+		})
+	}
+
+	return invitations, total, err
+}
+
+// Create stores the invitation, pgx.ErrNoRows is returned when the person already has a pending invitation.
+// Pending invitations of the person that are past their expiry are marked as expired first.
+func (i *invitation) Create(ctx context.Context, params db.CreateInvitationParams) (*models.Invitation, error) {
+	tx, err := i.client.Db().Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := i.client.Queries().WithTx(tx)
+
+	if err := q.ExpireInvitations(ctx, params.IdentityNumber); err != nil {
+		return nil, err
+	}
+
+	result, err := q.CreateInvitation(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return toInvitation(db.FindInvitationByIdRow(result)), tx.Commit(ctx)
+}
+
+func (i *invitation) FindById(ctx context.Context, id uuid.UUID) (*models.Invitation, error) {
+	result, err := i.client.Queries().FindInvitationById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toInvitation(result), nil
+}
+
+// Extend moves the expiry of the pending invitation
+func (i *invitation) Extend(ctx context.Context, id uuid.UUID, expiresAt time.Time) (*models.Invitation, error) {
+	result, err := i.client.Queries().ExtendInvitation(ctx, db.ExtendInvitationParams{
+		ID:        id,
+		ExpiresAt: pgtype.Timestamp{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toInvitation(db.FindInvitationByIdRow(result)), nil
+}
+
+func (i *invitation) Revoke(ctx context.Context, id uuid.UUID) (*models.Invitation, error) {
+	result, err := i.client.Queries().RevokeInvitation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toInvitation(db.FindInvitationByIdRow(result)), nil
+}
+
+// Accept registers the invited person and consumes their pending invitation within a single transaction,
+// the given roles and scopes are granted together with the invited ones. pgx.ErrNoRows is returned and
+// nothing is written when the person has no valid invitation.
+func (i *invitation) Accept(ctx context.Context, params *models.User) (*models.User, error) {
+	tx, err := i.client.Db().Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := i.client.Queries().WithTx(tx)
+
+	result, err := q.CreateUser(ctx, db.CreateUserParams{
+		IdentityNumber: params.IdentityNumber,
+		PersonalCode:   params.PersonalCode,
+		FirstName:      params.FirstName,
+		LastName:       params.LastName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accepted, err := q.AcceptInvitation(ctx, db.AcceptInvitationParams{
+		UserID:         result.ID,
+		IdentityNumber: params.IdentityNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	grants := []struct {
+		roleIds   []uuid.UUID
+		scopeIds  []uuid.UUID
+		grantedBy uuid.UUID
+	}{
+		{roleIds: params.RoleIDs, scopeIds: params.ScopeIDs},
+		{roleIds: accepted.RoleIds, scopeIds: accepted.ScopeIds, grantedBy: accepted.InvitedBy},
+	}
+
+	for _, grant := range grants {
+		if len(grant.roleIds) > 0 {
+			err = q.AddUserRoles(ctx, db.AddUserRolesParams{
+				UserID:    result.ID,
+				GrantedBy: grant.grantedBy,
+				RoleIds:   grant.roleIds,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if len(grant.scopeIds) > 0 {
+			err = q.AddUserScopes(ctx, db.AddUserScopesParams{
+				UserID:    result.ID,
+				GrantedBy: grant.grantedBy,
+				ScopeIds:  grant.scopeIds,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &models.User{
+		ID:             result.ID,
+		IdentityNumber: result.IdentityNumber,
+		PersonalCode:   result.PersonalCode,
+		FirstName:      result.FirstName,
+		LastName:       result.LastName,
+
+		Status:          string(result.Status),
+		StatusReason:    result.StatusReason.String,
+		StatusChangedAt: result.StatusChangedAt.Time,
+	}, tx.Commit(ctx)
+}
+
+func toInvitation(row db.FindInvitationByIdRow) *models.Invitation {
+	return &models.Invitation{
+		ID:             row.ID,
+		IdentityNumber: row.IdentityNumber,
+		PersonalCode:   row.PersonalCode,
+		RoleIDs:        row.RoleIds,
+		ScopeIDs:       row.ScopeIds,
+		Status:         string(row.Status),
+		InvitedBy:      row.InvitedBy,
+		UserID:         row.UserID,
+		ExpiresAt:      row.ExpiresAt.Time,
+		SentAt:         row.SentAt.Time,
+		AcceptedAt:     row.AcceptedAt.Time,
+	}
+}
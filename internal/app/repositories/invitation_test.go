@@ -0,0 +1,184 @@
+package repositories
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+	"loki/internal/config"
+)
+
+func Test_InvitationRepository(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	invitationRepository := NewInvitationRepository(client)
+	roleRepository := NewRoleRepository(client)
+	scopeRepository := NewScopeRepository(client)
+	userRepository := NewUserRepository(client)
+
+	managerRoleId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+	userRoleId := uuid.MustParse("10000000-1000-1000-1000-000000000003")
+	selfServiceId := uuid.MustParse("10000000-1000-1000-2000-000000000002")
+
+	admin, err := userRepository.Create(ctx, db.CreateUserParams{
+		IdentityNumber: "PNOEE-50001029996",
+		PersonalCode:   "50001029996",
+		FirstName:      "TESTNUMBER",
+		LastName:       "OK",
+	})
+	assert.NoError(t, err)
+
+	expiresAt := pgtype.Timestamp{Time: time.Now().Add(time.Hour), Valid: true}
+
+	t.Run("Accept registers the person with the invited roles", func(t *testing.T) {
+		created, err := invitationRepository.Create(ctx, db.CreateInvitationParams{
+			IdentityNumber: "PNOEE-39001010110",
+			PersonalCode:   "39001010110",
+			RoleIds:        []uuid.UUID{managerRoleId},
+			ScopeIds:       []uuid.UUID{},
+			InvitedBy:      admin.ID,
+			ExpiresAt:      expiresAt,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, models.InvitationPending, created.Status)
+
+		_, err = invitationRepository.Create(ctx, db.CreateInvitationParams{
+			IdentityNumber: "PNOEE-39001010110",
+			PersonalCode:   "39001010110",
+			RoleIds:        []uuid.UUID{},
+			ScopeIds:       []uuid.UUID{},
+			InvitedBy:      admin.ID,
+			ExpiresAt:      expiresAt,
+		})
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		user, err := invitationRepository.Accept(ctx, &models.User{
+			IdentityNumber: "PNOEE-39001010110",
+			PersonalCode:   "39001010110",
+			FirstName:      "JOHN",
+			LastName:       "DOE",
+			RoleIDs:        []uuid.UUID{userRoleId},
+			ScopeIDs:       []uuid.UUID{selfServiceId},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, models.UserActive, user.Status)
+
+		roles, err := roleRepository.FindByUserId(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Len(t, roles, 2)
+
+		scopes, err := scopeRepository.FindByUserId(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Len(t, scopes, 1)
+
+		accepted, err := invitationRepository.FindById(ctx, created.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, models.InvitationAccepted, accepted.Status)
+		assert.Equal(t, user.ID, accepted.UserID)
+		assert.False(t, accepted.AcceptedAt.IsZero())
+
+		_, err = invitationRepository.Revoke(ctx, created.ID)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("Accept without invitation writes nothing", func(t *testing.T) {
+		_, err := invitationRepository.Accept(ctx, &models.User{
+			IdentityNumber: "PNOEE-60001017869",
+			PersonalCode:   "60001017869",
+			FirstName:      "JANE",
+			LastName:       "DOE",
+		})
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		_, err = userRepository.FindByIdentityNumber(ctx, "PNOEE-60001017869")
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("Expired invitation is not accepted until extended", func(t *testing.T) {
+		created, err := invitationRepository.Create(ctx, db.CreateInvitationParams{
+			IdentityNumber: "PNOEE-60001017869",
+			PersonalCode:   "60001017869",
+			RoleIds:        []uuid.UUID{},
+			ScopeIds:       []uuid.UUID{},
+			InvitedBy:      admin.ID,
+			ExpiresAt:      pgtype.Timestamp{Time: time.Now().Add(-time.Minute), Valid: true},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, models.InvitationExpired, created.State())
+
+		person := &models.User{
+			IdentityNumber: "PNOEE-60001017869",
+			PersonalCode:   "60001017869",
+			FirstName:      "JANE",
+			LastName:       "DOE",
+		}
+
+		_, err = invitationRepository.Accept(ctx, person)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		extended, err := invitationRepository.Extend(ctx, created.ID, time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, models.InvitationPending, extended.State())
+
+		revoked, err := invitationRepository.Revoke(ctx, created.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, models.InvitationRevoked, revoked.Status)
+
+		_, err = invitationRepository.Accept(ctx, person)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("Expired invitation does not block a new one", func(t *testing.T) {
+		expired, err := invitationRepository.Create(ctx, db.CreateInvitationParams{
+			IdentityNumber: "PNOEE-50001029996",
+			PersonalCode:   "50001029996",
+			RoleIds:        []uuid.UUID{},
+			ScopeIds:       []uuid.UUID{},
+			InvitedBy:      admin.ID,
+			ExpiresAt:      pgtype.Timestamp{Time: time.Now().Add(-time.Minute), Valid: true},
+		})
+		assert.NoError(t, err)
+
+		created, err := invitationRepository.Create(ctx, db.CreateInvitationParams{
+			IdentityNumber: "PNOEE-50001029996",
+			PersonalCode:   "50001029996",
+			RoleIds:        []uuid.UUID{},
+			ScopeIds:       []uuid.UUID{},
+			InvitedBy:      admin.ID,
+			ExpiresAt:      expiresAt,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, models.InvitationPending, created.State())
+
+		result, err := invitationRepository.FindById(ctx, expired.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, models.InvitationExpired, result.Status)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		invitations, total, err := invitationRepository.List(ctx, 10, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(4), total)
+		assert.Len(t, invitations, 4)
+	})
+
+	t.Run("Find missing invitation", func(t *testing.T) {
+		_, err := invitationRepository.FindById(ctx, uuid.New())
+		assert.Error(t, err)
+	})
+}
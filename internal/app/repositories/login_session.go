@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+)
+
+type LoginSessionRepository interface {
+	Create(ctx context.Context, params db.CreateLoginSessionParams) (*models.LoginSession, error)
+	FindActive(ctx context.Context, id, userId uuid.UUID) (*models.LoginSession, error)
+	List(ctx context.Context, userId uuid.UUID, limit uint64) ([]models.LoginSession, error)
+	ListActive(ctx context.Context, userId uuid.UUID, since time.Time) ([]models.LoginSession, error)
+	Refresh(ctx context.Context, id, userId uuid.UUID) (*models.LoginSession, error)
+	Revoke(ctx context.Context, id, userId uuid.UUID) error
+}
+
+type loginSession struct {
+	client postgres.Postgres
+}
+
+func NewLoginSessionRepository(client postgres.Postgres) LoginSessionRepository {
+	return &loginSession{client: client}
+}
+
+func (l *loginSession) Create(ctx context.Context, params db.CreateLoginSessionParams) (*models.LoginSession, error) {
+	row, err := l.client.Queries().CreateLoginSession(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return toLoginSession(row), nil
+}
+
+// FindActive returns the session of the user unless it was revoked, otherwise pgx.ErrNoRows
+func (l *loginSession) FindActive(ctx context.Context, id, userId uuid.UUID) (*models.LoginSession, error) {
+	row, err := l.client.Queries().FindActiveLoginSession(ctx, db.FindActiveLoginSessionParams{
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toLoginSession(row), nil
+}
+
+func (l *loginSession) List(ctx context.Context, userId uuid.UUID, limit uint64) ([]models.LoginSession, error) {
+	rows, err := l.client.Queries().ListLoginSessions(ctx, db.ListLoginSessionsParams{
+		UserID:    userId,
+		PageLimit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toLoginSessions(rows), nil
+}
+
+// ListActive returns the sessions that are not revoked and have been refreshed after since
+func (l *loginSession) ListActive(ctx context.Context, userId uuid.UUID, since time.Time) ([]models.LoginSession, error) {
+	rows, err := l.client.Queries().ListActiveLoginSessions(ctx, db.ListActiveLoginSessionsParams{
+		UserID: userId,
+		Since:  pgtype.Timestamp{Time: since, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toLoginSessions(rows), nil
+}
+
+// Refresh records the use of a refresh token, a revoked session yields pgx.ErrNoRows
+func (l *loginSession) Refresh(ctx context.Context, id, userId uuid.UUID) (*models.LoginSession, error) {
+	row, err := l.client.Queries().RefreshLoginSession(ctx, db.RefreshLoginSessionParams{
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toLoginSession(row), nil
+}
+
+// Revoke ends the session and removes the tokens issued for it in one transaction
+func (l *loginSession) Revoke(ctx context.Context, id, userId uuid.UUID) error {
+	tx, err := l.client.Db().Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	q := l.client.Queries().WithTx(tx)
+
+	_, err = q.RevokeLoginSession(ctx, db.RevokeLoginSessionParams{
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = q.DeleteTokensByLoginSessionId(ctx, id); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func toLoginSessions(rows []db.LoginSession) []models.LoginSession {
+	result := make([]models.LoginSession, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, *toLoginSession(row))
+	}
+
+	return result
+}
+
+func toLoginSession(row db.LoginSession) *models.LoginSession {
+	return &models.LoginSession{
+		ID:              row.ID,
+		UserID:          row.UserID,
+		Provider:        row.Provider,
+		IPAddress:       row.IpAddress.String,
+		UserAgent:       row.UserAgent.String,
+		CreatedAt:       row.CreatedAt.Time,
+		LastRefreshedAt: row.LastRefreshedAt.Time,
+		RevokedAt:       row.RevokedAt.Time,
+	}
+}
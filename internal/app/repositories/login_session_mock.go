@@ -0,0 +1,134 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/repositories/login_session.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/repositories/login_session.go -destination=internal/app/repositories/login_session_mock.go -package=repositories
+//
+
+// Package repositories is a generated GoMock package.
+package repositories
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	db "loki/internal/app/repositories/db"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginSessionRepository is a mock of LoginSessionRepository interface.
+type MockLoginSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginSessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginSessionRepositoryMockRecorder is the mock recorder for MockLoginSessionRepository.
+type MockLoginSessionRepositoryMockRecorder struct {
+	mock *MockLoginSessionRepository
+}
+
+// NewMockLoginSessionRepository creates a new mock instance.
+func NewMockLoginSessionRepository(ctrl *gomock.Controller) *MockLoginSessionRepository {
+	mock := &MockLoginSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginSessionRepository) EXPECT() *MockLoginSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLoginSessionRepository) Create(ctx context.Context, params db.CreateLoginSessionParams) (*models.LoginSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, params)
+	ret0, _ := ret[0].(*models.LoginSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLoginSessionRepositoryMockRecorder) Create(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLoginSessionRepository)(nil).Create), ctx, params)
+}
+
+// FindActive mocks base method.
+func (m *MockLoginSessionRepository) FindActive(ctx context.Context, id, userId uuid.UUID) (*models.LoginSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", ctx, id, userId)
+	ret0, _ := ret[0].(*models.LoginSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockLoginSessionRepositoryMockRecorder) FindActive(ctx, id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockLoginSessionRepository)(nil).FindActive), ctx, id, userId)
+}
+
+// List mocks base method.
+func (m *MockLoginSessionRepository) List(ctx context.Context, userId uuid.UUID, limit uint64) ([]models.LoginSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userId, limit)
+	ret0, _ := ret[0].([]models.LoginSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLoginSessionRepositoryMockRecorder) List(ctx, userId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoginSessionRepository)(nil).List), ctx, userId, limit)
+}
+
+// ListActive mocks base method.
+func (m *MockLoginSessionRepository) ListActive(ctx context.Context, userId uuid.UUID, since time.Time) ([]models.LoginSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActive", ctx, userId, since)
+	ret0, _ := ret[0].([]models.LoginSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActive indicates an expected call of ListActive.
+func (mr *MockLoginSessionRepositoryMockRecorder) ListActive(ctx, userId, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActive", reflect.TypeOf((*MockLoginSessionRepository)(nil).ListActive), ctx, userId, since)
+}
+
+// Refresh mocks base method.
+func (m *MockLoginSessionRepository) Refresh(ctx context.Context, id, userId uuid.UUID) (*models.LoginSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, id, userId)
+	ret0, _ := ret[0].(*models.LoginSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockLoginSessionRepositoryMockRecorder) Refresh(ctx, id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockLoginSessionRepository)(nil).Refresh), ctx, id, userId)
+}
+
+// Revoke mocks base method.
+func (m *MockLoginSessionRepository) Revoke(ctx context.Context, id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockLoginSessionRepositoryMockRecorder) Revoke(ctx, id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockLoginSessionRepository)(nil).Revoke), ctx, id, userId)
+}
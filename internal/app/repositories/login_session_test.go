@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+	"loki/internal/config"
+)
+
+func Test_LoginSessionRepository(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	loginSessionRepository := NewLoginSessionRepository(client)
+	tokenRepository := NewTokenRepository(client)
+	userRepository := NewUserRepository(client)
+
+	user, err := userRepository.Create(ctx, db.CreateUserParams{
+		IdentityNumber: "PNOEE-39001010110",
+		PersonalCode:   "39001010110",
+		FirstName:      "JOHN",
+		LastName:       "DOE",
+	})
+	assert.NoError(t, err)
+
+	session, err := loginSessionRepository.Create(ctx, db.CreateLoginSessionParams{
+		UserID:    user.ID,
+		Provider:  models.AuthMethodSmartId,
+		IpAddress: pgtype.Text{String: "127.0.0.1", Valid: true},
+		UserAgent: pgtype.Text{String: "Mozilla/5.0", Valid: true},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.AuthMethodSmartId, session.Provider)
+	assert.Equal(t, "127.0.0.1", session.IPAddress)
+	assert.Equal(t, models.LoginSessionActive, session.Status())
+
+	t.Run("List", func(t *testing.T) {
+		result, err := loginSessionRepository.List(ctx, user.ID, 10)
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, session.ID, result[0].ID)
+
+		active, err := loginSessionRepository.ListActive(ctx, user.ID, time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.Len(t, active, 1)
+	})
+
+	t.Run("Find active", func(t *testing.T) {
+		result, err := loginSessionRepository.FindActive(ctx, session.ID, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, session.ID, result.ID)
+
+		_, err = loginSessionRepository.FindActive(ctx, session.ID, uuid.New())
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("Refresh", func(t *testing.T) {
+		result, err := loginSessionRepository.Refresh(ctx, session.ID, user.ID)
+		assert.NoError(t, err)
+		assert.False(t, result.LastRefreshedAt.Before(session.LastRefreshedAt))
+	})
+
+	t.Run("Revoke", func(t *testing.T) {
+		_, err := tokenRepository.Create(ctx, db.CreateTokensParams{
+			UserID:            user.ID,
+			AccessTokenValue:  "aaa.bbb.sss",
+			LoginSessionID:    session.ID,
+			RefreshTokenValue: "sss.sss.sss",
+		})
+		assert.NoError(t, err)
+
+		err = loginSessionRepository.Revoke(ctx, session.ID, user.ID)
+		assert.NoError(t, err)
+
+		_, err = loginSessionRepository.Refresh(ctx, session.ID, user.ID)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		_, err = loginSessionRepository.FindActive(ctx, session.ID, user.ID)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		active, err := loginSessionRepository.ListActive(ctx, user.ID, time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.Empty(t, active)
+	})
+}
@@ -11,9 +11,19 @@ var Module = fx.Options(
 	fx.Provide(postgres.NewPostgresClient),
 	fx.Provide(redis.NewRedisClient),
 
+	fx.Provide(NewAuditRepository),
+	fx.Provide(NewAuthenticatorRepository),
+	fx.Provide(NewConfirmationRepository),
 	fx.Provide(NewHealthRepository),
+	fx.Provide(NewInvitationRepository),
+	fx.Provide(NewLoginSessionRepository),
 	fx.Provide(NewSessionRepository),
+	fx.Provide(NewElevationRepository),
+	fx.Provide(NewOrganisationRepository),
 	fx.Provide(NewPermissionRepository),
+	fx.Provide(NewPolicyRepository),
+	fx.Provide(NewProfileRepository),
+	fx.Provide(NewRateLimitRepository),
 	fx.Provide(NewRoleRepository),
 	fx.Provide(NewScopeRepository),
 	fx.Provide(NewTokenRepository),
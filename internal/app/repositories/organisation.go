@@ -0,0 +1,193 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+)
+
+type OrganisationRepository interface {
+	List(ctx context.Context, limit, offset uint64) ([]models.Organisation, uint64, error)
+	Create(ctx context.Context, params db.CreateOrganisationParams) (*models.Organisation, error)
+	Update(ctx context.Context, params db.UpdateOrganisationParams) (*models.Organisation, error)
+	FindById(ctx context.Context, id uuid.UUID) (*models.Organisation, error)
+	Delete(ctx context.Context, id uuid.UUID) (bool, error)
+
+	FindMemberships(ctx context.Context, id uuid.UUID) ([]models.Membership, error)
+	CreateMembership(ctx context.Context, params db.CreateMembershipParams) (*models.Membership, error)
+	DeleteMembership(ctx context.Context, params db.DeleteMembershipParams) (bool, error)
+	FindGrantsByUserId(ctx context.Context, id uuid.UUID) ([]models.OrganisationGrant, error)
+}
+
+type organisation struct {
+	client postgres.Postgres
+}
+
+func NewOrganisationRepository(client postgres.Postgres) OrganisationRepository {
+	return &organisation{client: client}
+}
+
+func (o *organisation) List(ctx context.Context, limit, offset uint64) ([]models.Organisation, uint64, error) {
+	rows, err := o.client.Queries().FindOrganisations(ctx, db.FindOrganisationsParams{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	organisations := make([]models.Organisation, 0, len(rows))
+	var total uint64
+
+	if len(rows) > 0 {
+		total = rows[0].Total
+	}
+
+	for _, row := range rows {
+		organisations = append(organisations, models.Organisation{
+			ID:          row.ID,
+			Name:        row.Name.String,
+			Description: row.Description.String,
+		})
+	}
+
+	return organisations, total, err
+}
+
+func (o *organisation) Create(ctx context.Context, params db.CreateOrganisationParams) (*models.Organisation, error) {
+	result, err := o.client.Queries().CreateOrganisation(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Organisation{
+		ID:          result.ID,
+		Name:        result.Name,
+		Description: result.Description,
+	}, nil
+}
+
+func (o *organisation) Update(ctx context.Context, params db.UpdateOrganisationParams) (*models.Organisation, error) {
+	result, err := o.client.Queries().UpdateOrganisation(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Organisation{
+		ID:          result.ID,
+		Name:        result.Name,
+		Description: result.Description,
+	}, nil
+}
+
+func (o *organisation) FindById(ctx context.Context, id uuid.UUID) (*models.Organisation, error) {
+	result, err := o.client.Queries().FindOrganisationById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Organisation{
+		ID:          result.ID,
+		Name:        result.Name,
+		Description: result.Description,
+	}, nil
+}
+
+func (o *organisation) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
+	err := o.client.Queries().DeleteOrganisation(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (o *organisation) FindMemberships(ctx context.Context, id uuid.UUID) ([]models.Membership, error) {
+	rows, err := o.client.Queries().FindOrganisationMemberships(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := make([]models.Membership, 0, len(rows))
+	for _, row := range rows {
+		memberships = append(memberships, models.Membership{
+			UserID:         row.UserID,
+			OrganisationID: row.OrganisationID,
+			RoleID:         row.RoleID,
+		})
+	}
+
+	return memberships, nil
+}
+
+func (o *organisation) CreateMembership(ctx context.Context, params db.CreateMembershipParams) (*models.Membership, error) {
+	result, err := o.client.Queries().CreateMembership(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Membership{
+		UserID:         result.UserID,
+		OrganisationID: result.OrganisationID,
+		RoleID:         result.RoleID,
+	}, nil
+}
+
+func (o *organisation) DeleteMembership(ctx context.Context, params db.DeleteMembershipParams) (bool, error) {
+	affected, err := o.client.Queries().DeleteMembership(ctx, params)
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// FindGrantsByUserId returns the roles and inherited permissions of the user grouped by organisation
+func (o *organisation) FindGrantsByUserId(ctx context.Context, id uuid.UUID) ([]models.OrganisationGrant, error) {
+	roles, err := o.client.Queries().FindUserMembershipRoles(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Permissions come from the same paths that explain the effective permissions of the user
+	paths, err := o.client.Queries().FindUserPermissionPaths(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make([]models.OrganisationGrant, 0)
+	index := make(map[uuid.UUID]int)
+
+	grant := func(organisationId uuid.UUID) *models.OrganisationGrant {
+		i, ok := index[organisationId]
+		if !ok {
+			i = len(grants)
+			index[organisationId] = i
+			grants = append(grants, models.OrganisationGrant{OrganisationID: organisationId})
+		}
+
+		return &grants[i]
+	}
+
+	for _, row := range roles {
+		g := grant(row.OrganisationID)
+		g.Roles = append(g.Roles, row.Name)
+	}
+
+	for _, row := range paths {
+		if row.OrganisationID == uuid.Nil {
+			continue
+		}
+
+		g := grant(row.OrganisationID)
+		if n := len(g.Permissions); n == 0 || g.Permissions[n-1] != row.Name {
+			g.Permissions = append(g.Permissions, row.Name)
+		}
+	}
+
+	return grants, nil
+}
@@ -0,0 +1,180 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/repositories/organisation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/repositories/organisation.go -destination=internal/app/repositories/organisation_mock.go -package=repositories
+//
+
+// Package repositories is a generated GoMock package.
+package repositories
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	db "loki/internal/app/repositories/db"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrganisationRepository is a mock of OrganisationRepository interface.
+type MockOrganisationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganisationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOrganisationRepositoryMockRecorder is the mock recorder for MockOrganisationRepository.
+type MockOrganisationRepositoryMockRecorder struct {
+	mock *MockOrganisationRepository
+}
+
+// NewMockOrganisationRepository creates a new mock instance.
+func NewMockOrganisationRepository(ctrl *gomock.Controller) *MockOrganisationRepository {
+	mock := &MockOrganisationRepository{ctrl: ctrl}
+	mock.recorder = &MockOrganisationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganisationRepository) EXPECT() *MockOrganisationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOrganisationRepository) Create(ctx context.Context, params db.CreateOrganisationParams) (*models.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, params)
+	ret0, _ := ret[0].(*models.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrganisationRepositoryMockRecorder) Create(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganisationRepository)(nil).Create), ctx, params)
+}
+
+// CreateMembership mocks base method.
+func (m *MockOrganisationRepository) CreateMembership(ctx context.Context, params db.CreateMembershipParams) (*models.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMembership", ctx, params)
+	ret0, _ := ret[0].(*models.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMembership indicates an expected call of CreateMembership.
+func (mr *MockOrganisationRepositoryMockRecorder) CreateMembership(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMembership", reflect.TypeOf((*MockOrganisationRepository)(nil).CreateMembership), ctx, params)
+}
+
+// Delete mocks base method.
+func (m *MockOrganisationRepository) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOrganisationRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrganisationRepository)(nil).Delete), ctx, id)
+}
+
+// DeleteMembership mocks base method.
+func (m *MockOrganisationRepository) DeleteMembership(ctx context.Context, params db.DeleteMembershipParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMembership", ctx, params)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMembership indicates an expected call of DeleteMembership.
+func (mr *MockOrganisationRepositoryMockRecorder) DeleteMembership(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMembership", reflect.TypeOf((*MockOrganisationRepository)(nil).DeleteMembership), ctx, params)
+}
+
+// FindById mocks base method.
+func (m *MockOrganisationRepository) FindById(ctx context.Context, id uuid.UUID) (*models.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*models.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockOrganisationRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockOrganisationRepository)(nil).FindById), ctx, id)
+}
+
+// FindGrantsByUserId mocks base method.
+func (m *MockOrganisationRepository) FindGrantsByUserId(ctx context.Context, id uuid.UUID) ([]models.OrganisationGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGrantsByUserId", ctx, id)
+	ret0, _ := ret[0].([]models.OrganisationGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindGrantsByUserId indicates an expected call of FindGrantsByUserId.
+func (mr *MockOrganisationRepositoryMockRecorder) FindGrantsByUserId(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGrantsByUserId", reflect.TypeOf((*MockOrganisationRepository)(nil).FindGrantsByUserId), ctx, id)
+}
+
+// FindMemberships mocks base method.
+func (m *MockOrganisationRepository) FindMemberships(ctx context.Context, id uuid.UUID) ([]models.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMemberships", ctx, id)
+	ret0, _ := ret[0].([]models.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMemberships indicates an expected call of FindMemberships.
+func (mr *MockOrganisationRepositoryMockRecorder) FindMemberships(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMemberships", reflect.TypeOf((*MockOrganisationRepository)(nil).FindMemberships), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockOrganisationRepository) List(ctx context.Context, limit, offset uint64) ([]models.Organisation, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret0, _ := ret[0].([]models.Organisation)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockOrganisationRepositoryMockRecorder) List(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrganisationRepository)(nil).List), ctx, limit, offset)
+}
+
+// Update mocks base method.
+func (m *MockOrganisationRepository) Update(ctx context.Context, params db.UpdateOrganisationParams) (*models.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, params)
+	ret0, _ := ret[0].(*models.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOrganisationRepositoryMockRecorder) Update(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganisationRepository)(nil).Update), ctx, params)
+}
@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+	"loki/internal/config"
+)
+
+func Test_OrganisationRepository_CRUD(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	organisationRepository := NewOrganisationRepository(client)
+
+	created, err := organisationRepository.Create(ctx, db.CreateOrganisationParams{
+		Name:        "Acme",
+		Description: "Acme organisation",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Acme", created.Name)
+	defer organisationRepository.Delete(ctx, created.ID)
+
+	t.Run("Reject duplicate name", func(t *testing.T) {
+		_, err := organisationRepository.Create(ctx, db.CreateOrganisationParams{
+			Name:        "Acme",
+			Description: "Duplicate",
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("List organisations", func(t *testing.T) {
+		results, total, err := organisationRepository.List(ctx, 10, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), total)
+		assert.Equal(t, []models.Organisation{*created}, results)
+	})
+
+	t.Run("Update organisation", func(t *testing.T) {
+		result, err := organisationRepository.Update(ctx, db.UpdateOrganisationParams{
+			ID:          created.ID,
+			Name:        "Acme Ltd",
+			Description: "Acme limited",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &models.Organisation{ID: created.ID, Name: "Acme Ltd", Description: "Acme limited"}, result)
+	})
+
+	t.Run("Find missing organisation", func(t *testing.T) {
+		_, err := organisationRepository.FindById(ctx, uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func Test_OrganisationRepository_Memberships(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	organisationRepository := NewOrganisationRepository(client)
+	roleRepository := NewRoleRepository(client)
+	userRepository := NewUserRepository(client)
+
+	managerRoleId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+	userRoleId := uuid.MustParse("10000000-1000-1000-1000-000000000003")
+
+	organisation, err := organisationRepository.Create(ctx, db.CreateOrganisationParams{
+		Name:        "Globex",
+		Description: "Globex organisation",
+	})
+	assert.NoError(t, err)
+	defer organisationRepository.Delete(ctx, organisation.ID)
+
+	account, err := userRepository.Create(ctx, db.CreateUserParams{
+		IdentityNumber: "PNOEE-60001017869",
+		PersonalCode:   "60001017869",
+		FirstName:      "EID2016",
+		LastName:       "TESTNUMBER",
+	})
+	assert.NoError(t, err)
+	defer userRepository.Delete(ctx, account.ID)
+
+	support, err := roleRepository.Create(ctx, db.CreateRoleParams{
+		Name:        "support",
+		Description: "Support role",
+		ParentID:    userRoleId,
+	})
+	assert.NoError(t, err)
+	defer roleRepository.Delete(ctx, support.ID)
+
+	params := db.CreateMembershipParams{
+		UserID:         account.ID,
+		OrganisationID: organisation.ID,
+		RoleID:         support.ID,
+	}
+
+	t.Run("Create membership", func(t *testing.T) {
+		result, err := organisationRepository.CreateMembership(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, &models.Membership{UserID: account.ID, OrganisationID: organisation.ID, RoleID: support.ID}, result)
+
+		_, err = organisationRepository.CreateMembership(ctx, params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Find memberships", func(t *testing.T) {
+		results, err := organisationRepository.FindMemberships(ctx, organisation.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []models.Membership{{UserID: account.ID, OrganisationID: organisation.ID, RoleID: support.ID}}, results)
+	})
+
+	t.Run("Find grants with inherited permissions", func(t *testing.T) {
+		results, err := organisationRepository.FindGrantsByUserId(ctx, account.ID)
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, organisation.ID, results[0].OrganisationID)
+		assert.Equal(t, []string{"support"}, results[0].Roles)
+		assert.ElementsMatch(t, []string{"read:self", "write:self"}, results[0].Permissions)
+	})
+
+	t.Run("Grants do not leak into global permissions", func(t *testing.T) {
+		results, err := NewPermissionRepository(client).FindByUserId(ctx, account.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, results)
+	})
+
+	t.Run("Effective permissions carry the organisation", func(t *testing.T) {
+		results, err := NewPermissionRepository(client).FindEffectiveByUserId(ctx, account.ID)
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		assert.Equal(t, "read:self", results[0].Name)
+		assert.Equal(t, []models.PermissionPath{
+			{
+				Roles:          []models.Role{{ID: support.ID, Name: "support"}, {ID: userRoleId, Name: models.UserRoleType}},
+				OrganisationID: organisation.ID,
+			},
+		}, results[0].Paths)
+	})
+
+	t.Run("Delete membership", func(t *testing.T) {
+		ok, err := organisationRepository.DeleteMembership(ctx, db.DeleteMembershipParams(params))
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = organisationRepository.DeleteMembership(ctx, db.DeleteMembershipParams{
+			UserID:         account.ID,
+			OrganisationID: organisation.ID,
+			RoleID:         managerRoleId,
+		})
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"slices"
 
 	"github.com/google/uuid"
 
@@ -17,6 +18,7 @@ type PermissionRepository interface {
 	FindById(ctx context.Context, id uuid.UUID) (*models.Permission, error)
 	Delete(ctx context.Context, id uuid.UUID) (bool, error)
 	FindByUserId(ctx context.Context, id uuid.UUID) ([]models.Permission, error)
+	FindEffectiveByUserId(ctx context.Context, id uuid.UUID) ([]models.EffectivePermission, error)
 }
 
 type permission struct {
@@ -103,16 +105,46 @@ func (p *permission) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
 }
 
 func (p *permission) FindByUserId(ctx context.Context, id uuid.UUID) ([]models.Permission, error) {
-	records, err := p.client.Queries().FindUserPermissions(ctx, id)
+	records, err := p.FindEffectiveByUserId(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	permissions := make([]models.Permission, 0, len(records))
 	for _, record := range records {
-		permissions = append(permissions, models.Permission{
-			ID:   record.ID,
-			Name: record.Name,
+		if slices.ContainsFunc(record.Paths, func(path models.PermissionPath) bool {
+			return path.OrganisationID == uuid.Nil
+		}) {
+			permissions = append(permissions, record.Permission)
+		}
+	}
+
+	return permissions, nil
+}
+
+// FindEffectiveByUserId resolves the permissions granted through the user roles and their ancestors
+func (p *permission) FindEffectiveByUserId(ctx context.Context, id uuid.UUID) ([]models.EffectivePermission, error) {
+	rows, err := p.client.Queries().FindUserPermissionPaths(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make([]models.EffectivePermission, 0, len(rows))
+	for _, row := range rows {
+		roles := make([]models.Role, 0, len(row.RoleIds))
+		for i, roleId := range row.RoleIds {
+			roles = append(roles, models.Role{ID: roleId, Name: row.RoleNames[i]})
+		}
+		path := models.PermissionPath{Roles: roles, ExpiresAt: row.ExpiresAt.Time, OrganisationID: row.OrganisationID}
+
+		if n := len(permissions); n > 0 && permissions[n-1].ID == row.ID {
+			permissions[n-1].Paths = append(permissions[n-1].Paths, path)
+			continue
+		}
+
+		permissions = append(permissions, models.EffectivePermission{
+			Permission: models.Permission{ID: row.ID, Name: row.Name},
+			Paths:      []models.PermissionPath{path},
 		})
 	}
 
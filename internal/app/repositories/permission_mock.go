@@ -103,6 +103,21 @@ func (mr *MockPermissionRepositoryMockRecorder) FindByUserId(ctx, id any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockPermissionRepository)(nil).FindByUserId), ctx, id)
 }
 
+// FindEffectiveByUserId mocks base method.
+func (m *MockPermissionRepository) FindEffectiveByUserId(ctx context.Context, id uuid.UUID) ([]models.EffectivePermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEffectiveByUserId", ctx, id)
+	ret0, _ := ret[0].([]models.EffectivePermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEffectiveByUserId indicates an expected call of FindEffectiveByUserId.
+func (mr *MockPermissionRepositoryMockRecorder) FindEffectiveByUserId(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEffectiveByUserId", reflect.TypeOf((*MockPermissionRepository)(nil).FindEffectiveByUserId), ctx, id)
+}
+
 // List mocks base method.
 func (m *MockPermissionRepository) List(ctx context.Context, limit, offset uint64) ([]models.Permission, uint64, error) {
 	m.ctrl.T.Helper()
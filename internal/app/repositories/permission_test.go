@@ -368,3 +368,77 @@ func Test_PermissionRepository_FindByUserId(t *testing.T) {
 		})
 	}
 }
+
+func Test_PermissionRepository_FindEffectiveByUserId(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	userRepository := NewUserRepository(client)
+	roleRepository := NewRoleRepository(client)
+	permissionRepository := NewPermissionRepository(client)
+
+	userRoleId := uuid.MustParse("10000000-1000-1000-1000-000000000003")
+	readUsersId := uuid.MustParse("10000000-1000-1000-3000-000000000003")
+
+	account, err := userRepository.Create(ctx, db.CreateUserParams{
+		IdentityNumber: "PNOEE-40404049996",
+		PersonalCode:   "40404049996",
+		FirstName:      "TESTNUMBER",
+		LastName:       "EFFECTIVE",
+	})
+	assert.NoError(t, err)
+	defer userRepository.Delete(ctx, account.ID)
+
+	auditor, err := roleRepository.Create(ctx, db.CreateRoleParams{
+		Name:        "auditor",
+		Description: "Auditor role",
+		ParentID:    userRoleId,
+	})
+	assert.NoError(t, err)
+	defer roleRepository.Delete(ctx, auditor.ID)
+
+	_, err = roleRepository.AddPermissions(ctx, db.AddRolePermissionsParams{
+		RoleID:        auditor.ID,
+		PermissionIds: []uuid.UUID{readUsersId},
+	})
+	assert.NoError(t, err)
+
+	_, err = userRepository.AssignRoles(ctx, db.AddUserRolesParams{
+		UserID:  account.ID,
+		RoleIds: []uuid.UUID{auditor.ID},
+	})
+	assert.NoError(t, err)
+
+	userRole := models.Role{ID: userRoleId, Name: models.UserRoleType}
+	auditorRole := models.Role{ID: auditor.ID, Name: "auditor"}
+
+	results, err := permissionRepository.FindEffectiveByUserId(ctx, account.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.EffectivePermission{
+		{
+			Permission: models.Permission{ID: uuid.MustParse("10000000-1000-1000-3000-000000000001"), Name: "read:self"},
+			Paths: []models.PermissionPath{
+				{Roles: []models.Role{userRole}},
+				{Roles: []models.Role{auditorRole, userRole}},
+			},
+		},
+		{
+			Permission: models.Permission{ID: readUsersId, Name: "read:users"},
+			Paths: []models.PermissionPath{
+				{Roles: []models.Role{auditorRole}},
+			},
+		},
+		{
+			Permission: models.Permission{ID: uuid.MustParse("10000000-1000-1000-3000-000000000002"), Name: "write:self"},
+			Paths: []models.PermissionPath{
+				{Roles: []models.Role{userRole}},
+				{Roles: []models.Role{auditorRole, userRole}},
+			},
+		},
+	}, results)
+}
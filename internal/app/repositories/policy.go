@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"context"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/postgres"
+)
+
+type PolicyRepository interface {
+	List(ctx context.Context) ([]models.Policy, error)
+}
+
+type policy struct {
+	client postgres.Postgres
+}
+
+func NewPolicyRepository(client postgres.Postgres) PolicyRepository {
+	return &policy{client: client}
+}
+
+func (p *policy) List(ctx context.Context) ([]models.Policy, error) {
+	rows, err := p.client.Queries().FindPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]models.Policy, 0, len(rows))
+	for _, row := range rows {
+		policies = append(policies, models.Policy{
+			ID:          row.ID,
+			Name:        row.Name,
+			Description: row.Description,
+			Actions:     row.Actions,
+			Effect:      string(row.Effect),
+			Condition:   row.Condition,
+		})
+	}
+
+	return policies, nil
+}
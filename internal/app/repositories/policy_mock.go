@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/repositories/policy.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/repositories/policy.go -destination=internal/app/repositories/policy_mock.go -package=repositories
+//
+
+// Package repositories is a generated GoMock package.
+package repositories
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPolicyRepository is a mock of PolicyRepository interface.
+type MockPolicyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyRepositoryMockRecorder is the mock recorder for MockPolicyRepository.
+type MockPolicyRepositoryMockRecorder struct {
+	mock *MockPolicyRepository
+}
+
+// NewMockPolicyRepository creates a new mock instance.
+func NewMockPolicyRepository(ctrl *gomock.Controller) *MockPolicyRepository {
+	mock := &MockPolicyRepository{ctrl: ctrl}
+	mock.recorder = &MockPolicyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyRepository) EXPECT() *MockPolicyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockPolicyRepository) List(ctx context.Context) ([]models.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]models.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPolicyRepositoryMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPolicyRepository)(nil).List), ctx)
+}
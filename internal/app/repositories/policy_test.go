@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/postgres"
+	"loki/internal/config"
+)
+
+func Test_PolicyRepository_List(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	policyRepository := NewPolicyRepository(client)
+
+	t.Run("List policies", func(t *testing.T) {
+		results, err := policyRepository.List(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []models.Policy{}, results)
+	})
+}
@@ -33,6 +33,10 @@ func NewPostgresClient(cfg *config.Config) (Postgres, error) {
 		return nil, err
 	}
 
+	if err = otelpgx.RecordStats(pool); err != nil {
+		return nil, err
+	}
+
 	queries := db.New(pool)
 
 	return &pgClient{
@@ -0,0 +1,132 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+)
+
+type ProfileRepository interface {
+	FindByUserId(ctx context.Context, userId uuid.UUID) (*models.Profile, error)
+	Update(ctx context.Context, params db.UpsertUserProfileParams, email *db.UpsertUserEmailParams) (*models.Profile, error)
+	SavePhoneNumber(ctx context.Context, userId uuid.UUID, phoneNumber string) error
+	VerifyEmail(ctx context.Context, userId uuid.UUID, digest string, maxAttempts int32) (*models.Profile, error)
+}
+
+type profile struct {
+	client postgres.Postgres
+}
+
+func NewProfileRepository(client postgres.Postgres) ProfileRepository {
+	return &profile{client: client}
+}
+
+func (p *profile) FindByUserId(ctx context.Context, userId uuid.UUID) (*models.Profile, error) {
+	row, err := p.client.Queries().FindUserProfile(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProfile(row)
+}
+
+// Update stores the preferences and, when given, replaces the email with a new unverified address,
+// a new code is refused while the previous one was sent less than the cooldown ago
+func (p *profile) Update(ctx context.Context, params db.UpsertUserProfileParams, email *db.UpsertUserEmailParams) (*models.Profile, error) {
+	tx, err := p.client.Db().Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := p.client.Queries().WithTx(tx)
+
+	if email != nil {
+		rows, err := q.UpsertUserEmail(ctx, *email)
+		if err != nil {
+			return nil, err
+		}
+		if rows == 0 {
+			return nil, errors.ErrEmailVerificationCooldown
+		}
+	}
+
+	row, err := q.UpsertUserProfile(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return toProfile(db.FindUserProfileRow(row))
+}
+
+func (p *profile) SavePhoneNumber(ctx context.Context, userId uuid.UUID, phoneNumber string) error {
+	return p.client.Queries().UpsertUserPhoneNumber(ctx, db.UpsertUserPhoneNumberParams{
+		UserID:      userId,
+		PhoneNumber: phoneNumber,
+	})
+}
+
+// VerifyEmail confirms the email when the digest matches an unexpired verification code. Every call counts
+// as an attempt, including failed ones, and the code stops matching once more than maxAttempts were made
+func (p *profile) VerifyEmail(ctx context.Context, userId uuid.UUID, digest string, maxAttempts int32) (*models.Profile, error) {
+	tx, err := p.client.Db().Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := p.client.Queries().WithTx(tx)
+
+	if _, err = q.RecordUserEmailVerificationAttempt(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	row, err := q.VerifyUserEmail(ctx, db.VerifyUserEmailParams{
+		UserID:      userId,
+		Digest:      digest,
+		MaxAttempts: maxAttempts,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	if commitErr := tx.Commit(ctx); commitErr != nil {
+		return nil, commitErr
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toProfile(db.FindUserProfileRow(row))
+}
+
+func toProfile(row db.FindUserProfileRow) (*models.Profile, error) {
+	result := &models.Profile{
+		UserID:          row.UserID,
+		Email:           row.Email.String,
+		EmailVerifiedAt: row.EmailVerifiedAt.Time,
+		PhoneNumber:     row.PhoneNumber.String,
+		Locale:          row.Locale.String,
+		Attributes:      map[string]any{},
+	}
+
+	if len(row.Attributes) > 0 {
+		if err := json.Unmarshal(row.Attributes, &result.Attributes); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/repositories/profile.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/repositories/profile.go -destination=internal/app/repositories/profile_mock.go -package=repositories
+//
+
+// Package repositories is a generated GoMock package.
+package repositories
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	db "loki/internal/app/repositories/db"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProfileRepository is a mock of ProfileRepository interface.
+type MockProfileRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfileRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProfileRepositoryMockRecorder is the mock recorder for MockProfileRepository.
+type MockProfileRepositoryMockRecorder struct {
+	mock *MockProfileRepository
+}
+
+// NewMockProfileRepository creates a new mock instance.
+func NewMockProfileRepository(ctrl *gomock.Controller) *MockProfileRepository {
+	mock := &MockProfileRepository{ctrl: ctrl}
+	mock.recorder = &MockProfileRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfileRepository) EXPECT() *MockProfileRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByUserId mocks base method.
+func (m *MockProfileRepository) FindByUserId(ctx context.Context, userId uuid.UUID) (*models.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserId", ctx, userId)
+	ret0, _ := ret[0].(*models.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserId indicates an expected call of FindByUserId.
+func (mr *MockProfileRepositoryMockRecorder) FindByUserId(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockProfileRepository)(nil).FindByUserId), ctx, userId)
+}
+
+// SavePhoneNumber mocks base method.
+func (m *MockProfileRepository) SavePhoneNumber(ctx context.Context, userId uuid.UUID, phoneNumber string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePhoneNumber", ctx, userId, phoneNumber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePhoneNumber indicates an expected call of SavePhoneNumber.
+func (mr *MockProfileRepositoryMockRecorder) SavePhoneNumber(ctx, userId, phoneNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePhoneNumber", reflect.TypeOf((*MockProfileRepository)(nil).SavePhoneNumber), ctx, userId, phoneNumber)
+}
+
+// Update mocks base method.
+func (m *MockProfileRepository) Update(ctx context.Context, params db.UpsertUserProfileParams, email *db.UpsertUserEmailParams) (*models.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, params, email)
+	ret0, _ := ret[0].(*models.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProfileRepositoryMockRecorder) Update(ctx, params, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProfileRepository)(nil).Update), ctx, params, email)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockProfileRepository) VerifyEmail(ctx context.Context, userId uuid.UUID, digest string, maxAttempts int32) (*models.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, userId, digest, maxAttempts)
+	ret0, _ := ret[0].(*models.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockProfileRepositoryMockRecorder) VerifyEmail(ctx, userId, digest, maxAttempts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockProfileRepository)(nil).VerifyEmail), ctx, userId, digest, maxAttempts)
+}
@@ -0,0 +1,174 @@
+package repositories
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/repositories/db"
+	"loki/internal/app/repositories/postgres"
+	"loki/internal/config"
+)
+
+func Test_ProfileRepository(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	profileRepository := NewProfileRepository(client)
+	userRepository := NewUserRepository(client)
+
+	user, err := userRepository.Create(ctx, db.CreateUserParams{
+		IdentityNumber: "PNOEE-60001017869",
+		PersonalCode:   "60001017869",
+		FirstName:      "EID2016",
+		LastName:       "TESTNUMBER",
+	})
+	assert.NoError(t, err)
+
+	sum := sha256.Sum256([]byte("123456"))
+	digest := hex.EncodeToString(sum[:])
+
+	t.Run("Find missing profile", func(t *testing.T) {
+		_, err := profileRepository.FindByUserId(ctx, user.ID)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("Save phone number", func(t *testing.T) {
+		err := profileRepository.SavePhoneNumber(ctx, user.ID, "+37268000769")
+		assert.NoError(t, err)
+
+		profile, err := profileRepository.FindByUserId(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "+37268000769", profile.PhoneNumber)
+		assert.Equal(t, map[string]any{}, profile.Attributes)
+	})
+
+	t.Run("Update keeps the phone number", func(t *testing.T) {
+		profile, err := profileRepository.Update(ctx, db.UpsertUserProfileParams{
+			UserID:     user.ID,
+			Locale:     pgtype.Text{String: "et", Valid: true},
+			Attributes: []byte(`{"department":"IT"}`),
+		}, &db.UpsertUserEmailParams{
+			UserID:                      user.ID,
+			Email:                       pgtype.Text{String: "john.doe@example.com", Valid: true},
+			EmailVerificationDigest:     pgtype.Text{String: digest, Valid: true},
+			EmailVerificationTtlSeconds: 3600,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "john.doe@example.com", profile.Email)
+		assert.Equal(t, "+37268000769", profile.PhoneNumber)
+		assert.Equal(t, "et", profile.Locale)
+		assert.Equal(t, map[string]any{"department": "IT"}, profile.Attributes)
+		assert.False(t, profile.EmailVerified())
+	})
+
+	t.Run("Verify email", func(t *testing.T) {
+		_, err := profileRepository.VerifyEmail(ctx, user.ID, "invalid", 5)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		profile, err := profileRepository.VerifyEmail(ctx, user.ID, digest, 5)
+		assert.NoError(t, err)
+		assert.True(t, profile.EmailVerified())
+
+		profile, err = profileRepository.Update(ctx, db.UpsertUserProfileParams{
+			UserID:     user.ID,
+			Attributes: []byte(`{}`),
+		}, nil)
+		assert.NoError(t, err)
+		assert.True(t, profile.EmailVerified())
+		assert.Equal(t, "", profile.Locale)
+
+		_, err = profileRepository.VerifyEmail(ctx, user.ID, digest, 5)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("Verify expired code", func(t *testing.T) {
+		profile, err := profileRepository.Update(ctx, db.UpsertUserProfileParams{
+			UserID:     user.ID,
+			Attributes: []byte(`{}`),
+		}, &db.UpsertUserEmailParams{
+			UserID:                      user.ID,
+			Email:                       pgtype.Text{String: "jane.doe@example.com", Valid: true},
+			EmailVerificationDigest:     pgtype.Text{String: digest, Valid: true},
+			EmailVerificationTtlSeconds: -60,
+		})
+		assert.NoError(t, err)
+		assert.False(t, profile.EmailVerified())
+
+		_, err = profileRepository.VerifyEmail(ctx, user.ID, digest, 5)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("Verify after too many attempts", func(t *testing.T) {
+		_, err := profileRepository.Update(ctx, db.UpsertUserProfileParams{
+			UserID:     user.ID,
+			Attributes: []byte(`{}`),
+		}, &db.UpsertUserEmailParams{
+			UserID:                      user.ID,
+			Email:                       pgtype.Text{String: "jane.doe@example.com", Valid: true},
+			EmailVerificationDigest:     pgtype.Text{String: digest, Valid: true},
+			EmailVerificationTtlSeconds: 3600,
+		})
+		assert.NoError(t, err)
+
+		for range 2 {
+			_, err = profileRepository.VerifyEmail(ctx, user.ID, "invalid", 2)
+			assert.ErrorIs(t, err, pgx.ErrNoRows)
+		}
+
+		_, err = profileRepository.VerifyEmail(ctx, user.ID, digest, 2)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("Resend to the same address keeps the attempts", func(t *testing.T) {
+		_, err := profileRepository.Update(ctx, db.UpsertUserProfileParams{
+			UserID:     user.ID,
+			Attributes: []byte(`{}`),
+		}, &db.UpsertUserEmailParams{
+			UserID:                      user.ID,
+			Email:                       pgtype.Text{String: "jane.doe@example.com", Valid: true},
+			EmailVerificationDigest:     pgtype.Text{String: digest, Valid: true},
+			EmailVerificationTtlSeconds: 3600,
+		})
+		assert.NoError(t, err)
+
+		_, err = profileRepository.VerifyEmail(ctx, user.ID, digest, 2)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("Code within the cooldown", func(t *testing.T) {
+		_, err := profileRepository.Update(ctx, db.UpsertUserProfileParams{
+			UserID:     user.ID,
+			Attributes: []byte(`{}`),
+		}, &db.UpsertUserEmailParams{
+			UserID:                           user.ID,
+			Email:                            pgtype.Text{String: "john.smith@example.com", Valid: true},
+			EmailVerificationDigest:          pgtype.Text{String: digest, Valid: true},
+			EmailVerificationTtlSeconds:      3600,
+			EmailVerificationCooldownSeconds: 60,
+		})
+		assert.ErrorIs(t, err, errors.ErrEmailVerificationCooldown)
+
+		profile, err := profileRepository.FindByUserId(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "jane.doe@example.com", profile.Email)
+	})
+
+	t.Run("Missing user", func(t *testing.T) {
+		err := profileRepository.SavePhoneNumber(ctx, uuid.New(), "+37268000769")
+		assert.Error(t, err)
+	})
+}
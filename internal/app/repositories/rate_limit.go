@@ -0,0 +1,104 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+
+	"loki/internal/app/repositories/redis"
+)
+
+// RateLimitPrefix namespaces the rate limit counters and cooldowns in Redis
+const RateLimitPrefix = "ratelimit:"
+
+// slidingWindow drops the hits older than the window and adds a new one unless the limit is reached,
+// it returns zero on success or the milliseconds until the oldest hit leaves the window
+var slidingWindow = goredis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+
+if redis.call('ZCARD', KEYS[1]) >= tonumber(ARGV[3]) then
+  local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+  return math.max(tonumber(oldest[2]) + window - now, 1)
+end
+
+redis.call('ZADD', KEYS[1], now, ARGV[4])
+redis.call('PEXPIRE', KEYS[1], window)
+
+return 0
+`)
+
+// windowCount adds an event to the sliding window and returns the number of events in it
+var windowCount = goredis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+redis.call('ZADD', KEYS[1], now, ARGV[3])
+redis.call('PEXPIRE', KEYS[1], window)
+
+return redis.call('ZCARD', KEYS[1])
+`)
+
+type RateLimitRepository interface {
+	Hit(ctx context.Context, key string, limit int, window time.Duration) (time.Duration, error)
+	Record(ctx context.Context, key string, window time.Duration) (int64, error)
+	Block(ctx context.Context, key string, duration time.Duration) error
+	Blocked(ctx context.Context, key string) (time.Duration, error)
+}
+
+type rateLimit struct {
+	client redis.Redis
+}
+
+func NewRateLimitRepository(client redis.Redis) RateLimitRepository {
+	return &rateLimit{client: client}
+}
+
+// Hit counts a call within the sliding window, a rejected call is not counted and returns the time until a slot frees up
+func (r *rateLimit) Hit(ctx context.Context, key string, limit int, window time.Duration) (time.Duration, error) {
+	result, err := slidingWindow.Run(ctx, r.client.Connection(),
+		[]string{RateLimitPrefix + key},
+		time.Now().UnixMilli(),
+		window.Milliseconds(),
+		limit,
+		uuid.NewString(),
+	).Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(result) * time.Millisecond, nil
+}
+
+// Record counts an event within the sliding window and returns the number of events in it
+func (r *rateLimit) Record(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return windowCount.Run(ctx, r.client.Connection(),
+		[]string{RateLimitPrefix + key},
+		time.Now().UnixMilli(),
+		window.Milliseconds(),
+		uuid.NewString(),
+	).Int64()
+}
+
+func (r *rateLimit) Block(ctx context.Context, key string, duration time.Duration) error {
+	return r.client.Connection().Set(ctx, RateLimitPrefix+key, 1, duration).Err()
+}
+
+// Blocked returns the remaining time of a block, zero when the key is not blocked
+func (r *rateLimit) Blocked(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.client.Connection().PTTL(ctx, RateLimitPrefix+key).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
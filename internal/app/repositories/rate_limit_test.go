@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/repositories/redis"
+	"loki/internal/config"
+)
+
+func Test_RateLimitRepository(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		RedisURI: os.Getenv("REDIS_URI"),
+	}
+
+	client, err := redis.NewRedisClient(cfg)
+	assert.NoError(t, err)
+
+	repo := NewRateLimitRepository(client)
+
+	t.Run("Hit", func(t *testing.T) {
+		key := "test:hit:" + uuid.NewString()
+
+		for range 2 {
+			retryAfter, err := repo.Hit(ctx, key, 2, time.Minute)
+			assert.NoError(t, err)
+			assert.Zero(t, retryAfter)
+		}
+
+		retryAfter, err := repo.Hit(ctx, key, 2, time.Minute)
+		assert.NoError(t, err)
+		assert.Greater(t, retryAfter, time.Duration(0))
+		assert.LessOrEqual(t, retryAfter, time.Minute)
+	})
+
+	t.Run("Record", func(t *testing.T) {
+		key := "test:record:" + uuid.NewString()
+
+		count, err := repo.Record(ctx, key, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		count, err = repo.Record(ctx, key, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		key := "test:block:" + uuid.NewString()
+
+		remaining, err := repo.Blocked(ctx, key)
+		assert.NoError(t, err)
+		assert.Zero(t, remaining)
+
+		err = repo.Block(ctx, key, time.Minute)
+		assert.NoError(t, err)
+
+		remaining, err = repo.Blocked(ctx, key)
+		assert.NoError(t, err)
+		assert.Greater(t, remaining, time.Duration(0))
+	})
+}
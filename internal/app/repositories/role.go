@@ -18,11 +18,18 @@ type RoleRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) (bool, error)
 
 	FindByName(ctx context.Context, name string) (*models.Role, error)
+	FindByNames(ctx context.Context, names []string) ([]models.Role, error)
 
 	CreateUserRole(ctx context.Context, params db.CreateUserRoleParams) error
 	FindByUserId(ctx context.Context, id uuid.UUID) ([]models.Role, error)
 
 	FindRoleDetailsById(ctx context.Context, id uuid.UUID) (*models.Role, error)
+	FindAncestorIds(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
+	FindPermissionNames(ctx context.Context, id uuid.UUID) ([]string, error)
+
+	AddPermissions(ctx context.Context, params db.AddRolePermissionsParams) (*models.Role, error)
+	RemovePermissions(ctx context.Context, params db.RemoveRolePermissionsParams) (*models.Role, error)
+	SetPermissions(ctx context.Context, params db.CreateRolePermissionsParams) (*models.Role, error)
 }
 
 type role struct {
@@ -54,6 +61,7 @@ func (r *role) List(ctx context.Context, limit, offset uint64) ([]models.Role, u
 			ID:          row.ID,
 			Name:        row.Name.String,
 			Description: row.Description.String,
+			ParentID:    row.ParentID,
 		})
 	}
 
@@ -88,6 +96,7 @@ func (r *role) Create(ctx context.Context, params db.CreateRoleParams) (*models.
 		ID:          result.ID,
 		Name:        result.Name,
 		Description: result.Description,
+		ParentID:    result.ParentID,
 	}, tx.Commit(ctx)
 }
 
@@ -105,7 +114,7 @@ func (r *role) Update(ctx context.Context, params db.UpdateRoleParams) (*models.
 		return nil, err
 	}
 
-	if len(params.PermissionIDs) > 0 {
+	if params.UpdatePermissionIDs {
 		_, err = q.CreateRolePermissions(ctx, db.CreateRolePermissionsParams{
 			RoleID:        result.ID,
 			PermissionIds: params.PermissionIDs,
@@ -119,6 +128,7 @@ func (r *role) Update(ctx context.Context, params db.UpdateRoleParams) (*models.
 		ID:          result.ID,
 		Name:        result.Name,
 		Description: result.Description,
+		ParentID:    result.ParentID,
 	}, tx.Commit(ctx)
 }
 
@@ -132,6 +142,7 @@ func (r *role) FindById(ctx context.Context, id uuid.UUID) (*models.Role, error)
 		ID:          result.ID,
 		Name:        result.Name,
 		Description: result.Description,
+		ParentID:    result.ParentID,
 	}, nil
 }
 
@@ -161,6 +172,23 @@ func (r *role) CreateUserRole(ctx context.Context, params db.CreateUserRoleParam
 	return err
 }
 
+func (r *role) FindByNames(ctx context.Context, names []string) ([]models.Role, error) {
+	records, err := r.client.Queries().FindRolesByNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]models.Role, 0, len(records))
+	for _, record := range records {
+		roles = append(roles, models.Role{
+			ID:   record.ID,
+			Name: record.Name,
+		})
+	}
+
+	return roles, nil
+}
+
 func (r *role) FindByUserId(ctx context.Context, id uuid.UUID) ([]models.Role, error) {
 	records, err := r.client.Queries().FindUserRoles(ctx, id)
 	if err != nil {
@@ -185,9 +213,70 @@ func (r *role) FindRoleDetailsById(ctx context.Context, id uuid.UUID) (*models.R
 	}
 
 	return &models.Role{
-		ID:            result.ID,
-		Name:          result.Name,
-		Description:   result.Description,
-		PermissionIDs: result.PermissionIds,
+		ID:                     result.ID,
+		Name:                   result.Name,
+		Description:            result.Description,
+		ParentID:               result.ParentID,
+		PermissionIDs:          result.PermissionIds,
+		InheritedPermissionIDs: result.InheritedPermissionIds,
 	}, nil
 }
+
+// FindAncestorIds returns the role itself followed by all of its ancestors
+func (r *role) FindAncestorIds(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	return r.client.Queries().FindRoleAncestorIds(ctx, id)
+}
+
+// FindPermissionNames returns the names of the permissions the role grants, including inherited ones
+func (r *role) FindPermissionNames(ctx context.Context, id uuid.UUID) ([]string, error) {
+	return r.client.Queries().FindRolePermissionNames(ctx, id)
+}
+
+func (r *role) AddPermissions(ctx context.Context, params db.AddRolePermissionsParams) (*models.Role, error) {
+	return r.changePermissions(ctx, params.RoleID, func(q *db.Queries) error {
+		return q.AddRolePermissions(ctx, params)
+	})
+}
+
+func (r *role) RemovePermissions(ctx context.Context, params db.RemoveRolePermissionsParams) (*models.Role, error) {
+	return r.changePermissions(ctx, params.RoleID, func(q *db.Queries) error {
+		return q.RemoveRolePermissions(ctx, params)
+	})
+}
+
+// SetPermissions replaces the permissions of the role, an empty list removes all of them
+func (r *role) SetPermissions(ctx context.Context, params db.CreateRolePermissionsParams) (*models.Role, error) {
+	return r.changePermissions(ctx, params.RoleID, func(q *db.Queries) error {
+		_, err := q.CreateRolePermissions(ctx, params)
+		return err
+	})
+}
+
+// changePermissions applies the change and reads back the role details within one transaction
+func (r *role) changePermissions(ctx context.Context, id uuid.UUID, change func(q *db.Queries) error) (*models.Role, error) {
+	tx, err := r.client.Db().Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := r.client.Queries().WithTx(tx)
+
+	if err = change(q); err != nil {
+		return nil, err
+	}
+
+	result, err := q.FindRoleDetailsById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Role{
+		ID:                     result.ID,
+		Name:                   result.Name,
+		Description:            result.Description,
+		ParentID:               result.ParentID,
+		PermissionIDs:          result.PermissionIds,
+		InheritedPermissionIDs: result.InheritedPermissionIds,
+	}, tx.Commit(ctx)
+}
@@ -43,6 +43,21 @@ func (m *MockRoleRepository) EXPECT() *MockRoleRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddPermissions mocks base method.
+func (m *MockRoleRepository) AddPermissions(ctx context.Context, params db.AddRolePermissionsParams) (*models.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPermissions", ctx, params)
+	ret0, _ := ret[0].(*models.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPermissions indicates an expected call of AddPermissions.
+func (mr *MockRoleRepositoryMockRecorder) AddPermissions(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPermissions", reflect.TypeOf((*MockRoleRepository)(nil).AddPermissions), ctx, params)
+}
+
 // Create mocks base method.
 func (m *MockRoleRepository) Create(ctx context.Context, params db.CreateRoleParams) (*models.Role, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +102,21 @@ func (mr *MockRoleRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoleRepository)(nil).Delete), ctx, id)
 }
 
+// FindAncestorIds mocks base method.
+func (m *MockRoleRepository) FindAncestorIds(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAncestorIds", ctx, id)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAncestorIds indicates an expected call of FindAncestorIds.
+func (mr *MockRoleRepositoryMockRecorder) FindAncestorIds(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAncestorIds", reflect.TypeOf((*MockRoleRepository)(nil).FindAncestorIds), ctx, id)
+}
+
 // FindById mocks base method.
 func (m *MockRoleRepository) FindById(ctx context.Context, id uuid.UUID) (*models.Role, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +147,21 @@ func (mr *MockRoleRepositoryMockRecorder) FindByName(ctx, name any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockRoleRepository)(nil).FindByName), ctx, name)
 }
 
+// FindByNames mocks base method.
+func (m *MockRoleRepository) FindByNames(ctx context.Context, names []string) ([]models.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByNames", ctx, names)
+	ret0, _ := ret[0].([]models.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByNames indicates an expected call of FindByNames.
+func (mr *MockRoleRepositoryMockRecorder) FindByNames(ctx, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNames", reflect.TypeOf((*MockRoleRepository)(nil).FindByNames), ctx, names)
+}
+
 // FindByUserId mocks base method.
 func (m *MockRoleRepository) FindByUserId(ctx context.Context, id uuid.UUID) ([]models.Role, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +177,21 @@ func (mr *MockRoleRepositoryMockRecorder) FindByUserId(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockRoleRepository)(nil).FindByUserId), ctx, id)
 }
 
+// FindPermissionNames mocks base method.
+func (m *MockRoleRepository) FindPermissionNames(ctx context.Context, id uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPermissionNames", ctx, id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPermissionNames indicates an expected call of FindPermissionNames.
+func (mr *MockRoleRepositoryMockRecorder) FindPermissionNames(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPermissionNames", reflect.TypeOf((*MockRoleRepository)(nil).FindPermissionNames), ctx, id)
+}
+
 // FindRoleDetailsById mocks base method.
 func (m *MockRoleRepository) FindRoleDetailsById(ctx context.Context, id uuid.UUID) (*models.Role, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +223,36 @@ func (mr *MockRoleRepositoryMockRecorder) List(ctx, limit, offset any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoleRepository)(nil).List), ctx, limit, offset)
 }
 
+// RemovePermissions mocks base method.
+func (m *MockRoleRepository) RemovePermissions(ctx context.Context, params db.RemoveRolePermissionsParams) (*models.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePermissions", ctx, params)
+	ret0, _ := ret[0].(*models.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePermissions indicates an expected call of RemovePermissions.
+func (mr *MockRoleRepositoryMockRecorder) RemovePermissions(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePermissions", reflect.TypeOf((*MockRoleRepository)(nil).RemovePermissions), ctx, params)
+}
+
+// SetPermissions mocks base method.
+func (m *MockRoleRepository) SetPermissions(ctx context.Context, params db.CreateRolePermissionsParams) (*models.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPermissions", ctx, params)
+	ret0, _ := ret[0].(*models.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPermissions indicates an expected call of SetPermissions.
+func (mr *MockRoleRepositoryMockRecorder) SetPermissions(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPermissions", reflect.TypeOf((*MockRoleRepository)(nil).SetPermissions), ctx, params)
+}
+
 // Update mocks base method.
 func (m *MockRoleRepository) Update(ctx context.Context, params db.UpdateRoleParams) (*models.Role, error) {
 	m.ctrl.T.Helper()
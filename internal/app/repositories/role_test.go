@@ -168,18 +168,22 @@ func Test_RoleRepository_Update(t *testing.T) {
 		{
 			name: "Success",
 			params: db.UpdateRoleParams{
-				ID:          existingRole.ID,
-				Name:        "Tester Updated",
-				Description: "Updated tester role",
+				ID:                existingRole.ID,
+				UpdateName:        true,
+				Name:              "Tester Updated",
+				UpdateDescription: true,
+				Description:       "Updated tester role",
 			},
 			error: false,
 		},
 		{
 			name: "Update non-existing role",
 			params: db.UpdateRoleParams{
-				ID:          uuid.New(),
-				Name:        "Nonexistent",
-				Description: "Does not exist",
+				ID:                uuid.New(),
+				UpdateName:        true,
+				Name:              "Nonexistent",
+				UpdateDescription: true,
+				Description:       "Does not exist",
 			},
 			error: true,
 		},
@@ -373,6 +377,25 @@ func Test_RoleRepository_FindByName(t *testing.T) {
 	}
 }
 
+func Test_RoleRepository_FindByNames(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	repository := NewRoleRepository(client)
+
+	roles, err := repository.FindByNames(ctx, []string{models.AdminRoleType, models.UserRoleType, "unknown"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []models.Role{
+		{ID: uuid.MustParse("10000000-1000-1000-1000-000000000001"), Name: models.AdminRoleType},
+		{ID: uuid.MustParse("10000000-1000-1000-1000-000000000003"), Name: models.UserRoleType},
+	}, roles)
+}
+
 func Test_RoleRepository_CreateUserRole(t *testing.T) {
 	ctx := context.Background()
 	cfg := &config.Config{
@@ -532,3 +555,174 @@ func Test_RoleRepository_FindRoleDetailsById(t *testing.T) {
 		})
 	}
 }
+
+func Test_RoleRepository_Hierarchy(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	roleRepository := NewRoleRepository(client)
+	permissionRepository := NewPermissionRepository(client)
+	userRepository := NewUserRepository(client)
+
+	userRoleId := uuid.MustParse("10000000-1000-1000-1000-000000000003")
+	readSelf := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	writeSelf := uuid.MustParse("10000000-1000-1000-3000-000000000002")
+	readUsers := uuid.MustParse("10000000-1000-1000-3000-000000000003")
+
+	child, err := roleRepository.Create(ctx, db.CreateRoleParams{
+		Name:          "support",
+		Description:   "Support role",
+		ParentID:      userRoleId,
+		PermissionIDs: []uuid.UUID{readUsers},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, userRoleId, child.ParentID)
+	defer roleRepository.Delete(ctx, child.ID)
+
+	t.Run("Find ancestor ids", func(t *testing.T) {
+		result, err := roleRepository.FindAncestorIds(ctx, child.ID)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{child.ID, userRoleId}, result)
+	})
+
+	t.Run("Find role details with inherited permissions", func(t *testing.T) {
+		result, err := roleRepository.FindRoleDetailsById(ctx, child.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, userRoleId, result.ParentID)
+		assert.ElementsMatch(t, []uuid.UUID{readUsers}, result.PermissionIDs)
+		assert.ElementsMatch(t, []uuid.UUID{readSelf, writeSelf}, result.InheritedPermissionIDs)
+	})
+
+	t.Run("Find permission names with inherited permissions", func(t *testing.T) {
+		result, err := roleRepository.FindPermissionNames(ctx, child.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"read:self", "read:users", "write:self"}, result)
+	})
+
+	t.Run("Find user permissions through ancestors", func(t *testing.T) {
+		account, err := userRepository.Create(ctx, db.CreateUserParams{
+			IdentityNumber: "PNOEE-60001017869",
+			PersonalCode:   "60001017869",
+			FirstName:      "EID2016",
+			LastName:       "TESTNUMBER",
+		})
+		assert.NoError(t, err)
+		defer userRepository.Delete(ctx, account.ID)
+
+		err = roleRepository.CreateUserRole(ctx, db.CreateUserRoleParams{
+			UserID: account.ID,
+			RoleID: child.ID,
+		})
+		assert.NoError(t, err)
+
+		results, err := permissionRepository.FindByUserId(ctx, account.ID)
+		assert.NoError(t, err)
+
+		ids := make([]uuid.UUID, 0, len(results))
+		for _, result := range results {
+			ids = append(ids, result.ID)
+		}
+		assert.ElementsMatch(t, []uuid.UUID{readSelf, writeSelf, readUsers}, ids)
+	})
+
+	t.Run("Reject cyclic hierarchy", func(t *testing.T) {
+		_, err := roleRepository.Update(ctx, db.UpdateRoleParams{
+			ID:             userRoleId,
+			UpdateParentID: true,
+			ParentID:       child.ID,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func Test_RoleRepository_Permissions(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	roleRepository := NewRoleRepository(client)
+
+	readSelf := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	writeSelf := uuid.MustParse("10000000-1000-1000-3000-000000000002")
+	readUsers := uuid.MustParse("10000000-1000-1000-3000-000000000003")
+
+	role, err := roleRepository.Create(ctx, db.CreateRoleParams{
+		Name:          "auditor",
+		Description:   "Auditor role",
+		PermissionIDs: []uuid.UUID{readSelf},
+	})
+	assert.NoError(t, err)
+	defer roleRepository.Delete(ctx, role.ID)
+
+	t.Run("Add permissions", func(t *testing.T) {
+		result, err := roleRepository.AddPermissions(ctx, db.AddRolePermissionsParams{
+			RoleID:        role.ID,
+			PermissionIds: []uuid.UUID{readSelf, readUsers},
+		})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{readSelf, readUsers}, result.PermissionIDs)
+	})
+
+	t.Run("Remove permissions", func(t *testing.T) {
+		result, err := roleRepository.RemovePermissions(ctx, db.RemoveRolePermissionsParams{
+			RoleID:        role.ID,
+			PermissionIds: []uuid.UUID{readSelf},
+		})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{readUsers}, result.PermissionIDs)
+	})
+
+	t.Run("Set permissions", func(t *testing.T) {
+		result, err := roleRepository.SetPermissions(ctx, db.CreateRolePermissionsParams{
+			RoleID:        role.ID,
+			PermissionIds: []uuid.UUID{writeSelf},
+		})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{writeSelf}, result.PermissionIDs)
+	})
+
+	t.Run("Update keeps permissions outside the mask", func(t *testing.T) {
+		_, err := roleRepository.Update(ctx, db.UpdateRoleParams{
+			ID:                role.ID,
+			UpdateDescription: true,
+			Description:       "Auditor role updated",
+		})
+		assert.NoError(t, err)
+
+		result, err := roleRepository.FindRoleDetailsById(ctx, role.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "auditor", result.Name)
+		assert.Equal(t, "Auditor role updated", result.Description)
+		assert.ElementsMatch(t, []uuid.UUID{writeSelf}, result.PermissionIDs)
+	})
+
+	t.Run("Update replaces masked permissions", func(t *testing.T) {
+		_, err := roleRepository.Update(ctx, db.UpdateRoleParams{
+			ID:                  role.ID,
+			UpdatePermissionIDs: true,
+			PermissionIDs:       []uuid.UUID{},
+		})
+		assert.NoError(t, err)
+
+		result, err := roleRepository.FindRoleDetailsById(ctx, role.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, result.PermissionIDs)
+	})
+
+	t.Run("Unknown role", func(t *testing.T) {
+		_, err := roleRepository.AddPermissions(ctx, db.AddRolePermissionsParams{
+			RoleID:        uuid.New(),
+			PermissionIds: []uuid.UUID{readSelf},
+		})
+		assert.Error(t, err)
+	})
+}
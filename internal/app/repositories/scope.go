@@ -18,6 +18,7 @@ type ScopeRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) (bool, error)
 
 	FindByName(ctx context.Context, name string) (*models.Scope, error)
+	FindByNames(ctx context.Context, names []string) ([]models.Scope, error)
 
 	CreateUserScope(ctx context.Context, params db.CreateUserScopeParams) error
 	FindByUserId(ctx context.Context, id uuid.UUID) ([]models.Scope, error)
@@ -118,6 +119,23 @@ func (s *scope) FindByName(ctx context.Context, name string) (*models.Scope, err
 	}, nil
 }
 
+func (s *scope) FindByNames(ctx context.Context, names []string) ([]models.Scope, error) {
+	records, err := s.client.Queries().FindScopesByNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]models.Scope, 0, len(records))
+	for _, record := range records {
+		scopes = append(scopes, models.Scope{
+			ID:   record.ID,
+			Name: record.Name,
+		})
+	}
+
+	return scopes, nil
+}
+
 func (s *scope) CreateUserScope(ctx context.Context, params db.CreateUserScopeParams) error {
 	_, err := s.client.Queries().CreateUserScope(ctx, params)
 	return err
@@ -117,6 +117,21 @@ func (mr *MockScopeRepositoryMockRecorder) FindByName(ctx, name any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockScopeRepository)(nil).FindByName), ctx, name)
 }
 
+// FindByNames mocks base method.
+func (m *MockScopeRepository) FindByNames(ctx context.Context, names []string) ([]models.Scope, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByNames", ctx, names)
+	ret0, _ := ret[0].([]models.Scope)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByNames indicates an expected call of FindByNames.
+func (mr *MockScopeRepositoryMockRecorder) FindByNames(ctx, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNames", reflect.TypeOf((*MockScopeRepository)(nil).FindByNames), ctx, names)
+}
+
 // FindByUserId mocks base method.
 func (m *MockScopeRepository) FindByUserId(ctx context.Context, id uuid.UUID) ([]models.Scope, error) {
 	m.ctrl.T.Helper()
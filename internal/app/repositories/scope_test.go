@@ -343,6 +343,24 @@ func Test_ScopeRepository_FindByName(t *testing.T) {
 	}
 }
 
+func Test_ScopeRepository_FindByNames(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	repository := NewScopeRepository(client)
+
+	scopes, err := repository.FindByNames(ctx, []string{models.SelfServiceType, "unknown"})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Scope{
+		{ID: uuid.MustParse("10000000-1000-1000-2000-000000000002"), Name: models.SelfServiceType},
+	}, scopes)
+}
+
 func Test_ScopeRepository_CreateUserScope(t *testing.T) {
 	ctx := context.Background()
 	cfg := &config.Config{
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
 
 	"loki/internal/app/errors"
 	"loki/internal/app/models"
@@ -14,10 +15,26 @@ import (
 
 const SessionTTL = 5 * time.Minute
 
+// consumeSession returns the session and deletes it in the same step when it has the given status,
+// so a successful session is exchanged at most once
+var consumeSession = goredis.NewScript(`
+local data = redis.call('GET', KEYS[1])
+if not data then
+  return false
+end
+
+if cjson.decode(data).Status == ARGV[1] then
+  redis.call('DEL', KEYS[1])
+end
+
+return data
+`)
+
 type SessionRepository interface {
 	Create(ctx context.Context, session *models.Session) error
 	Update(ctx context.Context, session *models.Session) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	Consume(ctx context.Context, id uuid.UUID) (*models.Session, error)
 	FindById(ctx context.Context, id uuid.UUID) (*models.Session, error)
 }
 
@@ -51,6 +68,21 @@ func (s *session) Delete(ctx context.Context, id uuid.UUID) error {
 	return s.client.Connection().Del(ctx, id.String()).Err()
 }
 
+// Consume returns the session and deletes it when it succeeded, concurrent callers cannot both receive it
+func (s *session) Consume(ctx context.Context, id uuid.UUID) (*models.Session, error) {
+	data, err := consumeSession.Run(ctx, s.client.Connection(), []string{id.String()}, models.SessionSuccess).Text()
+	if err != nil {
+		return nil, errors.ErrSessionNotFound
+	}
+
+	var result models.Session
+	if err = json.Unmarshal([]byte(data), &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 func (s *session) FindById(ctx context.Context, id uuid.UUID) (*models.Session, error) {
 	data, err := s.client.Connection().Get(ctx, id.String()).Result()
 	if err != nil {
@@ -42,6 +42,21 @@ func (m *MockSessionRepository) EXPECT() *MockSessionRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Consume mocks base method.
+func (m *MockSessionRepository) Consume(ctx context.Context, id uuid.UUID) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, id)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockSessionRepositoryMockRecorder) Consume(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockSessionRepository)(nil).Consume), ctx, id)
+}
+
 // Create mocks base method.
 func (m *MockSessionRepository) Create(ctx context.Context, session *models.Session) error {
 	m.ctrl.T.Helper()
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/repositories/redis"
 	"loki/internal/config"
@@ -143,6 +144,87 @@ func Test_SessionRepository_Delete(t *testing.T) {
 	}
 }
 
+func Test_SessionRepository_Consume(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		RedisURI: os.Getenv("REDIS_URI"),
+	}
+
+	client, err := redis.NewRedisClient(cfg)
+	assert.NoError(t, err)
+
+	repo := NewSessionRepository(client)
+
+	running := uuid.MustParse("0c6a3a4e-4d7f-4b53-9d0a-2f1d0f4c1a01")
+	succeeded := uuid.MustParse("0c6a3a4e-4d7f-4b53-9d0a-2f1d0f4c1a02")
+
+	tests := []struct {
+		name      string
+		before    func()
+		sessionId uuid.UUID
+		expected  *models.Session
+		remaining bool
+	}{
+		{
+			name: "Running session is kept",
+			before: func() {
+				err := repo.Create(ctx, &models.Session{
+					ID:     running,
+					Status: models.SessionRunning,
+				})
+				assert.NoError(t, err)
+			},
+			sessionId: running,
+			expected: &models.Session{
+				ID:     running,
+				Status: models.SessionRunning,
+			},
+			remaining: true,
+		},
+		{
+			name: "Successful session is consumed once",
+			before: func() {
+				err := repo.Create(ctx, &models.Session{
+					ID:     succeeded,
+					Status: models.SessionSuccess,
+				})
+				assert.NoError(t, err)
+			},
+			sessionId: succeeded,
+			expected: &models.Session{
+				ID:     succeeded,
+				Status: models.SessionSuccess,
+			},
+			remaining: false,
+		},
+		{
+			name:      "Not found",
+			before:    func() {},
+			sessionId: uuid.New(),
+			expected:  nil,
+			remaining: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := repo.Consume(ctx, tt.sessionId)
+
+			if tt.expected == nil {
+				assert.ErrorIs(t, err, errors.ErrSessionNotFound)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+
+			_, err = repo.FindById(ctx, tt.sessionId)
+			assert.Equal(t, tt.remaining, err == nil)
+		})
+	}
+}
+
 func Test_SessionRepository_FindById(t *testing.T) {
 	ctx := context.Background()
 	cfg := &config.Config{
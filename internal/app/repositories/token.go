@@ -72,6 +72,7 @@ func (t *token) Create(ctx context.Context, params db.CreateTokensParams) ([]mod
 			Time:  time.Now().Add(models.AccessTokenExp),
 			Valid: true,
 		},
+		LoginSessionID:    params.LoginSessionID,
 		RefreshTokenValue: params.RefreshTokenValue,
 		RefreshTokenExpiresAt: pgtype.Timestamp{
 			Time:  time.Now().Add(models.RefreshTokenExp),
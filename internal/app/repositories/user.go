@@ -13,12 +13,26 @@ import (
 type UserRepository interface {
 	List(ctx context.Context, limit, offset uint64) ([]models.User, uint64, error)
 	Create(ctx context.Context, params db.CreateUserParams) (*models.User, error)
+	Provision(ctx context.Context, params *models.User) (*models.User, error)
 	Update(ctx context.Context, params db.UpdateUserParams) (*models.User, error)
 	FindById(ctx context.Context, id uuid.UUID) (*models.User, error)
 	Delete(ctx context.Context, id uuid.UUID) (bool, error)
+	UpdateStatus(ctx context.Context, params db.UpdateUserStatusParams) (*models.User, error)
 
 	FindByIdentityNumber(ctx context.Context, identityNumber string) (*models.User, error)
 	FindUserDetailsById(ctx context.Context, id uuid.UUID) (*models.User, error)
+	FindByIdentityNumbers(ctx context.Context, identityNumbers []string) ([]models.User, error)
+
+	Import(ctx context.Context, users []models.User) ([]models.User, error)
+	ListRecords(ctx context.Context, after string, limit uint64) ([]models.UserRecord, error)
+
+	AssignRoles(ctx context.Context, params db.AddUserRolesParams) (*models.User, error)
+	RevokeRoles(ctx context.Context, params db.RemoveUserRolesParams) (*models.User, error)
+	SetRoles(ctx context.Context, params db.CreateUserRolesParams) (*models.User, error)
+
+	AssignScopes(ctx context.Context, params db.AddUserScopesParams) (*models.User, error)
+	RevokeScopes(ctx context.Context, params db.RemoveUserScopesParams) (*models.User, error)
+	SetScopes(ctx context.Context, params db.CreateUserScopesParams) (*models.User, error)
 }
 
 type user struct {
@@ -52,6 +66,10 @@ func (u *user) List(ctx context.Context, limit, offset uint64) ([]models.User, u
 			PersonalCode:   row.PersonalCode.String,
 			FirstName:      row.FirstName.String,
 			LastName:       row.LastName.String,
+
+			Status:          string(row.Status.UserStatus),
+			StatusReason:    row.StatusReason.String,
+			StatusChangedAt: row.StatusChangedAt.Time,
 		})
 	}
 
@@ -99,10 +117,15 @@ func (u *user) Create(ctx context.Context, params db.CreateUserParams) (*models.
 		PersonalCode:   result.PersonalCode,
 		FirstName:      result.FirstName,
 		LastName:       result.LastName,
+
+		Status:          string(result.Status),
+		StatusReason:    result.StatusReason.String,
+		StatusChangedAt: result.StatusChangedAt.Time,
 	}, tx.Commit(ctx)
 }
 
-func (u *user) Update(ctx context.Context, params db.UpdateUserParams) (*models.User, error) {
+// Provision upserts the user on login and grants the roles and scopes in addition to the ones already held
+func (u *user) Provision(ctx context.Context, params *models.User) (*models.User, error) {
 	tx, err := u.client.Db().Begin(ctx)
 	if err != nil {
 		return nil, err
@@ -111,8 +134,7 @@ func (u *user) Update(ctx context.Context, params db.UpdateUserParams) (*models.
 
 	q := u.client.Queries().WithTx(tx)
 
-	result, err := q.UpdateUser(ctx, db.UpdateUserParams{
-		ID:             params.ID,
+	result, err := q.CreateUser(ctx, db.CreateUserParams{
 		IdentityNumber: params.IdentityNumber,
 		PersonalCode:   params.PersonalCode,
 		FirstName:      params.FirstName,
@@ -122,28 +144,83 @@ func (u *user) Update(ctx context.Context, params db.UpdateUserParams) (*models.
 		return nil, err
 	}
 
-	_, err = q.CreateUserRoles(ctx, db.CreateUserRolesParams{
-		UserID:  result.ID,
-		RoleIds: params.RoleIDs,
-	})
+	if len(params.RoleIDs) > 0 {
+		err = q.AddUserRoles(ctx, db.AddUserRolesParams{
+			UserID:  result.ID,
+			RoleIds: params.RoleIDs,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(params.ScopeIDs) > 0 {
+		err = q.AddUserScopes(ctx, db.AddUserScopesParams{
+			UserID:   result.ID,
+			ScopeIds: params.ScopeIDs,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.User{
+		ID:             result.ID,
+		IdentityNumber: result.IdentityNumber,
+		PersonalCode:   result.PersonalCode,
+		FirstName:      result.FirstName,
+		LastName:       result.LastName,
+
+		Status:          string(result.Status),
+		StatusReason:    result.StatusReason.String,
+		StatusChangedAt: result.StatusChangedAt.Time,
+	}, tx.Commit(ctx)
+}
+
+func (u *user) Update(ctx context.Context, params db.UpdateUserParams) (*models.User, error) {
+	tx, err := u.client.Db().Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer tx.Rollback(ctx)
 
-	_, err = q.CreateUserScopes(ctx, db.CreateUserScopesParams{
-		UserID:   result.ID,
-		ScopeIds: params.ScopeIDs,
-	})
+	q := u.client.Queries().WithTx(tx)
+
+	result, err := q.UpdateUser(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
+	if params.UpdateRoleIDs {
+		_, err = q.CreateUserRoles(ctx, db.CreateUserRolesParams{
+			UserID:  result.ID,
+			RoleIds: params.RoleIDs,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if params.UpdateScopeIDs {
+		_, err = q.CreateUserScopes(ctx, db.CreateUserScopesParams{
+			UserID:   result.ID,
+			ScopeIds: params.ScopeIDs,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &models.User{
 		ID:             result.ID,
 		IdentityNumber: result.IdentityNumber,
 		PersonalCode:   result.PersonalCode,
 		FirstName:      result.FirstName,
 		LastName:       result.LastName,
+
+		Status:          string(result.Status),
+		StatusReason:    result.StatusReason.String,
+		StatusChangedAt: result.StatusChangedAt.Time,
 	}, tx.Commit(ctx)
 }
 
@@ -159,6 +236,10 @@ func (u *user) FindById(ctx context.Context, id uuid.UUID) (*models.User, error)
 		PersonalCode:   result.PersonalCode,
 		FirstName:      result.FirstName,
 		LastName:       result.LastName,
+
+		Status:          string(result.Status),
+		StatusReason:    result.StatusReason.String,
+		StatusChangedAt: result.StatusChangedAt.Time,
 	}, nil
 }
 
@@ -171,6 +252,40 @@ func (u *user) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
 	return true, nil
 }
 
+// UpdateStatus changes the lifecycle status of the user, the tokens of a user who is no longer active are removed
+func (u *user) UpdateStatus(ctx context.Context, params db.UpdateUserStatusParams) (*models.User, error) {
+	tx, err := u.client.Db().Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := u.client.Queries().WithTx(tx)
+
+	result, err := q.UpdateUserStatus(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Status != db.UserStatusActive {
+		if err = q.DeleteTokensByUserId(ctx, result.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.User{
+		ID:             result.ID,
+		IdentityNumber: result.IdentityNumber,
+		PersonalCode:   result.PersonalCode,
+		FirstName:      result.FirstName,
+		LastName:       result.LastName,
+
+		Status:          string(result.Status),
+		StatusReason:    result.StatusReason.String,
+		StatusChangedAt: result.StatusChangedAt.Time,
+	}, tx.Commit(ctx)
+}
+
 func (u *user) FindByIdentityNumber(ctx context.Context, identityNumber string) (*models.User, error) {
 	result, err := u.client.Queries().FindUserByIdentityNumber(ctx, identityNumber)
 	if err != nil {
@@ -183,6 +298,10 @@ func (u *user) FindByIdentityNumber(ctx context.Context, identityNumber string)
 		PersonalCode:   result.PersonalCode,
 		FirstName:      result.FirstName,
 		LastName:       result.LastName,
+
+		Status:          string(result.Status),
+		StatusReason:    result.StatusReason.String,
+		StatusChangedAt: result.StatusChangedAt.Time,
 	}, nil
 }
 
@@ -198,7 +317,182 @@ func (u *user) FindUserDetailsById(ctx context.Context, id uuid.UUID) (*models.U
 		PersonalCode:   result.PersonalCode,
 		FirstName:      result.FirstName,
 		LastName:       result.LastName,
-		RoleIDs:        result.RoleIds,
-		ScopeIDs:       result.ScopeIds,
+
+		Status:          string(result.Status),
+		StatusReason:    result.StatusReason.String,
+		StatusChangedAt: result.StatusChangedAt.Time,
+
+		RoleIDs:  result.RoleIds,
+		ScopeIDs: result.ScopeIds,
 	}, nil
 }
+
+func (u *user) FindByIdentityNumbers(ctx context.Context, identityNumbers []string) ([]models.User, error) {
+	rows, err := u.client.Queries().FindUsersByIdentityNumbers(ctx, identityNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]models.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, models.User{
+			ID:             row.ID,
+			IdentityNumber: row.IdentityNumber,
+			PersonalCode:   row.PersonalCode,
+			FirstName:      row.FirstName,
+			LastName:       row.LastName,
+		})
+	}
+
+	return users, nil
+}
+
+// Import upserts the users by identity number and replaces their roles and scopes within one transaction
+func (u *user) Import(ctx context.Context, users []models.User) ([]models.User, error) {
+	tx, err := u.client.Db().Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := u.client.Queries().WithTx(tx)
+
+	imported := make([]models.User, 0, len(users))
+	for _, user := range users {
+		result, err := q.UpsertUser(ctx, db.UpsertUserParams{
+			IdentityNumber: user.IdentityNumber,
+			PersonalCode:   user.PersonalCode,
+			FirstName:      user.FirstName,
+			LastName:       user.LastName,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err = q.CreateUserRoles(ctx, db.CreateUserRolesParams{
+			UserID:  result.ID,
+			RoleIds: user.RoleIDs,
+		}); err != nil {
+			return nil, err
+		}
+
+		if _, err = q.CreateUserScopes(ctx, db.CreateUserScopesParams{
+			UserID:   result.ID,
+			ScopeIds: user.ScopeIDs,
+		}); err != nil {
+			return nil, err
+		}
+
+		imported = append(imported, models.User{
+			ID:             result.ID,
+			IdentityNumber: result.IdentityNumber,
+			PersonalCode:   result.PersonalCode,
+			FirstName:      result.FirstName,
+			LastName:       result.LastName,
+			RoleIDs:        user.RoleIDs,
+			ScopeIDs:       user.ScopeIDs,
+		})
+	}
+
+	return imported, tx.Commit(ctx)
+}
+
+// ListRecords returns the users ordered by identity number after the given one, with their permanent role and scope names
+func (u *user) ListRecords(ctx context.Context, after string, limit uint64) ([]models.UserRecord, error) {
+	rows, err := u.client.Queries().FindUserRecords(ctx, db.FindUserRecordsParams{
+		AfterIdentityNumber: after,
+		PageLimit:           limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]models.UserRecord, 0, len(rows))
+	for _, row := range rows {
+		records = append(records, models.UserRecord{
+			IdentityNumber: row.IdentityNumber,
+			PersonalCode:   row.PersonalCode,
+			FirstName:      row.FirstName,
+			LastName:       row.LastName,
+			Roles:          row.RoleNames,
+			Scopes:         row.ScopeNames,
+		})
+	}
+
+	return records, nil
+}
+
+func (u *user) AssignRoles(ctx context.Context, params db.AddUserRolesParams) (*models.User, error) {
+	return u.changeLinks(ctx, params.UserID, func(q *db.Queries) error {
+		return q.AddUserRoles(ctx, params)
+	})
+}
+
+func (u *user) RevokeRoles(ctx context.Context, params db.RemoveUserRolesParams) (*models.User, error) {
+	return u.changeLinks(ctx, params.UserID, func(q *db.Queries) error {
+		return q.RemoveUserRoles(ctx, params)
+	})
+}
+
+// SetRoles replaces the roles of the user, an empty list removes all of them
+func (u *user) SetRoles(ctx context.Context, params db.CreateUserRolesParams) (*models.User, error) {
+	return u.changeLinks(ctx, params.UserID, func(q *db.Queries) error {
+		_, err := q.CreateUserRoles(ctx, params)
+		return err
+	})
+}
+
+func (u *user) AssignScopes(ctx context.Context, params db.AddUserScopesParams) (*models.User, error) {
+	return u.changeLinks(ctx, params.UserID, func(q *db.Queries) error {
+		return q.AddUserScopes(ctx, params)
+	})
+}
+
+func (u *user) RevokeScopes(ctx context.Context, params db.RemoveUserScopesParams) (*models.User, error) {
+	return u.changeLinks(ctx, params.UserID, func(q *db.Queries) error {
+		return q.RemoveUserScopes(ctx, params)
+	})
+}
+
+// SetScopes replaces the scopes of the user, an empty list removes all of them
+func (u *user) SetScopes(ctx context.Context, params db.CreateUserScopesParams) (*models.User, error) {
+	return u.changeLinks(ctx, params.UserID, func(q *db.Queries) error {
+		_, err := q.CreateUserScopes(ctx, params)
+		return err
+	})
+}
+
+// changeLinks applies the change and reads back the user details within one transaction
+func (u *user) changeLinks(ctx context.Context, id uuid.UUID, change func(q *db.Queries) error) (*models.User, error) {
+	tx, err := u.client.Db().Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := u.client.Queries().WithTx(tx)
+
+	if err = change(q); err != nil {
+		return nil, err
+	}
+
+	result, err := q.FindUserDetailsById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.User{
+		ID:             result.ID,
+		IdentityNumber: result.IdentityNumber,
+		PersonalCode:   result.PersonalCode,
+		FirstName:      result.FirstName,
+		LastName:       result.LastName,
+
+		Status:          string(result.Status),
+		StatusReason:    result.StatusReason.String,
+		StatusChangedAt: result.StatusChangedAt.Time,
+
+		RoleIDs:  result.RoleIds,
+		ScopeIDs: result.ScopeIds,
+	}, tx.Commit(ctx)
+}
@@ -43,6 +43,36 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AssignRoles mocks base method.
+func (m *MockUserRepository) AssignRoles(ctx context.Context, params db.AddUserRolesParams) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRoles", ctx, params)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignRoles indicates an expected call of AssignRoles.
+func (mr *MockUserRepositoryMockRecorder) AssignRoles(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRoles", reflect.TypeOf((*MockUserRepository)(nil).AssignRoles), ctx, params)
+}
+
+// AssignScopes mocks base method.
+func (m *MockUserRepository) AssignScopes(ctx context.Context, params db.AddUserScopesParams) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignScopes", ctx, params)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignScopes indicates an expected call of AssignScopes.
+func (mr *MockUserRepositoryMockRecorder) AssignScopes(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignScopes", reflect.TypeOf((*MockUserRepository)(nil).AssignScopes), ctx, params)
+}
+
 // Create mocks base method.
 func (m *MockUserRepository) Create(ctx context.Context, params db.CreateUserParams) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +133,21 @@ func (mr *MockUserRepositoryMockRecorder) FindByIdentityNumber(ctx, identityNumb
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdentityNumber", reflect.TypeOf((*MockUserRepository)(nil).FindByIdentityNumber), ctx, identityNumber)
 }
 
+// FindByIdentityNumbers mocks base method.
+func (m *MockUserRepository) FindByIdentityNumbers(ctx context.Context, identityNumbers []string) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdentityNumbers", ctx, identityNumbers)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdentityNumbers indicates an expected call of FindByIdentityNumbers.
+func (mr *MockUserRepositoryMockRecorder) FindByIdentityNumbers(ctx, identityNumbers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdentityNumbers", reflect.TypeOf((*MockUserRepository)(nil).FindByIdentityNumbers), ctx, identityNumbers)
+}
+
 // FindUserDetailsById mocks base method.
 func (m *MockUserRepository) FindUserDetailsById(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +163,21 @@ func (mr *MockUserRepositoryMockRecorder) FindUserDetailsById(ctx, id any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserDetailsById", reflect.TypeOf((*MockUserRepository)(nil).FindUserDetailsById), ctx, id)
 }
 
+// Import mocks base method.
+func (m *MockUserRepository) Import(ctx context.Context, users []models.User) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, users)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockUserRepositoryMockRecorder) Import(ctx, users any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockUserRepository)(nil).Import), ctx, users)
+}
+
 // List mocks base method.
 func (m *MockUserRepository) List(ctx context.Context, limit, offset uint64) ([]models.User, uint64, error) {
 	m.ctrl.T.Helper()
@@ -134,6 +194,96 @@ func (mr *MockUserRepositoryMockRecorder) List(ctx, limit, offset any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), ctx, limit, offset)
 }
 
+// ListRecords mocks base method.
+func (m *MockUserRepository) ListRecords(ctx context.Context, after string, limit uint64) ([]models.UserRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecords", ctx, after, limit)
+	ret0, _ := ret[0].([]models.UserRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecords indicates an expected call of ListRecords.
+func (mr *MockUserRepositoryMockRecorder) ListRecords(ctx, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecords", reflect.TypeOf((*MockUserRepository)(nil).ListRecords), ctx, after, limit)
+}
+
+// Provision mocks base method.
+func (m *MockUserRepository) Provision(ctx context.Context, params *models.User) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Provision", ctx, params)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Provision indicates an expected call of Provision.
+func (mr *MockUserRepositoryMockRecorder) Provision(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Provision", reflect.TypeOf((*MockUserRepository)(nil).Provision), ctx, params)
+}
+
+// RevokeRoles mocks base method.
+func (m *MockUserRepository) RevokeRoles(ctx context.Context, params db.RemoveUserRolesParams) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRoles", ctx, params)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeRoles indicates an expected call of RevokeRoles.
+func (mr *MockUserRepositoryMockRecorder) RevokeRoles(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRoles", reflect.TypeOf((*MockUserRepository)(nil).RevokeRoles), ctx, params)
+}
+
+// RevokeScopes mocks base method.
+func (m *MockUserRepository) RevokeScopes(ctx context.Context, params db.RemoveUserScopesParams) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeScopes", ctx, params)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeScopes indicates an expected call of RevokeScopes.
+func (mr *MockUserRepositoryMockRecorder) RevokeScopes(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeScopes", reflect.TypeOf((*MockUserRepository)(nil).RevokeScopes), ctx, params)
+}
+
+// SetRoles mocks base method.
+func (m *MockUserRepository) SetRoles(ctx context.Context, params db.CreateUserRolesParams) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoles", ctx, params)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRoles indicates an expected call of SetRoles.
+func (mr *MockUserRepositoryMockRecorder) SetRoles(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoles", reflect.TypeOf((*MockUserRepository)(nil).SetRoles), ctx, params)
+}
+
+// SetScopes mocks base method.
+func (m *MockUserRepository) SetScopes(ctx context.Context, params db.CreateUserScopesParams) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScopes", ctx, params)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScopes indicates an expected call of SetScopes.
+func (mr *MockUserRepositoryMockRecorder) SetScopes(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScopes", reflect.TypeOf((*MockUserRepository)(nil).SetScopes), ctx, params)
+}
+
 // Update mocks base method.
 func (m *MockUserRepository) Update(ctx context.Context, params db.UpdateUserParams) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -148,3 +298,18 @@ func (mr *MockUserRepositoryMockRecorder) Update(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), ctx, params)
 }
+
+// UpdateStatus mocks base method.
+func (m *MockUserRepository) UpdateStatus(ctx context.Context, params db.UpdateUserStatusParams) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, params)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockUserRepositoryMockRecorder) UpdateStatus(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockUserRepository)(nil).UpdateStatus), ctx, params)
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 
 	"loki/internal/app/models"
@@ -197,6 +198,57 @@ func Test_UserRepository_Create(t *testing.T) {
 	}
 }
 
+func Test_UserRepository_Provision(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	repo := NewUserRepository(client)
+
+	roleId := uuid.MustParse("10000000-1000-1000-1000-000000000003")
+	scopeId := uuid.MustParse("10000000-1000-1000-2000-000000000002")
+
+	user, err := repo.Provision(ctx, &models.User{
+		IdentityNumber: "PNOEE-30303039914",
+		PersonalCode:   "30303039914",
+		FirstName:      "TESTNUMBER",
+		LastName:       "OK",
+		RoleIDs:        []uuid.UUID{roleId},
+		ScopeIDs:       []uuid.UUID{scopeId},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.UserActive, user.Status)
+	defer repo.Delete(ctx, user.ID)
+
+	t.Run("Grants roles and scopes", func(t *testing.T) {
+		details, err := repo.FindUserDetailsById(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{roleId}, details.RoleIDs)
+		assert.Equal(t, []uuid.UUID{scopeId}, details.ScopeIDs)
+	})
+
+	t.Run("Refreshes names and keeps links", func(t *testing.T) {
+		result, err := repo.Provision(ctx, &models.User{
+			IdentityNumber: "PNOEE-30303039914",
+			PersonalCode:   "30303039914",
+			FirstName:      "JOHN",
+			LastName:       "DOE",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, user.ID, result.ID)
+		assert.Equal(t, "JOHN", result.FirstName)
+
+		details, err := repo.FindUserDetailsById(ctx, user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{roleId}, details.RoleIDs)
+		assert.Equal(t, []uuid.UUID{scopeId}, details.ScopeIDs)
+	})
+}
+
 func Test_UserRepository_Update(t *testing.T) {
 	ctx := context.Background()
 	cfg := &config.Config{
@@ -227,11 +279,15 @@ func Test_UserRepository_Update(t *testing.T) {
 			name:   "Success",
 			before: func() {},
 			params: db.UpdateUserParams{
-				ID:             account.ID,
-				IdentityNumber: "PNOEE-30303039914",
-				PersonalCode:   "30303039914",
-				FirstName:      "JOHN",
-				LastName:       "DOE",
+				ID:                   account.ID,
+				UpdateIdentityNumber: true,
+				IdentityNumber:       "PNOEE-30303039914",
+				UpdatePersonalCode:   true,
+				PersonalCode:         "30303039914",
+				UpdateFirstName:      true,
+				FirstName:            "JOHN",
+				UpdateLastName:       true,
+				LastName:             "DOE",
 			},
 			expected: &models.User{
 				ID:             account.ID,
@@ -246,11 +302,15 @@ func Test_UserRepository_Update(t *testing.T) {
 			name:   "User not found",
 			before: func() {},
 			params: db.UpdateUserParams{
-				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				IdentityNumber: "PNOEE-30303039914",
-				PersonalCode:   "30303039914",
-				FirstName:      "JOHN",
-				LastName:       "DOE",
+				ID:                   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				UpdateIdentityNumber: true,
+				IdentityNumber:       "PNOEE-30303039914",
+				UpdatePersonalCode:   true,
+				PersonalCode:         "30303039914",
+				UpdateFirstName:      true,
+				FirstName:            "JOHN",
+				UpdateLastName:       true,
+				LastName:             "DOE",
 			},
 			expected: nil,
 			error:    true,
@@ -259,11 +319,15 @@ func Test_UserRepository_Update(t *testing.T) {
 			name:   "Invalid identity number",
 			before: func() {},
 			params: db.UpdateUserParams{
-				ID:             account.ID,
-				IdentityNumber: "",
-				PersonalCode:   "30303039914",
-				FirstName:      "JOHN",
-				LastName:       "DOE",
+				ID:                   account.ID,
+				UpdateIdentityNumber: true,
+				IdentityNumber:       "",
+				UpdatePersonalCode:   true,
+				PersonalCode:         "30303039914",
+				UpdateFirstName:      true,
+				FirstName:            "JOHN",
+				UpdateLastName:       true,
+				LastName:             "DOE",
 			},
 			expected: nil,
 			error:    true,
@@ -406,6 +470,83 @@ func Test_UserRepository_Delete(t *testing.T) {
 	}
 }
 
+func Test_UserRepository_UpdateStatus(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	userRepository := NewUserRepository(client)
+	tokenRepository := NewTokenRepository(client)
+
+	account, err := userRepository.Create(ctx, db.CreateUserParams{
+		IdentityNumber: "PNOEE-30303039914",
+		PersonalCode:   "30303039914",
+		FirstName:      "TESTNUMBER",
+		LastName:       "OK",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.UserActive, account.Status)
+	defer userRepository.Delete(ctx, account.ID)
+
+	tokens, err := tokenRepository.Create(ctx, db.CreateTokensParams{
+		UserID:            account.ID,
+		AccessTokenValue:  "aaa.bbb.ccc",
+		RefreshTokenValue: "ddd.eee.fff",
+	})
+	assert.NoError(t, err)
+
+	t.Run("Suspend", func(t *testing.T) {
+		user, err := userRepository.UpdateStatus(ctx, db.UpdateUserStatusParams{
+			ID:           account.ID,
+			Status:       db.UserStatusSuspended,
+			StatusReason: pgtype.Text{String: "Fraud investigation", Valid: true},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, models.UserSuspended, user.Status)
+		assert.Equal(t, "Fraud investigation", user.StatusReason)
+		assert.False(t, user.StatusChangedAt.IsZero())
+
+		for _, token := range tokens {
+			_, err = tokenRepository.FindById(ctx, token.ID)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("Login keeps status", func(t *testing.T) {
+		user, err := userRepository.Create(ctx, db.CreateUserParams{
+			IdentityNumber: "PNOEE-30303039914",
+			PersonalCode:   "30303039914",
+			FirstName:      "TESTNUMBER",
+			LastName:       "OK",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, account.ID, user.ID)
+		assert.Equal(t, models.UserSuspended, user.Status)
+	})
+
+	t.Run("Activate", func(t *testing.T) {
+		user, err := userRepository.UpdateStatus(ctx, db.UpdateUserStatusParams{
+			ID:     account.ID,
+			Status: db.UserStatusActive,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, models.UserActive, user.Status)
+		assert.Empty(t, user.StatusReason)
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		_, err := userRepository.UpdateStatus(ctx, db.UpdateUserStatusParams{
+			ID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Status: db.UserStatusLocked,
+		})
+		assert.Error(t, err)
+	})
+}
+
 func Test_UserRepository_FindByIdentityNumber(t *testing.T) {
 	ctx := context.Background()
 	cfg := &config.Config{
@@ -547,3 +688,193 @@ func Test_UserRepository_FindUserDetailsById(t *testing.T) {
 		})
 	}
 }
+
+func Test_UserRepository_Links(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	userRepository := NewUserRepository(client)
+
+	managerRoleId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+	userRoleId := uuid.MustParse("10000000-1000-1000-1000-000000000003")
+	scopeId := uuid.MustParse("10000000-1000-1000-2000-000000000001")
+
+	account, err := userRepository.Create(ctx, db.CreateUserParams{
+		IdentityNumber: "PNOEE-60001017869",
+		PersonalCode:   "60001017869",
+		FirstName:      "EID2016",
+		LastName:       "TESTNUMBER",
+	})
+	assert.NoError(t, err)
+	defer userRepository.Delete(ctx, account.ID)
+
+	t.Run("Assign roles", func(t *testing.T) {
+		result, err := userRepository.AssignRoles(ctx, db.AddUserRolesParams{
+			UserID:  account.ID,
+			RoleIds: []uuid.UUID{managerRoleId, userRoleId},
+		})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{managerRoleId, userRoleId}, result.RoleIDs)
+	})
+
+	t.Run("Revoke roles", func(t *testing.T) {
+		result, err := userRepository.RevokeRoles(ctx, db.RemoveUserRolesParams{
+			UserID:  account.ID,
+			RoleIds: []uuid.UUID{managerRoleId},
+		})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{userRoleId}, result.RoleIDs)
+	})
+
+	t.Run("Set roles over an elevated grant", func(t *testing.T) {
+		_, err := client.Queries().GrantUserRole(ctx, db.GrantUserRoleParams{
+			UserID:          account.ID,
+			RoleID:          managerRoleId,
+			DurationMinutes: -1,
+		})
+		assert.NoError(t, err)
+
+		result, err := userRepository.SetRoles(ctx, db.CreateUserRolesParams{
+			UserID:    account.ID,
+			RoleIds:   []uuid.UUID{managerRoleId, userRoleId},
+			GrantedBy: account.ID,
+		})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{managerRoleId, userRoleId}, result.RoleIDs)
+
+		grants, err := client.Queries().DeleteExpiredUserRoles(ctx)
+		assert.NoError(t, err)
+		for _, grant := range grants {
+			assert.NotEqual(t, account.ID, grant.UserID)
+		}
+	})
+
+	t.Run("Set roles to empty", func(t *testing.T) {
+		result, err := userRepository.SetRoles(ctx, db.CreateUserRolesParams{
+			UserID:  account.ID,
+			RoleIds: []uuid.UUID{},
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, result.RoleIDs)
+	})
+
+	t.Run("Assign, set and revoke scopes", func(t *testing.T) {
+		result, err := userRepository.AssignScopes(ctx, db.AddUserScopesParams{
+			UserID:   account.ID,
+			ScopeIds: []uuid.UUID{scopeId},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{scopeId}, result.ScopeIDs)
+
+		result, err = userRepository.SetScopes(ctx, db.CreateUserScopesParams{
+			UserID:   account.ID,
+			ScopeIds: []uuid.UUID{scopeId},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{scopeId}, result.ScopeIDs)
+
+		result, err = userRepository.RevokeScopes(ctx, db.RemoveUserScopesParams{
+			UserID:   account.ID,
+			ScopeIds: []uuid.UUID{scopeId},
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, result.ScopeIDs)
+	})
+}
+
+func Test_UserRepository_Import(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{
+		DatabaseDSN: os.Getenv("DATABASE_DSN"),
+	}
+
+	client, err := postgres.NewPostgresClient(cfg)
+	assert.NoError(t, err)
+
+	userRepository := NewUserRepository(client)
+
+	managerRoleId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+	userRoleId := uuid.MustParse("10000000-1000-1000-1000-000000000003")
+	scopeId := uuid.MustParse("10000000-1000-1000-2000-000000000002")
+
+	existing, err := userRepository.Create(ctx, db.CreateUserParams{
+		IdentityNumber: "PNOEE-60001017869",
+		PersonalCode:   "60001017869",
+		FirstName:      "EID2016",
+		LastName:       "TESTNUMBER",
+	})
+	assert.NoError(t, err)
+	defer userRepository.Delete(ctx, existing.ID)
+
+	imported, err := userRepository.Import(ctx, []models.User{
+		{
+			IdentityNumber: "PNOEE-30303039914",
+			PersonalCode:   "30303039914",
+			FirstName:      "John",
+			LastName:       "Doe",
+			RoleIDs:        []uuid.UUID{managerRoleId, userRoleId},
+			ScopeIDs:       []uuid.UUID{scopeId},
+		},
+		{
+			IdentityNumber: "PNOEE-60001017869",
+			PersonalCode:   "60001017869",
+			FirstName:      "Jane",
+			LastName:       "Doe",
+			RoleIDs:        []uuid.UUID{},
+			ScopeIDs:       []uuid.UUID{},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, imported, 2)
+	defer userRepository.Delete(ctx, imported[0].ID)
+
+	t.Run("Upserts by identity number", func(t *testing.T) {
+		assert.Equal(t, existing.ID, imported[1].ID)
+
+		details, err := userRepository.FindUserDetailsById(ctx, existing.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "Jane", details.FirstName)
+		assert.Empty(t, details.RoleIDs)
+		assert.Empty(t, details.ScopeIDs)
+	})
+
+	t.Run("Find by identity numbers", func(t *testing.T) {
+		users, err := userRepository.FindByIdentityNumbers(ctx, []string{"PNOEE-30303039914", "PNOEE-39001010110"})
+		assert.NoError(t, err)
+		assert.Len(t, users, 1)
+		assert.Equal(t, imported[0].ID, users[0].ID)
+	})
+
+	t.Run("List records", func(t *testing.T) {
+		records, err := userRepository.ListRecords(ctx, "", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []models.UserRecord{
+			{
+				IdentityNumber: "PNOEE-30303039914",
+				PersonalCode:   "30303039914",
+				FirstName:      "John",
+				LastName:       "Doe",
+				Roles:          []string{models.ManagerRoleType, models.UserRoleType},
+				Scopes:         []string{models.SelfServiceType},
+			},
+			{
+				IdentityNumber: "PNOEE-60001017869",
+				PersonalCode:   "60001017869",
+				FirstName:      "Jane",
+				LastName:       "Doe",
+				Roles:          []string{},
+				Scopes:         []string{},
+			},
+		}, records)
+
+		records, err = userRepository.ListRecords(ctx, "PNOEE-30303039914", 10)
+		assert.NoError(t, err)
+		assert.Len(t, records, 1)
+		assert.Equal(t, "PNOEE-60001017869", records[0].IdentityNumber)
+	})
+}
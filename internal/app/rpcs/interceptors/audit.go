@@ -0,0 +1,205 @@
+package interceptors
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"loki/internal/app/models"
+	"loki/internal/app/services"
+	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
+)
+
+const mutatingPermissionPrefix = "write:"
+
+// Snapshot loads the current state of a record, it is used to diff mutating calls
+type Snapshot func(ctx context.Context, id string) (proto.Message, error)
+
+type AuditInterceptor interface {
+	Audit(permissions map[string]string, snapshots map[string]Snapshot) grpc.UnaryServerInterceptor
+	AuditStream(permissions map[string]string) grpc.StreamServerInterceptor
+}
+
+type auditInterceptor struct {
+	audit services.Audit
+	log   *logger.Logger
+}
+
+func NewAuditInterceptor(audit services.Audit, log *logger.Logger) AuditInterceptor {
+	return &auditInterceptor{
+		audit: audit,
+		log:   log,
+	}
+}
+
+// Audit records every call to a method guarded by a write permission
+func (i *auditInterceptor) Audit(permissions map[string]string, snapshots map[string]Snapshot) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(permissions[info.FullMethod], mutatingPermissionPrefix) {
+			return handler(ctx, req)
+		}
+
+		service, _, _ := strings.Cut(strings.TrimPrefix(info.FullMethod, "/"), "/")
+		load := snapshots[service]
+
+		var targetId string
+		if r, ok := req.(identifiedRequest); ok {
+			targetId = r.GetId()
+		}
+
+		before := i.snapshot(ctx, load, targetId)
+
+		resp, err := handler(ctx, req)
+
+		event := &models.AuditEvent{
+			Action:     info.FullMethod,
+			TargetType: service,
+			TargetID:   targetId,
+			Status:     models.AuditSuccess,
+			RemoteAddr: remoteAddr(ctx),
+		}
+		event.TraceID, _ = middlewares.CurrentTraceIdFromContext(ctx)
+
+		if user, ok := middlewares.CurrentUserFromContext(ctx); ok {
+			event.ActorID = user.ID
+		}
+
+		if err != nil {
+			event.Status = models.AuditFailure
+			event.Metadata = map[string]string{"code": status.Code(err).String()}
+		} else {
+			if event.TargetID == "" {
+				event.TargetID = responseId(resp)
+			}
+
+			event.Changes = diff(before, i.snapshot(ctx, load, event.TargetID))
+		}
+
+		i.audit.Record(ctx, event)
+
+		return resp, err
+	}
+}
+
+// AuditStream records every streaming call to a method guarded by a write permission, streams have no single target
+func (i *auditInterceptor) AuditStream(permissions map[string]string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !strings.HasPrefix(permissions[info.FullMethod], mutatingPermissionPrefix) {
+			return handler(srv, stream)
+		}
+
+		err := handler(srv, stream)
+
+		ctx := stream.Context()
+		service, _, _ := strings.Cut(strings.TrimPrefix(info.FullMethod, "/"), "/")
+
+		event := &models.AuditEvent{
+			Action:     info.FullMethod,
+			TargetType: service,
+			Status:     models.AuditSuccess,
+			RemoteAddr: remoteAddr(ctx),
+		}
+		event.TraceID, _ = middlewares.CurrentTraceIdFromContext(ctx)
+
+		if user, ok := middlewares.CurrentUserFromContext(ctx); ok {
+			event.ActorID = user.ID
+		}
+
+		if err != nil {
+			event.Status = models.AuditFailure
+			event.Metadata = map[string]string{"code": status.Code(err).String()}
+		}
+
+		i.audit.Record(ctx, event)
+
+		return err
+	}
+}
+
+// snapshot returns the record as a JSON object, nil when it cannot be loaded
+func (i *auditInterceptor) snapshot(ctx context.Context, load Snapshot, id string) map[string]any {
+	if load == nil || id == "" {
+		return nil
+	}
+
+	message, err := load(ctx, id)
+	if err != nil || message == nil {
+		return nil
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		i.log.Error().Err(err).Msg("Failed to encode audit snapshot")
+		return nil
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal(data, &result); err != nil {
+		i.log.Error().Err(err).Msg("Failed to decode audit snapshot")
+		return nil
+	}
+
+	return result
+}
+
+// diff returns the before and after values of the top-level fields that changed
+func diff(before, after map[string]any) map[string]any {
+	changes := map[string]any{}
+
+	for key, value := range before {
+		if !reflect.DeepEqual(value, after[key]) {
+			changes[key] = map[string]any{"before": value, "after": after[key]}
+		}
+	}
+
+	for key, value := range after {
+		if _, ok := before[key]; !ok {
+			changes[key] = map[string]any{"before": nil, "after": value}
+		}
+	}
+
+	return changes
+}
+
+// responseId returns the id of the record in the response data, used for create calls
+func responseId(resp interface{}) string {
+	message, ok := resp.(proto.Message)
+	if !ok || message == nil {
+		return ""
+	}
+
+	reflection := message.ProtoReflect()
+	if !reflection.IsValid() {
+		return ""
+	}
+
+	data := reflection.Descriptor().Fields().ByName("data")
+	if data == nil || data.Message() == nil || data.IsList() || !reflection.Has(data) {
+		return ""
+	}
+
+	record := reflection.Get(data).Message()
+	id := record.Descriptor().Fields().ByName("id")
+	if id == nil {
+		return ""
+	}
+
+	return record.Get(id).String()
+}
+
+func remoteAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	return p.Addr.String()
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/rpcs/interceptors/audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/rpcs/interceptors/audit.go -destination=internal/app/rpcs/interceptors/audit_mock.go -package=interceptors
+//
+
+// Package interceptors is a generated GoMock package.
+package interceptors
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockAuditInterceptor is a mock of AuditInterceptor interface.
+type MockAuditInterceptor struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditInterceptorMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditInterceptorMockRecorder is the mock recorder for MockAuditInterceptor.
+type MockAuditInterceptorMockRecorder struct {
+	mock *MockAuditInterceptor
+}
+
+// NewMockAuditInterceptor creates a new mock instance.
+func NewMockAuditInterceptor(ctrl *gomock.Controller) *MockAuditInterceptor {
+	mock := &MockAuditInterceptor{ctrl: ctrl}
+	mock.recorder = &MockAuditInterceptorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditInterceptor) EXPECT() *MockAuditInterceptorMockRecorder {
+	return m.recorder
+}
+
+// Audit mocks base method.
+func (m *MockAuditInterceptor) Audit(permissions map[string]string, snapshots map[string]Snapshot) grpc.UnaryServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Audit", permissions, snapshots)
+	ret0, _ := ret[0].(grpc.UnaryServerInterceptor)
+	return ret0
+}
+
+// Audit indicates an expected call of Audit.
+func (mr *MockAuditInterceptorMockRecorder) Audit(permissions, snapshots any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Audit", reflect.TypeOf((*MockAuditInterceptor)(nil).Audit), permissions, snapshots)
+}
+
+// AuditStream mocks base method.
+func (m *MockAuditInterceptor) AuditStream(permissions map[string]string) grpc.StreamServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditStream", permissions)
+	ret0, _ := ret[0].(grpc.StreamServerInterceptor)
+	return ret0
+}
+
+// AuditStream indicates an expected call of AuditStream.
+func (mr *MockAuditInterceptorMockRecorder) AuditStream(permissions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditStream", reflect.TypeOf((*MockAuditInterceptor)(nil).AuditStream), permissions)
+}
@@ -0,0 +1,229 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"loki/internal/app/models"
+	proto "loki/internal/app/rpcs/proto/sso/v1"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
+	"loki/pkg/rbac"
+)
+
+func Test_AuditInterceptor_Audit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	audit := services.NewMockAudit(ctrl)
+
+	roleId := uuid.New().String()
+	actor := &models.User{ID: uuid.New()}
+	roles := map[string]*proto.Role{
+		roleId: {Id: roleId, Name: "admin", Description: "Admin role"},
+	}
+
+	interceptor := NewAuditInterceptor(audit, log).Audit(
+		map[string]string{
+			proto.RoleService_List_FullMethodName:   rbac.ReadRoles,
+			proto.RoleService_Create_FullMethodName: rbac.WriteRoles,
+			proto.RoleService_Update_FullMethodName: rbac.WriteRoles,
+		},
+		map[string]Snapshot{
+			proto.RoleService_ServiceDesc.ServiceName: func(ctx context.Context, id string) (protobuf.Message, error) {
+				role, ok := roles[id]
+				if !ok {
+					return nil, status.Error(codes.NotFound, "not found")
+				}
+
+				return protobuf.Clone(role), nil
+			},
+		},
+	)
+
+	ctx := middlewares.NewContextModifier(context.Background()).
+		WithCurrentUser(actor).
+		WithTraceId("trace-id").
+		Context()
+
+	tests := []struct {
+		name     string
+		method   string
+		req      interface{}
+		handler  grpc.UnaryHandler
+		code     codes.Code
+		expected *models.AuditEvent
+	}{
+		{
+			name:   "Read call is not recorded",
+			method: proto.RoleService_List_FullMethodName,
+			req:    &proto.PaginatedListRequest{},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &proto.ListRolesResponse{}, nil
+			},
+		},
+		{
+			name:   "Update records changed fields",
+			method: proto.RoleService_Update_FullMethodName,
+			req:    &proto.UpdateRoleRequest{Id: roleId, Name: "owner"},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				roles[roleId].Name = "owner"
+				return &proto.UpdateRoleResponse{Data: roles[roleId]}, nil
+			},
+			expected: &models.AuditEvent{
+				Action:     proto.RoleService_Update_FullMethodName,
+				ActorID:    actor.ID,
+				TargetType: proto.RoleService_ServiceDesc.ServiceName,
+				TargetID:   roleId,
+				Status:     models.AuditSuccess,
+				Changes: map[string]any{
+					"name": map[string]any{"before": "admin", "after": "owner"},
+				},
+				TraceID: "trace-id",
+			},
+		},
+		{
+			name:   "Create takes the target from the response",
+			method: proto.RoleService_Create_FullMethodName,
+			req:    &proto.CreateRoleRequest{Name: "auditor"},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				roles["created"] = &proto.Role{Id: "created", Name: "auditor"}
+				return &proto.CreateRoleResponse{Data: roles["created"]}, nil
+			},
+			expected: &models.AuditEvent{
+				Action:     proto.RoleService_Create_FullMethodName,
+				ActorID:    actor.ID,
+				TargetType: proto.RoleService_ServiceDesc.ServiceName,
+				TargetID:   "created",
+				Status:     models.AuditSuccess,
+				Changes: map[string]any{
+					"id":   map[string]any{"before": nil, "after": "created"},
+					"name": map[string]any{"before": nil, "after": "auditor"},
+				},
+				TraceID: "trace-id",
+			},
+		},
+		{
+			name:   "Failed call",
+			method: proto.RoleService_Update_FullMethodName,
+			req:    &proto.UpdateRoleRequest{Id: roleId},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, status.Error(codes.InvalidArgument, "invalid")
+			},
+			code: codes.InvalidArgument,
+			expected: &models.AuditEvent{
+				Action:     proto.RoleService_Update_FullMethodName,
+				ActorID:    actor.ID,
+				TargetType: proto.RoleService_ServiceDesc.ServiceName,
+				TargetID:   roleId,
+				Status:     models.AuditFailure,
+				Metadata:   map[string]string{"code": codes.InvalidArgument.String()},
+				TraceID:    "trace-id",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expected != nil {
+				audit.EXPECT().Record(gomock.Any(), tt.expected)
+			}
+
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, tt.handler)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func Test_AuditInterceptor_AuditStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	audit := services.NewMockAudit(ctrl)
+	actor := &models.User{ID: uuid.New()}
+
+	interceptor := NewAuditInterceptor(audit, log).AuditStream(map[string]string{
+		proto.UserService_Import_FullMethodName: rbac.WriteUsers,
+		proto.UserService_Export_FullMethodName: rbac.ReadUsers,
+	})
+
+	stream := &serverStream{
+		ctx: middlewares.NewContextModifier(context.Background()).
+			WithCurrentUser(actor).
+			WithTraceId("trace-id").
+			Context(),
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		err      error
+		expected *models.AuditEvent
+	}{
+		{
+			name:   "Read stream is not recorded",
+			method: proto.UserService_Export_FullMethodName,
+		},
+		{
+			name:   "Write stream",
+			method: proto.UserService_Import_FullMethodName,
+			expected: &models.AuditEvent{
+				Action:     proto.UserService_Import_FullMethodName,
+				ActorID:    actor.ID,
+				TargetType: proto.UserService_ServiceDesc.ServiceName,
+				Status:     models.AuditSuccess,
+				TraceID:    "trace-id",
+			},
+		},
+		{
+			name:   "Failed stream",
+			method: proto.UserService_Import_FullMethodName,
+			err:    status.Error(codes.InvalidArgument, "invalid"),
+			expected: &models.AuditEvent{
+				Action:     proto.UserService_Import_FullMethodName,
+				ActorID:    actor.ID,
+				TargetType: proto.UserService_ServiceDesc.ServiceName,
+				Status:     models.AuditFailure,
+				Metadata:   map[string]string{"code": codes.InvalidArgument.String()},
+				TraceID:    "trace-id",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expected != nil {
+				audit.EXPECT().Record(gomock.Any(), tt.expected)
+			}
+
+			err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: tt.method}, func(srv interface{}, stream grpc.ServerStream) error {
+				return tt.err
+			})
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/services"
 	"loki/internal/config/logger"
 	"loki/internal/config/middlewares"
@@ -52,6 +53,11 @@ func (i *authenticationInterceptor) Authenticate(ctx context.Context) (context.C
 		return nil, status.Errorf(codes.Unauthenticated, "invalid auth token: %v", err)
 	}
 
+	if !user.CanAuthenticate() {
+		i.log.Warn().Msgf("User %s is %s", user.ID, user.Status)
+		return nil, status.Error(codes.Unauthenticated, errors.ErrUserInactive.Error())
+	}
+
 	if !rbac.HasScope(claims.Scope) {
 		i.log.Error().Msgf("User %s does not have required scope: %s", claims.ID, rbac.SsoServiceType)
 		return nil, status.Errorf(codes.PermissionDenied, "missing required scope")
@@ -146,6 +146,33 @@ func Test_AuthenticationInterceptor_Authenticate(t *testing.T) {
 				error:  true,
 			},
 		},
+		{
+			name: "Locked user",
+			ctx: func() context.Context {
+				md := metadata.New(map[string]string{
+					"authorization": "Bearer " + token,
+				})
+				return metadata.NewIncomingContext(context.Background(), md)
+			},
+			before: func() {
+				mockJWT.EXPECT().Decode(token).Return(&jwt.Payload{
+					ID:          identityNumber,
+					Permissions: []string{"read:users"},
+					Roles:       []string{"admin"},
+					Scope:       []string{"sso-service"},
+				}, nil)
+				mockUsers.EXPECT().FindByIdentityNumber(gomock.Any(), identityNumber).Return(&models.User{
+					ID:             userId,
+					IdentityNumber: identityNumber,
+					Status:         models.UserLocked,
+				}, nil)
+			},
+			expected: result{
+				code:   codes.Unauthenticated,
+				userId: uuid.Nil,
+				error:  true,
+			},
+		},
 		{
 			name: "Missing required scope",
 			ctx: func() context.Context {
@@ -0,0 +1,163 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"loki/internal/app/errors"
+	proto "loki/internal/app/rpcs/proto/sso/v1"
+	"loki/internal/app/services"
+	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
+	"loki/pkg/policy"
+	"loki/pkg/rbac"
+)
+
+type AuthorizationInterceptor interface {
+	Authorize(permissions map[string]string) grpc.UnaryServerInterceptor
+	AuthorizeStream(permissions map[string]string) grpc.StreamServerInterceptor
+}
+
+type authorizationInterceptor struct {
+	policies services.Policies
+	users    services.Users
+	log      *logger.Logger
+}
+
+func NewAuthorizationInterceptor(policies services.Policies, users services.Users, log *logger.Logger) AuthorizationInterceptor {
+	return &authorizationInterceptor{
+		policies: policies,
+		users:    users,
+		log:      log,
+	}
+}
+
+// organisationRequest is implemented by requests scoped to a single organisation
+type organisationRequest interface {
+	GetOrganisationId() string
+}
+
+// identifiedRequest is implemented by requests targeting a single record
+type identifiedRequest interface {
+	GetId() string
+}
+
+// Authorize denies calls to methods without a permission mapping
+func (i *authorizationInterceptor) Authorize(permissions map[string]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := i.authorize(ctx, permissions, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AuthorizeStream checks the permission before the stream is opened, streaming calls are always global
+func (i *authorizationInterceptor) AuthorizeStream(permissions map[string]string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := i.authorize(stream.Context(), permissions, info.FullMethod, nil); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+func (i *authorizationInterceptor) authorize(ctx context.Context, permissions map[string]string, method string, req interface{}) error {
+	claim, ok := middlewares.CurrentClaimFromContext(ctx)
+	if !ok {
+		i.log.Error().Msg("No claims found in context")
+		return status.Error(codes.Unauthenticated, "missing claims")
+	}
+
+	permission, ok := permissions[method]
+	if !ok {
+		i.log.Error().Msgf("No permission configured for method: %s", method)
+		return status.Error(codes.PermissionDenied, "method is not allowed")
+	}
+
+	if !rbac.HasPermission(claim, resource(req), permission) {
+		i.log.Warn().Msgf("User %s does not have required permission: %s", claim.ID, permission)
+		return status.Error(codes.PermissionDenied, "missing required permission")
+	}
+
+	target, err := i.policyResource(ctx, method, req)
+	if err != nil {
+		i.log.Error().Err(err).Msgf("Failed to describe the resource of method: %s", method)
+		return status.Error(codes.Unavailable, "failed to load resource")
+	}
+
+	allowed, err := i.policies.Evaluate(ctx, &policy.Request{
+		Principal: claim,
+		Action:    permission,
+		Resource:  *target,
+		Context: map[string]interface{}{
+			"time":   time.Now(),
+			"method": method,
+		},
+	})
+	if err != nil || !allowed {
+		i.log.Warn().Err(err).Msgf("User %s is denied by policy for method: %s", claim.ID, method)
+		return status.Error(codes.PermissionDenied, "denied by policy")
+	}
+
+	return nil
+}
+
+// resource returns the organisation the request is scoped to, or the global resource
+func resource(req interface{}) string {
+	if r, ok := req.(organisationRequest); ok {
+		return r.GetOrganisationId()
+	}
+
+	return rbac.GlobalResource
+}
+
+// policyResource describes the target of the call, the type is the gRPC service name;
+// calls to UserService carry the status and organisations of the target user as attributes
+func (i *authorizationInterceptor) policyResource(ctx context.Context, fullMethod string, req interface{}) (*policy.Resource, error) {
+	service, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+
+	result := &policy.Resource{
+		Type:           service,
+		OrganisationID: resource(req),
+	}
+
+	if r, ok := req.(identifiedRequest); ok {
+		result.ID = r.GetId()
+	}
+
+	if service != proto.UserService_ServiceDesc.ServiceName || result.ID == "" {
+		return result, nil
+	}
+
+	// unknown users and malformed ids are described without status and organisations
+	result.Attributes = map[string]interface{}{
+		"status":        "",
+		"organisations": []string{},
+	}
+
+	id, err := uuid.Parse(result.ID)
+	if err != nil {
+		return result, nil
+	}
+
+	attributes, err := i.users.FindPolicyAttributes(ctx, id)
+	switch {
+	case errors.Is(err, errors.ErrRecordNotFound):
+		return result, nil
+	case err != nil:
+		return nil, err
+	}
+
+	result.Attributes = attributes
+
+	return result, nil
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/rpcs/interceptors/authorization.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/rpcs/interceptors/authorization.go -destination=internal/app/rpcs/interceptors/authorization_mock.go -package=interceptors
+//
+
+// Package interceptors is a generated GoMock package.
+package interceptors
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockAuthorizationInterceptor is a mock of AuthorizationInterceptor interface.
+type MockAuthorizationInterceptor struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationInterceptorMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorizationInterceptorMockRecorder is the mock recorder for MockAuthorizationInterceptor.
+type MockAuthorizationInterceptorMockRecorder struct {
+	mock *MockAuthorizationInterceptor
+}
+
+// NewMockAuthorizationInterceptor creates a new mock instance.
+func NewMockAuthorizationInterceptor(ctrl *gomock.Controller) *MockAuthorizationInterceptor {
+	mock := &MockAuthorizationInterceptor{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationInterceptorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationInterceptor) EXPECT() *MockAuthorizationInterceptorMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockAuthorizationInterceptor) Authorize(permissions map[string]string) grpc.UnaryServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", permissions)
+	ret0, _ := ret[0].(grpc.UnaryServerInterceptor)
+	return ret0
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockAuthorizationInterceptorMockRecorder) Authorize(permissions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthorizationInterceptor)(nil).Authorize), permissions)
+}
+
+// AuthorizeStream mocks base method.
+func (m *MockAuthorizationInterceptor) AuthorizeStream(permissions map[string]string) grpc.StreamServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeStream", permissions)
+	ret0, _ := ret[0].(grpc.StreamServerInterceptor)
+	return ret0
+}
+
+// AuthorizeStream indicates an expected call of AuthorizeStream.
+func (mr *MockAuthorizationInterceptorMockRecorder) AuthorizeStream(permissions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeStream", reflect.TypeOf((*MockAuthorizationInterceptor)(nil).AuthorizeStream), permissions)
+}
+
+// MockorganisationRequest is a mock of organisationRequest interface.
+type MockorganisationRequest struct {
+	ctrl     *gomock.Controller
+	recorder *MockorganisationRequestMockRecorder
+	isgomock struct{}
+}
+
+// MockorganisationRequestMockRecorder is the mock recorder for MockorganisationRequest.
+type MockorganisationRequestMockRecorder struct {
+	mock *MockorganisationRequest
+}
+
+// NewMockorganisationRequest creates a new mock instance.
+func NewMockorganisationRequest(ctrl *gomock.Controller) *MockorganisationRequest {
+	mock := &MockorganisationRequest{ctrl: ctrl}
+	mock.recorder = &MockorganisationRequestMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockorganisationRequest) EXPECT() *MockorganisationRequestMockRecorder {
+	return m.recorder
+}
+
+// GetOrganisationId mocks base method.
+func (m *MockorganisationRequest) GetOrganisationId() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganisationId")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetOrganisationId indicates an expected call of GetOrganisationId.
+func (mr *MockorganisationRequestMockRecorder) GetOrganisationId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganisationId", reflect.TypeOf((*MockorganisationRequest)(nil).GetOrganisationId))
+}
+
+// MockidentifiedRequest is a mock of identifiedRequest interface.
+type MockidentifiedRequest struct {
+	ctrl     *gomock.Controller
+	recorder *MockidentifiedRequestMockRecorder
+	isgomock struct{}
+}
+
+// MockidentifiedRequestMockRecorder is the mock recorder for MockidentifiedRequest.
+type MockidentifiedRequestMockRecorder struct {
+	mock *MockidentifiedRequest
+}
+
+// NewMockidentifiedRequest creates a new mock instance.
+func NewMockidentifiedRequest(ctrl *gomock.Controller) *MockidentifiedRequest {
+	mock := &MockidentifiedRequest{ctrl: ctrl}
+	mock.recorder = &MockidentifiedRequestMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockidentifiedRequest) EXPECT() *MockidentifiedRequestMockRecorder {
+	return m.recorder
+}
+
+// GetId mocks base method.
+func (m *MockidentifiedRequest) GetId() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetId")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetId indicates an expected call of GetId.
+func (mr *MockidentifiedRequestMockRecorder) GetId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetId", reflect.TypeOf((*MockidentifiedRequest)(nil).GetId))
+}
@@ -0,0 +1,386 @@
+package interceptors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	proto "loki/internal/app/rpcs/proto/sso/v1"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
+	"loki/pkg/jwt"
+	"loki/pkg/policy"
+	"loki/pkg/rbac"
+)
+
+func Test_AuthorizationInterceptor_Authorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	policies := services.NewMockPolicies(ctrl)
+	policies.EXPECT().Evaluate(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+
+	interceptor := NewAuthorizationInterceptor(policies, services.NewMockUsers(ctrl), log).Authorize(map[string]string{
+		"/sso.v1.UserService/List":   rbac.ReadUsers,
+		"/sso.v1.UserService/Delete": rbac.WriteUsers,
+	})
+
+	withClaim := func(permissions ...string) func() context.Context {
+		return func() context.Context {
+			return middlewares.NewContextModifier(context.Background()).
+				WithClaim(&jwt.Payload{
+					ID:          "PNOEE-123456789",
+					Permissions: permissions,
+					Scope:       []string{rbac.SsoServiceType},
+				}).
+				Context()
+		}
+	}
+
+	type result struct {
+		code  codes.Code
+		error bool
+	}
+
+	tests := []struct {
+		name     string
+		ctx      func() context.Context
+		method   string
+		expected result
+	}{
+		{
+			name:   "Success",
+			ctx:    withClaim(rbac.ReadUsers),
+			method: "/sso.v1.UserService/List",
+			expected: result{
+				code:  codes.OK,
+				error: false,
+			},
+		},
+		{
+			name:   "Missing required permission",
+			ctx:    withClaim(rbac.ReadUsers),
+			method: "/sso.v1.UserService/Delete",
+			expected: result{
+				code:  codes.PermissionDenied,
+				error: true,
+			},
+		},
+		{
+			name:   "Method without permission mapping",
+			ctx:    withClaim(rbac.ReadUsers, rbac.WriteUsers),
+			method: "/sso.v1.UserService/Export",
+			expected: result{
+				code:  codes.PermissionDenied,
+				error: true,
+			},
+		},
+		{
+			name:   "Missing claims",
+			ctx:    context.Background,
+			method: "/sso.v1.UserService/List",
+			expected: result{
+				code:  codes.Unauthenticated,
+				error: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "test response", nil
+			}
+
+			resp, err := interceptor(tt.ctx(), "test request", &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			if tt.expected.error {
+				assert.Error(t, err)
+				assert.Nil(t, resp)
+
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expected.code, st.Code())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "test response", resp)
+			}
+		})
+	}
+
+	t.Run("Organisation scoped request", func(t *testing.T) {
+		interceptor := NewAuthorizationInterceptor(policies, services.NewMockUsers(ctrl), log).Authorize(map[string]string{
+			proto.OrganisationService_AddMembership_FullMethodName: rbac.WriteOrganisations,
+		})
+		info := &grpc.UnaryServerInfo{FullMethod: proto.OrganisationService_AddMembership_FullMethodName}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "test response", nil
+		}
+
+		ctx := middlewares.NewContextModifier(context.Background()).
+			WithClaim(&jwt.Payload{
+				ID:    "PNOEE-123456789",
+				Scope: []string{rbac.SsoServiceType},
+				Organisations: map[string]jwt.Organisation{
+					"10000000-1000-1000-4000-000000000001": {Permissions: []string{rbac.WriteOrganisations}},
+				},
+			}).
+			Context()
+
+		resp, err := interceptor(ctx, &proto.AddMembershipRequest{OrganisationId: "10000000-1000-1000-4000-000000000001"}, info, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "test response", resp)
+
+		resp, err = interceptor(ctx, &proto.AddMembershipRequest{OrganisationId: "10000000-1000-1000-4000-000000000002"}, info, handler)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Denied by policy", func(t *testing.T) {
+		denying := services.NewMockPolicies(ctrl)
+		interceptor := NewAuthorizationInterceptor(denying, services.NewMockUsers(ctrl), log).Authorize(map[string]string{
+			proto.TokenService_Delete_FullMethodName: rbac.WriteTokens,
+		})
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "test response", nil
+		}
+
+		denying.EXPECT().Evaluate(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *policy.Request) (bool, error) {
+			assert.Equal(t, rbac.WriteTokens, req.Action)
+			assert.Equal(t, policy.Resource{Type: "sso.v1.TokenService", ID: "10000000-1000-1000-6000-000000000001"}, req.Resource)
+			assert.Contains(t, req.Context, "time")
+			return false, nil
+		})
+
+		resp, err := interceptor(
+			withClaim(rbac.WriteTokens)(),
+			&proto.DeleteTokenRequest{Id: "10000000-1000-1000-6000-000000000001"},
+			&grpc.UnaryServerInfo{FullMethod: proto.TokenService_Delete_FullMethodName},
+			handler,
+		)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Managers may only edit users in their own organisation", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "policies.json")
+		assert.NoError(t, os.WriteFile(path, []byte(`[{
+			"name": "managers-own-organisation",
+			"actions": ["write:users"],
+			"effect": "deny",
+			"condition": "\"manager\" in principal.roles && !(\"admin\" in principal.roles) && !resource.organisations.exists(id, id in principal.organisations)"
+		}]`), 0600))
+
+		engine, err := services.NewPolicies(&config.Config{PoliciesPath: path}, repositories.NewMockPolicyRepository(ctrl), log)
+		assert.NoError(t, err)
+
+		users := services.NewMockUsers(ctrl)
+		interceptor := NewAuthorizationInterceptor(engine, users, log).Authorize(map[string]string{
+			proto.UserService_Update_FullMethodName: rbac.WriteUsers,
+		})
+		info := &grpc.UnaryServerInfo{FullMethod: proto.UserService_Update_FullMethodName}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "test response", nil
+		}
+
+		ownOrganisation := "10000000-1000-1000-4000-000000000001"
+		otherOrganisation := "10000000-1000-1000-4000-000000000002"
+		member := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+		outsider := uuid.MustParse("10000000-1000-1000-5000-000000000002")
+		unknown := uuid.MustParse("10000000-1000-1000-5000-000000000003")
+
+		users.EXPECT().FindPolicyAttributes(gomock.Any(), member).Return(map[string]interface{}{
+			"status":        models.UserActive,
+			"organisations": []string{ownOrganisation},
+		}, nil).AnyTimes()
+		users.EXPECT().FindPolicyAttributes(gomock.Any(), outsider).Return(map[string]interface{}{
+			"status":        models.UserActive,
+			"organisations": []string{otherOrganisation},
+		}, nil).AnyTimes()
+		users.EXPECT().FindPolicyAttributes(gomock.Any(), unknown).Return(nil, errors.ErrRecordNotFound).AnyTimes()
+
+		withRoles := func(roles ...string) context.Context {
+			return middlewares.NewContextModifier(context.Background()).
+				WithClaim(&jwt.Payload{
+					ID:          "PNOEE-123456789",
+					Roles:       roles,
+					Permissions: []string{rbac.WriteUsers},
+					Scope:       []string{rbac.SsoServiceType},
+					Organisations: map[string]jwt.Organisation{
+						ownOrganisation: {Roles: []string{"manager"}},
+					},
+				}).
+				Context()
+		}
+
+		tests := []struct {
+			name     string
+			ctx      context.Context
+			id       uuid.UUID
+			expected codes.Code
+		}{
+			{
+				name:     "Manager edits user in own organisation",
+				ctx:      withRoles("manager"),
+				id:       member,
+				expected: codes.OK,
+			},
+			{
+				name:     "Manager edits user in another organisation",
+				ctx:      withRoles("manager"),
+				id:       outsider,
+				expected: codes.PermissionDenied,
+			},
+			{
+				name:     "Manager edits unknown user",
+				ctx:      withRoles("manager"),
+				id:       unknown,
+				expected: codes.PermissionDenied,
+			},
+			{
+				name:     "Admin edits user in another organisation",
+				ctx:      withRoles("manager", "admin"),
+				id:       outsider,
+				expected: codes.OK,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := interceptor(tt.ctx, &proto.UpdateUserRequest{Id: tt.id.String()}, info, handler)
+				assert.Equal(t, tt.expected, status.Code(err))
+			})
+		}
+	})
+
+	t.Run("Error failed to describe user", func(t *testing.T) {
+		users := services.NewMockUsers(ctrl)
+		interceptor := NewAuthorizationInterceptor(policies, users, log).Authorize(map[string]string{
+			proto.UserService_Delete_FullMethodName: rbac.WriteUsers,
+		})
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "test response", nil
+		}
+
+		id := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+		users.EXPECT().FindPolicyAttributes(gomock.Any(), id).Return(nil, errors.ErrFailedToFetchResults)
+
+		resp, err := interceptor(
+			withClaim(rbac.WriteUsers)(),
+			&proto.DeleteUserRequest{Id: id.String()},
+			&grpc.UnaryServerInfo{FullMethod: proto.UserService_Delete_FullMethodName},
+			handler,
+		)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
+
+// serverStream is a server stream carrying only a context
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func Test_AuthorizationInterceptor_AuthorizeStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	policies := services.NewMockPolicies(ctrl)
+	policies.EXPECT().Evaluate(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+
+	interceptor := NewAuthorizationInterceptor(policies, services.NewMockUsers(ctrl), log).AuthorizeStream(map[string]string{
+		proto.UserService_Import_FullMethodName: rbac.WriteUsers,
+		proto.UserService_Export_FullMethodName: rbac.ReadUsers,
+	})
+
+	withClaim := func(permissions ...string) context.Context {
+		return middlewares.NewContextModifier(context.Background()).
+			WithClaim(&jwt.Payload{
+				ID:          "PNOEE-123456789",
+				Permissions: permissions,
+				Scope:       []string{rbac.SsoServiceType},
+			}).
+			Context()
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		method  string
+		code    codes.Code
+		handled bool
+	}{
+		{
+			name:    "Success",
+			ctx:     withClaim(rbac.ReadUsers),
+			method:  proto.UserService_Export_FullMethodName,
+			code:    codes.OK,
+			handled: true,
+		},
+		{
+			name:   "Missing required permission",
+			ctx:    withClaim(rbac.ReadUsers),
+			method: proto.UserService_Import_FullMethodName,
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "Method without permission mapping",
+			ctx:    withClaim(rbac.ReadUsers, rbac.WriteUsers),
+			method: proto.UserService_List_FullMethodName,
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "Missing claims",
+			ctx:    context.Background(),
+			method: proto.UserService_Export_FullMethodName,
+			code:   codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled bool
+			handler := func(srv interface{}, stream grpc.ServerStream) error {
+				handled = true
+				return nil
+			}
+
+			err := interceptor(nil, &serverStream{ctx: tt.ctx}, &grpc.StreamServerInfo{FullMethod: tt.method}, handler)
+
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Equal(t, tt.handled, handled)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 type LoggerInterceptor interface {
 	Log() grpc.UnaryServerInterceptor
+	LogStream() grpc.StreamServerInterceptor
 }
 
 type loggerInterceptor struct {
@@ -28,25 +29,41 @@ func (i *loggerInterceptor) Log() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		startTime := time.Now()
 
-		traceId := extractTraceId(ctx)
-		requestId := extractRequestId(ctx)
+		resp, err := handler(ctx, req)
 
-		reqLogger := i.log.
-			WithComponent("gRPC").
-			WithRequestId(requestId).
-			WithTraceId(traceId)
+		i.logCall(ctx, info.FullMethod, startTime, err)
 
-		resp, err := handler(ctx, req)
+		return resp, err
+	}
+}
 
-		code := status.Code(err).String()
-		duration := time.Since(startTime)
+func (i *loggerInterceptor) LogStream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		startTime := time.Now()
 
-		reqLogger.Info().
-			Str("method", info.FullMethod).
-			Str("status", code).
-			Dur("duration", duration).
-			Msgf("%s - %s in %s", info.FullMethod, code, duration)
+		err := handler(srv, stream)
 
-		return resp, err
+		i.logCall(stream.Context(), info.FullMethod, startTime, err)
+
+		return err
 	}
 }
+
+func (i *loggerInterceptor) logCall(ctx context.Context, method string, startTime time.Time, err error) {
+	traceId := extractTraceId(ctx)
+	requestId := extractRequestId(ctx)
+
+	reqLogger := i.log.
+		WithComponent("gRPC").
+		WithRequestId(requestId).
+		WithTraceId(traceId)
+
+	code := status.Code(err).String()
+	duration := time.Since(startTime)
+
+	reqLogger.Info().
+		Str("method", method).
+		Str("status", code).
+		Dur("duration", duration).
+		Msgf("%s - %s in %s", method, code, duration)
+}
@@ -53,3 +53,17 @@ func (mr *MockLoggerInterceptorMockRecorder) Log() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockLoggerInterceptor)(nil).Log))
 }
+
+// LogStream mocks base method.
+func (m *MockLoggerInterceptor) LogStream() grpc.StreamServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogStream")
+	ret0, _ := ret[0].(grpc.StreamServerInterceptor)
+	return ret0
+}
+
+// LogStream indicates an expected call of LogStream.
+func (mr *MockLoggerInterceptorMockRecorder) LogStream() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogStream", reflect.TypeOf((*MockLoggerInterceptor)(nil).LogStream))
+}
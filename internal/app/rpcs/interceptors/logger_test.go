@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"loki/internal/config"
 	"loki/internal/config/logger"
@@ -56,3 +58,18 @@ func Test_LoggerInterceptor_UnaryServerInterceptor(t *testing.T) {
 		})
 	}
 }
+
+func Test_LoggerInterceptor_StreamServerInterceptor(t *testing.T) {
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	interceptor := NewLoggerInterceptor(logger.NewLogger(cfg)).LogStream()
+
+	err := interceptor(nil, &serverStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/sso.v1.UserService/Export"}, func(srv interface{}, stream grpc.ServerStream) error {
+		return status.Error(codes.Unavailable, "unavailable")
+	})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -3,7 +3,10 @@ package interceptors
 import "go.uber.org/fx"
 
 var Module = fx.Options(
+	fx.Provide(NewAuditInterceptor),
 	fx.Provide(NewAuthenticationInterceptor),
+	fx.Provide(NewAuthorizationInterceptor),
+	fx.Provide(NewRateLimitInterceptor),
 	fx.Provide(NewTraceInterceptor),
 	fx.Provide(NewLoggerInterceptor),
 )
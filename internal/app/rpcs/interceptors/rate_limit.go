@@ -0,0 +1,82 @@
+package interceptors
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"loki/internal/app/services"
+	"loki/internal/config/middlewares"
+)
+
+const RetryAfter = "retry-after"
+
+type RateLimitInterceptor interface {
+	Limit() grpc.UnaryServerInterceptor
+	LimitStream() grpc.StreamServerInterceptor
+}
+
+type rateLimitInterceptor struct {
+	throttle services.Throttle
+	proxies  middlewares.TrustedProxies
+}
+
+func NewRateLimitInterceptor(throttle services.Throttle, proxies middlewares.TrustedProxies) RateLimitInterceptor {
+	return &rateLimitInterceptor{
+		throttle: throttle,
+		proxies:  proxies,
+	}
+}
+
+func (i *rateLimitInterceptor) Limit() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := i.allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (i *rateLimitInterceptor) LimitStream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := i.allow(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// allow counts the call of the client address, a throttled call gets ResourceExhausted with the retry-after header
+func (i *rateLimitInterceptor) allow(ctx context.Context, method string) error {
+	retryAfter, err := i.throttle.AllowMethod(ctx, method, i.clientAddress(ctx))
+	if err == nil {
+		return nil
+	}
+
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfter, strconv.Itoa(seconds)))
+
+	return status.Errorf(codes.ResourceExhausted, "%s, retry after %s", err, time.Duration(seconds)*time.Second)
+}
+
+// clientAddress returns the peer address, or the forwarded client address when the peer is a trusted proxy
+func (i *rateLimitInterceptor) clientAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	return i.proxies.ClientIP(p.Addr.String(), metadata.ValueFromIncomingContext(ctx, "x-forwarded-for"))
+}
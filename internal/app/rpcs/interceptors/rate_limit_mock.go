@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/rpcs/interceptors/rate_limit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/rpcs/interceptors/rate_limit.go -destination=internal/app/rpcs/interceptors/rate_limit_mock.go -package=interceptors
+//
+
+// Package interceptors is a generated GoMock package.
+package interceptors
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockRateLimitInterceptor is a mock of RateLimitInterceptor interface.
+type MockRateLimitInterceptor struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitInterceptorMockRecorder
+	isgomock struct{}
+}
+
+// MockRateLimitInterceptorMockRecorder is the mock recorder for MockRateLimitInterceptor.
+type MockRateLimitInterceptorMockRecorder struct {
+	mock *MockRateLimitInterceptor
+}
+
+// NewMockRateLimitInterceptor creates a new mock instance.
+func NewMockRateLimitInterceptor(ctrl *gomock.Controller) *MockRateLimitInterceptor {
+	mock := &MockRateLimitInterceptor{ctrl: ctrl}
+	mock.recorder = &MockRateLimitInterceptorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitInterceptor) EXPECT() *MockRateLimitInterceptorMockRecorder {
+	return m.recorder
+}
+
+// Limit mocks base method.
+func (m *MockRateLimitInterceptor) Limit() grpc.UnaryServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit")
+	ret0, _ := ret[0].(grpc.UnaryServerInterceptor)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockRateLimitInterceptorMockRecorder) Limit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockRateLimitInterceptor)(nil).Limit))
+}
+
+// LimitStream mocks base method.
+func (m *MockRateLimitInterceptor) LimitStream() grpc.StreamServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitStream")
+	ret0, _ := ret[0].(grpc.StreamServerInterceptor)
+	return ret0
+}
+
+// LimitStream indicates an expected call of LimitStream.
+func (mr *MockRateLimitInterceptorMockRecorder) LimitStream() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitStream", reflect.TypeOf((*MockRateLimitInterceptor)(nil).LimitStream))
+}
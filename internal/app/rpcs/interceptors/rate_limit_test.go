@@ -0,0 +1,141 @@
+package interceptors
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/middlewares"
+)
+
+func Test_RateLimitInterceptor_Limit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	throttle := services.NewMockThrottle(ctrl)
+	interceptor := NewRateLimitInterceptor(throttle, nil).Limit()
+
+	method := "/sso.v1.UserService/List"
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 51234},
+	})
+
+	type result struct {
+		response interface{}
+		code     codes.Code
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected result
+	}{
+		{
+			name: "Success",
+			before: func() {
+				throttle.EXPECT().AllowMethod(ctx, method, "192.0.2.1").Return(time.Duration(0), nil)
+			},
+			expected: result{
+				response: "test response",
+				code:     codes.OK,
+			},
+		},
+		{
+			name: "Too many requests",
+			before: func() {
+				throttle.EXPECT().AllowMethod(ctx, method, "192.0.2.1").Return(30*time.Second, errors.ErrTooManyRequests)
+			},
+			expected: result{
+				code: codes.ResourceExhausted,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			resp, err := interceptor(ctx, "test request", &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "test response", nil
+			})
+
+			assert.Equal(t, tt.expected.response, resp)
+			assert.Equal(t, tt.expected.code, status.Code(err))
+		})
+	}
+}
+
+func Test_RateLimitInterceptor_TrustedProxy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	throttle := services.NewMockThrottle(ctrl)
+	proxies := middlewares.NewTrustedProxies(&config.Config{TrustedProxies: []string{"10.0.0.0/8"}})
+	interceptor := NewRateLimitInterceptor(throttle, proxies).Limit()
+
+	method := "/sso.v1.UserService/List"
+
+	tests := []struct {
+		name     string
+		peer     string
+		expected string
+	}{
+		{
+			name:     "Trusted proxy",
+			peer:     "10.0.0.5",
+			expected: "198.51.100.7",
+		},
+		{
+			name:     "Untrusted peer",
+			peer:     "192.0.2.1",
+			expected: "192.0.2.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP(tt.peer), Port: 51234},
+			})
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "203.0.113.9, 198.51.100.7"))
+
+			throttle.EXPECT().AllowMethod(ctx, method, tt.expected).Return(time.Duration(0), nil)
+
+			_, err := interceptor(ctx, "test request", &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "test response", nil
+			})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_RateLimitInterceptor_LimitStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	throttle := services.NewMockThrottle(ctrl)
+	interceptor := NewRateLimitInterceptor(throttle, nil).LimitStream()
+
+	method := "/sso.v1.UserService/Export"
+	ctx := context.Background()
+
+	throttle.EXPECT().AllowMethod(ctx, method, "").Return(time.Minute, errors.ErrTooManyRequests)
+
+	err := interceptor(nil, &serverStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: method}, func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	})
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
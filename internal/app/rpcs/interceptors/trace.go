@@ -4,8 +4,12 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+
+	"loki/internal/config/middlewares"
 )
 
 const (
@@ -15,6 +19,7 @@ const (
 
 type TraceInterceptor interface {
 	Trace() grpc.UnaryServerInterceptor
+	TraceStream() grpc.StreamServerInterceptor
 }
 
 type traceInterceptor struct{}
@@ -25,21 +30,48 @@ func NewTraceInterceptor() TraceInterceptor {
 
 func (i *traceInterceptor) Trace() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		traceId := extractTraceId(ctx)
-		if traceId == "" {
-			traceId = uuid.New().String()
-		}
+		return handler(withTrace(ctx), req)
+	}
+}
+
+func (i *traceInterceptor) TraceStream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = withTrace(stream.Context())
 
-		requestId := extractRequestId(ctx)
-		if requestId == "" {
-			requestId = uuid.New().String()
-		}
+		return handler(srv, wrapped)
+	}
+}
 
-		ctx = metadata.AppendToOutgoingContext(ctx, TraceId, traceId)
-		ctx = metadata.AppendToOutgoingContext(ctx, RequestId, requestId)
+// withTrace propagates the trace id of the span continued from traceparent and the request id, generating them when missing
+func withTrace(ctx context.Context) context.Context {
+	traceId := spanTraceId(ctx)
+	if traceId == "" {
+		traceId = extractTraceId(ctx)
+	}
+	if traceId == "" {
+		traceId = uuid.New().String()
+	}
 
-		return handler(ctx, req)
+	requestId := extractRequestId(ctx)
+	if requestId == "" {
+		requestId = uuid.New().String()
 	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, TraceId, traceId)
+	ctx = metadata.AppendToOutgoingContext(ctx, RequestId, requestId)
+
+	return middlewares.NewContextModifier(ctx).WithTraceId(traceId).Context()
+}
+
+// spanTraceId returns the trace id of the server span, the X-Trace-Id metadata is a fallback for disabled tracing
+func spanTraceId(ctx context.Context) string {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.HasTraceID() {
+		return ""
+	}
+
+	return spanCtx.TraceID().String()
 }
 
 func extractTraceId(ctx context.Context) string {
@@ -53,3 +53,17 @@ func (mr *MockTraceInterceptorMockRecorder) Trace() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trace", reflect.TypeOf((*MockTraceInterceptor)(nil).Trace))
 }
+
+// TraceStream mocks base method.
+func (m *MockTraceInterceptor) TraceStream() grpc.StreamServerInterceptor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceStream")
+	ret0, _ := ret[0].(grpc.StreamServerInterceptor)
+	return ret0
+}
+
+// TraceStream indicates an expected call of TraceStream.
+func (mr *MockTraceInterceptorMockRecorder) TraceStream() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceStream", reflect.TypeOf((*MockTraceInterceptor)(nil).TraceStream))
+}
@@ -6,9 +6,12 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+
+	"loki/internal/config/middlewares"
 )
 
 func Test_TraceInterceptor_Trace(t *testing.T) {
@@ -25,12 +28,32 @@ func Test_TraceInterceptor_Trace(t *testing.T) {
 		requestId string
 	}
 
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		TraceFlags: trace.FlagsSampled,
+	})
+
 	tests := []struct {
-		name   string
-		method string
-		md     metadata.MD
-		expect result
+		name    string
+		method  string
+		md      metadata.MD
+		spanCtx trace.SpanContext
+		expect  result
 	}{
+		{
+			name:   "Span continued from traceparent",
+			method: "/sso.v1.PermissionService/List",
+			md: metadata.Pairs(
+				TraceId, traceId,
+				RequestId, requestId,
+			),
+			spanCtx: spanCtx,
+			expect: result{
+				traceId:   "4bf92f3577b34da6a3ce929d0e0e4736",
+				requestId: requestId,
+			},
+		},
 		{
 			name:   "Success",
 			method: "/sso.v1.PermissionService/List",
@@ -76,6 +99,9 @@ func Test_TraceInterceptor_Trace(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			if tt.spanCtx.IsValid() {
+				ctx = trace.ContextWithSpanContext(ctx, tt.spanCtx)
+			}
 
 			var actualTraceId, actualRequestId string
 
@@ -122,3 +148,25 @@ func Test_TraceInterceptor_Trace(t *testing.T) {
 		})
 	}
 }
+
+func Test_TraceInterceptor_TraceStream(t *testing.T) {
+	traceId := uuid.New().String()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(TraceId, traceId))
+	interceptor := NewTraceInterceptor().TraceStream()
+
+	err := interceptor(nil, &serverStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/sso.v1.UserService/Export"}, func(srv interface{}, stream grpc.ServerStream) error {
+		md, ok := metadata.FromOutgoingContext(stream.Context())
+		assert.True(t, ok)
+		assert.Equal(t, []string{traceId}, md.Get(TraceId))
+		assert.Len(t, md.Get(RequestId), 1)
+
+		current, ok := middlewares.CurrentTraceIdFromContext(stream.Context())
+		assert.True(t, ok)
+		assert.Equal(t, traceId, current)
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+}
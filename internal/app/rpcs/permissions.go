@@ -0,0 +1,77 @@
+package rpcs
+
+import (
+	proto "loki/internal/app/rpcs/proto/sso/v1"
+	"loki/pkg/rbac"
+)
+
+// permissions maps each gRPC full method name to the permission required to call it
+var permissions = map[string]string{
+	proto.AuditService_List_FullMethodName:   rbac.ReadAudit,
+	proto.AuditService_Export_FullMethodName: rbac.ReadAudit,
+	proto.AuditService_Verify_FullMethodName: rbac.ReadAudit,
+
+	proto.ElevationService_List_FullMethodName:    rbac.ReadRoles,
+	proto.ElevationService_Get_FullMethodName:     rbac.ReadRoles,
+	proto.ElevationService_Request_FullMethodName: rbac.WriteSelf,
+	proto.ElevationService_Approve_FullMethodName: rbac.WriteRoles,
+	proto.ElevationService_Reject_FullMethodName:  rbac.WriteRoles,
+
+	proto.InvitationService_List_FullMethodName:   rbac.ReadUsers,
+	proto.InvitationService_Get_FullMethodName:    rbac.ReadUsers,
+	proto.InvitationService_Create_FullMethodName: rbac.WriteUsers,
+	proto.InvitationService_Extend_FullMethodName: rbac.WriteUsers,
+	proto.InvitationService_Revoke_FullMethodName: rbac.WriteUsers,
+
+	proto.OrganisationService_List_FullMethodName:             rbac.ReadOrganisations,
+	proto.OrganisationService_Get_FullMethodName:              rbac.ReadOrganisations,
+	proto.OrganisationService_Create_FullMethodName:           rbac.WriteOrganisations,
+	proto.OrganisationService_Update_FullMethodName:           rbac.WriteOrganisations,
+	proto.OrganisationService_Delete_FullMethodName:           rbac.WriteOrganisations,
+	proto.OrganisationService_ListMemberships_FullMethodName:  rbac.ReadOrganisations,
+	proto.OrganisationService_AddMembership_FullMethodName:    rbac.WriteOrganisations,
+	proto.OrganisationService_RemoveMembership_FullMethodName: rbac.WriteOrganisations,
+
+	proto.PermissionService_List_FullMethodName:   rbac.ReadPermissions,
+	proto.PermissionService_Get_FullMethodName:    rbac.ReadPermissions,
+	proto.PermissionService_Create_FullMethodName: rbac.WritePermissions,
+	proto.PermissionService_Update_FullMethodName: rbac.WritePermissions,
+	proto.PermissionService_Delete_FullMethodName: rbac.WritePermissions,
+
+	proto.RoleService_List_FullMethodName:              rbac.ReadRoles,
+	proto.RoleService_Get_FullMethodName:               rbac.ReadRoles,
+	proto.RoleService_Create_FullMethodName:            rbac.WriteRoles,
+	proto.RoleService_Update_FullMethodName:            rbac.WriteRoles,
+	proto.RoleService_Delete_FullMethodName:            rbac.WriteRoles,
+	proto.RoleService_AddPermissions_FullMethodName:    rbac.WriteRoles,
+	proto.RoleService_RemovePermissions_FullMethodName: rbac.WriteRoles,
+	proto.RoleService_SetPermissions_FullMethodName:    rbac.WriteRoles,
+
+	proto.ScopeService_List_FullMethodName:   rbac.ReadScopes,
+	proto.ScopeService_Get_FullMethodName:    rbac.ReadScopes,
+	proto.ScopeService_Create_FullMethodName: rbac.WriteScopes,
+	proto.ScopeService_Update_FullMethodName: rbac.WriteScopes,
+	proto.ScopeService_Delete_FullMethodName: rbac.WriteScopes,
+
+	proto.TokenService_List_FullMethodName:   rbac.ReadTokens,
+	proto.TokenService_Delete_FullMethodName: rbac.WriteTokens,
+
+	proto.UserService_List_FullMethodName:                    rbac.ReadUsers,
+	proto.UserService_Get_FullMethodName:                     rbac.ReadUsers,
+	proto.UserService_Create_FullMethodName:                  rbac.WriteUsers,
+	proto.UserService_Update_FullMethodName:                  rbac.WriteUsers,
+	proto.UserService_Delete_FullMethodName:                  rbac.WriteUsers,
+	proto.UserService_SetStatus_FullMethodName:               rbac.WriteUsers,
+	proto.UserService_AssignRoles_FullMethodName:             rbac.WriteUsers,
+	proto.UserService_RevokeRoles_FullMethodName:             rbac.WriteUsers,
+	proto.UserService_SetRoles_FullMethodName:                rbac.WriteUsers,
+	proto.UserService_AssignScopes_FullMethodName:            rbac.WriteUsers,
+	proto.UserService_RevokeScopes_FullMethodName:            rbac.WriteUsers,
+	proto.UserService_SetScopes_FullMethodName:               rbac.WriteUsers,
+	proto.UserService_GetEffectivePermissions_FullMethodName: rbac.ReadUsers,
+	proto.UserService_Explain_FullMethodName:                 rbac.ReadUsers,
+	proto.UserService_Import_FullMethodName:                  rbac.WriteUsers,
+	proto.UserService_Export_FullMethodName:                  rbac.ReadUsers,
+	proto.UserService_ListAuthenticators_FullMethodName:      rbac.ReadUsers,
+	proto.UserService_ListSessions_FullMethodName:            rbac.ReadUsers,
+}
@@ -0,0 +1,665 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: sso/v1/elevation.proto
+
+package ssov1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Elevation represents a request for a temporary role
+type Elevation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId          string                 `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Reason          string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	DurationMinutes uint32                 `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	Status          string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	DecidedBy       string                 `protobuf:"bytes,7,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Elevation) Reset() {
+	*x = Elevation{}
+	mi := &file_sso_v1_elevation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Elevation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Elevation) ProtoMessage() {}
+
+func (x *Elevation) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_elevation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Elevation.ProtoReflect.Descriptor instead.
+func (*Elevation) Descriptor() ([]byte, []int) {
+	return file_sso_v1_elevation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Elevation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Elevation) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Elevation) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *Elevation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Elevation) GetDurationMinutes() uint32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *Elevation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Elevation) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+// ListElevationsResponse is the response for the List method
+type ListElevationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Elevation           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListElevationsResponse) Reset() {
+	*x = ListElevationsResponse{}
+	mi := &file_sso_v1_elevation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListElevationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListElevationsResponse) ProtoMessage() {}
+
+func (x *ListElevationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_elevation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListElevationsResponse.ProtoReflect.Descriptor instead.
+func (*ListElevationsResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_elevation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListElevationsResponse) GetData() []*Elevation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListElevationsResponse) GetMeta() *PaginationMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// GetElevationRequest is the request for the Get method
+type GetElevationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetElevationRequest) Reset() {
+	*x = GetElevationRequest{}
+	mi := &file_sso_v1_elevation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetElevationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetElevationRequest) ProtoMessage() {}
+
+func (x *GetElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_elevation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetElevationRequest.ProtoReflect.Descriptor instead.
+func (*GetElevationRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_elevation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetElevationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetElevationResponse is the response for the Get method
+type GetElevationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Elevation             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetElevationResponse) Reset() {
+	*x = GetElevationResponse{}
+	mi := &file_sso_v1_elevation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetElevationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetElevationResponse) ProtoMessage() {}
+
+func (x *GetElevationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_elevation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetElevationResponse.ProtoReflect.Descriptor instead.
+func (*GetElevationResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_elevation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetElevationResponse) GetData() *Elevation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// RequestElevationRequest is the request for the Request method, the elevation is requested for the current user
+type RequestElevationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RoleId          string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	DurationMinutes uint32                 `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestElevationRequest) Reset() {
+	*x = RequestElevationRequest{}
+	mi := &file_sso_v1_elevation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestElevationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestElevationRequest) ProtoMessage() {}
+
+func (x *RequestElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_elevation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestElevationRequest.ProtoReflect.Descriptor instead.
+func (*RequestElevationRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_elevation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RequestElevationRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *RequestElevationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestElevationRequest) GetDurationMinutes() uint32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+// RequestElevationResponse is the response for the Request method
+type RequestElevationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Elevation             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestElevationResponse) Reset() {
+	*x = RequestElevationResponse{}
+	mi := &file_sso_v1_elevation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestElevationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestElevationResponse) ProtoMessage() {}
+
+func (x *RequestElevationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_elevation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestElevationResponse.ProtoReflect.Descriptor instead.
+func (*RequestElevationResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_elevation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RequestElevationResponse) GetData() *Elevation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ApproveElevationRequest is the request for the Approve method
+type ApproveElevationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveElevationRequest) Reset() {
+	*x = ApproveElevationRequest{}
+	mi := &file_sso_v1_elevation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveElevationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveElevationRequest) ProtoMessage() {}
+
+func (x *ApproveElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_elevation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveElevationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveElevationRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_elevation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApproveElevationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ApproveElevationResponse is the response for the Approve method
+type ApproveElevationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Elevation             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveElevationResponse) Reset() {
+	*x = ApproveElevationResponse{}
+	mi := &file_sso_v1_elevation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveElevationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveElevationResponse) ProtoMessage() {}
+
+func (x *ApproveElevationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_elevation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveElevationResponse.ProtoReflect.Descriptor instead.
+func (*ApproveElevationResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_elevation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApproveElevationResponse) GetData() *Elevation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// RejectElevationRequest is the request for the Reject method
+type RejectElevationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectElevationRequest) Reset() {
+	*x = RejectElevationRequest{}
+	mi := &file_sso_v1_elevation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectElevationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectElevationRequest) ProtoMessage() {}
+
+func (x *RejectElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_elevation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectElevationRequest.ProtoReflect.Descriptor instead.
+func (*RejectElevationRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_elevation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectElevationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RejectElevationResponse is the response for the Reject method
+type RejectElevationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Elevation             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectElevationResponse) Reset() {
+	*x = RejectElevationResponse{}
+	mi := &file_sso_v1_elevation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectElevationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectElevationResponse) ProtoMessage() {}
+
+func (x *RejectElevationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_elevation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectElevationResponse.ProtoReflect.Descriptor instead.
+func (*RejectElevationResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_elevation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RejectElevationResponse) GetData() *Elevation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_sso_v1_elevation_proto protoreflect.FileDescriptor
+
+const file_sso_v1_elevation_proto_rawDesc = "" +
+	"\n" +
+	"\x16sso/v1/elevation.proto\x12\x06sso.v1\x1a\x1bbuf/validate/validate.proto\x1a\x17sso/v1/pagination.proto\"\xe5\x01\n" +
+	"\tElevation\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12!\n" +
+	"\arole_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roleId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\rR\x0fdurationMinutes\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\a \x01(\tR\tdecidedBy\"k\n" +
+	"\x16ListElevationsResponse\x12%\n" +
+	"\x04data\x18\x01 \x03(\v2\x11.sso.v1.ElevationR\x04data\x12*\n" +
+	"\x04meta\x18\x02 \x01(\v2\x16.sso.v1.PaginationMetaR\x04meta\"/\n" +
+	"\x13GetElevationRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"=\n" +
+	"\x14GetElevationResponse\x12%\n" +
+	"\x04data\x18\x01 \x01(\v2\x11.sso.v1.ElevationR\x04data\"\x97\x01\n" +
+	"\x17RequestElevationRequest\x12!\n" +
+	"\arole_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roleId\x12\"\n" +
+	"\x06reason\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x10R\x06reason\x125\n" +
+	"\x10duration_minutes\x18\x03 \x01(\rB\n" +
+	"\xbaH\a*\x05\x18\xa0\v(\x01R\x0fdurationMinutes\"A\n" +
+	"\x18RequestElevationResponse\x12%\n" +
+	"\x04data\x18\x01 \x01(\v2\x11.sso.v1.ElevationR\x04data\"3\n" +
+	"\x17ApproveElevationRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"A\n" +
+	"\x18ApproveElevationResponse\x12%\n" +
+	"\x04data\x18\x01 \x01(\v2\x11.sso.v1.ElevationR\x04data\"2\n" +
+	"\x16RejectElevationRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"@\n" +
+	"\x17RejectElevationResponse\x12%\n" +
+	"\x04data\x18\x01 \x01(\v2\x11.sso.v1.ElevationR\x04data2\x8b\x03\n" +
+	"\x10ElevationService\x12F\n" +
+	"\x04List\x12\x1c.sso.v1.PaginatedListRequest\x1a\x1e.sso.v1.ListElevationsResponse\"\x00\x12B\n" +
+	"\x03Get\x12\x1b.sso.v1.GetElevationRequest\x1a\x1c.sso.v1.GetElevationResponse\"\x00\x12N\n" +
+	"\aRequest\x12\x1f.sso.v1.RequestElevationRequest\x1a .sso.v1.RequestElevationResponse\"\x00\x12N\n" +
+	"\aApprove\x12\x1f.sso.v1.ApproveElevationRequest\x1a .sso.v1.ApproveElevationResponse\"\x00\x12K\n" +
+	"\x06Reject\x12\x1e.sso.v1.RejectElevationRequest\x1a\x1f.sso.v1.RejectElevationResponse\"\x00B+Z)loki/internal/app/rpcs/proto/sso/v1;ssov1b\x06proto3"
+
+var (
+	file_sso_v1_elevation_proto_rawDescOnce sync.Once
+	file_sso_v1_elevation_proto_rawDescData []byte
+)
+
+func file_sso_v1_elevation_proto_rawDescGZIP() []byte {
+	file_sso_v1_elevation_proto_rawDescOnce.Do(func() {
+		file_sso_v1_elevation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sso_v1_elevation_proto_rawDesc), len(file_sso_v1_elevation_proto_rawDesc)))
+	})
+	return file_sso_v1_elevation_proto_rawDescData
+}
+
+var file_sso_v1_elevation_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_sso_v1_elevation_proto_goTypes = []any{
+	(*Elevation)(nil),                // 0: sso.v1.Elevation
+	(*ListElevationsResponse)(nil),   // 1: sso.v1.ListElevationsResponse
+	(*GetElevationRequest)(nil),      // 2: sso.v1.GetElevationRequest
+	(*GetElevationResponse)(nil),     // 3: sso.v1.GetElevationResponse
+	(*RequestElevationRequest)(nil),  // 4: sso.v1.RequestElevationRequest
+	(*RequestElevationResponse)(nil), // 5: sso.v1.RequestElevationResponse
+	(*ApproveElevationRequest)(nil),  // 6: sso.v1.ApproveElevationRequest
+	(*ApproveElevationResponse)(nil), // 7: sso.v1.ApproveElevationResponse
+	(*RejectElevationRequest)(nil),   // 8: sso.v1.RejectElevationRequest
+	(*RejectElevationResponse)(nil),  // 9: sso.v1.RejectElevationResponse
+	(*PaginationMeta)(nil),           // 10: sso.v1.PaginationMeta
+	(*PaginatedListRequest)(nil),     // 11: sso.v1.PaginatedListRequest
+}
+var file_sso_v1_elevation_proto_depIdxs = []int32{
+	0,  // 0: sso.v1.ListElevationsResponse.data:type_name -> sso.v1.Elevation
+	10, // 1: sso.v1.ListElevationsResponse.meta:type_name -> sso.v1.PaginationMeta
+	0,  // 2: sso.v1.GetElevationResponse.data:type_name -> sso.v1.Elevation
+	0,  // 3: sso.v1.RequestElevationResponse.data:type_name -> sso.v1.Elevation
+	0,  // 4: sso.v1.ApproveElevationResponse.data:type_name -> sso.v1.Elevation
+	0,  // 5: sso.v1.RejectElevationResponse.data:type_name -> sso.v1.Elevation
+	11, // 6: sso.v1.ElevationService.List:input_type -> sso.v1.PaginatedListRequest
+	2,  // 7: sso.v1.ElevationService.Get:input_type -> sso.v1.GetElevationRequest
+	4,  // 8: sso.v1.ElevationService.Request:input_type -> sso.v1.RequestElevationRequest
+	6,  // 9: sso.v1.ElevationService.Approve:input_type -> sso.v1.ApproveElevationRequest
+	8,  // 10: sso.v1.ElevationService.Reject:input_type -> sso.v1.RejectElevationRequest
+	1,  // 11: sso.v1.ElevationService.List:output_type -> sso.v1.ListElevationsResponse
+	3,  // 12: sso.v1.ElevationService.Get:output_type -> sso.v1.GetElevationResponse
+	5,  // 13: sso.v1.ElevationService.Request:output_type -> sso.v1.RequestElevationResponse
+	7,  // 14: sso.v1.ElevationService.Approve:output_type -> sso.v1.ApproveElevationResponse
+	9,  // 15: sso.v1.ElevationService.Reject:output_type -> sso.v1.RejectElevationResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_sso_v1_elevation_proto_init() }
+func file_sso_v1_elevation_proto_init() {
+	if File_sso_v1_elevation_proto != nil {
+		return
+	}
+	file_sso_v1_pagination_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sso_v1_elevation_proto_rawDesc), len(file_sso_v1_elevation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sso_v1_elevation_proto_goTypes,
+		DependencyIndexes: file_sso_v1_elevation_proto_depIdxs,
+		MessageInfos:      file_sso_v1_elevation_proto_msgTypes,
+	}.Build()
+	File_sso_v1_elevation_proto = out.File
+	file_sso_v1_elevation_proto_goTypes = nil
+	file_sso_v1_elevation_proto_depIdxs = nil
+}
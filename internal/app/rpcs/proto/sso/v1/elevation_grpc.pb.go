@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: sso/v1/elevation.proto
+
+package ssov1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ElevationService_List_FullMethodName    = "/sso.v1.ElevationService/List"
+	ElevationService_Get_FullMethodName     = "/sso.v1.ElevationService/Get"
+	ElevationService_Request_FullMethodName = "/sso.v1.ElevationService/Request"
+	ElevationService_Approve_FullMethodName = "/sso.v1.ElevationService/Approve"
+	ElevationService_Reject_FullMethodName  = "/sso.v1.ElevationService/Reject"
+)
+
+// ElevationServiceClient is the client API for ElevationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Elevation service lets users request a temporary role that another administrator approves
+type ElevationServiceClient interface {
+	List(ctx context.Context, in *PaginatedListRequest, opts ...grpc.CallOption) (*ListElevationsResponse, error)
+	Get(ctx context.Context, in *GetElevationRequest, opts ...grpc.CallOption) (*GetElevationResponse, error)
+	Request(ctx context.Context, in *RequestElevationRequest, opts ...grpc.CallOption) (*RequestElevationResponse, error)
+	Approve(ctx context.Context, in *ApproveElevationRequest, opts ...grpc.CallOption) (*ApproveElevationResponse, error)
+	Reject(ctx context.Context, in *RejectElevationRequest, opts ...grpc.CallOption) (*RejectElevationResponse, error)
+}
+
+type elevationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewElevationServiceClient(cc grpc.ClientConnInterface) ElevationServiceClient {
+	return &elevationServiceClient{cc}
+}
+
+func (c *elevationServiceClient) List(ctx context.Context, in *PaginatedListRequest, opts ...grpc.CallOption) (*ListElevationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListElevationsResponse)
+	err := c.cc.Invoke(ctx, ElevationService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevationServiceClient) Get(ctx context.Context, in *GetElevationRequest, opts ...grpc.CallOption) (*GetElevationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetElevationResponse)
+	err := c.cc.Invoke(ctx, ElevationService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevationServiceClient) Request(ctx context.Context, in *RequestElevationRequest, opts ...grpc.CallOption) (*RequestElevationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestElevationResponse)
+	err := c.cc.Invoke(ctx, ElevationService_Request_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevationServiceClient) Approve(ctx context.Context, in *ApproveElevationRequest, opts ...grpc.CallOption) (*ApproveElevationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveElevationResponse)
+	err := c.cc.Invoke(ctx, ElevationService_Approve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevationServiceClient) Reject(ctx context.Context, in *RejectElevationRequest, opts ...grpc.CallOption) (*RejectElevationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectElevationResponse)
+	err := c.cc.Invoke(ctx, ElevationService_Reject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ElevationServiceServer is the server API for ElevationService service.
+// All implementations must embed UnimplementedElevationServiceServer
+// for forward compatibility.
+//
+// Elevation service lets users request a temporary role that another administrator approves
+type ElevationServiceServer interface {
+	List(context.Context, *PaginatedListRequest) (*ListElevationsResponse, error)
+	Get(context.Context, *GetElevationRequest) (*GetElevationResponse, error)
+	Request(context.Context, *RequestElevationRequest) (*RequestElevationResponse, error)
+	Approve(context.Context, *ApproveElevationRequest) (*ApproveElevationResponse, error)
+	Reject(context.Context, *RejectElevationRequest) (*RejectElevationResponse, error)
+	mustEmbedUnimplementedElevationServiceServer()
+}
+
+// UnimplementedElevationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedElevationServiceServer struct{}
+
+func (UnimplementedElevationServiceServer) List(context.Context, *PaginatedListRequest) (*ListElevationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedElevationServiceServer) Get(context.Context, *GetElevationRequest) (*GetElevationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedElevationServiceServer) Request(context.Context, *RequestElevationRequest) (*RequestElevationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Request not implemented")
+}
+func (UnimplementedElevationServiceServer) Approve(context.Context, *ApproveElevationRequest) (*ApproveElevationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedElevationServiceServer) Reject(context.Context, *RejectElevationRequest) (*RejectElevationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reject not implemented")
+}
+func (UnimplementedElevationServiceServer) mustEmbedUnimplementedElevationServiceServer() {}
+func (UnimplementedElevationServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeElevationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ElevationServiceServer will
+// result in compilation errors.
+type UnsafeElevationServiceServer interface {
+	mustEmbedUnimplementedElevationServiceServer()
+}
+
+func RegisterElevationServiceServer(s grpc.ServiceRegistrar, srv ElevationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedElevationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ElevationService_ServiceDesc, srv)
+}
+
+func _ElevationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaginatedListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevationServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevationService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevationServiceServer).List(ctx, req.(*PaginatedListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetElevationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevationServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevationService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevationServiceServer).Get(ctx, req.(*GetElevationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevationService_Request_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestElevationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevationServiceServer).Request(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevationService_Request_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevationServiceServer).Request(ctx, req.(*RequestElevationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevationService_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveElevationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevationServiceServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevationService_Approve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevationServiceServer).Approve(ctx, req.(*ApproveElevationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevationService_Reject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectElevationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevationServiceServer).Reject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevationService_Reject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevationServiceServer).Reject(ctx, req.(*RejectElevationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ElevationService_ServiceDesc is the grpc.ServiceDesc for ElevationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ElevationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sso.v1.ElevationService",
+	HandlerType: (*ElevationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _ElevationService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ElevationService_Get_Handler,
+		},
+		{
+			MethodName: "Request",
+			Handler:    _ElevationService_Request_Handler,
+		},
+		{
+			MethodName: "Approve",
+			Handler:    _ElevationService_Approve_Handler,
+		},
+		{
+			MethodName: "Reject",
+			Handler:    _ElevationService_Reject_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sso/v1/elevation.proto",
+}
@@ -0,0 +1,719 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: sso/v1/invitation.proto
+
+package ssov1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Invitation pre-registers a person by personal code with the roles and scopes granted on their first login
+type Invitation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdentityNumber string                 `protobuf:"bytes,2,opt,name=identity_number,json=identityNumber,proto3" json:"identity_number,omitempty"`
+	PersonalCode   string                 `protobuf:"bytes,3,opt,name=personal_code,json=personalCode,proto3" json:"personal_code,omitempty"`
+	RoleIds        []string               `protobuf:"bytes,4,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	ScopeIds       []string               `protobuf:"bytes,5,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	InvitedBy      string                 `protobuf:"bytes,7,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	UserId         string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SentAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	AcceptedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_sso_v1_invitation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_invitation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_sso_v1_invitation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invitation) GetIdentityNumber() string {
+	if x != nil {
+		return x.IdentityNumber
+	}
+	return ""
+}
+
+func (x *Invitation) GetPersonalCode() string {
+	if x != nil {
+		return x.PersonalCode
+	}
+	return ""
+}
+
+func (x *Invitation) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *Invitation) GetScopeIds() []string {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+func (x *Invitation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *Invitation) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+// ListInvitationsResponse is the response for the List method
+type ListInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Invitation          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_sso_v1_invitation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_invitation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_invitation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListInvitationsResponse) GetData() []*Invitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListInvitationsResponse) GetMeta() *PaginationMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// GetInvitationRequest is the request for the Get method
+type GetInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitationRequest) Reset() {
+	*x = GetInvitationRequest{}
+	mi := &file_sso_v1_invitation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationRequest) ProtoMessage() {}
+
+func (x *GetInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_invitation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitationRequest.ProtoReflect.Descriptor instead.
+func (*GetInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_invitation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetInvitationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetInvitationResponse is the response for the Get method
+type GetInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Invitation            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitationResponse) Reset() {
+	*x = GetInvitationResponse{}
+	mi := &file_sso_v1_invitation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationResponse) ProtoMessage() {}
+
+func (x *GetInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_invitation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitationResponse.ProtoReflect.Descriptor instead.
+func (*GetInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_invitation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetInvitationResponse) GetData() *Invitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CreateInvitationRequest is the request for the Create method
+type CreateInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	PersonalCode  string                 `protobuf:"bytes,2,opt,name=personal_code,json=personalCode,proto3" json:"personal_code,omitempty"`
+	RoleIds       []string               `protobuf:"bytes,3,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	ScopeIds      []string               `protobuf:"bytes,4,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationRequest) Reset() {
+	*x = CreateInvitationRequest{}
+	mi := &file_sso_v1_invitation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationRequest) ProtoMessage() {}
+
+func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_invitation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_invitation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateInvitationRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CreateInvitationRequest) GetPersonalCode() string {
+	if x != nil {
+		return x.PersonalCode
+	}
+	return ""
+}
+
+func (x *CreateInvitationRequest) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *CreateInvitationRequest) GetScopeIds() []string {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+// CreateInvitationResponse is the response for the Create method
+type CreateInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Invitation            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationResponse) Reset() {
+	*x = CreateInvitationResponse{}
+	mi := &file_sso_v1_invitation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationResponse) ProtoMessage() {}
+
+func (x *CreateInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_invitation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CreateInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_invitation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateInvitationResponse) GetData() *Invitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ExtendInvitationRequest is the request for the Extend method
+type ExtendInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendInvitationRequest) Reset() {
+	*x = ExtendInvitationRequest{}
+	mi := &file_sso_v1_invitation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendInvitationRequest) ProtoMessage() {}
+
+func (x *ExtendInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_invitation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ExtendInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_invitation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExtendInvitationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ExtendInvitationResponse is the response for the Extend method
+type ExtendInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Invitation            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendInvitationResponse) Reset() {
+	*x = ExtendInvitationResponse{}
+	mi := &file_sso_v1_invitation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendInvitationResponse) ProtoMessage() {}
+
+func (x *ExtendInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_invitation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendInvitationResponse.ProtoReflect.Descriptor instead.
+func (*ExtendInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_invitation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExtendInvitationResponse) GetData() *Invitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// RevokeInvitationRequest is the request for the Revoke method
+type RevokeInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_sso_v1_invitation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_invitation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_invitation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RevokeInvitationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RevokeInvitationResponse is the response for the Revoke method
+type RevokeInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Invitation            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationResponse) Reset() {
+	*x = RevokeInvitationResponse{}
+	mi := &file_sso_v1_invitation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_invitation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_invitation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RevokeInvitationResponse) GetData() *Invitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_sso_v1_invitation_proto protoreflect.FileDescriptor
+
+const file_sso_v1_invitation_proto_rawDesc = "" +
+	"\n" +
+	"\x17sso/v1/invitation.proto\x12\x06sso.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17sso/v1/pagination.proto\"\xa9\x03\n" +
+	"\n" +
+	"Invitation\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12'\n" +
+	"\x0fidentity_number\x18\x02 \x01(\tR\x0eidentityNumber\x12#\n" +
+	"\rpersonal_code\x18\x03 \x01(\tR\fpersonalCode\x12\x19\n" +
+	"\brole_ids\x18\x04 \x03(\tR\aroleIds\x12\x1b\n" +
+	"\tscope_ids\x18\x05 \x03(\tR\bscopeIds\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\a \x01(\tR\tinvitedBy\x12\x17\n" +
+	"\auser_id\x18\b \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x123\n" +
+	"\asent_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x12;\n" +
+	"\vaccepted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acceptedAt\"m\n" +
+	"\x17ListInvitationsResponse\x12&\n" +
+	"\x04data\x18\x01 \x03(\v2\x12.sso.v1.InvitationR\x04data\x12*\n" +
+	"\x04meta\x18\x02 \x01(\v2\x16.sso.v1.PaginationMetaR\x04meta\"0\n" +
+	"\x14GetInvitationRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"?\n" +
+	"\x15GetInvitationResponse\x12&\n" +
+	"\x04data\x18\x01 \x01(\v2\x12.sso.v1.InvitationR\x04data\"\xcc\x01\n" +
+	"\x17CreateInvitationRequest\x12+\n" +
+	"\acountry\x18\x01 \x01(\tB\x11\xbaH\x0er\fR\x02EER\x02LVR\x02LTR\acountry\x12.\n" +
+	"\rpersonal_code\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\v\x18\x14R\fpersonalCode\x12(\n" +
+	"\brole_ids\x18\x03 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\aroleIds\x12*\n" +
+	"\tscope_ids\x18\x04 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\bscopeIds\"B\n" +
+	"\x18CreateInvitationResponse\x12&\n" +
+	"\x04data\x18\x01 \x01(\v2\x12.sso.v1.InvitationR\x04data\"3\n" +
+	"\x17ExtendInvitationRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"B\n" +
+	"\x18ExtendInvitationResponse\x12&\n" +
+	"\x04data\x18\x01 \x01(\v2\x12.sso.v1.InvitationR\x04data\"3\n" +
+	"\x17RevokeInvitationRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"B\n" +
+	"\x18RevokeInvitationResponse\x12&\n" +
+	"\x04data\x18\x01 \x01(\v2\x12.sso.v1.InvitationR\x04data2\x8f\x03\n" +
+	"\x11InvitationService\x12G\n" +
+	"\x04List\x12\x1c.sso.v1.PaginatedListRequest\x1a\x1f.sso.v1.ListInvitationsResponse\"\x00\x12D\n" +
+	"\x03Get\x12\x1c.sso.v1.GetInvitationRequest\x1a\x1d.sso.v1.GetInvitationResponse\"\x00\x12M\n" +
+	"\x06Create\x12\x1f.sso.v1.CreateInvitationRequest\x1a .sso.v1.CreateInvitationResponse\"\x00\x12M\n" +
+	"\x06Extend\x12\x1f.sso.v1.ExtendInvitationRequest\x1a .sso.v1.ExtendInvitationResponse\"\x00\x12M\n" +
+	"\x06Revoke\x12\x1f.sso.v1.RevokeInvitationRequest\x1a .sso.v1.RevokeInvitationResponse\"\x00B+Z)loki/internal/app/rpcs/proto/sso/v1;ssov1b\x06proto3"
+
+var (
+	file_sso_v1_invitation_proto_rawDescOnce sync.Once
+	file_sso_v1_invitation_proto_rawDescData []byte
+)
+
+func file_sso_v1_invitation_proto_rawDescGZIP() []byte {
+	file_sso_v1_invitation_proto_rawDescOnce.Do(func() {
+		file_sso_v1_invitation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sso_v1_invitation_proto_rawDesc), len(file_sso_v1_invitation_proto_rawDesc)))
+	})
+	return file_sso_v1_invitation_proto_rawDescData
+}
+
+var file_sso_v1_invitation_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_sso_v1_invitation_proto_goTypes = []any{
+	(*Invitation)(nil),               // 0: sso.v1.Invitation
+	(*ListInvitationsResponse)(nil),  // 1: sso.v1.ListInvitationsResponse
+	(*GetInvitationRequest)(nil),     // 2: sso.v1.GetInvitationRequest
+	(*GetInvitationResponse)(nil),    // 3: sso.v1.GetInvitationResponse
+	(*CreateInvitationRequest)(nil),  // 4: sso.v1.CreateInvitationRequest
+	(*CreateInvitationResponse)(nil), // 5: sso.v1.CreateInvitationResponse
+	(*ExtendInvitationRequest)(nil),  // 6: sso.v1.ExtendInvitationRequest
+	(*ExtendInvitationResponse)(nil), // 7: sso.v1.ExtendInvitationResponse
+	(*RevokeInvitationRequest)(nil),  // 8: sso.v1.RevokeInvitationRequest
+	(*RevokeInvitationResponse)(nil), // 9: sso.v1.RevokeInvitationResponse
+	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
+	(*PaginationMeta)(nil),           // 11: sso.v1.PaginationMeta
+	(*PaginatedListRequest)(nil),     // 12: sso.v1.PaginatedListRequest
+}
+var file_sso_v1_invitation_proto_depIdxs = []int32{
+	10, // 0: sso.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
+	10, // 1: sso.v1.Invitation.sent_at:type_name -> google.protobuf.Timestamp
+	10, // 2: sso.v1.Invitation.accepted_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: sso.v1.ListInvitationsResponse.data:type_name -> sso.v1.Invitation
+	11, // 4: sso.v1.ListInvitationsResponse.meta:type_name -> sso.v1.PaginationMeta
+	0,  // 5: sso.v1.GetInvitationResponse.data:type_name -> sso.v1.Invitation
+	0,  // 6: sso.v1.CreateInvitationResponse.data:type_name -> sso.v1.Invitation
+	0,  // 7: sso.v1.ExtendInvitationResponse.data:type_name -> sso.v1.Invitation
+	0,  // 8: sso.v1.RevokeInvitationResponse.data:type_name -> sso.v1.Invitation
+	12, // 9: sso.v1.InvitationService.List:input_type -> sso.v1.PaginatedListRequest
+	2,  // 10: sso.v1.InvitationService.Get:input_type -> sso.v1.GetInvitationRequest
+	4,  // 11: sso.v1.InvitationService.Create:input_type -> sso.v1.CreateInvitationRequest
+	6,  // 12: sso.v1.InvitationService.Extend:input_type -> sso.v1.ExtendInvitationRequest
+	8,  // 13: sso.v1.InvitationService.Revoke:input_type -> sso.v1.RevokeInvitationRequest
+	1,  // 14: sso.v1.InvitationService.List:output_type -> sso.v1.ListInvitationsResponse
+	3,  // 15: sso.v1.InvitationService.Get:output_type -> sso.v1.GetInvitationResponse
+	5,  // 16: sso.v1.InvitationService.Create:output_type -> sso.v1.CreateInvitationResponse
+	7,  // 17: sso.v1.InvitationService.Extend:output_type -> sso.v1.ExtendInvitationResponse
+	9,  // 18: sso.v1.InvitationService.Revoke:output_type -> sso.v1.RevokeInvitationResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_sso_v1_invitation_proto_init() }
+func file_sso_v1_invitation_proto_init() {
+	if File_sso_v1_invitation_proto != nil {
+		return
+	}
+	file_sso_v1_pagination_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sso_v1_invitation_proto_rawDesc), len(file_sso_v1_invitation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sso_v1_invitation_proto_goTypes,
+		DependencyIndexes: file_sso_v1_invitation_proto_depIdxs,
+		MessageInfos:      file_sso_v1_invitation_proto_msgTypes,
+	}.Build()
+	File_sso_v1_invitation_proto = out.File
+	file_sso_v1_invitation_proto_goTypes = nil
+	file_sso_v1_invitation_proto_depIdxs = nil
+}
@@ -0,0 +1,945 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: sso/v1/organisation.proto
+
+package ssov1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Organisation represents an organisation object
+type Organisation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organisation) Reset() {
+	*x = Organisation{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organisation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organisation) ProtoMessage() {}
+
+func (x *Organisation) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organisation.ProtoReflect.Descriptor instead.
+func (*Organisation) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Organisation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Organisation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organisation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Membership represents a role held by a user within an organisation
+type Membership struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganisationId string                 `protobuf:"bytes,2,opt,name=organisation_id,json=organisationId,proto3" json:"organisation_id,omitempty"`
+	RoleId         string                 `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Membership) Reset() {
+	*x = Membership{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Membership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Membership) ProtoMessage() {}
+
+func (x *Membership) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Membership.ProtoReflect.Descriptor instead.
+func (*Membership) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Membership) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Membership) GetOrganisationId() string {
+	if x != nil {
+		return x.OrganisationId
+	}
+	return ""
+}
+
+func (x *Membership) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+// ListOrganisationsResponse is the response for the List method
+type ListOrganisationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Organisation        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Meta          *PaginationMeta        `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganisationsResponse) Reset() {
+	*x = ListOrganisationsResponse{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganisationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganisationsResponse) ProtoMessage() {}
+
+func (x *ListOrganisationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganisationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganisationsResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListOrganisationsResponse) GetData() []*Organisation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListOrganisationsResponse) GetMeta() *PaginationMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// GetOrganisationRequest is the request for the Get method
+type GetOrganisationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganisationRequest) Reset() {
+	*x = GetOrganisationRequest{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganisationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganisationRequest) ProtoMessage() {}
+
+func (x *GetOrganisationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganisationRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganisationRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetOrganisationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetOrganisationResponse is the response for the Get method
+type GetOrganisationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Organisation          `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganisationResponse) Reset() {
+	*x = GetOrganisationResponse{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganisationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganisationResponse) ProtoMessage() {}
+
+func (x *GetOrganisationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganisationResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganisationResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetOrganisationResponse) GetData() *Organisation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CreateOrganisationRequest is the request for the Create method
+type CreateOrganisationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganisationRequest) Reset() {
+	*x = CreateOrganisationRequest{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganisationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganisationRequest) ProtoMessage() {}
+
+func (x *CreateOrganisationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganisationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganisationRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateOrganisationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOrganisationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// CreateOrganisationResponse is the response for the Create method
+type CreateOrganisationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Organisation          `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganisationResponse) Reset() {
+	*x = CreateOrganisationResponse{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganisationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganisationResponse) ProtoMessage() {}
+
+func (x *CreateOrganisationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganisationResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganisationResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateOrganisationResponse) GetData() *Organisation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// UpdateOrganisationRequest is the request for the Update method
+type UpdateOrganisationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganisationRequest) Reset() {
+	*x = UpdateOrganisationRequest{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganisationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganisationRequest) ProtoMessage() {}
+
+func (x *UpdateOrganisationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganisationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganisationRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateOrganisationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateOrganisationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateOrganisationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// UpdateOrganisationResponse is the response for the Update method
+type UpdateOrganisationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Organisation          `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganisationResponse) Reset() {
+	*x = UpdateOrganisationResponse{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganisationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganisationResponse) ProtoMessage() {}
+
+func (x *UpdateOrganisationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganisationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrganisationResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateOrganisationResponse) GetData() *Organisation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DeleteOrganisationRequest is the request for the Delete method
+type DeleteOrganisationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganisationRequest) Reset() {
+	*x = DeleteOrganisationRequest{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganisationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganisationRequest) ProtoMessage() {}
+
+func (x *DeleteOrganisationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganisationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrganisationRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteOrganisationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ListMembershipsRequest is the request for the ListMemberships method
+type ListMembershipsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganisationId string                 `protobuf:"bytes,1,opt,name=organisation_id,json=organisationId,proto3" json:"organisation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListMembershipsRequest) Reset() {
+	*x = ListMembershipsRequest{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembershipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembershipsRequest) ProtoMessage() {}
+
+func (x *ListMembershipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembershipsRequest.ProtoReflect.Descriptor instead.
+func (*ListMembershipsRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMembershipsRequest) GetOrganisationId() string {
+	if x != nil {
+		return x.OrganisationId
+	}
+	return ""
+}
+
+// ListMembershipsResponse is the response for the ListMemberships method
+type ListMembershipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Membership          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembershipsResponse) Reset() {
+	*x = ListMembershipsResponse{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembershipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembershipsResponse) ProtoMessage() {}
+
+func (x *ListMembershipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembershipsResponse.ProtoReflect.Descriptor instead.
+func (*ListMembershipsResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMembershipsResponse) GetData() []*Membership {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// AddMembershipRequest is the request for the AddMembership method
+type AddMembershipRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganisationId string                 `protobuf:"bytes,1,opt,name=organisation_id,json=organisationId,proto3" json:"organisation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId         string                 `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddMembershipRequest) Reset() {
+	*x = AddMembershipRequest{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMembershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMembershipRequest) ProtoMessage() {}
+
+func (x *AddMembershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMembershipRequest.ProtoReflect.Descriptor instead.
+func (*AddMembershipRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddMembershipRequest) GetOrganisationId() string {
+	if x != nil {
+		return x.OrganisationId
+	}
+	return ""
+}
+
+func (x *AddMembershipRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddMembershipRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+// AddMembershipResponse is the response for the AddMembership method
+type AddMembershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Membership            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMembershipResponse) Reset() {
+	*x = AddMembershipResponse{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMembershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMembershipResponse) ProtoMessage() {}
+
+func (x *AddMembershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMembershipResponse.ProtoReflect.Descriptor instead.
+func (*AddMembershipResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddMembershipResponse) GetData() *Membership {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// RemoveMembershipRequest is the request for the RemoveMembership method
+type RemoveMembershipRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganisationId string                 `protobuf:"bytes,1,opt,name=organisation_id,json=organisationId,proto3" json:"organisation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId         string                 `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveMembershipRequest) Reset() {
+	*x = RemoveMembershipRequest{}
+	mi := &file_sso_v1_organisation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMembershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMembershipRequest) ProtoMessage() {}
+
+func (x *RemoveMembershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_organisation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMembershipRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMembershipRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_organisation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveMembershipRequest) GetOrganisationId() string {
+	if x != nil {
+		return x.OrganisationId
+	}
+	return ""
+}
+
+func (x *RemoveMembershipRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveMembershipRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+var File_sso_v1_organisation_proto protoreflect.FileDescriptor
+
+const file_sso_v1_organisation_proto_rawDesc = "" +
+	"\n" +
+	"\x19sso/v1/organisation.proto\x12\x06sso.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17sso/v1/pagination.proto\"u\n" +
+	"\fOrganisation\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12,\n" +
+	"\vdescription\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x10R\vdescription\"\x85\x01\n" +
+	"\n" +
+	"Membership\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x121\n" +
+	"\x0forganisation_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0eorganisationId\x12!\n" +
+	"\arole_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roleId\"q\n" +
+	"\x19ListOrganisationsResponse\x12(\n" +
+	"\x04data\x18\x01 \x03(\v2\x14.sso.v1.OrganisationR\x04data\x12*\n" +
+	"\x04meta\x18\x02 \x01(\v2\x16.sso.v1.PaginationMetaR\x04meta\"2\n" +
+	"\x16GetOrganisationRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"C\n" +
+	"\x17GetOrganisationResponse\x12(\n" +
+	"\x04data\x18\x01 \x01(\v2\x14.sso.v1.OrganisationR\x04data\"h\n" +
+	"\x19CreateOrganisationRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12,\n" +
+	"\vdescription\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x10R\vdescription\"F\n" +
+	"\x1aCreateOrganisationResponse\x12(\n" +
+	"\x04data\x18\x01 \x01(\v2\x14.sso.v1.OrganisationR\x04data\"\x82\x01\n" +
+	"\x19UpdateOrganisationRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12,\n" +
+	"\vdescription\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x10R\vdescription\"F\n" +
+	"\x1aUpdateOrganisationResponse\x12(\n" +
+	"\x04data\x18\x01 \x01(\v2\x14.sso.v1.OrganisationR\x04data\"5\n" +
+	"\x19DeleteOrganisationRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"K\n" +
+	"\x16ListMembershipsRequest\x121\n" +
+	"\x0forganisation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0eorganisationId\"A\n" +
+	"\x17ListMembershipsResponse\x12&\n" +
+	"\x04data\x18\x01 \x03(\v2\x12.sso.v1.MembershipR\x04data\"\x8f\x01\n" +
+	"\x14AddMembershipRequest\x121\n" +
+	"\x0forganisation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0eorganisationId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12!\n" +
+	"\arole_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roleId\"?\n" +
+	"\x15AddMembershipResponse\x12&\n" +
+	"\x04data\x18\x01 \x01(\v2\x12.sso.v1.MembershipR\x04data\"\x92\x01\n" +
+	"\x17RemoveMembershipRequest\x121\n" +
+	"\x0forganisation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0eorganisationId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12!\n" +
+	"\arole_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roleId2\x8c\x05\n" +
+	"\x13OrganisationService\x12I\n" +
+	"\x04List\x12\x1c.sso.v1.PaginatedListRequest\x1a!.sso.v1.ListOrganisationsResponse\"\x00\x12H\n" +
+	"\x03Get\x12\x1e.sso.v1.GetOrganisationRequest\x1a\x1f.sso.v1.GetOrganisationResponse\"\x00\x12Q\n" +
+	"\x06Create\x12!.sso.v1.CreateOrganisationRequest\x1a\".sso.v1.CreateOrganisationResponse\"\x00\x12Q\n" +
+	"\x06Update\x12!.sso.v1.UpdateOrganisationRequest\x1a\".sso.v1.UpdateOrganisationResponse\"\x00\x12E\n" +
+	"\x06Delete\x12!.sso.v1.DeleteOrganisationRequest\x1a\x16.google.protobuf.Empty\"\x00\x12T\n" +
+	"\x0fListMemberships\x12\x1e.sso.v1.ListMembershipsRequest\x1a\x1f.sso.v1.ListMembershipsResponse\"\x00\x12N\n" +
+	"\rAddMembership\x12\x1c.sso.v1.AddMembershipRequest\x1a\x1d.sso.v1.AddMembershipResponse\"\x00\x12M\n" +
+	"\x10RemoveMembership\x12\x1f.sso.v1.RemoveMembershipRequest\x1a\x16.google.protobuf.Empty\"\x00B+Z)loki/internal/app/rpcs/proto/sso/v1;ssov1b\x06proto3"
+
+var (
+	file_sso_v1_organisation_proto_rawDescOnce sync.Once
+	file_sso_v1_organisation_proto_rawDescData []byte
+)
+
+func file_sso_v1_organisation_proto_rawDescGZIP() []byte {
+	file_sso_v1_organisation_proto_rawDescOnce.Do(func() {
+		file_sso_v1_organisation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sso_v1_organisation_proto_rawDesc), len(file_sso_v1_organisation_proto_rawDesc)))
+	})
+	return file_sso_v1_organisation_proto_rawDescData
+}
+
+var file_sso_v1_organisation_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_sso_v1_organisation_proto_goTypes = []any{
+	(*Organisation)(nil),               // 0: sso.v1.Organisation
+	(*Membership)(nil),                 // 1: sso.v1.Membership
+	(*ListOrganisationsResponse)(nil),  // 2: sso.v1.ListOrganisationsResponse
+	(*GetOrganisationRequest)(nil),     // 3: sso.v1.GetOrganisationRequest
+	(*GetOrganisationResponse)(nil),    // 4: sso.v1.GetOrganisationResponse
+	(*CreateOrganisationRequest)(nil),  // 5: sso.v1.CreateOrganisationRequest
+	(*CreateOrganisationResponse)(nil), // 6: sso.v1.CreateOrganisationResponse
+	(*UpdateOrganisationRequest)(nil),  // 7: sso.v1.UpdateOrganisationRequest
+	(*UpdateOrganisationResponse)(nil), // 8: sso.v1.UpdateOrganisationResponse
+	(*DeleteOrganisationRequest)(nil),  // 9: sso.v1.DeleteOrganisationRequest
+	(*ListMembershipsRequest)(nil),     // 10: sso.v1.ListMembershipsRequest
+	(*ListMembershipsResponse)(nil),    // 11: sso.v1.ListMembershipsResponse
+	(*AddMembershipRequest)(nil),       // 12: sso.v1.AddMembershipRequest
+	(*AddMembershipResponse)(nil),      // 13: sso.v1.AddMembershipResponse
+	(*RemoveMembershipRequest)(nil),    // 14: sso.v1.RemoveMembershipRequest
+	(*PaginationMeta)(nil),             // 15: sso.v1.PaginationMeta
+	(*PaginatedListRequest)(nil),       // 16: sso.v1.PaginatedListRequest
+	(*emptypb.Empty)(nil),              // 17: google.protobuf.Empty
+}
+var file_sso_v1_organisation_proto_depIdxs = []int32{
+	0,  // 0: sso.v1.ListOrganisationsResponse.data:type_name -> sso.v1.Organisation
+	15, // 1: sso.v1.ListOrganisationsResponse.meta:type_name -> sso.v1.PaginationMeta
+	0,  // 2: sso.v1.GetOrganisationResponse.data:type_name -> sso.v1.Organisation
+	0,  // 3: sso.v1.CreateOrganisationResponse.data:type_name -> sso.v1.Organisation
+	0,  // 4: sso.v1.UpdateOrganisationResponse.data:type_name -> sso.v1.Organisation
+	1,  // 5: sso.v1.ListMembershipsResponse.data:type_name -> sso.v1.Membership
+	1,  // 6: sso.v1.AddMembershipResponse.data:type_name -> sso.v1.Membership
+	16, // 7: sso.v1.OrganisationService.List:input_type -> sso.v1.PaginatedListRequest
+	3,  // 8: sso.v1.OrganisationService.Get:input_type -> sso.v1.GetOrganisationRequest
+	5,  // 9: sso.v1.OrganisationService.Create:input_type -> sso.v1.CreateOrganisationRequest
+	7,  // 10: sso.v1.OrganisationService.Update:input_type -> sso.v1.UpdateOrganisationRequest
+	9,  // 11: sso.v1.OrganisationService.Delete:input_type -> sso.v1.DeleteOrganisationRequest
+	10, // 12: sso.v1.OrganisationService.ListMemberships:input_type -> sso.v1.ListMembershipsRequest
+	12, // 13: sso.v1.OrganisationService.AddMembership:input_type -> sso.v1.AddMembershipRequest
+	14, // 14: sso.v1.OrganisationService.RemoveMembership:input_type -> sso.v1.RemoveMembershipRequest
+	2,  // 15: sso.v1.OrganisationService.List:output_type -> sso.v1.ListOrganisationsResponse
+	4,  // 16: sso.v1.OrganisationService.Get:output_type -> sso.v1.GetOrganisationResponse
+	6,  // 17: sso.v1.OrganisationService.Create:output_type -> sso.v1.CreateOrganisationResponse
+	8,  // 18: sso.v1.OrganisationService.Update:output_type -> sso.v1.UpdateOrganisationResponse
+	17, // 19: sso.v1.OrganisationService.Delete:output_type -> google.protobuf.Empty
+	11, // 20: sso.v1.OrganisationService.ListMemberships:output_type -> sso.v1.ListMembershipsResponse
+	13, // 21: sso.v1.OrganisationService.AddMembership:output_type -> sso.v1.AddMembershipResponse
+	17, // 22: sso.v1.OrganisationService.RemoveMembership:output_type -> google.protobuf.Empty
+	15, // [15:23] is the sub-list for method output_type
+	7,  // [7:15] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_sso_v1_organisation_proto_init() }
+func file_sso_v1_organisation_proto_init() {
+	if File_sso_v1_organisation_proto != nil {
+		return
+	}
+	file_sso_v1_pagination_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sso_v1_organisation_proto_rawDesc), len(file_sso_v1_organisation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sso_v1_organisation_proto_goTypes,
+		DependencyIndexes: file_sso_v1_organisation_proto_depIdxs,
+		MessageInfos:      file_sso_v1_organisation_proto_msgTypes,
+	}.Build()
+	File_sso_v1_organisation_proto = out.File
+	file_sso_v1_organisation_proto_goTypes = nil
+	file_sso_v1_organisation_proto_depIdxs = nil
+}
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -25,13 +26,15 @@ const (
 
 // Role represents a role object
 type Role struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	PermissionIds []string               `protobuf:"bytes,4,rep,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description            string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PermissionIds          []string               `protobuf:"bytes,4,rep,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	ParentId               string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	InheritedPermissionIds []string               `protobuf:"bytes,6,rep,name=inherited_permission_ids,json=inheritedPermissionIds,proto3" json:"inherited_permission_ids,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *Role) Reset() {
@@ -92,6 +95,20 @@ func (x *Role) GetPermissionIds() []string {
 	return nil
 }
 
+func (x *Role) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Role) GetInheritedPermissionIds() []string {
+	if x != nil {
+		return x.InheritedPermissionIds
+	}
+	return nil
+}
+
 // ListRolesResponse is the response for the List method
 type ListRolesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -241,6 +258,7 @@ type CreateRoleRequest struct {
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	PermissionIds []string               `protobuf:"bytes,3,rep,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -296,6 +314,13 @@ func (x *CreateRoleRequest) GetPermissionIds() []string {
 	return nil
 }
 
+func (x *CreateRoleRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
 // CreateRoleResponse is the response for the Create method
 type CreateRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -348,6 +373,9 @@ type UpdateRoleRequest struct {
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	PermissionIds []string               `protobuf:"bytes,4,rep,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	ParentId      string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// update_mask limits the update to the listed fields, every field is replaced when empty
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -410,6 +438,20 @@ func (x *UpdateRoleRequest) GetPermissionIds() []string {
 	return nil
 }
 
+func (x *UpdateRoleRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 // UpdateRoleResponse is the response for the Update method
 type UpdateRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -500,50 +542,220 @@ func (x *DeleteRoleRequest) GetId() string {
 	return ""
 }
 
+// ChangeRolePermissionsRequest is the request for the AddPermissions and RemovePermissions methods
+type ChangeRolePermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PermissionIds []string               `protobuf:"bytes,2,rep,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeRolePermissionsRequest) Reset() {
+	*x = ChangeRolePermissionsRequest{}
+	mi := &file_sso_v1_role_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeRolePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeRolePermissionsRequest) ProtoMessage() {}
+
+func (x *ChangeRolePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_role_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeRolePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*ChangeRolePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_role_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChangeRolePermissionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeRolePermissionsRequest) GetPermissionIds() []string {
+	if x != nil {
+		return x.PermissionIds
+	}
+	return nil
+}
+
+// SetRolePermissionsRequest is the request for the SetPermissions method, an empty list removes all permissions
+type SetRolePermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PermissionIds []string               `protobuf:"bytes,2,rep,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRolePermissionsRequest) Reset() {
+	*x = SetRolePermissionsRequest{}
+	mi := &file_sso_v1_role_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRolePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRolePermissionsRequest) ProtoMessage() {}
+
+func (x *SetRolePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_role_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRolePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*SetRolePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_role_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetRolePermissionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetRolePermissionsRequest) GetPermissionIds() []string {
+	if x != nil {
+		return x.PermissionIds
+	}
+	return nil
+}
+
+// RolePermissionsResponse is the response for the permission methods, it holds the resulting role
+type RolePermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Role                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolePermissionsResponse) Reset() {
+	*x = RolePermissionsResponse{}
+	mi := &file_sso_v1_role_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolePermissionsResponse) ProtoMessage() {}
+
+func (x *RolePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_role_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolePermissionsResponse.ProtoReflect.Descriptor instead.
+func (*RolePermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_role_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RolePermissionsResponse) GetData() *Role {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_sso_v1_role_proto protoreflect.FileDescriptor
 
 const file_sso_v1_role_proto_rawDesc = "" +
 	"\n" +
-	"\x11sso/v1/role.proto\x12\x06sso.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17sso/v1/pagination.proto\"\xa3\x01\n" +
+	"\x11sso/v1/role.proto\x12\x06sso.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x17sso/v1/pagination.proto\"\x96\x02\n" +
 	"\x04Role\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
 	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12,\n" +
 	"\vdescription\x18\x03 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\x80\x10R\vdescription\x124\n" +
 	"\x0epermission_ids\x18\x04 \x03(\tB\r\xbaH\n" +
-	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\rpermissionIds\"a\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\rpermissionIds\x12(\n" +
+	"\tparent_id\x18\x05 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\bparentId\x12G\n" +
+	"\x18inherited_permission_ids\x18\x06 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\x16inheritedPermissionIds\"a\n" +
 	"\x11ListRolesResponse\x12 \n" +
 	"\x04data\x18\x01 \x03(\v2\f.sso.v1.RoleR\x04data\x12*\n" +
 	"\x04meta\x18\x02 \x01(\v2\x16.sso.v1.PaginationMetaR\x04meta\"*\n" +
 	"\x0eGetRoleRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"3\n" +
 	"\x0fGetRoleResponse\x12 \n" +
-	"\x04data\x18\x01 \x01(\v2\f.sso.v1.RoleR\x04data\"\x96\x01\n" +
+	"\x04data\x18\x01 \x01(\v2\f.sso.v1.RoleR\x04data\"\xc0\x01\n" +
 	"\x11CreateRoleRequest\x12\x1d\n" +
 	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12,\n" +
 	"\vdescription\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\x80\x10R\vdescription\x124\n" +
 	"\x0epermission_ids\x18\x03 \x03(\tB\r\xbaH\n" +
-	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\rpermissionIds\"6\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\rpermissionIds\x12(\n" +
+	"\tparent_id\x18\x04 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\bparentId\"6\n" +
 	"\x12CreateRoleResponse\x12 \n" +
-	"\x04data\x18\x01 \x01(\v2\f.sso.v1.RoleR\x04data\"\xb0\x01\n" +
+	"\x04data\x18\x01 \x01(\v2\f.sso.v1.RoleR\x04data\"\x97\x02\n" +
 	"\x11UpdateRoleRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
 	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12,\n" +
 	"\vdescription\x18\x03 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\x80\x10R\vdescription\x124\n" +
 	"\x0epermission_ids\x18\x04 \x03(\tB\r\xbaH\n" +
-	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\rpermissionIds\"6\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\rpermissionIds\x12(\n" +
+	"\tparent_id\x18\x05 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\bparentId\x12;\n" +
+	"\vupdate_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"6\n" +
 	"\x12UpdateRoleResponse\x12 \n" +
 	"\x04data\x18\x01 \x01(\v2\f.sso.v1.RoleR\x04data\"-\n" +
 	"\x11DeleteRoleRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id2\xcf\x02\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"p\n" +
+	"\x1cChangeRolePermissionsRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x126\n" +
+	"\x0epermission_ids\x18\x02 \x03(\tB\x0f\xbaH\f\x92\x01\t\b\x01\"\x05r\x03\xb0\x01\x01R\rpermissionIds\"k\n" +
+	"\x19SetRolePermissionsRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x124\n" +
+	"\x0epermission_ids\x18\x02 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\rpermissionIds\";\n" +
+	"\x17RolePermissionsResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.sso.v1.RoleR\x04data2\xe0\x04\n" +
 	"\vRoleService\x12A\n" +
 	"\x04List\x12\x1c.sso.v1.PaginatedListRequest\x1a\x19.sso.v1.ListRolesResponse\"\x00\x128\n" +
 	"\x03Get\x12\x16.sso.v1.GetRoleRequest\x1a\x17.sso.v1.GetRoleResponse\"\x00\x12A\n" +
 	"\x06Create\x12\x19.sso.v1.CreateRoleRequest\x1a\x1a.sso.v1.CreateRoleResponse\"\x00\x12A\n" +
 	"\x06Update\x12\x19.sso.v1.UpdateRoleRequest\x1a\x1a.sso.v1.UpdateRoleResponse\"\x00\x12=\n" +
-	"\x06Delete\x12\x19.sso.v1.DeleteRoleRequest\x1a\x16.google.protobuf.Empty\"\x00B+Z)loki/internal/app/rpcs/proto/sso/v1;ssov1b\x06proto3"
+	"\x06Delete\x12\x19.sso.v1.DeleteRoleRequest\x1a\x16.google.protobuf.Empty\"\x00\x12Y\n" +
+	"\x0eAddPermissions\x12$.sso.v1.ChangeRolePermissionsRequest\x1a\x1f.sso.v1.RolePermissionsResponse\"\x00\x12\\\n" +
+	"\x11RemovePermissions\x12$.sso.v1.ChangeRolePermissionsRequest\x1a\x1f.sso.v1.RolePermissionsResponse\"\x00\x12V\n" +
+	"\x0eSetPermissions\x12!.sso.v1.SetRolePermissionsRequest\x1a\x1f.sso.v1.RolePermissionsResponse\"\x00B+Z)loki/internal/app/rpcs/proto/sso/v1;ssov1b\x06proto3"
 
 var (
 	file_sso_v1_role_proto_rawDescOnce sync.Once
@@ -557,42 +769,54 @@ func file_sso_v1_role_proto_rawDescGZIP() []byte {
 	return file_sso_v1_role_proto_rawDescData
 }
 
-var file_sso_v1_role_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_sso_v1_role_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_sso_v1_role_proto_goTypes = []any{
-	(*Role)(nil),                 // 0: sso.v1.Role
-	(*ListRolesResponse)(nil),    // 1: sso.v1.ListRolesResponse
-	(*GetRoleRequest)(nil),       // 2: sso.v1.GetRoleRequest
-	(*GetRoleResponse)(nil),      // 3: sso.v1.GetRoleResponse
-	(*CreateRoleRequest)(nil),    // 4: sso.v1.CreateRoleRequest
-	(*CreateRoleResponse)(nil),   // 5: sso.v1.CreateRoleResponse
-	(*UpdateRoleRequest)(nil),    // 6: sso.v1.UpdateRoleRequest
-	(*UpdateRoleResponse)(nil),   // 7: sso.v1.UpdateRoleResponse
-	(*DeleteRoleRequest)(nil),    // 8: sso.v1.DeleteRoleRequest
-	(*PaginationMeta)(nil),       // 9: sso.v1.PaginationMeta
-	(*PaginatedListRequest)(nil), // 10: sso.v1.PaginatedListRequest
-	(*emptypb.Empty)(nil),        // 11: google.protobuf.Empty
+	(*Role)(nil),                         // 0: sso.v1.Role
+	(*ListRolesResponse)(nil),            // 1: sso.v1.ListRolesResponse
+	(*GetRoleRequest)(nil),               // 2: sso.v1.GetRoleRequest
+	(*GetRoleResponse)(nil),              // 3: sso.v1.GetRoleResponse
+	(*CreateRoleRequest)(nil),            // 4: sso.v1.CreateRoleRequest
+	(*CreateRoleResponse)(nil),           // 5: sso.v1.CreateRoleResponse
+	(*UpdateRoleRequest)(nil),            // 6: sso.v1.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),           // 7: sso.v1.UpdateRoleResponse
+	(*DeleteRoleRequest)(nil),            // 8: sso.v1.DeleteRoleRequest
+	(*ChangeRolePermissionsRequest)(nil), // 9: sso.v1.ChangeRolePermissionsRequest
+	(*SetRolePermissionsRequest)(nil),    // 10: sso.v1.SetRolePermissionsRequest
+	(*RolePermissionsResponse)(nil),      // 11: sso.v1.RolePermissionsResponse
+	(*PaginationMeta)(nil),               // 12: sso.v1.PaginationMeta
+	(*fieldmaskpb.FieldMask)(nil),        // 13: google.protobuf.FieldMask
+	(*PaginatedListRequest)(nil),         // 14: sso.v1.PaginatedListRequest
+	(*emptypb.Empty)(nil),                // 15: google.protobuf.Empty
 }
 var file_sso_v1_role_proto_depIdxs = []int32{
 	0,  // 0: sso.v1.ListRolesResponse.data:type_name -> sso.v1.Role
-	9,  // 1: sso.v1.ListRolesResponse.meta:type_name -> sso.v1.PaginationMeta
+	12, // 1: sso.v1.ListRolesResponse.meta:type_name -> sso.v1.PaginationMeta
 	0,  // 2: sso.v1.GetRoleResponse.data:type_name -> sso.v1.Role
 	0,  // 3: sso.v1.CreateRoleResponse.data:type_name -> sso.v1.Role
-	0,  // 4: sso.v1.UpdateRoleResponse.data:type_name -> sso.v1.Role
-	10, // 5: sso.v1.RoleService.List:input_type -> sso.v1.PaginatedListRequest
-	2,  // 6: sso.v1.RoleService.Get:input_type -> sso.v1.GetRoleRequest
-	4,  // 7: sso.v1.RoleService.Create:input_type -> sso.v1.CreateRoleRequest
-	6,  // 8: sso.v1.RoleService.Update:input_type -> sso.v1.UpdateRoleRequest
-	8,  // 9: sso.v1.RoleService.Delete:input_type -> sso.v1.DeleteRoleRequest
-	1,  // 10: sso.v1.RoleService.List:output_type -> sso.v1.ListRolesResponse
-	3,  // 11: sso.v1.RoleService.Get:output_type -> sso.v1.GetRoleResponse
-	5,  // 12: sso.v1.RoleService.Create:output_type -> sso.v1.CreateRoleResponse
-	7,  // 13: sso.v1.RoleService.Update:output_type -> sso.v1.UpdateRoleResponse
-	11, // 14: sso.v1.RoleService.Delete:output_type -> google.protobuf.Empty
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	13, // 4: sso.v1.UpdateRoleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 5: sso.v1.UpdateRoleResponse.data:type_name -> sso.v1.Role
+	0,  // 6: sso.v1.RolePermissionsResponse.data:type_name -> sso.v1.Role
+	14, // 7: sso.v1.RoleService.List:input_type -> sso.v1.PaginatedListRequest
+	2,  // 8: sso.v1.RoleService.Get:input_type -> sso.v1.GetRoleRequest
+	4,  // 9: sso.v1.RoleService.Create:input_type -> sso.v1.CreateRoleRequest
+	6,  // 10: sso.v1.RoleService.Update:input_type -> sso.v1.UpdateRoleRequest
+	8,  // 11: sso.v1.RoleService.Delete:input_type -> sso.v1.DeleteRoleRequest
+	9,  // 12: sso.v1.RoleService.AddPermissions:input_type -> sso.v1.ChangeRolePermissionsRequest
+	9,  // 13: sso.v1.RoleService.RemovePermissions:input_type -> sso.v1.ChangeRolePermissionsRequest
+	10, // 14: sso.v1.RoleService.SetPermissions:input_type -> sso.v1.SetRolePermissionsRequest
+	1,  // 15: sso.v1.RoleService.List:output_type -> sso.v1.ListRolesResponse
+	3,  // 16: sso.v1.RoleService.Get:output_type -> sso.v1.GetRoleResponse
+	5,  // 17: sso.v1.RoleService.Create:output_type -> sso.v1.CreateRoleResponse
+	7,  // 18: sso.v1.RoleService.Update:output_type -> sso.v1.UpdateRoleResponse
+	15, // 19: sso.v1.RoleService.Delete:output_type -> google.protobuf.Empty
+	11, // 20: sso.v1.RoleService.AddPermissions:output_type -> sso.v1.RolePermissionsResponse
+	11, // 21: sso.v1.RoleService.RemovePermissions:output_type -> sso.v1.RolePermissionsResponse
+	11, // 22: sso.v1.RoleService.SetPermissions:output_type -> sso.v1.RolePermissionsResponse
+	15, // [15:23] is the sub-list for method output_type
+	7,  // [7:15] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_sso_v1_role_proto_init() }
@@ -607,7 +831,7 @@ func file_sso_v1_role_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sso_v1_role_proto_rawDesc), len(file_sso_v1_role_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,11 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RoleService_List_FullMethodName   = "/sso.v1.RoleService/List"
-	RoleService_Get_FullMethodName    = "/sso.v1.RoleService/Get"
-	RoleService_Create_FullMethodName = "/sso.v1.RoleService/Create"
-	RoleService_Update_FullMethodName = "/sso.v1.RoleService/Update"
-	RoleService_Delete_FullMethodName = "/sso.v1.RoleService/Delete"
+	RoleService_List_FullMethodName              = "/sso.v1.RoleService/List"
+	RoleService_Get_FullMethodName               = "/sso.v1.RoleService/Get"
+	RoleService_Create_FullMethodName            = "/sso.v1.RoleService/Create"
+	RoleService_Update_FullMethodName            = "/sso.v1.RoleService/Update"
+	RoleService_Delete_FullMethodName            = "/sso.v1.RoleService/Delete"
+	RoleService_AddPermissions_FullMethodName    = "/sso.v1.RoleService/AddPermissions"
+	RoleService_RemovePermissions_FullMethodName = "/sso.v1.RoleService/RemovePermissions"
+	RoleService_SetPermissions_FullMethodName    = "/sso.v1.RoleService/SetPermissions"
 )
 
 // RoleServiceClient is the client API for RoleService service.
@@ -38,6 +41,9 @@ type RoleServiceClient interface {
 	Create(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
 	Update(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error)
 	Delete(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddPermissions(ctx context.Context, in *ChangeRolePermissionsRequest, opts ...grpc.CallOption) (*RolePermissionsResponse, error)
+	RemovePermissions(ctx context.Context, in *ChangeRolePermissionsRequest, opts ...grpc.CallOption) (*RolePermissionsResponse, error)
+	SetPermissions(ctx context.Context, in *SetRolePermissionsRequest, opts ...grpc.CallOption) (*RolePermissionsResponse, error)
 }
 
 type roleServiceClient struct {
@@ -98,6 +104,36 @@ func (c *roleServiceClient) Delete(ctx context.Context, in *DeleteRoleRequest, o
 	return out, nil
 }
 
+func (c *roleServiceClient) AddPermissions(ctx context.Context, in *ChangeRolePermissionsRequest, opts ...grpc.CallOption) (*RolePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolePermissionsResponse)
+	err := c.cc.Invoke(ctx, RoleService_AddPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) RemovePermissions(ctx context.Context, in *ChangeRolePermissionsRequest, opts ...grpc.CallOption) (*RolePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolePermissionsResponse)
+	err := c.cc.Invoke(ctx, RoleService_RemovePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) SetPermissions(ctx context.Context, in *SetRolePermissionsRequest, opts ...grpc.CallOption) (*RolePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolePermissionsResponse)
+	err := c.cc.Invoke(ctx, RoleService_SetPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleServiceServer is the server API for RoleService service.
 // All implementations must embed UnimplementedRoleServiceServer
 // for forward compatibility.
@@ -109,6 +145,9 @@ type RoleServiceServer interface {
 	Create(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
 	Update(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
 	Delete(context.Context, *DeleteRoleRequest) (*emptypb.Empty, error)
+	AddPermissions(context.Context, *ChangeRolePermissionsRequest) (*RolePermissionsResponse, error)
+	RemovePermissions(context.Context, *ChangeRolePermissionsRequest) (*RolePermissionsResponse, error)
+	SetPermissions(context.Context, *SetRolePermissionsRequest) (*RolePermissionsResponse, error)
 	mustEmbedUnimplementedRoleServiceServer()
 }
 
@@ -134,6 +173,15 @@ func (UnimplementedRoleServiceServer) Update(context.Context, *UpdateRoleRequest
 func (UnimplementedRoleServiceServer) Delete(context.Context, *DeleteRoleRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedRoleServiceServer) AddPermissions(context.Context, *ChangeRolePermissionsRequest) (*RolePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPermissions not implemented")
+}
+func (UnimplementedRoleServiceServer) RemovePermissions(context.Context, *ChangeRolePermissionsRequest) (*RolePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePermissions not implemented")
+}
+func (UnimplementedRoleServiceServer) SetPermissions(context.Context, *SetRolePermissionsRequest) (*RolePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPermissions not implemented")
+}
 func (UnimplementedRoleServiceServer) mustEmbedUnimplementedRoleServiceServer() {}
 func (UnimplementedRoleServiceServer) testEmbeddedByValue()                     {}
 
@@ -245,6 +293,60 @@ func _RoleService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_AddPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeRolePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).AddPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_AddPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).AddPermissions(ctx, req.(*ChangeRolePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_RemovePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeRolePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).RemovePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_RemovePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).RemovePermissions(ctx, req.(*ChangeRolePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_SetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRolePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).SetPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_SetPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).SetPermissions(ctx, req.(*SetRolePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoleService_ServiceDesc is the grpc.ServiceDesc for RoleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -272,6 +374,18 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _RoleService_Delete_Handler,
 		},
+		{
+			MethodName: "AddPermissions",
+			Handler:    _RoleService_AddPermissions_Handler,
+		},
+		{
+			MethodName: "RemovePermissions",
+			Handler:    _RoleService_RemovePermissions_Handler,
+		},
+		{
+			MethodName: "SetPermissions",
+			Handler:    _RoleService_SetPermissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sso/v1/role.proto",
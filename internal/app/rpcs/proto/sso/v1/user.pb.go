@@ -11,6 +11,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -25,16 +27,19 @@ const (
 
 // User represents a user object
 type User struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IdentityNumber string                 `protobuf:"bytes,2,opt,name=identity_number,json=identityNumber,proto3" json:"identity_number,omitempty"`
-	PersonalCode   string                 `protobuf:"bytes,3,opt,name=personal_code,json=personalCode,proto3" json:"personal_code,omitempty"`
-	FirstName      string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName       string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	RoleIds        []string               `protobuf:"bytes,6,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
-	ScopeIds       []string               `protobuf:"bytes,7,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdentityNumber  string                 `protobuf:"bytes,2,opt,name=identity_number,json=identityNumber,proto3" json:"identity_number,omitempty"`
+	PersonalCode    string                 `protobuf:"bytes,3,opt,name=personal_code,json=personalCode,proto3" json:"personal_code,omitempty"`
+	FirstName       string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName        string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	RoleIds         []string               `protobuf:"bytes,6,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	ScopeIds        []string               `protobuf:"bytes,7,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	Status          string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	StatusReason    string                 `protobuf:"bytes,9,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	StatusChangedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -116,6 +121,27 @@ func (x *User) GetScopeIds() []string {
 	return nil
 }
 
+func (x *User) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *User) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *User) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
 // ListUsersResponse is the response for the List method
 type ListUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -399,8 +425,10 @@ type UpdateUserRequest struct {
 	LastName       string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
 	RoleIds        []string               `protobuf:"bytes,6,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
 	ScopeIds       []string               `protobuf:"bytes,7,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// update_mask limits the update to the listed fields, every field is replaced when empty
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateUserRequest) Reset() {
@@ -482,6 +510,13 @@ func (x *UpdateUserRequest) GetScopeIds() []string {
 	return nil
 }
 
+func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 // UpdateUserResponse is the response for the Update method
 type UpdateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -572,126 +607,1734 @@ func (x *DeleteUserRequest) GetId() string {
 	return ""
 }
 
-var File_sso_v1_user_proto protoreflect.FileDescriptor
+// SetUserStatusRequest is the request for the SetStatus method
+type SetUserStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_sso_v1_user_proto_rawDesc = "" +
-	"\n" +
-	"\x11sso/v1/user.proto\x12\x06sso.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17sso/v1/pagination.proto\"\xac\x02\n" +
-	"\x04User\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x122\n" +
-	"\x0fidentity_number\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x0f\x18\x14R\x0eidentityNumber\x12.\n" +
-	"\rpersonal_code\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\v\x18\x14R\fpersonalCode\x12(\n" +
-	"\n" +
-	"first_name\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\tfirstName\x12&\n" +
-	"\tlast_name\x18\x05 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\blastName\x12(\n" +
-	"\brole_ids\x18\x06 \x03(\tB\r\xbaH\n" +
-	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\aroleIds\x12*\n" +
-	"\tscope_ids\x18\a \x03(\tB\r\xbaH\n" +
-	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\bscopeIds\"a\n" +
-	"\x11ListUsersResponse\x12 \n" +
-	"\x04data\x18\x01 \x03(\v2\f.sso.v1.UserR\x04data\x12*\n" +
-	"\x04meta\x18\x02 \x01(\v2\x16.sso.v1.PaginationMetaR\x04meta\"*\n" +
-	"\x0eGetUserRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"3\n" +
-	"\x0fGetUserResponse\x12 \n" +
-	"\x04data\x18\x01 \x01(\v2\f.sso.v1.UserR\x04data\"\x9f\x02\n" +
-	"\x11CreateUserRequest\x122\n" +
-	"\x0fidentity_number\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x0f\x18\x14R\x0eidentityNumber\x12.\n" +
-	"\rpersonal_code\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\v\x18\x14R\fpersonalCode\x12(\n" +
-	"\n" +
-	"first_name\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\tfirstName\x12&\n" +
-	"\tlast_name\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\blastName\x12(\n" +
-	"\brole_ids\x18\x05 \x03(\tB\r\xbaH\n" +
-	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\aroleIds\x12*\n" +
-	"\tscope_ids\x18\x06 \x03(\tB\r\xbaH\n" +
-	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\bscopeIds\"6\n" +
-	"\x12CreateUserResponse\x12 \n" +
-	"\x04data\x18\x01 \x01(\v2\f.sso.v1.UserR\x04data\"\xb9\x02\n" +
-	"\x11UpdateUserRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x122\n" +
-	"\x0fidentity_number\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x0f\x18\x14R\x0eidentityNumber\x12.\n" +
-	"\rpersonal_code\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\v\x18\x14R\fpersonalCode\x12(\n" +
-	"\n" +
-	"first_name\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\tfirstName\x12&\n" +
-	"\tlast_name\x18\x05 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\blastName\x12(\n" +
-	"\brole_ids\x18\x06 \x03(\tB\r\xbaH\n" +
-	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\aroleIds\x12*\n" +
-	"\tscope_ids\x18\a \x03(\tB\r\xbaH\n" +
-	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\bscopeIds\"6\n" +
-	"\x12UpdateUserResponse\x12 \n" +
-	"\x04data\x18\x01 \x01(\v2\f.sso.v1.UserR\x04data\"-\n" +
-	"\x11DeleteUserRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id2\xcf\x02\n" +
-	"\vUserService\x12A\n" +
-	"\x04List\x12\x1c.sso.v1.PaginatedListRequest\x1a\x19.sso.v1.ListUsersResponse\"\x00\x128\n" +
-	"\x03Get\x12\x16.sso.v1.GetUserRequest\x1a\x17.sso.v1.GetUserResponse\"\x00\x12A\n" +
-	"\x06Create\x12\x19.sso.v1.CreateUserRequest\x1a\x1a.sso.v1.CreateUserResponse\"\x00\x12A\n" +
-	"\x06Update\x12\x19.sso.v1.UpdateUserRequest\x1a\x1a.sso.v1.UpdateUserResponse\"\x00\x12=\n" +
-	"\x06Delete\x12\x19.sso.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"\x00B+Z)loki/internal/app/rpcs/proto/sso/v1;ssov1b\x06proto3"
+func (x *SetUserStatusRequest) Reset() {
+	*x = SetUserStatusRequest{}
+	mi := &file_sso_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_sso_v1_user_proto_rawDescOnce sync.Once
-	file_sso_v1_user_proto_rawDescData []byte
-)
+func (x *SetUserStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_sso_v1_user_proto_rawDescGZIP() []byte {
-	file_sso_v1_user_proto_rawDescOnce.Do(func() {
-		file_sso_v1_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sso_v1_user_proto_rawDesc), len(file_sso_v1_user_proto_rawDesc)))
-	})
-	return file_sso_v1_user_proto_rawDescData
+func (*SetUserStatusRequest) ProtoMessage() {}
+
+func (x *SetUserStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_sso_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_sso_v1_user_proto_goTypes = []any{
-	(*User)(nil),                 // 0: sso.v1.User
-	(*ListUsersResponse)(nil),    // 1: sso.v1.ListUsersResponse
-	(*GetUserRequest)(nil),       // 2: sso.v1.GetUserRequest
-	(*GetUserResponse)(nil),      // 3: sso.v1.GetUserResponse
-	(*CreateUserRequest)(nil),    // 4: sso.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),   // 5: sso.v1.CreateUserResponse
-	(*UpdateUserRequest)(nil),    // 6: sso.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),   // 7: sso.v1.UpdateUserResponse
-	(*DeleteUserRequest)(nil),    // 8: sso.v1.DeleteUserRequest
-	(*PaginationMeta)(nil),       // 9: sso.v1.PaginationMeta
-	(*PaginatedListRequest)(nil), // 10: sso.v1.PaginatedListRequest
-	(*emptypb.Empty)(nil),        // 11: google.protobuf.Empty
+// Deprecated: Use SetUserStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetUserStatusRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{9}
 }
-var file_sso_v1_user_proto_depIdxs = []int32{
-	0,  // 0: sso.v1.ListUsersResponse.data:type_name -> sso.v1.User
-	9,  // 1: sso.v1.ListUsersResponse.meta:type_name -> sso.v1.PaginationMeta
-	0,  // 2: sso.v1.GetUserResponse.data:type_name -> sso.v1.User
-	0,  // 3: sso.v1.CreateUserResponse.data:type_name -> sso.v1.User
-	0,  // 4: sso.v1.UpdateUserResponse.data:type_name -> sso.v1.User
-	10, // 5: sso.v1.UserService.List:input_type -> sso.v1.PaginatedListRequest
-	2,  // 6: sso.v1.UserService.Get:input_type -> sso.v1.GetUserRequest
-	4,  // 7: sso.v1.UserService.Create:input_type -> sso.v1.CreateUserRequest
-	6,  // 8: sso.v1.UserService.Update:input_type -> sso.v1.UpdateUserRequest
-	8,  // 9: sso.v1.UserService.Delete:input_type -> sso.v1.DeleteUserRequest
-	1,  // 10: sso.v1.UserService.List:output_type -> sso.v1.ListUsersResponse
-	3,  // 11: sso.v1.UserService.Get:output_type -> sso.v1.GetUserResponse
-	5,  // 12: sso.v1.UserService.Create:output_type -> sso.v1.CreateUserResponse
-	7,  // 13: sso.v1.UserService.Update:output_type -> sso.v1.UpdateUserResponse
-	11, // 14: sso.v1.UserService.Delete:output_type -> google.protobuf.Empty
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+
+func (x *SetUserStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func init() { file_sso_v1_user_proto_init() }
-func file_sso_v1_user_proto_init() {
-	if File_sso_v1_user_proto != nil {
-		return
+func (x *SetUserStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SetUserStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// SetUserStatusResponse is the response for the SetStatus method
+type SetUserStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *User                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserStatusResponse) Reset() {
+	*x = SetUserStatusResponse{}
+	mi := &file_sso_v1_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserStatusResponse) ProtoMessage() {}
+
+func (x *SetUserStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetUserStatusResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetUserStatusResponse) GetData() *User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ChangeUserRolesRequest is the request for the AssignRoles and RevokeRoles methods
+type ChangeUserRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoleIds       []string               `protobuf:"bytes,2,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserRolesRequest) Reset() {
+	*x = ChangeUserRolesRequest{}
+	mi := &file_sso_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserRolesRequest) ProtoMessage() {}
+
+func (x *ChangeUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChangeUserRolesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeUserRolesRequest) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+// SetUserRolesRequest is the request for the SetRoles method, an empty list removes all roles
+type SetUserRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoleIds       []string               `protobuf:"bytes,2,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRolesRequest) Reset() {
+	*x = SetUserRolesRequest{}
+	mi := &file_sso_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRolesRequest) ProtoMessage() {}
+
+func (x *SetUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*SetUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetUserRolesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetUserRolesRequest) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+// ChangeUserScopesRequest is the request for the AssignScopes and RevokeScopes methods
+type ChangeUserScopesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScopeIds      []string               `protobuf:"bytes,2,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserScopesRequest) Reset() {
+	*x = ChangeUserScopesRequest{}
+	mi := &file_sso_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserScopesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserScopesRequest) ProtoMessage() {}
+
+func (x *ChangeUserScopesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserScopesRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserScopesRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChangeUserScopesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeUserScopesRequest) GetScopeIds() []string {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+// SetUserScopesRequest is the request for the SetScopes method, an empty list removes all scopes
+type SetUserScopesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScopeIds      []string               `protobuf:"bytes,2,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserScopesRequest) Reset() {
+	*x = SetUserScopesRequest{}
+	mi := &file_sso_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserScopesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserScopesRequest) ProtoMessage() {}
+
+func (x *SetUserScopesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserScopesRequest.ProtoReflect.Descriptor instead.
+func (*SetUserScopesRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetUserScopesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetUserScopesRequest) GetScopeIds() []string {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+// UserLinksResponse is the response for the role and scope methods, it holds the resulting user
+type UserLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *User                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserLinksResponse) Reset() {
+	*x = UserLinksResponse{}
+	mi := &file_sso_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLinksResponse) ProtoMessage() {}
+
+func (x *UserLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLinksResponse.ProtoReflect.Descriptor instead.
+func (*UserLinksResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserLinksResponse) GetData() *User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetEffectivePermissionsRequest is the request for the GetEffectivePermissions method
+type GetEffectivePermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEffectivePermissionsRequest) Reset() {
+	*x = GetEffectivePermissionsRequest{}
+	mi := &file_sso_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectivePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectivePermissionsRequest) ProtoMessage() {}
+
+func (x *GetEffectivePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectivePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetEffectivePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetEffectivePermissionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PathRole is a role on the path that grants a permission
+type PathRole struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PathRole) Reset() {
+	*x = PathRole{}
+	mi := &file_sso_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PathRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathRole) ProtoMessage() {}
+
+func (x *PathRole) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathRole.ProtoReflect.Descriptor instead.
+func (*PathRole) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PathRole) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PathRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// PermissionPath lists the roles from the one assigned to the user to the one holding the permission
+type PermissionPath struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Roles []*PathRole            `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	// expires_at is set when the assignment of the first role is temporary
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// organisation_id is set when the first role is held through an organisation membership
+	OrganisationId string `protobuf:"bytes,3,opt,name=organisation_id,json=organisationId,proto3" json:"organisation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PermissionPath) Reset() {
+	*x = PermissionPath{}
+	mi := &file_sso_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionPath) ProtoMessage() {}
+
+func (x *PermissionPath) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionPath.ProtoReflect.Descriptor instead.
+func (*PermissionPath) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PermissionPath) GetRoles() []*PathRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *PermissionPath) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PermissionPath) GetOrganisationId() string {
+	if x != nil {
+		return x.OrganisationId
+	}
+	return ""
+}
+
+// EffectivePermission is a permission held by the user with every path that grants it
+type EffectivePermission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Paths         []*PermissionPath      `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EffectivePermission) Reset() {
+	*x = EffectivePermission{}
+	mi := &file_sso_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectivePermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectivePermission) ProtoMessage() {}
+
+func (x *EffectivePermission) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectivePermission.ProtoReflect.Descriptor instead.
+func (*EffectivePermission) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EffectivePermission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EffectivePermission) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EffectivePermission) GetPaths() []*PermissionPath {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+// GetEffectivePermissionsResponse is the response for the GetEffectivePermissions method
+type GetEffectivePermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*EffectivePermission `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEffectivePermissionsResponse) Reset() {
+	*x = GetEffectivePermissionsResponse{}
+	mi := &file_sso_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectivePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectivePermissionsResponse) ProtoMessage() {}
+
+func (x *GetEffectivePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectivePermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetEffectivePermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetEffectivePermissionsResponse) GetData() []*EffectivePermission {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ExplainRequest is the request for the Explain method
+type ExplainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Permission    string                 `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainRequest) Reset() {
+	*x = ExplainRequest{}
+	mi := &file_sso_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainRequest) ProtoMessage() {}
+
+func (x *ExplainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainRequest.ProtoReflect.Descriptor instead.
+func (*ExplainRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExplainRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExplainRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+// ExplainResponse is the response for the Explain method, data is empty when the permission is not granted
+type ExplainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Data          *EffectivePermission   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainResponse) Reset() {
+	*x = ExplainResponse{}
+	mi := &file_sso_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainResponse) ProtoMessage() {}
+
+func (x *ExplainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainResponse.ProtoReflect.Descriptor instead.
+func (*ExplainResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExplainResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *ExplainResponse) GetData() *EffectivePermission {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ImportUsersOptions configures an import, it must be sent as the first message of the stream
+type ImportUsersOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUsersOptions) Reset() {
+	*x = ImportUsersOptions{}
+	mi := &file_sso_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersOptions) ProtoMessage() {}
+
+func (x *ImportUsersOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersOptions.ProtoReflect.Descriptor instead.
+func (*ImportUsersOptions) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportUsersOptions) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportUsersOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ImportUsersRequest is the request for the Import method, the options are followed by chunks of the input
+type ImportUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportUsersRequest_Options
+	//	*ImportUsersRequest_Chunk
+	Payload       isImportUsersRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUsersRequest) Reset() {
+	*x = ImportUsersRequest{}
+	mi := &file_sso_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersRequest) ProtoMessage() {}
+
+func (x *ImportUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersRequest.ProtoReflect.Descriptor instead.
+func (*ImportUsersRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportUsersRequest) GetPayload() isImportUsersRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportUsersRequest) GetOptions() *ImportUsersOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportUsersRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportUsersRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportUsersRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportUsersRequest_Payload interface {
+	isImportUsersRequest_Payload()
+}
+
+type ImportUsersRequest_Options struct {
+	Options *ImportUsersOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportUsersRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportUsersRequest_Options) isImportUsersRequest_Payload() {}
+
+func (*ImportUsersRequest_Chunk) isImportUsersRequest_Payload() {}
+
+// ImportUsersResponse is the result of a single imported record
+type ImportUsersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Line           uint64                 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	IdentityNumber string                 `protobuf:"bytes,2,opt,name=identity_number,json=identityNumber,proto3" json:"identity_number,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Id             string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Error          string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportUsersResponse) Reset() {
+	*x = ImportUsersResponse{}
+	mi := &file_sso_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersResponse) ProtoMessage() {}
+
+func (x *ImportUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersResponse.ProtoReflect.Descriptor instead.
+func (*ImportUsersResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportUsersResponse) GetLine() uint64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportUsersResponse) GetIdentityNumber() string {
+	if x != nil {
+		return x.IdentityNumber
+	}
+	return ""
+}
+
+func (x *ImportUsersResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportUsersResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportUsersResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ExportUsersRequest is the request for the Export method
+type ExportUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsersRequest) Reset() {
+	*x = ExportUsersRequest{}
+	mi := &file_sso_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersRequest) ProtoMessage() {}
+
+func (x *ExportUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersRequest.ProtoReflect.Descriptor instead.
+func (*ExportUsersRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportUsersRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// ExportUsersResponse is a chunk of the exported users
+type ExportUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsersResponse) Reset() {
+	*x = ExportUsersResponse{}
+	mi := &file_sso_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersResponse) ProtoMessage() {}
+
+func (x *ExportUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersResponse.ProtoReflect.Descriptor instead.
+func (*ExportUsersResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportUsersResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// Authenticator represents a method and identifier a user has logged in with
+type Authenticator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Identifier    string                 `protobuf:"bytes,3,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	FirstUsedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=first_used_at,json=firstUsedAt,proto3" json:"first_used_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Authenticator) Reset() {
+	*x = Authenticator{}
+	mi := &file_sso_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Authenticator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authenticator) ProtoMessage() {}
+
+func (x *Authenticator) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Authenticator.ProtoReflect.Descriptor instead.
+func (*Authenticator) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Authenticator) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Authenticator) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Authenticator) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *Authenticator) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Authenticator) GetFirstUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstUsedAt
+	}
+	return nil
+}
+
+func (x *Authenticator) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+// ListAuthenticatorsRequest is the request for the ListAuthenticators method
+type ListAuthenticatorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthenticatorsRequest) Reset() {
+	*x = ListAuthenticatorsRequest{}
+	mi := &file_sso_v1_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthenticatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthenticatorsRequest) ProtoMessage() {}
+
+func (x *ListAuthenticatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthenticatorsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthenticatorsRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListAuthenticatorsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ListAuthenticatorsResponse is the response for the ListAuthenticators method, an empty allowed_methods allows every method
+type ListAuthenticatorsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Data           []*Authenticator       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	AllowedMethods []string               `protobuf:"bytes,2,rep,name=allowed_methods,json=allowedMethods,proto3" json:"allowed_methods,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAuthenticatorsResponse) Reset() {
+	*x = ListAuthenticatorsResponse{}
+	mi := &file_sso_v1_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthenticatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthenticatorsResponse) ProtoMessage() {}
+
+func (x *ListAuthenticatorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthenticatorsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthenticatorsResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListAuthenticatorsResponse) GetData() []*Authenticator {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListAuthenticatorsResponse) GetAllowedMethods() []string {
+	if x != nil {
+		return x.AllowedMethods
+	}
+	return nil
+}
+
+// LoginSession represents a completed login and the refresh token family issued for it
+type LoginSession struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider        string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	IpAddress       string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent       string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Status          string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastRefreshedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_refreshed_at,json=lastRefreshedAt,proto3" json:"last_refreshed_at,omitempty"`
+	RevokedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LoginSession) Reset() {
+	*x = LoginSession{}
+	mi := &file_sso_v1_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginSession) ProtoMessage() {}
+
+func (x *LoginSession) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginSession.ProtoReflect.Descriptor instead.
+func (*LoginSession) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LoginSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoginSession) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LoginSession) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *LoginSession) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *LoginSession) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LoginSession) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LoginSession) GetLastRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRefreshedAt
+	}
+	return nil
+}
+
+func (x *LoginSession) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+// ListSessionsRequest is the request for the ListSessions method
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_sso_v1_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListSessionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ListSessionsResponse is the response for the ListSessions method, the most recent logins come first
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*LoginSession        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_sso_v1_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sso_v1_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_sso_v1_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListSessionsResponse) GetData() []*LoginSession {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_sso_v1_user_proto protoreflect.FileDescriptor
+
+const file_sso_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x11sso/v1/user.proto\x12\x06sso.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17sso/v1/pagination.proto\"\xb1\x03\n" +
+	"\x04User\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x122\n" +
+	"\x0fidentity_number\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x0f\x18\x14R\x0eidentityNumber\x12.\n" +
+	"\rpersonal_code\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\v\x18\x14R\fpersonalCode\x12(\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\tfirstName\x12&\n" +
+	"\tlast_name\x18\x05 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\blastName\x12(\n" +
+	"\brole_ids\x18\x06 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\aroleIds\x12*\n" +
+	"\tscope_ids\x18\a \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\bscopeIds\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12#\n" +
+	"\rstatus_reason\x18\t \x01(\tR\fstatusReason\x12F\n" +
+	"\x11status_changed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\"a\n" +
+	"\x11ListUsersResponse\x12 \n" +
+	"\x04data\x18\x01 \x03(\v2\f.sso.v1.UserR\x04data\x12*\n" +
+	"\x04meta\x18\x02 \x01(\v2\x16.sso.v1.PaginationMetaR\x04meta\"*\n" +
+	"\x0eGetUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"3\n" +
+	"\x0fGetUserResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.sso.v1.UserR\x04data\"\x9f\x02\n" +
+	"\x11CreateUserRequest\x122\n" +
+	"\x0fidentity_number\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x0f\x18\x14R\x0eidentityNumber\x12.\n" +
+	"\rpersonal_code\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\v\x18\x14R\fpersonalCode\x12(\n" +
+	"\n" +
+	"first_name\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\tfirstName\x12&\n" +
+	"\tlast_name\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\blastName\x12(\n" +
+	"\brole_ids\x18\x05 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\aroleIds\x12*\n" +
+	"\tscope_ids\x18\x06 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\bscopeIds\"6\n" +
+	"\x12CreateUserResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.sso.v1.UserR\x04data\"\xf6\x02\n" +
+	"\x11UpdateUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x122\n" +
+	"\x0fidentity_number\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x0f\x18\x14R\x0eidentityNumber\x12.\n" +
+	"\rpersonal_code\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\v\x18\x14R\fpersonalCode\x12(\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\tfirstName\x12&\n" +
+	"\tlast_name\x18\x05 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\blastName\x12(\n" +
+	"\brole_ids\x18\x06 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\aroleIds\x12*\n" +
+	"\tscope_ids\x18\a \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\bscopeIds\x12;\n" +
+	"\vupdate_mask\x18\b \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"6\n" +
+	"\x12UpdateUserResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.sso.v1.UserR\x04data\"-\n" +
+	"\x11DeleteUserRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x97\x01\n" +
+	"\x14SetUserStatusRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
+	"\x06status\x18\x02 \x01(\tB)\xbaH&r$R\x06activeR\tsuspendedR\x06lockedR\adeletedR\x06status\x12\"\n" +
+	"\x06reason\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06reason\"9\n" +
+	"\x15SetUserStatusResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.sso.v1.UserR\x04data\"^\n" +
+	"\x16ChangeUserRolesRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12*\n" +
+	"\brole_ids\x18\x02 \x03(\tB\x0f\xbaH\f\x92\x01\t\b\x01\"\x05r\x03\xb0\x01\x01R\aroleIds\"Y\n" +
+	"\x13SetUserRolesRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12(\n" +
+	"\brole_ids\x18\x02 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\aroleIds\"a\n" +
+	"\x17ChangeUserScopesRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12,\n" +
+	"\tscope_ids\x18\x02 \x03(\tB\x0f\xbaH\f\x92\x01\t\b\x01\"\x05r\x03\xb0\x01\x01R\bscopeIds\"\\\n" +
+	"\x14SetUserScopesRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12*\n" +
+	"\tscope_ids\x18\x02 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\bscopeIds\"5\n" +
+	"\x11UserLinksResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.sso.v1.UserR\x04data\":\n" +
+	"\x1eGetEffectivePermissionsRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\".\n" +
+	"\bPathRole\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x9c\x01\n" +
+	"\x0ePermissionPath\x12&\n" +
+	"\x05roles\x18\x01 \x03(\v2\x10.sso.v1.PathRoleR\x05roles\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12'\n" +
+	"\x0forganisation_id\x18\x03 \x01(\tR\x0eorganisationId\"g\n" +
+	"\x13EffectivePermission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
+	"\x05paths\x18\x03 \x03(\v2\x16.sso.v1.PermissionPathR\x05paths\"R\n" +
+	"\x1fGetEffectivePermissionsResponse\x12/\n" +
+	"\x04data\x18\x01 \x03(\v2\x1b.sso.v1.EffectivePermissionR\x04data\"U\n" +
+	"\x0eExplainRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12)\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\n" +
+	"permission\"\\\n" +
+	"\x0fExplainResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12/\n" +
+	"\x04data\x18\x02 \x01(\v2\x1b.sso.v1.EffectivePermissionR\x04data\"X\n" +
+	"\x12ImportUsersOptions\x12)\n" +
+	"\x06format\x18\x01 \x01(\tB\x11\xbaH\x0er\fR\x03csvR\x05jsonlR\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\x81\x01\n" +
+	"\x12ImportUsersRequest\x126\n" +
+	"\aoptions\x18\x01 \x01(\v2\x1a.sso.v1.ImportUsersOptionsH\x00R\aoptions\x12!\n" +
+	"\x05chunk\x18\x02 \x01(\fB\t\xbaH\x06z\x04\x18\x80\x80@H\x00R\x05chunkB\x10\n" +
+	"\apayload\x12\x05\xbaH\x02\b\x01\"\x90\x01\n" +
+	"\x13ImportUsersResponse\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x04R\x04line\x12'\n" +
+	"\x0fidentity_number\x18\x02 \x01(\tR\x0eidentityNumber\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"?\n" +
+	"\x12ExportUsersRequest\x12)\n" +
+	"\x06format\x18\x01 \x01(\tB\x11\xbaH\x0er\fR\x03csvR\x05jsonlR\x06format\"+\n" +
+	"\x13ExportUsersResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"\xd3\x02\n" +
+	"\rAuthenticator\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x03 \x01(\tR\n" +
+	"identifier\x12?\n" +
+	"\bmetadata\x18\x04 \x03(\v2#.sso.v1.Authenticator.MetadataEntryR\bmetadata\x12>\n" +
+	"\rfirst_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vfirstUsedAt\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"5\n" +
+	"\x19ListAuthenticatorsRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"p\n" +
+	"\x1aListAuthenticatorsResponse\x12)\n" +
+	"\x04data\x18\x01 \x03(\v2\x15.sso.v1.AuthenticatorR\x04data\x12'\n" +
+	"\x0fallowed_methods\x18\x02 \x03(\tR\x0eallowedMethods\"\xce\x02\n" +
+	"\fLoginSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12F\n" +
+	"\x11last_refreshed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0flastRefreshedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"/\n" +
+	"\x13ListSessionsRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"@\n" +
+	"\x14ListSessionsResponse\x12(\n" +
+	"\x04data\x18\x01 \x03(\v2\x14.sso.v1.LoginSessionR\x04data2\xc5\n" +
+	"\n" +
+	"\vUserService\x12A\n" +
+	"\x04List\x12\x1c.sso.v1.PaginatedListRequest\x1a\x19.sso.v1.ListUsersResponse\"\x00\x128\n" +
+	"\x03Get\x12\x16.sso.v1.GetUserRequest\x1a\x17.sso.v1.GetUserResponse\"\x00\x12A\n" +
+	"\x06Create\x12\x19.sso.v1.CreateUserRequest\x1a\x1a.sso.v1.CreateUserResponse\"\x00\x12A\n" +
+	"\x06Update\x12\x19.sso.v1.UpdateUserRequest\x1a\x1a.sso.v1.UpdateUserResponse\"\x00\x12=\n" +
+	"\x06Delete\x12\x19.sso.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"\x00\x12J\n" +
+	"\tSetStatus\x12\x1c.sso.v1.SetUserStatusRequest\x1a\x1d.sso.v1.SetUserStatusResponse\"\x00\x12J\n" +
+	"\vAssignRoles\x12\x1e.sso.v1.ChangeUserRolesRequest\x1a\x19.sso.v1.UserLinksResponse\"\x00\x12J\n" +
+	"\vRevokeRoles\x12\x1e.sso.v1.ChangeUserRolesRequest\x1a\x19.sso.v1.UserLinksResponse\"\x00\x12D\n" +
+	"\bSetRoles\x12\x1b.sso.v1.SetUserRolesRequest\x1a\x19.sso.v1.UserLinksResponse\"\x00\x12L\n" +
+	"\fAssignScopes\x12\x1f.sso.v1.ChangeUserScopesRequest\x1a\x19.sso.v1.UserLinksResponse\"\x00\x12L\n" +
+	"\fRevokeScopes\x12\x1f.sso.v1.ChangeUserScopesRequest\x1a\x19.sso.v1.UserLinksResponse\"\x00\x12F\n" +
+	"\tSetScopes\x12\x1c.sso.v1.SetUserScopesRequest\x1a\x19.sso.v1.UserLinksResponse\"\x00\x12l\n" +
+	"\x17GetEffectivePermissions\x12&.sso.v1.GetEffectivePermissionsRequest\x1a'.sso.v1.GetEffectivePermissionsResponse\"\x00\x12<\n" +
+	"\aExplain\x12\x16.sso.v1.ExplainRequest\x1a\x17.sso.v1.ExplainResponse\"\x00\x12G\n" +
+	"\x06Import\x12\x1a.sso.v1.ImportUsersRequest\x1a\x1b.sso.v1.ImportUsersResponse\"\x00(\x010\x01\x12E\n" +
+	"\x06Export\x12\x1a.sso.v1.ExportUsersRequest\x1a\x1b.sso.v1.ExportUsersResponse\"\x000\x01\x12]\n" +
+	"\x12ListAuthenticators\x12!.sso.v1.ListAuthenticatorsRequest\x1a\".sso.v1.ListAuthenticatorsResponse\"\x00\x12K\n" +
+	"\fListSessions\x12\x1b.sso.v1.ListSessionsRequest\x1a\x1c.sso.v1.ListSessionsResponse\"\x00B+Z)loki/internal/app/rpcs/proto/sso/v1;ssov1b\x06proto3"
+
+var (
+	file_sso_v1_user_proto_rawDescOnce sync.Once
+	file_sso_v1_user_proto_rawDescData []byte
+)
+
+func file_sso_v1_user_proto_rawDescGZIP() []byte {
+	file_sso_v1_user_proto_rawDescOnce.Do(func() {
+		file_sso_v1_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sso_v1_user_proto_rawDesc), len(file_sso_v1_user_proto_rawDesc)))
+	})
+	return file_sso_v1_user_proto_rawDescData
+}
+
+var file_sso_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_sso_v1_user_proto_goTypes = []any{
+	(*User)(nil),                            // 0: sso.v1.User
+	(*ListUsersResponse)(nil),               // 1: sso.v1.ListUsersResponse
+	(*GetUserRequest)(nil),                  // 2: sso.v1.GetUserRequest
+	(*GetUserResponse)(nil),                 // 3: sso.v1.GetUserResponse
+	(*CreateUserRequest)(nil),               // 4: sso.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),              // 5: sso.v1.CreateUserResponse
+	(*UpdateUserRequest)(nil),               // 6: sso.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),              // 7: sso.v1.UpdateUserResponse
+	(*DeleteUserRequest)(nil),               // 8: sso.v1.DeleteUserRequest
+	(*SetUserStatusRequest)(nil),            // 9: sso.v1.SetUserStatusRequest
+	(*SetUserStatusResponse)(nil),           // 10: sso.v1.SetUserStatusResponse
+	(*ChangeUserRolesRequest)(nil),          // 11: sso.v1.ChangeUserRolesRequest
+	(*SetUserRolesRequest)(nil),             // 12: sso.v1.SetUserRolesRequest
+	(*ChangeUserScopesRequest)(nil),         // 13: sso.v1.ChangeUserScopesRequest
+	(*SetUserScopesRequest)(nil),            // 14: sso.v1.SetUserScopesRequest
+	(*UserLinksResponse)(nil),               // 15: sso.v1.UserLinksResponse
+	(*GetEffectivePermissionsRequest)(nil),  // 16: sso.v1.GetEffectivePermissionsRequest
+	(*PathRole)(nil),                        // 17: sso.v1.PathRole
+	(*PermissionPath)(nil),                  // 18: sso.v1.PermissionPath
+	(*EffectivePermission)(nil),             // 19: sso.v1.EffectivePermission
+	(*GetEffectivePermissionsResponse)(nil), // 20: sso.v1.GetEffectivePermissionsResponse
+	(*ExplainRequest)(nil),                  // 21: sso.v1.ExplainRequest
+	(*ExplainResponse)(nil),                 // 22: sso.v1.ExplainResponse
+	(*ImportUsersOptions)(nil),              // 23: sso.v1.ImportUsersOptions
+	(*ImportUsersRequest)(nil),              // 24: sso.v1.ImportUsersRequest
+	(*ImportUsersResponse)(nil),             // 25: sso.v1.ImportUsersResponse
+	(*ExportUsersRequest)(nil),              // 26: sso.v1.ExportUsersRequest
+	(*ExportUsersResponse)(nil),             // 27: sso.v1.ExportUsersResponse
+	(*Authenticator)(nil),                   // 28: sso.v1.Authenticator
+	(*ListAuthenticatorsRequest)(nil),       // 29: sso.v1.ListAuthenticatorsRequest
+	(*ListAuthenticatorsResponse)(nil),      // 30: sso.v1.ListAuthenticatorsResponse
+	(*LoginSession)(nil),                    // 31: sso.v1.LoginSession
+	(*ListSessionsRequest)(nil),             // 32: sso.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),            // 33: sso.v1.ListSessionsResponse
+	nil,                                     // 34: sso.v1.Authenticator.MetadataEntry
+	(*timestamppb.Timestamp)(nil),           // 35: google.protobuf.Timestamp
+	(*PaginationMeta)(nil),                  // 36: sso.v1.PaginationMeta
+	(*fieldmaskpb.FieldMask)(nil),           // 37: google.protobuf.FieldMask
+	(*PaginatedListRequest)(nil),            // 38: sso.v1.PaginatedListRequest
+	(*emptypb.Empty)(nil),                   // 39: google.protobuf.Empty
+}
+var file_sso_v1_user_proto_depIdxs = []int32{
+	35, // 0: sso.v1.User.status_changed_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: sso.v1.ListUsersResponse.data:type_name -> sso.v1.User
+	36, // 2: sso.v1.ListUsersResponse.meta:type_name -> sso.v1.PaginationMeta
+	0,  // 3: sso.v1.GetUserResponse.data:type_name -> sso.v1.User
+	0,  // 4: sso.v1.CreateUserResponse.data:type_name -> sso.v1.User
+	37, // 5: sso.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 6: sso.v1.UpdateUserResponse.data:type_name -> sso.v1.User
+	0,  // 7: sso.v1.SetUserStatusResponse.data:type_name -> sso.v1.User
+	0,  // 8: sso.v1.UserLinksResponse.data:type_name -> sso.v1.User
+	17, // 9: sso.v1.PermissionPath.roles:type_name -> sso.v1.PathRole
+	35, // 10: sso.v1.PermissionPath.expires_at:type_name -> google.protobuf.Timestamp
+	18, // 11: sso.v1.EffectivePermission.paths:type_name -> sso.v1.PermissionPath
+	19, // 12: sso.v1.GetEffectivePermissionsResponse.data:type_name -> sso.v1.EffectivePermission
+	19, // 13: sso.v1.ExplainResponse.data:type_name -> sso.v1.EffectivePermission
+	23, // 14: sso.v1.ImportUsersRequest.options:type_name -> sso.v1.ImportUsersOptions
+	34, // 15: sso.v1.Authenticator.metadata:type_name -> sso.v1.Authenticator.MetadataEntry
+	35, // 16: sso.v1.Authenticator.first_used_at:type_name -> google.protobuf.Timestamp
+	35, // 17: sso.v1.Authenticator.last_used_at:type_name -> google.protobuf.Timestamp
+	28, // 18: sso.v1.ListAuthenticatorsResponse.data:type_name -> sso.v1.Authenticator
+	35, // 19: sso.v1.LoginSession.created_at:type_name -> google.protobuf.Timestamp
+	35, // 20: sso.v1.LoginSession.last_refreshed_at:type_name -> google.protobuf.Timestamp
+	35, // 21: sso.v1.LoginSession.revoked_at:type_name -> google.protobuf.Timestamp
+	31, // 22: sso.v1.ListSessionsResponse.data:type_name -> sso.v1.LoginSession
+	38, // 23: sso.v1.UserService.List:input_type -> sso.v1.PaginatedListRequest
+	2,  // 24: sso.v1.UserService.Get:input_type -> sso.v1.GetUserRequest
+	4,  // 25: sso.v1.UserService.Create:input_type -> sso.v1.CreateUserRequest
+	6,  // 26: sso.v1.UserService.Update:input_type -> sso.v1.UpdateUserRequest
+	8,  // 27: sso.v1.UserService.Delete:input_type -> sso.v1.DeleteUserRequest
+	9,  // 28: sso.v1.UserService.SetStatus:input_type -> sso.v1.SetUserStatusRequest
+	11, // 29: sso.v1.UserService.AssignRoles:input_type -> sso.v1.ChangeUserRolesRequest
+	11, // 30: sso.v1.UserService.RevokeRoles:input_type -> sso.v1.ChangeUserRolesRequest
+	12, // 31: sso.v1.UserService.SetRoles:input_type -> sso.v1.SetUserRolesRequest
+	13, // 32: sso.v1.UserService.AssignScopes:input_type -> sso.v1.ChangeUserScopesRequest
+	13, // 33: sso.v1.UserService.RevokeScopes:input_type -> sso.v1.ChangeUserScopesRequest
+	14, // 34: sso.v1.UserService.SetScopes:input_type -> sso.v1.SetUserScopesRequest
+	16, // 35: sso.v1.UserService.GetEffectivePermissions:input_type -> sso.v1.GetEffectivePermissionsRequest
+	21, // 36: sso.v1.UserService.Explain:input_type -> sso.v1.ExplainRequest
+	24, // 37: sso.v1.UserService.Import:input_type -> sso.v1.ImportUsersRequest
+	26, // 38: sso.v1.UserService.Export:input_type -> sso.v1.ExportUsersRequest
+	29, // 39: sso.v1.UserService.ListAuthenticators:input_type -> sso.v1.ListAuthenticatorsRequest
+	32, // 40: sso.v1.UserService.ListSessions:input_type -> sso.v1.ListSessionsRequest
+	1,  // 41: sso.v1.UserService.List:output_type -> sso.v1.ListUsersResponse
+	3,  // 42: sso.v1.UserService.Get:output_type -> sso.v1.GetUserResponse
+	5,  // 43: sso.v1.UserService.Create:output_type -> sso.v1.CreateUserResponse
+	7,  // 44: sso.v1.UserService.Update:output_type -> sso.v1.UpdateUserResponse
+	39, // 45: sso.v1.UserService.Delete:output_type -> google.protobuf.Empty
+	10, // 46: sso.v1.UserService.SetStatus:output_type -> sso.v1.SetUserStatusResponse
+	15, // 47: sso.v1.UserService.AssignRoles:output_type -> sso.v1.UserLinksResponse
+	15, // 48: sso.v1.UserService.RevokeRoles:output_type -> sso.v1.UserLinksResponse
+	15, // 49: sso.v1.UserService.SetRoles:output_type -> sso.v1.UserLinksResponse
+	15, // 50: sso.v1.UserService.AssignScopes:output_type -> sso.v1.UserLinksResponse
+	15, // 51: sso.v1.UserService.RevokeScopes:output_type -> sso.v1.UserLinksResponse
+	15, // 52: sso.v1.UserService.SetScopes:output_type -> sso.v1.UserLinksResponse
+	20, // 53: sso.v1.UserService.GetEffectivePermissions:output_type -> sso.v1.GetEffectivePermissionsResponse
+	22, // 54: sso.v1.UserService.Explain:output_type -> sso.v1.ExplainResponse
+	25, // 55: sso.v1.UserService.Import:output_type -> sso.v1.ImportUsersResponse
+	27, // 56: sso.v1.UserService.Export:output_type -> sso.v1.ExportUsersResponse
+	30, // 57: sso.v1.UserService.ListAuthenticators:output_type -> sso.v1.ListAuthenticatorsResponse
+	33, // 58: sso.v1.UserService.ListSessions:output_type -> sso.v1.ListSessionsResponse
+	41, // [41:59] is the sub-list for method output_type
+	23, // [23:41] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_sso_v1_user_proto_init() }
+func file_sso_v1_user_proto_init() {
+	if File_sso_v1_user_proto != nil {
+		return
+	}
+	file_sso_v1_pagination_proto_init()
+	file_sso_v1_user_proto_msgTypes[24].OneofWrappers = []any{
+		(*ImportUsersRequest_Options)(nil),
+		(*ImportUsersRequest_Chunk)(nil),
 	}
-	file_sso_v1_pagination_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sso_v1_user_proto_rawDesc), len(file_sso_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
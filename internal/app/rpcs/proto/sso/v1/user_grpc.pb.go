@@ -20,11 +20,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_List_FullMethodName   = "/sso.v1.UserService/List"
-	UserService_Get_FullMethodName    = "/sso.v1.UserService/Get"
-	UserService_Create_FullMethodName = "/sso.v1.UserService/Create"
-	UserService_Update_FullMethodName = "/sso.v1.UserService/Update"
-	UserService_Delete_FullMethodName = "/sso.v1.UserService/Delete"
+	UserService_List_FullMethodName                    = "/sso.v1.UserService/List"
+	UserService_Get_FullMethodName                     = "/sso.v1.UserService/Get"
+	UserService_Create_FullMethodName                  = "/sso.v1.UserService/Create"
+	UserService_Update_FullMethodName                  = "/sso.v1.UserService/Update"
+	UserService_Delete_FullMethodName                  = "/sso.v1.UserService/Delete"
+	UserService_SetStatus_FullMethodName               = "/sso.v1.UserService/SetStatus"
+	UserService_AssignRoles_FullMethodName             = "/sso.v1.UserService/AssignRoles"
+	UserService_RevokeRoles_FullMethodName             = "/sso.v1.UserService/RevokeRoles"
+	UserService_SetRoles_FullMethodName                = "/sso.v1.UserService/SetRoles"
+	UserService_AssignScopes_FullMethodName            = "/sso.v1.UserService/AssignScopes"
+	UserService_RevokeScopes_FullMethodName            = "/sso.v1.UserService/RevokeScopes"
+	UserService_SetScopes_FullMethodName               = "/sso.v1.UserService/SetScopes"
+	UserService_GetEffectivePermissions_FullMethodName = "/sso.v1.UserService/GetEffectivePermissions"
+	UserService_Explain_FullMethodName                 = "/sso.v1.UserService/Explain"
+	UserService_Import_FullMethodName                  = "/sso.v1.UserService/Import"
+	UserService_Export_FullMethodName                  = "/sso.v1.UserService/Export"
+	UserService_ListAuthenticators_FullMethodName      = "/sso.v1.UserService/ListAuthenticators"
+	UserService_ListSessions_FullMethodName            = "/sso.v1.UserService/ListSessions"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -38,6 +51,19 @@ type UserServiceClient interface {
 	Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetStatus(ctx context.Context, in *SetUserStatusRequest, opts ...grpc.CallOption) (*SetUserStatusResponse, error)
+	AssignRoles(ctx context.Context, in *ChangeUserRolesRequest, opts ...grpc.CallOption) (*UserLinksResponse, error)
+	RevokeRoles(ctx context.Context, in *ChangeUserRolesRequest, opts ...grpc.CallOption) (*UserLinksResponse, error)
+	SetRoles(ctx context.Context, in *SetUserRolesRequest, opts ...grpc.CallOption) (*UserLinksResponse, error)
+	AssignScopes(ctx context.Context, in *ChangeUserScopesRequest, opts ...grpc.CallOption) (*UserLinksResponse, error)
+	RevokeScopes(ctx context.Context, in *ChangeUserScopesRequest, opts ...grpc.CallOption) (*UserLinksResponse, error)
+	SetScopes(ctx context.Context, in *SetUserScopesRequest, opts ...grpc.CallOption) (*UserLinksResponse, error)
+	GetEffectivePermissions(ctx context.Context, in *GetEffectivePermissionsRequest, opts ...grpc.CallOption) (*GetEffectivePermissionsResponse, error)
+	Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*ExplainResponse, error)
+	Import(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportUsersRequest, ImportUsersResponse], error)
+	Export(ctx context.Context, in *ExportUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportUsersResponse], error)
+	ListAuthenticators(ctx context.Context, in *ListAuthenticatorsRequest, opts ...grpc.CallOption) (*ListAuthenticatorsResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
 }
 
 type userServiceClient struct {
@@ -98,6 +124,148 @@ func (c *userServiceClient) Delete(ctx context.Context, in *DeleteUserRequest, o
 	return out, nil
 }
 
+func (c *userServiceClient) SetStatus(ctx context.Context, in *SetUserStatusRequest, opts ...grpc.CallOption) (*SetUserStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserStatusResponse)
+	err := c.cc.Invoke(ctx, UserService_SetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AssignRoles(ctx context.Context, in *ChangeUserRolesRequest, opts ...grpc.CallOption) (*UserLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserLinksResponse)
+	err := c.cc.Invoke(ctx, UserService_AssignRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeRoles(ctx context.Context, in *ChangeUserRolesRequest, opts ...grpc.CallOption) (*UserLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserLinksResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetRoles(ctx context.Context, in *SetUserRolesRequest, opts ...grpc.CallOption) (*UserLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserLinksResponse)
+	err := c.cc.Invoke(ctx, UserService_SetRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AssignScopes(ctx context.Context, in *ChangeUserScopesRequest, opts ...grpc.CallOption) (*UserLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserLinksResponse)
+	err := c.cc.Invoke(ctx, UserService_AssignScopes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeScopes(ctx context.Context, in *ChangeUserScopesRequest, opts ...grpc.CallOption) (*UserLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserLinksResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeScopes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetScopes(ctx context.Context, in *SetUserScopesRequest, opts ...grpc.CallOption) (*UserLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserLinksResponse)
+	err := c.cc.Invoke(ctx, UserService_SetScopes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetEffectivePermissions(ctx context.Context, in *GetEffectivePermissionsRequest, opts ...grpc.CallOption) (*GetEffectivePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEffectivePermissionsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetEffectivePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*ExplainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainResponse)
+	err := c.cc.Invoke(ctx, UserService_Explain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Import(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportUsersRequest, ImportUsersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[0], UserService_Import_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportUsersRequest, ImportUsersResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_ImportClient = grpc.BidiStreamingClient[ImportUsersRequest, ImportUsersResponse]
+
+func (c *userServiceClient) Export(ctx context.Context, in *ExportUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportUsersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[1], UserService_Export_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportUsersRequest, ExportUsersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_ExportClient = grpc.ServerStreamingClient[ExportUsersResponse]
+
+func (c *userServiceClient) ListAuthenticators(ctx context.Context, in *ListAuthenticatorsRequest, opts ...grpc.CallOption) (*ListAuthenticatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuthenticatorsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListAuthenticators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -109,6 +277,19 @@ type UserServiceServer interface {
 	Create(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	Update(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	Delete(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	SetStatus(context.Context, *SetUserStatusRequest) (*SetUserStatusResponse, error)
+	AssignRoles(context.Context, *ChangeUserRolesRequest) (*UserLinksResponse, error)
+	RevokeRoles(context.Context, *ChangeUserRolesRequest) (*UserLinksResponse, error)
+	SetRoles(context.Context, *SetUserRolesRequest) (*UserLinksResponse, error)
+	AssignScopes(context.Context, *ChangeUserScopesRequest) (*UserLinksResponse, error)
+	RevokeScopes(context.Context, *ChangeUserScopesRequest) (*UserLinksResponse, error)
+	SetScopes(context.Context, *SetUserScopesRequest) (*UserLinksResponse, error)
+	GetEffectivePermissions(context.Context, *GetEffectivePermissionsRequest) (*GetEffectivePermissionsResponse, error)
+	Explain(context.Context, *ExplainRequest) (*ExplainResponse, error)
+	Import(grpc.BidiStreamingServer[ImportUsersRequest, ImportUsersResponse]) error
+	Export(*ExportUsersRequest, grpc.ServerStreamingServer[ExportUsersResponse]) error
+	ListAuthenticators(context.Context, *ListAuthenticatorsRequest) (*ListAuthenticatorsResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -134,6 +315,45 @@ func (UnimplementedUserServiceServer) Update(context.Context, *UpdateUserRequest
 func (UnimplementedUserServiceServer) Delete(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedUserServiceServer) SetStatus(context.Context, *SetUserStatusRequest) (*SetUserStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStatus not implemented")
+}
+func (UnimplementedUserServiceServer) AssignRoles(context.Context, *ChangeUserRolesRequest) (*UserLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRoles not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeRoles(context.Context, *ChangeUserRolesRequest) (*UserLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRoles not implemented")
+}
+func (UnimplementedUserServiceServer) SetRoles(context.Context, *SetUserRolesRequest) (*UserLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoles not implemented")
+}
+func (UnimplementedUserServiceServer) AssignScopes(context.Context, *ChangeUserScopesRequest) (*UserLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignScopes not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeScopes(context.Context, *ChangeUserScopesRequest) (*UserLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeScopes not implemented")
+}
+func (UnimplementedUserServiceServer) SetScopes(context.Context, *SetUserScopesRequest) (*UserLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScopes not implemented")
+}
+func (UnimplementedUserServiceServer) GetEffectivePermissions(context.Context, *GetEffectivePermissionsRequest) (*GetEffectivePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectivePermissions not implemented")
+}
+func (UnimplementedUserServiceServer) Explain(context.Context, *ExplainRequest) (*ExplainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Explain not implemented")
+}
+func (UnimplementedUserServiceServer) Import(grpc.BidiStreamingServer[ImportUsersRequest, ImportUsersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedUserServiceServer) Export(*ExportUsersRequest, grpc.ServerStreamingServer[ExportUsersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedUserServiceServer) ListAuthenticators(context.Context, *ListAuthenticatorsRequest) (*ListAuthenticatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthenticators not implemented")
+}
+func (UnimplementedUserServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -245,6 +465,222 @@ func _UserService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetStatus(ctx, req.(*SetUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AssignRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AssignRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AssignRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AssignRoles(ctx, req.(*ChangeUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeRoles(ctx, req.(*ChangeUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetRoles(ctx, req.(*SetUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AssignScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserScopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AssignScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AssignScopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AssignScopes(ctx, req.(*ChangeUserScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserScopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeScopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeScopes(ctx, req.(*ChangeUserScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserScopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetScopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetScopes(ctx, req.(*SetUserScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetEffectivePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectivePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetEffectivePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetEffectivePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetEffectivePermissions(ctx, req.(*GetEffectivePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Explain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Explain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Explain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Explain(ctx, req.(*ExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UserServiceServer).Import(&grpc.GenericServerStream[ImportUsersRequest, ImportUsersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_ImportServer = grpc.BidiStreamingServer[ImportUsersRequest, ImportUsersResponse]
+
+func _UserService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUsersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).Export(m, &grpc.GenericServerStream[ExportUsersRequest, ExportUsersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_ExportServer = grpc.ServerStreamingServer[ExportUsersResponse]
+
+func _UserService_ListAuthenticators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthenticatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAuthenticators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAuthenticators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAuthenticators(ctx, req.(*ListAuthenticatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -272,7 +708,63 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _UserService_Delete_Handler,
 		},
+		{
+			MethodName: "SetStatus",
+			Handler:    _UserService_SetStatus_Handler,
+		},
+		{
+			MethodName: "AssignRoles",
+			Handler:    _UserService_AssignRoles_Handler,
+		},
+		{
+			MethodName: "RevokeRoles",
+			Handler:    _UserService_RevokeRoles_Handler,
+		},
+		{
+			MethodName: "SetRoles",
+			Handler:    _UserService_SetRoles_Handler,
+		},
+		{
+			MethodName: "AssignScopes",
+			Handler:    _UserService_AssignScopes_Handler,
+		},
+		{
+			MethodName: "RevokeScopes",
+			Handler:    _UserService_RevokeScopes_Handler,
+		},
+		{
+			MethodName: "SetScopes",
+			Handler:    _UserService_SetScopes_Handler,
+		},
+		{
+			MethodName: "GetEffectivePermissions",
+			Handler:    _UserService_GetEffectivePermissions_Handler,
+		},
+		{
+			MethodName: "Explain",
+			Handler:    _UserService_Explain_Handler,
+		},
+		{
+			MethodName: "ListAuthenticators",
+			Handler:    _UserService_ListAuthenticators_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _UserService_ListSessions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Import",
+			Handler:       _UserService_Import_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _UserService_Export_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "sso/v1/user.proto",
 }
@@ -1,20 +1,32 @@
 package rpcs
 
 import (
+	"context"
+
 	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
 
+	"loki/internal/app/rpcs/interceptors"
 	proto "loki/internal/app/rpcs/proto/sso/v1"
 )
 
 type Registry struct {
-	permissions proto.PermissionServiceServer
-	roles       proto.RoleServiceServer
-	scopes      proto.ScopeServiceServer
-	tokens      proto.TokenServiceServer
-	users       proto.UserServiceServer
+	audit         proto.AuditServiceServer
+	elevations    proto.ElevationServiceServer
+	invitations   proto.InvitationServiceServer
+	organisations proto.OrganisationServiceServer
+	permissions   proto.PermissionServiceServer
+	roles         proto.RoleServiceServer
+	scopes        proto.ScopeServiceServer
+	tokens        proto.TokenServiceServer
+	users         proto.UserServiceServer
 }
 
 func NewRegistry(
+	audit proto.AuditServiceServer,
+	elevations proto.ElevationServiceServer,
+	invitations proto.InvitationServiceServer,
+	organisations proto.OrganisationServiceServer,
 	permissions proto.PermissionServiceServer,
 	roles proto.RoleServiceServer,
 	scopes proto.ScopeServiceServer,
@@ -22,18 +34,67 @@ func NewRegistry(
 	users proto.UserServiceServer,
 ) *Registry {
 	return &Registry{
-		permissions: permissions,
-		roles:       roles,
-		scopes:      scopes,
-		tokens:      tokens,
-		users:       users,
+		audit:         audit,
+		elevations:    elevations,
+		invitations:   invitations,
+		organisations: organisations,
+		permissions:   permissions,
+		roles:         roles,
+		scopes:        scopes,
+		tokens:        tokens,
+		users:         users,
 	}
 }
 
 func (r *Registry) RegisterAll(server *grpc.Server) {
+	proto.RegisterAuditServiceServer(server, r.audit)
+	proto.RegisterElevationServiceServer(server, r.elevations)
+	proto.RegisterInvitationServiceServer(server, r.invitations)
+	proto.RegisterOrganisationServiceServer(server, r.organisations)
 	proto.RegisterPermissionServiceServer(server, r.permissions)
 	proto.RegisterRoleServiceServer(server, r.roles)
 	proto.RegisterScopeServiceServer(server, r.scopes)
 	proto.RegisterTokenServiceServer(server, r.tokens)
 	proto.RegisterUserServiceServer(server, r.users)
 }
+
+// Permissions returns the permission required by each registered gRPC method
+func (r *Registry) Permissions() map[string]string {
+	return permissions
+}
+
+// Snapshots returns the getters used to capture the state of a record before and after a mutating call
+func (r *Registry) Snapshots() map[string]interceptors.Snapshot {
+	return map[string]interceptors.Snapshot{
+		proto.ElevationService_ServiceDesc.ServiceName: func(ctx context.Context, id string) (protobuf.Message, error) {
+			return snapshot(r.elevations.Get(ctx, &proto.GetElevationRequest{Id: id}))
+		},
+		proto.InvitationService_ServiceDesc.ServiceName: func(ctx context.Context, id string) (protobuf.Message, error) {
+			return snapshot(r.invitations.Get(ctx, &proto.GetInvitationRequest{Id: id}))
+		},
+		proto.OrganisationService_ServiceDesc.ServiceName: func(ctx context.Context, id string) (protobuf.Message, error) {
+			return snapshot(r.organisations.Get(ctx, &proto.GetOrganisationRequest{Id: id}))
+		},
+		proto.PermissionService_ServiceDesc.ServiceName: func(ctx context.Context, id string) (protobuf.Message, error) {
+			return snapshot(r.permissions.Get(ctx, &proto.GetPermissionRequest{Id: id}))
+		},
+		proto.RoleService_ServiceDesc.ServiceName: func(ctx context.Context, id string) (protobuf.Message, error) {
+			return snapshot(r.roles.Get(ctx, &proto.GetRoleRequest{Id: id}))
+		},
+		proto.ScopeService_ServiceDesc.ServiceName: func(ctx context.Context, id string) (protobuf.Message, error) {
+			return snapshot(r.scopes.Get(ctx, &proto.GetScopeRequest{Id: id}))
+		},
+		proto.UserService_ServiceDesc.ServiceName: func(ctx context.Context, id string) (protobuf.Message, error) {
+			return snapshot(r.users.Get(ctx, &proto.GetUserRequest{Id: id}))
+		},
+	}
+}
+
+// snapshot unwraps the record from a get response, a failed lookup yields an untyped nil
+func snapshot[T interface{ GetData() M }, M protobuf.Message](response T, err error) (protobuf.Message, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetData(), nil
+}
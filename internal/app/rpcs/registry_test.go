@@ -9,6 +9,22 @@ import (
 	proto "loki/internal/app/rpcs/proto/sso/v1"
 )
 
+type auditService struct {
+	proto.UnimplementedAuditServiceServer
+}
+
+type elevationService struct {
+	proto.UnimplementedElevationServiceServer
+}
+
+type invitationService struct {
+	proto.UnimplementedInvitationServiceServer
+}
+
+type organisationService struct {
+	proto.UnimplementedOrganisationServiceServer
+}
+
 type permissionService struct {
 	proto.UnimplementedPermissionServiceServer
 }
@@ -31,6 +47,10 @@ type userService struct {
 
 func Test_Registry_RegisterAll(t *testing.T) {
 	registry := NewRegistry(
+		&auditService{},
+		&elevationService{},
+		&invitationService{},
+		&organisationService{},
 		&permissionService{},
 		&roleService{},
 		&scopeService{},
@@ -43,9 +63,42 @@ func Test_Registry_RegisterAll(t *testing.T) {
 	registry.RegisterAll(server)
 
 	serviceInfo := server.GetServiceInfo()
+	assert.Contains(t, serviceInfo, "sso.v1.AuditService")
+	assert.Contains(t, serviceInfo, "sso.v1.ElevationService")
+	assert.Contains(t, serviceInfo, "sso.v1.InvitationService")
+	assert.Contains(t, serviceInfo, "sso.v1.OrganisationService")
 	assert.Contains(t, serviceInfo, "sso.v1.PermissionService")
 	assert.Contains(t, serviceInfo, "sso.v1.RoleService")
 	assert.Contains(t, serviceInfo, "sso.v1.ScopeService")
 	assert.Contains(t, serviceInfo, "sso.v1.TokenService")
 	assert.Contains(t, serviceInfo, "sso.v1.UserService")
 }
+
+func Test_Registry_Permissions(t *testing.T) {
+	registry := NewRegistry(
+		&auditService{},
+		&elevationService{},
+		&invitationService{},
+		&organisationService{},
+		&permissionService{},
+		&roleService{},
+		&scopeService{},
+		&tokenService{},
+		&userService{},
+	)
+
+	server := grpc.NewServer()
+	registry.RegisterAll(server)
+
+	permissions := registry.Permissions()
+
+	for name, service := range server.GetServiceInfo() {
+		for _, method := range service.Methods {
+			fullMethod := "/" + name + "/" + method.Name
+
+			permission, ok := permissions[fullMethod]
+			assert.True(t, ok, "missing permission mapping for %s", fullMethod)
+			assert.NotEmpty(t, permission, "empty permission mapping for %s", fullMethod)
+		}
+	}
+}
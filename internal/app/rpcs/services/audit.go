@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	proto "loki/internal/app/rpcs/proto/sso/v1"
+	"loki/internal/app/services"
+	"loki/internal/config/logger"
+)
+
+type auditService struct {
+	proto.UnimplementedAuditServiceServer
+	audit services.Audit
+	log   *logger.Logger
+}
+
+func NewAudit(audit services.Audit, log *logger.Logger) proto.AuditServiceServer {
+	return &auditService{
+		audit: audit,
+		log:   log,
+	}
+}
+
+func (p *auditService) List(ctx context.Context, req *proto.ListAuditEventsRequest) (*proto.ListAuditEventsResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	filter := models.AuditFilter{
+		Action:   req.Action,
+		TargetID: req.TargetId,
+	}
+
+	if req.ActorId != "" {
+		actorId, err := uuid.Parse(req.ActorId)
+		if err != nil {
+			p.log.Error().Err(err).Str("actor_id", req.ActorId).Msg("Invalid UUID format")
+			return nil, status.Error(codes.InvalidArgument, "invalid actor id format")
+		}
+		filter.ActorID = actorId
+	}
+
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+
+	pagination := &services.Pagination{
+		Page:    req.Limit,
+		PerPage: req.Offset,
+	}
+
+	rows, total, err := p.audit.List(ctx, filter, pagination)
+	if err != nil {
+		p.log.Error().Err(err).Msg("Failed to fetch audit events")
+
+		switch {
+		case errors.Is(err, errors.ErrFailedToFetchResults):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to fetch audit events")
+		}
+	}
+
+	collection := make([]*proto.AuditEvent, 0, len(rows))
+	for _, row := range rows {
+		event, err := toProtoAuditEvent(&row)
+		if err != nil {
+			p.log.Error().Err(err).Str("id", row.ID.String()).Msg("Failed to encode audit event")
+			return nil, status.Error(codes.Internal, "failed to fetch audit events")
+		}
+		collection = append(collection, event)
+	}
+
+	return &proto.ListAuditEventsResponse{
+		Data: collection,
+		Meta: &proto.PaginationMeta{
+			Page:  pagination.Page,
+			Per:   pagination.PerPage,
+			Total: total,
+		},
+	}, nil
+}
+
+func (p *auditService) Export(ctx context.Context, req *proto.ExportAuditEventsRequest) (*proto.ExportAuditEventsResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	events, checkpoints, err := p.audit.Export(ctx, req.AfterSequence, req.Limit)
+	if err != nil {
+		p.log.Error().Err(err).Msg("Failed to export audit events")
+
+		switch {
+		case errors.Is(err, errors.ErrFailedToFetchResults):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to export audit events")
+		}
+	}
+
+	response := &proto.ExportAuditEventsResponse{
+		Events:      make([]*proto.AuditEvent, 0, len(events)),
+		Checkpoints: make([]*proto.AuditCheckpoint, 0, len(checkpoints)),
+	}
+
+	for _, row := range events {
+		event, err := toProtoAuditEvent(&row)
+		if err != nil {
+			p.log.Error().Err(err).Str("id", row.ID.String()).Msg("Failed to encode audit event")
+			return nil, status.Error(codes.Internal, "failed to export audit events")
+		}
+		response.Events = append(response.Events, event)
+	}
+
+	for _, checkpoint := range checkpoints {
+		response.Checkpoints = append(response.Checkpoints, &proto.AuditCheckpoint{
+			Id:        checkpoint.ID.String(),
+			Sequence:  checkpoint.Sequence,
+			Hash:      checkpoint.Hash,
+			Signature: checkpoint.Signature,
+			CreatedAt: timestamppb.New(checkpoint.CreatedAt),
+		})
+	}
+
+	return response, nil
+}
+
+// Verify walks the live chain, or the exported events and checkpoints when they are given
+func (p *auditService) Verify(ctx context.Context, req *proto.VerifyAuditChainRequest) (*proto.VerifyAuditChainResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	var result *models.AuditVerification
+
+	if len(req.Events) == 0 {
+		verification, err := p.audit.Verify(ctx)
+		if err != nil {
+			p.log.Error().Err(err).Msg("Failed to verify audit chain")
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		result = verification
+	} else {
+		events := make([]models.AuditEvent, 0, len(req.Events))
+		for _, event := range req.Events {
+			row, err := fromProtoAuditEvent(event)
+			if err != nil {
+				p.log.Error().Err(err).Str("id", event.Id).Msg("Invalid audit event")
+				return nil, status.Error(codes.InvalidArgument, "invalid audit event")
+			}
+			events = append(events, *row)
+		}
+
+		checkpoints := make([]models.AuditCheckpoint, 0, len(req.Checkpoints))
+		for _, checkpoint := range req.Checkpoints {
+			checkpoints = append(checkpoints, models.AuditCheckpoint{
+				Sequence:  checkpoint.Sequence,
+				Hash:      checkpoint.Hash,
+				Signature: checkpoint.Signature,
+			})
+		}
+
+		result = p.audit.VerifyRecords(req.PrevHash, events, checkpoints)
+	}
+
+	response := &proto.VerifyAuditChainResponse{
+		Valid:          result.Valid,
+		Events:         result.Events,
+		Checkpoints:    result.Checkpoints,
+		BrokenSequence: result.BrokenSequence,
+		Reason:         result.Reason,
+	}
+	if result.BrokenEventID != uuid.Nil {
+		response.BrokenEventId = result.BrokenEventID.String()
+	}
+
+	return response, nil
+}
+
+func toProtoAuditEvent(event *models.AuditEvent) (*proto.AuditEvent, error) {
+	changes, err := structpb.NewStruct(event.Changes)
+	if err != nil {
+		return nil, err
+	}
+
+	var actorId string
+	if event.ActorID != uuid.Nil {
+		actorId = event.ActorID.String()
+	}
+
+	return &proto.AuditEvent{
+		Id:         event.ID.String(),
+		Action:     event.Action,
+		ActorId:    actorId,
+		TargetType: event.TargetType,
+		TargetId:   event.TargetID,
+		Status:     event.Status,
+		Changes:    changes,
+		Metadata:   event.Metadata,
+		TraceId:    event.TraceID,
+		RemoteAddr: event.RemoteAddr,
+		CreatedAt:  timestamppb.New(event.CreatedAt),
+		Sequence:   event.Sequence,
+		PrevHash:   event.PrevHash,
+		Hash:       event.Hash,
+	}, nil
+}
+
+// fromProtoAuditEvent restores an exported event, the values must match the stored ones to keep the hash
+func fromProtoAuditEvent(event *proto.AuditEvent) (*models.AuditEvent, error) {
+	id, err := uuid.Parse(event.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var actorId uuid.UUID
+	if event.ActorId != "" {
+		actorId, err = uuid.Parse(event.ActorId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.AuditEvent{
+		ID:         id,
+		Action:     event.Action,
+		ActorID:    actorId,
+		TargetType: event.TargetType,
+		TargetID:   event.TargetId,
+		Status:     event.Status,
+		Changes:    event.Changes.AsMap(),
+		Metadata:   event.Metadata,
+		TraceID:    event.TraceId,
+		RemoteAddr: event.RemoteAddr,
+		CreatedAt:  event.CreatedAt.AsTime(),
+		Sequence:   event.Sequence,
+		PrevHash:   event.PrevHash,
+		Hash:       event.Hash,
+	}, nil
+}
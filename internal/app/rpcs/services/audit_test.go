@@ -0,0 +1,379 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	proto "loki/internal/app/rpcs/proto/sso/v1"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+func Test_Audit_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	audit := services.NewMockAudit(ctrl)
+	service := NewAudit(audit, log)
+
+	actorId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		before   func()
+		request  *proto.ListAuditEventsRequest
+		expected *proto.ListAuditEventsResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				audit.EXPECT().List(ctx, models.AuditFilter{
+					Action:  "/sso.v1.RoleService/Update",
+					ActorID: actorId,
+					From:    from,
+				}, gomock.Any()).Return([]models.AuditEvent{
+					{
+						ID:         uuid.MustParse("10000000-1000-1000-7000-000000000001"),
+						Action:     "/sso.v1.RoleService/Update",
+						ActorID:    actorId,
+						TargetType: "sso.v1.RoleService",
+						TargetID:   "10000000-1000-1000-1000-000000000001",
+						Status:     models.AuditSuccess,
+						Changes: map[string]any{
+							"name": map[string]any{"before": "admin", "after": "owner"},
+						},
+						CreatedAt: from,
+					},
+				}, uint64(1), nil)
+			},
+			request: &proto.ListAuditEventsRequest{
+				Limit:   1,
+				Offset:  10,
+				Action:  "/sso.v1.RoleService/Update",
+				ActorId: actorId.String(),
+				From:    timestamppb.New(from),
+			},
+			expected: &proto.ListAuditEventsResponse{
+				Data: []*proto.AuditEvent{
+					{
+						Id:         "10000000-1000-1000-7000-000000000001",
+						Action:     "/sso.v1.RoleService/Update",
+						ActorId:    actorId.String(),
+						TargetType: "sso.v1.RoleService",
+						TargetId:   "10000000-1000-1000-1000-000000000001",
+						Status:     models.AuditSuccess,
+					},
+				},
+				Meta: &proto.PaginationMeta{
+					Page:  1,
+					Per:   10,
+					Total: 1,
+				},
+			},
+			error: false,
+		},
+		{
+			name: "Invalid actor id",
+			before: func() {
+				audit.EXPECT().List(ctx, gomock.Any(), gomock.Any()).Times(0)
+			},
+			request: &proto.ListAuditEventsRequest{
+				Limit:   1,
+				Offset:  10,
+				ActorId: "invalid",
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Failed to fetch results",
+			before: func() {
+				audit.EXPECT().List(ctx, gomock.Any(), gomock.Any()).Return(nil, uint64(0), errors.ErrFailedToFetchResults)
+			},
+			request: &proto.ListAuditEventsRequest{
+				Limit:  1,
+				Offset: 10,
+			},
+			expected: nil,
+			code:     codes.Unavailable,
+			error:    true,
+		},
+		{
+			name: "Error",
+			before: func() {
+				audit.EXPECT().List(ctx, gomock.Any(), gomock.Any()).Return(nil, uint64(0), assert.AnError)
+			},
+			request: &proto.ListAuditEventsRequest{
+				Limit:  1,
+				Offset: 10,
+			},
+			expected: nil,
+			code:     codes.Internal,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.List(ctx, tt.request)
+
+			if tt.error {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tt.code, st.Code())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, len(tt.expected.Data), len(result.Data))
+				assert.Equal(t, tt.expected.Meta.Total, result.Meta.Total)
+				for i, event := range tt.expected.Data {
+					assert.Equal(t, event.Id, result.Data[i].Id)
+					assert.Equal(t, event.Action, result.Data[i].Action)
+					assert.Equal(t, event.ActorId, result.Data[i].ActorId)
+					assert.Equal(t, event.TargetId, result.Data[i].TargetId)
+					assert.Equal(t, event.Status, result.Data[i].Status)
+				}
+				assert.Equal(t, "owner", result.Data[0].Changes.AsMap()["name"].(map[string]any)["after"])
+			}
+		})
+	}
+}
+
+func Test_Audit_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	audit := services.NewMockAudit(ctrl)
+	service := NewAudit(audit, log)
+
+	eventId := uuid.MustParse("10000000-1000-1000-7000-000000000001")
+
+	tests := []struct {
+		name     string
+		before   func()
+		request  *proto.ExportAuditEventsRequest
+		expected *proto.ExportAuditEventsResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				audit.EXPECT().Export(ctx, int64(0), uint64(100)).Return(
+					[]models.AuditEvent{
+						{ID: eventId, Action: models.AuditTokenIssued, Sequence: 1, Hash: "hash"},
+					},
+					[]models.AuditCheckpoint{
+						{Sequence: 1, Hash: "hash", Signature: "signature"},
+					},
+					nil,
+				)
+			},
+			request: &proto.ExportAuditEventsRequest{Limit: 100},
+			expected: &proto.ExportAuditEventsResponse{
+				Events: []*proto.AuditEvent{
+					{Id: eventId.String(), Action: models.AuditTokenIssued, Sequence: 1, Hash: "hash"},
+				},
+				Checkpoints: []*proto.AuditCheckpoint{
+					{Sequence: 1, Hash: "hash", Signature: "signature"},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "Invalid Request",
+			before: func() {
+				audit.EXPECT().Export(ctx, gomock.Any(), gomock.Any()).Times(0)
+			},
+			request: &proto.ExportAuditEventsRequest{Limit: 5000},
+			code:    codes.InvalidArgument,
+			error:   true,
+		},
+		{
+			name: "Failed to fetch results",
+			before: func() {
+				audit.EXPECT().Export(ctx, int64(0), uint64(100)).Return(nil, nil, errors.ErrFailedToFetchResults)
+			},
+			request: &proto.ExportAuditEventsRequest{Limit: 100},
+			code:    codes.Unavailable,
+			error:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Export(ctx, tt.request)
+
+			if tt.error {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tt.code, st.Code())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, len(tt.expected.Events), len(result.Events))
+				for i, event := range tt.expected.Events {
+					assert.Equal(t, event.Id, result.Events[i].Id)
+					assert.Equal(t, event.Sequence, result.Events[i].Sequence)
+					assert.Equal(t, event.Hash, result.Events[i].Hash)
+				}
+				assert.Equal(t, len(tt.expected.Checkpoints), len(result.Checkpoints))
+				for i, checkpoint := range tt.expected.Checkpoints {
+					assert.Equal(t, checkpoint.Sequence, result.Checkpoints[i].Sequence)
+					assert.Equal(t, checkpoint.Signature, result.Checkpoints[i].Signature)
+				}
+			}
+		})
+	}
+}
+
+func Test_Audit_Verify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	audit := services.NewMockAudit(ctrl)
+	service := NewAudit(audit, log)
+
+	eventId := uuid.MustParse("10000000-1000-1000-7000-000000000001")
+	createdAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		before   func()
+		request  *proto.VerifyAuditChainRequest
+		expected *proto.VerifyAuditChainResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Live table",
+			before: func() {
+				audit.EXPECT().Verify(ctx).Return(&models.AuditVerification{Valid: true, Events: 10, Checkpoints: 2}, nil)
+			},
+			request:  &proto.VerifyAuditChainRequest{},
+			expected: &proto.VerifyAuditChainResponse{Valid: true, Events: 10, Checkpoints: 2},
+			error:    false,
+		},
+		{
+			name: "Exported events",
+			before: func() {
+				audit.EXPECT().VerifyRecords("prev", []models.AuditEvent{
+					{
+						ID:        eventId,
+						Action:    models.AuditTokenIssued,
+						Changes:   map[string]any{},
+						CreatedAt: createdAt,
+						Sequence:  2,
+						PrevHash:  "prev",
+						Hash:      "hash",
+					},
+				}, []models.AuditCheckpoint{}).Return(&models.AuditVerification{
+					Valid:          false,
+					BrokenSequence: 2,
+					BrokenEventID:  eventId,
+					Reason:         "hash mismatch",
+				})
+			},
+			request: &proto.VerifyAuditChainRequest{
+				PrevHash: "prev",
+				Events: []*proto.AuditEvent{
+					{
+						Id:        eventId.String(),
+						Action:    models.AuditTokenIssued,
+						CreatedAt: timestamppb.New(createdAt),
+						Sequence:  2,
+						PrevHash:  "prev",
+						Hash:      "hash",
+					},
+				},
+			},
+			expected: &proto.VerifyAuditChainResponse{
+				Valid:          false,
+				BrokenSequence: 2,
+				BrokenEventId:  eventId.String(),
+				Reason:         "hash mismatch",
+			},
+			error: false,
+		},
+		{
+			name: "Invalid event id",
+			before: func() {
+				audit.EXPECT().VerifyRecords(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			request: &proto.VerifyAuditChainRequest{
+				Events: []*proto.AuditEvent{{Id: "invalid"}},
+			},
+			code:  codes.InvalidArgument,
+			error: true,
+		},
+		{
+			name: "Error",
+			before: func() {
+				audit.EXPECT().Verify(ctx).Return(nil, errors.ErrFailedToFetchResults)
+			},
+			request: &proto.VerifyAuditChainRequest{},
+			code:    codes.Unavailable,
+			error:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Verify(ctx, tt.request)
+
+			if tt.error {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tt.code, st.Code())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.Valid, result.Valid)
+				assert.Equal(t, tt.expected.Events, result.Events)
+				assert.Equal(t, tt.expected.Checkpoints, result.Checkpoints)
+				assert.Equal(t, tt.expected.BrokenSequence, result.BrokenSequence)
+				assert.Equal(t, tt.expected.BrokenEventId, result.BrokenEventId)
+				assert.Equal(t, tt.expected.Reason, result.Reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	proto "loki/internal/app/rpcs/proto/sso/v1"
+	"loki/internal/app/services"
+	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
+)
+
+type elevationsService struct {
+	proto.UnimplementedElevationServiceServer
+	elevations services.Elevations
+	log        *logger.Logger
+}
+
+func NewElevations(elevations services.Elevations, log *logger.Logger) proto.ElevationServiceServer {
+	return &elevationsService{
+		elevations: elevations,
+		log:        log,
+	}
+}
+
+//nolint:dupl
+func (p *elevationsService) List(ctx context.Context, req *proto.PaginatedListRequest) (*proto.ListElevationsResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	pagination := &services.Pagination{
+		Page:    req.Limit,
+		PerPage: req.Offset,
+	}
+
+	rows, total, err := p.elevations.List(ctx, pagination)
+	if err != nil {
+		p.log.Error().Err(err).Msg("Failed to fetch elevations")
+
+		switch {
+		case errors.Is(err, errors.ErrFailedToFetchResults):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to fetch elevations")
+		}
+	}
+
+	collection := make([]*proto.Elevation, 0, len(rows))
+	for i := range rows {
+		collection = append(collection, toProtoElevation(&rows[i]))
+	}
+
+	return &proto.ListElevationsResponse{
+		Data: collection,
+		Meta: &proto.PaginationMeta{
+			Page:  pagination.Page,
+			Per:   pagination.PerPage,
+			Total: total,
+		},
+	}, nil
+}
+
+func (p *elevationsService) Get(ctx context.Context, req *proto.GetElevationRequest) (*proto.GetElevationResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to parse elevation ID")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	elevation, err := p.elevations.FindById(ctx, id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to get elevation")
+
+		switch {
+		case errors.Is(err, errors.ErrElevationNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to get elevation")
+		}
+	}
+
+	return &proto.GetElevationResponse{
+		Data: toProtoElevation(elevation),
+	}, nil
+}
+
+func (p *elevationsService) Request(ctx context.Context, req *proto.RequestElevationRequest) (*proto.RequestElevationResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	currentUser, ok := middlewares.CurrentUserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errors.ErrUnauthorized.Error())
+	}
+
+	roleId, err := uuid.Parse(req.RoleId)
+	if err != nil {
+		p.log.Error().Err(err).Str("role_id", req.RoleId).Msg("Failed to parse role ID")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	elevation, err := p.elevations.Request(ctx, &models.Elevation{
+		UserID:   currentUser.ID,
+		RoleID:   roleId,
+		Reason:   req.Reason,
+		Duration: time.Duration(req.DurationMinutes) * time.Minute,
+	})
+	if err != nil {
+		p.log.Error().Err(err).Str("role_id", req.RoleId).Msg("Failed to request elevation")
+
+		switch {
+		case errors.Is(err, errors.ErrRoleNotFound):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to request elevation")
+		}
+	}
+
+	return &proto.RequestElevationResponse{
+		Data: toProtoElevation(elevation),
+	}, nil
+}
+
+func (p *elevationsService) Approve(ctx context.Context, req *proto.ApproveElevationRequest) (*proto.ApproveElevationResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	claim, ok := middlewares.CurrentClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errors.ErrUnauthorized.Error())
+	}
+
+	elevation, err := p.decide(ctx, req.Id, func(ctx context.Context, id, approverId uuid.UUID) (*models.Elevation, error) {
+		return p.elevations.Approve(ctx, id, approverId, claim)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ApproveElevationResponse{
+		Data: toProtoElevation(elevation),
+	}, nil
+}
+
+func (p *elevationsService) Reject(ctx context.Context, req *proto.RejectElevationRequest) (*proto.RejectElevationResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	elevation, err := p.decide(ctx, req.Id, p.elevations.Reject)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RejectElevationResponse{
+		Data: toProtoElevation(elevation),
+	}, nil
+}
+
+// decide applies the approval or rejection on behalf of the current user
+func (p *elevationsService) decide(
+	ctx context.Context,
+	rawId string,
+	decision func(ctx context.Context, id, approverId uuid.UUID) (*models.Elevation, error),
+) (*models.Elevation, error) {
+	currentUser, ok := middlewares.CurrentUserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errors.ErrUnauthorized.Error())
+	}
+
+	id, err := uuid.Parse(rawId)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", rawId).Msg("Failed to parse elevation ID")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	elevation, err := decision(ctx, id, currentUser.ID)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", rawId).Msg("Failed to decide elevation")
+
+		switch {
+		case errors.Is(err, errors.ErrElevationNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, errors.ErrElevationAlreadyDecided):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, errors.ErrSelfApproval), errors.Is(err, errors.ErrRoleExceedsCallerPermissions):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to decide elevation")
+		}
+	}
+
+	return elevation, nil
+}
+
+func toProtoElevation(elevation *models.Elevation) *proto.Elevation {
+	result := &proto.Elevation{
+		Id:              elevation.ID.String(),
+		UserId:          elevation.UserID.String(),
+		RoleId:          elevation.RoleID.String(),
+		Reason:          elevation.Reason,
+		DurationMinutes: uint32(elevation.Duration / time.Minute),
+		Status:          elevation.Status,
+	}
+
+	if elevation.DecidedBy != uuid.Nil {
+		result.DecidedBy = elevation.DecidedBy.String()
+	}
+
+	return result
+}
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	proto "loki/internal/app/rpcs/proto/sso/v1"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
+	"loki/pkg/jwt"
+	"loki/pkg/rbac"
+)
+
+func Test_Elevations_Request(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	elevations := services.NewMockElevations(ctrl)
+	service := NewElevations(elevations, log)
+
+	currentUser := &models.User{ID: uuid.MustParse("10000000-1000-1000-5000-000000000001")}
+	ctx := middlewares.NewContextModifier(context.Background()).WithCurrentUser(currentUser).Context()
+
+	roleId := uuid.MustParse("10000000-1000-1000-1000-000000000001")
+	params := &models.Elevation{
+		UserID:   currentUser.ID,
+		RoleID:   roleId,
+		Reason:   "Incident response",
+		Duration: time.Hour,
+	}
+	elevation := &models.Elevation{
+		ID:       uuid.MustParse("10000000-1000-1000-7000-000000000001"),
+		UserID:   currentUser.ID,
+		RoleID:   roleId,
+		Reason:   "Incident response",
+		Duration: time.Hour,
+		Status:   models.ElevationPending,
+	}
+	request := &proto.RequestElevationRequest{
+		RoleId:          roleId.String(),
+		Reason:          "Incident response",
+		DurationMinutes: 60,
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		ctx      context.Context
+		request  *proto.RequestElevationRequest
+		expected *proto.RequestElevationResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				elevations.EXPECT().Request(ctx, params).Return(elevation, nil)
+			},
+			ctx:     ctx,
+			request: request,
+			expected: &proto.RequestElevationResponse{
+				Data: &proto.Elevation{
+					Id:              elevation.ID.String(),
+					UserId:          currentUser.ID.String(),
+					RoleId:          roleId.String(),
+					Reason:          "Incident response",
+					DurationMinutes: 60,
+					Status:          models.ElevationPending,
+				},
+			},
+			code:  codes.OK,
+			error: false,
+		},
+		{
+			name:   "Duration too long",
+			before: func() {},
+			ctx:    ctx,
+			request: &proto.RequestElevationRequest{
+				RoleId:          roleId.String(),
+				Reason:          "Incident response",
+				DurationMinutes: 10080,
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name:     "Missing current user",
+			before:   func() {},
+			ctx:      context.Background(),
+			request:  request,
+			expected: nil,
+			code:     codes.Unauthenticated,
+			error:    true,
+		},
+		{
+			name: "Role not found",
+			before: func() {
+				elevations.EXPECT().Request(ctx, params).Return(nil, errors.ErrRoleNotFound)
+			},
+			ctx:      ctx,
+			request:  request,
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			resp, err := service.Request(tt.ctx, tt.request)
+
+			if tt.error {
+				assert.Error(t, err)
+				assert.Equal(t, tt.code, status.Code(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, resp)
+			}
+		})
+	}
+}
+
+func Test_Elevations_Approve(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	elevations := services.NewMockElevations(ctrl)
+	service := NewElevations(elevations, log)
+
+	approver := &models.User{ID: uuid.MustParse("10000000-1000-1000-5000-000000000002")}
+	claim := &jwt.Payload{ID: "PNOEE-60001017869", Permissions: []string{rbac.WriteRoles}}
+	ctx := middlewares.NewContextModifier(context.Background()).WithCurrentUser(approver).WithClaim(claim).Context()
+
+	id := uuid.MustParse("10000000-1000-1000-7000-000000000001")
+	elevation := &models.Elevation{
+		ID:        id,
+		UserID:    uuid.MustParse("10000000-1000-1000-5000-000000000001"),
+		RoleID:    uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+		Reason:    "Incident response",
+		Duration:  time.Hour,
+		Status:    models.ElevationApproved,
+		DecidedBy: approver.ID,
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *proto.ApproveElevationResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				elevations.EXPECT().Approve(ctx, id, approver.ID, claim).Return(elevation, nil)
+			},
+			expected: &proto.ApproveElevationResponse{
+				Data: &proto.Elevation{
+					Id:              id.String(),
+					UserId:          elevation.UserID.String(),
+					RoleId:          elevation.RoleID.String(),
+					Reason:          "Incident response",
+					DurationMinutes: 60,
+					Status:          models.ElevationApproved,
+					DecidedBy:       approver.ID.String(),
+				},
+			},
+			code:  codes.OK,
+			error: false,
+		},
+		{
+			name: "Not found",
+			before: func() {
+				elevations.EXPECT().Approve(ctx, id, approver.ID, claim).Return(nil, errors.ErrElevationNotFound)
+			},
+			expected: nil,
+			code:     codes.NotFound,
+			error:    true,
+		},
+		{
+			name: "Already decided",
+			before: func() {
+				elevations.EXPECT().Approve(ctx, id, approver.ID, claim).Return(nil, errors.ErrElevationAlreadyDecided)
+			},
+			expected: nil,
+			code:     codes.FailedPrecondition,
+			error:    true,
+		},
+		{
+			name: "Self approval",
+			before: func() {
+				elevations.EXPECT().Approve(ctx, id, approver.ID, claim).Return(nil, errors.ErrSelfApproval)
+			},
+			expected: nil,
+			code:     codes.PermissionDenied,
+			error:    true,
+		},
+		{
+			name: "Role grants permissions the approver does not hold",
+			before: func() {
+				elevations.EXPECT().Approve(ctx, id, approver.ID, claim).Return(nil, errors.ErrRoleExceedsCallerPermissions)
+			},
+			expected: nil,
+			code:     codes.PermissionDenied,
+			error:    true,
+		},
+		{
+			name: "Failed to approve",
+			before: func() {
+				elevations.EXPECT().Approve(ctx, id, approver.ID, claim).Return(nil, errors.ErrFailedToUpdateRecord)
+			},
+			expected: nil,
+			code:     codes.Internal,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			resp, err := service.Approve(ctx, &proto.ApproveElevationRequest{Id: id.String()})
+
+			if tt.error {
+				assert.Error(t, err)
+				assert.Equal(t, tt.code, status.Code(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, resp)
+			}
+		})
+	}
+}
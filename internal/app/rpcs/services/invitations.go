@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	proto "loki/internal/app/rpcs/proto/sso/v1"
+	"loki/internal/app/services"
+	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
+)
+
+type invitationsService struct {
+	proto.UnimplementedInvitationServiceServer
+	invitations services.Invitations
+	log         *logger.Logger
+}
+
+func NewInvitations(invitations services.Invitations, log *logger.Logger) proto.InvitationServiceServer {
+	return &invitationsService{
+		invitations: invitations,
+		log:         log,
+	}
+}
+
+//nolint:dupl
+func (p *invitationsService) List(ctx context.Context, req *proto.PaginatedListRequest) (*proto.ListInvitationsResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	pagination := &services.Pagination{
+		Page:    req.Limit,
+		PerPage: req.Offset,
+	}
+
+	rows, total, err := p.invitations.List(ctx, pagination)
+	if err != nil {
+		p.log.Error().Err(err).Msg("Failed to fetch invitations")
+
+		switch {
+		case errors.Is(err, errors.ErrFailedToFetchResults):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to fetch invitations")
+		}
+	}
+
+	collection := make([]*proto.Invitation, 0, len(rows))
+	for i := range rows {
+		collection = append(collection, toProtoInvitation(&rows[i]))
+	}
+
+	return &proto.ListInvitationsResponse{
+		Data: collection,
+		Meta: &proto.PaginationMeta{
+			Page:  pagination.Page,
+			Per:   pagination.PerPage,
+			Total: total,
+		},
+	}, nil
+}
+
+func (p *invitationsService) Get(ctx context.Context, req *proto.GetInvitationRequest) (*proto.GetInvitationResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to parse invitation ID")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	invitation, err := p.invitations.FindById(ctx, id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to get invitation")
+
+		switch {
+		case errors.Is(err, errors.ErrInvitationNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to get invitation")
+		}
+	}
+
+	return &proto.GetInvitationResponse{
+		Data: toProtoInvitation(invitation),
+	}, nil
+}
+
+func (p *invitationsService) Create(ctx context.Context, req *proto.CreateInvitationRequest) (*proto.CreateInvitationResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	currentUser, ok := middlewares.CurrentUserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errors.ErrUnauthorized.Error())
+	}
+
+	roleIds, err := parseIds(req.RoleIds)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid role id format")
+	}
+
+	scopeIds, err := parseIds(req.ScopeIds)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid scope id format")
+	}
+
+	invitation, err := p.invitations.Create(ctx, req.Country, &models.Invitation{
+		PersonalCode: req.PersonalCode,
+		RoleIDs:      roleIds,
+		ScopeIDs:     scopeIds,
+		InvitedBy:    currentUser.ID,
+	})
+	if err != nil {
+		p.log.Error().Err(err).Str("country", req.Country).Msg("Failed to create invitation")
+
+		switch {
+		case errors.Is(err, errors.ErrInvalidArguments):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, errors.ErrUserAlreadyRegistered), errors.Is(err, errors.ErrInvitationAlreadyExists):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, errors.ErrFailedToFetchResults):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to create invitation")
+		}
+	}
+
+	return &proto.CreateInvitationResponse{
+		Data: toProtoInvitation(invitation),
+	}, nil
+}
+
+func (p *invitationsService) Extend(ctx context.Context, req *proto.ExtendInvitationRequest) (*proto.ExtendInvitationResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	invitation, err := p.change(ctx, req.Id, p.invitations.Extend)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ExtendInvitationResponse{
+		Data: toProtoInvitation(invitation),
+	}, nil
+}
+
+func (p *invitationsService) Revoke(ctx context.Context, req *proto.RevokeInvitationRequest) (*proto.RevokeInvitationResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	invitation, err := p.change(ctx, req.Id, p.invitations.Revoke)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RevokeInvitationResponse{
+		Data: toProtoInvitation(invitation),
+	}, nil
+}
+
+// change extends or revokes the pending invitation on behalf of the current user
+func (p *invitationsService) change(
+	ctx context.Context,
+	rawId string,
+	change func(ctx context.Context, id, actorId uuid.UUID) (*models.Invitation, error),
+) (*models.Invitation, error) {
+	currentUser, ok := middlewares.CurrentUserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errors.ErrUnauthorized.Error())
+	}
+
+	id, err := uuid.Parse(rawId)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", rawId).Msg("Failed to parse invitation ID")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	invitation, err := change(ctx, id, currentUser.ID)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", rawId).Msg("Failed to change invitation")
+
+		switch {
+		case errors.Is(err, errors.ErrInvitationNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, errors.ErrInvitationNotPending):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to change invitation")
+		}
+	}
+
+	return invitation, nil
+}
+
+func toProtoInvitation(invitation *models.Invitation) *proto.Invitation {
+	result := &proto.Invitation{
+		Id:             invitation.ID.String(),
+		IdentityNumber: invitation.IdentityNumber,
+		PersonalCode:   invitation.PersonalCode,
+		RoleIds:        formatIds(invitation.RoleIDs),
+		ScopeIds:       formatIds(invitation.ScopeIDs),
+		Status:         invitation.State(),
+		ExpiresAt:      timestamppb.New(invitation.ExpiresAt),
+		SentAt:         timestamppb.New(invitation.SentAt),
+	}
+
+	if invitation.InvitedBy != uuid.Nil {
+		result.InvitedBy = invitation.InvitedBy.String()
+	}
+
+	if invitation.UserID != uuid.Nil {
+		result.UserId = invitation.UserID.String()
+	}
+
+	if !invitation.AcceptedAt.IsZero() {
+		result.AcceptedAt = timestamppb.New(invitation.AcceptedAt)
+	}
+
+	return result
+}
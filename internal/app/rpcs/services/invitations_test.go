@@ -0,0 +1,263 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	proto "loki/internal/app/rpcs/proto/sso/v1"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
+)
+
+func Test_Invitations_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	invitations := services.NewMockInvitations(ctrl)
+	service := NewInvitations(invitations, log)
+
+	currentUser := &models.User{ID: uuid.MustParse("10000000-1000-1000-5000-000000000001")}
+	ctx := middlewares.NewContextModifier(context.Background()).WithCurrentUser(currentUser).Context()
+
+	roleId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+	expiresAt := time.Now().Add(services.InvitationTTL).UTC()
+	sentAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+	params := &models.Invitation{
+		PersonalCode: "30303039914",
+		RoleIDs:      []uuid.UUID{roleId},
+		ScopeIDs:     []uuid.UUID{},
+		InvitedBy:    currentUser.ID,
+	}
+	invitation := &models.Invitation{
+		ID:             uuid.MustParse("10000000-1000-1000-6000-000000000001"),
+		IdentityNumber: "PNOEE-30303039914",
+		PersonalCode:   "30303039914",
+		RoleIDs:        []uuid.UUID{roleId},
+		ScopeIDs:       []uuid.UUID{},
+		Status:         models.InvitationPending,
+		InvitedBy:      currentUser.ID,
+		ExpiresAt:      expiresAt,
+		SentAt:         sentAt,
+	}
+	request := &proto.CreateInvitationRequest{
+		Country:      "EE",
+		PersonalCode: "30303039914",
+		RoleIds:      []string{roleId.String()},
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		ctx      context.Context
+		request  *proto.CreateInvitationRequest
+		expected *proto.CreateInvitationResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				invitations.EXPECT().Create(ctx, "EE", params).Return(invitation, nil)
+			},
+			ctx:     ctx,
+			request: request,
+			expected: &proto.CreateInvitationResponse{
+				Data: &proto.Invitation{
+					Id:             invitation.ID.String(),
+					IdentityNumber: "PNOEE-30303039914",
+					PersonalCode:   "30303039914",
+					RoleIds:        []string{roleId.String()},
+					ScopeIds:       []string{},
+					Status:         models.InvitationPending,
+					InvitedBy:      currentUser.ID.String(),
+					ExpiresAt:      timestamppb.New(expiresAt),
+					SentAt:         timestamppb.New(sentAt),
+				},
+			},
+			code:  codes.OK,
+			error: false,
+		},
+		{
+			name:   "Unsupported country",
+			before: func() {},
+			ctx:    ctx,
+			request: &proto.CreateInvitationRequest{
+				Country:      "FI",
+				PersonalCode: "30303039914",
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name:     "Missing current user",
+			before:   func() {},
+			ctx:      context.Background(),
+			request:  request,
+			expected: nil,
+			code:     codes.Unauthenticated,
+			error:    true,
+		},
+		{
+			name: "Invalid personal code",
+			before: func() {
+				invitations.EXPECT().Create(ctx, "EE", params).Return(nil, errors.ErrInvalidArguments)
+			},
+			ctx:      ctx,
+			request:  request,
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Already invited",
+			before: func() {
+				invitations.EXPECT().Create(ctx, "EE", params).Return(nil, errors.ErrInvitationAlreadyExists)
+			},
+			ctx:      ctx,
+			request:  request,
+			expected: nil,
+			code:     codes.AlreadyExists,
+			error:    true,
+		},
+		{
+			name: "Already registered",
+			before: func() {
+				invitations.EXPECT().Create(ctx, "EE", params).Return(nil, errors.ErrUserAlreadyRegistered)
+			},
+			ctx:      ctx,
+			request:  request,
+			expected: nil,
+			code:     codes.AlreadyExists,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			resp, err := service.Create(tt.ctx, tt.request)
+
+			if tt.error {
+				assert.Error(t, err)
+				assert.Equal(t, tt.code, status.Code(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, resp)
+			}
+		})
+	}
+}
+
+func Test_Invitations_Revoke(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	invitations := services.NewMockInvitations(ctrl)
+	service := NewInvitations(invitations, log)
+
+	currentUser := &models.User{ID: uuid.MustParse("10000000-1000-1000-5000-000000000001")}
+	ctx := middlewares.NewContextModifier(context.Background()).WithCurrentUser(currentUser).Context()
+
+	id := uuid.MustParse("10000000-1000-1000-6000-000000000001")
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	sentAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *proto.RevokeInvitationResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				invitations.EXPECT().Revoke(ctx, id, currentUser.ID).Return(&models.Invitation{
+					ID:             id,
+					IdentityNumber: "PNOEE-30303039914",
+					PersonalCode:   "30303039914",
+					Status:         models.InvitationRevoked,
+					ExpiresAt:      expiresAt,
+					SentAt:         sentAt,
+				}, nil)
+			},
+			expected: &proto.RevokeInvitationResponse{
+				Data: &proto.Invitation{
+					Id:             id.String(),
+					IdentityNumber: "PNOEE-30303039914",
+					PersonalCode:   "30303039914",
+					RoleIds:        []string{},
+					ScopeIds:       []string{},
+					Status:         models.InvitationRevoked,
+					ExpiresAt:      timestamppb.New(expiresAt),
+					SentAt:         timestamppb.New(sentAt),
+				},
+			},
+			code:  codes.OK,
+			error: false,
+		},
+		{
+			name: "Not found",
+			before: func() {
+				invitations.EXPECT().Revoke(ctx, id, currentUser.ID).Return(nil, errors.ErrInvitationNotFound)
+			},
+			expected: nil,
+			code:     codes.NotFound,
+			error:    true,
+		},
+		{
+			name: "Not pending",
+			before: func() {
+				invitations.EXPECT().Revoke(ctx, id, currentUser.ID).Return(nil, errors.ErrInvitationNotPending)
+			},
+			expected: nil,
+			code:     codes.FailedPrecondition,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			resp, err := service.Revoke(ctx, &proto.RevokeInvitationRequest{Id: id.String()})
+
+			if tt.error {
+				assert.Error(t, err)
+				assert.Equal(t, tt.code, status.Code(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, resp)
+			}
+		})
+	}
+}
@@ -3,6 +3,10 @@ package services
 import "go.uber.org/fx"
 
 var Module = fx.Options(
+	fx.Provide(NewAudit),
+	fx.Provide(NewElevations),
+	fx.Provide(NewInvitations),
+	fx.Provide(NewOrganisations),
 	fx.Provide(NewPermissions),
 	fx.Provide(NewRoles),
 	fx.Provide(NewScopes),
@@ -0,0 +1,330 @@
+package services
+
+import (
+	"context"
+
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	proto "loki/internal/app/rpcs/proto/sso/v1"
+	"loki/internal/app/services"
+	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
+)
+
+type organisationsService struct {
+	proto.UnimplementedOrganisationServiceServer
+	organisations services.Organisations
+	log           *logger.Logger
+}
+
+func NewOrganisations(organisations services.Organisations, log *logger.Logger) proto.OrganisationServiceServer {
+	return &organisationsService{
+		organisations: organisations,
+		log:           log,
+	}
+}
+
+//nolint:dupl
+func (p *organisationsService) List(ctx context.Context, req *proto.PaginatedListRequest) (*proto.ListOrganisationsResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	pagination := &services.Pagination{
+		Page:    req.Limit,
+		PerPage: req.Offset,
+	}
+
+	rows, total, err := p.organisations.List(ctx, pagination)
+	if err != nil {
+		p.log.Error().Err(err).Msg("Failed to fetch organisations")
+
+		switch {
+		case errors.Is(err, errors.ErrFailedToFetchResults):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to fetch organisations")
+		}
+	}
+
+	collection := make([]*proto.Organisation, 0, len(rows))
+	for _, row := range rows {
+		collection = append(collection, &proto.Organisation{
+			Id:          row.ID.String(),
+			Name:        row.Name,
+			Description: row.Description,
+		})
+	}
+
+	return &proto.ListOrganisationsResponse{
+		Data: collection,
+		Meta: &proto.PaginationMeta{
+			Page:  pagination.Page,
+			Per:   pagination.PerPage,
+			Total: total,
+		},
+	}, nil
+}
+
+func (p *organisationsService) Get(ctx context.Context, req *proto.GetOrganisationRequest) (*proto.GetOrganisationResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to parse organisation ID")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	organisation, err := p.organisations.FindById(ctx, id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to get organisation")
+
+		switch {
+		case errors.Is(err, errors.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to get organisation")
+		}
+	}
+
+	return &proto.GetOrganisationResponse{
+		Data: &proto.Organisation{
+			Id:          organisation.ID.String(),
+			Name:        organisation.Name,
+			Description: organisation.Description,
+		},
+	}, nil
+}
+
+func (p *organisationsService) Create(ctx context.Context, req *proto.CreateOrganisationRequest) (*proto.CreateOrganisationResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	organisation, err := p.organisations.Create(ctx, &models.Organisation{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		p.log.Error().Err(err).Str("name", req.Name).Msg("Failed to create organisation")
+
+		switch {
+		case errors.Is(err, errors.ErrFailedToCreateRecord):
+			return nil, status.Error(codes.Internal, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to create organisation")
+		}
+	}
+
+	return &proto.CreateOrganisationResponse{
+		Data: &proto.Organisation{
+			Id:          organisation.ID.String(),
+			Name:        organisation.Name,
+			Description: organisation.Description,
+		},
+	}, nil
+}
+
+func (p *organisationsService) Update(ctx context.Context, req *proto.UpdateOrganisationRequest) (*proto.UpdateOrganisationResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Invalid UUID format")
+		return nil, status.Error(codes.InvalidArgument, "invalid organisation id format")
+	}
+
+	organisation, err := p.organisations.Update(ctx, &models.Organisation{
+		ID:          id,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to update organisation")
+
+		switch {
+		case errors.Is(err, errors.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, errors.ErrFailedToUpdateRecord):
+			return nil, status.Error(codes.Internal, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to update organisation")
+		}
+	}
+
+	return &proto.UpdateOrganisationResponse{
+		Data: &proto.Organisation{
+			Id:          organisation.ID.String(),
+			Name:        organisation.Name,
+			Description: organisation.Description,
+		},
+	}, nil
+}
+
+//nolint:dupl
+func (p *organisationsService) Delete(ctx context.Context, req *proto.DeleteOrganisationRequest) (*emptypb.Empty, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to parse organisation ID")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = p.organisations.Delete(ctx, id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to delete organisation")
+
+		switch {
+		case errors.Is(err, errors.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to delete organisation")
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (p *organisationsService) ListMemberships(ctx context.Context, req *proto.ListMembershipsRequest) (*proto.ListMembershipsResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	id, err := uuid.Parse(req.OrganisationId)
+	if err != nil {
+		p.log.Error().Err(err).Str("organisation_id", req.OrganisationId).Msg("Failed to parse organisation ID")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rows, err := p.organisations.ListMemberships(ctx, id)
+	if err != nil {
+		p.log.Error().Err(err).Str("organisation_id", req.OrganisationId).Msg("Failed to fetch memberships")
+
+		switch {
+		case errors.Is(err, errors.ErrOrganisationNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, errors.ErrFailedToFetchResults):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to fetch memberships")
+		}
+	}
+
+	collection := make([]*proto.Membership, 0, len(rows))
+	for _, row := range rows {
+		collection = append(collection, &proto.Membership{
+			UserId:         row.UserID.String(),
+			OrganisationId: row.OrganisationID.String(),
+			RoleId:         row.RoleID.String(),
+		})
+	}
+
+	return &proto.ListMembershipsResponse{
+		Data: collection,
+	}, nil
+}
+
+func (p *organisationsService) AddMembership(ctx context.Context, req *proto.AddMembershipRequest) (*proto.AddMembershipResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	claim, ok := middlewares.CurrentClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errors.ErrUnauthorized.Error())
+	}
+
+	params, err := parseMembership(req.OrganisationId, req.UserId, req.RoleId)
+	if err != nil {
+		p.log.Error().Err(err).Str("organisation_id", req.OrganisationId).Msg("Invalid UUID format")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	membership, err := p.organisations.AddMembership(ctx, params, claim)
+	if err != nil {
+		p.log.Error().Err(err).Str("organisation_id", req.OrganisationId).Msg("Failed to add membership")
+
+		switch {
+		case errors.Is(err, errors.ErrOrganisationNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, errors.ErrRoleNotFound), errors.Is(err, errors.ErrUserNotFound):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, errors.ErrRoleExceedsCallerPermissions):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, errors.ErrFailedToCreateRecord):
+			return nil, status.Error(codes.Internal, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to add membership")
+		}
+	}
+
+	return &proto.AddMembershipResponse{
+		Data: &proto.Membership{
+			UserId:         membership.UserID.String(),
+			OrganisationId: membership.OrganisationID.String(),
+			RoleId:         membership.RoleID.String(),
+		},
+	}, nil
+}
+
+func (p *organisationsService) RemoveMembership(ctx context.Context, req *proto.RemoveMembershipRequest) (*emptypb.Empty, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	params, err := parseMembership(req.OrganisationId, req.UserId, req.RoleId)
+	if err != nil {
+		p.log.Error().Err(err).Str("organisation_id", req.OrganisationId).Msg("Invalid UUID format")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = p.organisations.RemoveMembership(ctx, params)
+	if err != nil {
+		p.log.Error().Err(err).Str("organisation_id", req.OrganisationId).Msg("Failed to remove membership")
+
+		switch {
+		case errors.Is(err, errors.ErrMembershipNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to remove membership")
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func parseMembership(organisationId, userId, roleId string) (*models.Membership, error) {
+	organisation, err := uuid.Parse(organisationId)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := uuid.Parse(roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Membership{
+		UserID:         user,
+		OrganisationID: organisation,
+		RoleID:         role,
+	}, nil
+}
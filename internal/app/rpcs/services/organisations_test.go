@@ -0,0 +1,395 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	proto "loki/internal/app/rpcs/proto/sso/v1"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
+	"loki/pkg/jwt"
+)
+
+func Test_Organisations_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	organisations := services.NewMockOrganisations(ctrl)
+	service := NewOrganisations(organisations, log)
+
+	id := uuid.MustParse("10000000-1000-1000-4000-000000000001")
+
+	tests := []struct {
+		name     string
+		before   func()
+		request  *proto.GetOrganisationRequest
+		expected *proto.GetOrganisationResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				organisations.EXPECT().FindById(ctx, id).Return(&models.Organisation{
+					ID:          id,
+					Name:        "Acme",
+					Description: "Acme organisation",
+				}, nil)
+			},
+			request: &proto.GetOrganisationRequest{Id: id.String()},
+			expected: &proto.GetOrganisationResponse{
+				Data: &proto.Organisation{
+					Id:          id.String(),
+					Name:        "Acme",
+					Description: "Acme organisation",
+				},
+			},
+			code:  codes.OK,
+			error: false,
+		},
+		{
+			name:     "Invalid UUID",
+			before:   func() {},
+			request:  &proto.GetOrganisationRequest{Id: "invalid-uuid"},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Not found",
+			before: func() {
+				organisations.EXPECT().FindById(ctx, id).Return(nil, errors.ErrRecordNotFound)
+			},
+			request:  &proto.GetOrganisationRequest{Id: id.String()},
+			expected: nil,
+			code:     codes.NotFound,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			resp, err := service.Get(ctx, tt.request)
+
+			if tt.error {
+				assert.Error(t, err)
+				assert.Equal(t, tt.code, status.Code(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, resp)
+			}
+		})
+	}
+}
+
+func Test_Organisations_ListMemberships(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	organisations := services.NewMockOrganisations(ctrl)
+	service := NewOrganisations(organisations, log)
+
+	membership := models.Membership{
+		UserID:         uuid.MustParse("10000000-1000-1000-5000-000000000001"),
+		OrganisationID: uuid.MustParse("10000000-1000-1000-4000-000000000001"),
+		RoleID:         uuid.MustParse("10000000-1000-1000-1000-000000000002"),
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		request  *proto.ListMembershipsRequest
+		expected *proto.ListMembershipsResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				organisations.EXPECT().ListMemberships(ctx, membership.OrganisationID).Return([]models.Membership{membership}, nil)
+			},
+			request: &proto.ListMembershipsRequest{OrganisationId: membership.OrganisationID.String()},
+			expected: &proto.ListMembershipsResponse{
+				Data: []*proto.Membership{
+					{
+						UserId:         membership.UserID.String(),
+						OrganisationId: membership.OrganisationID.String(),
+						RoleId:         membership.RoleID.String(),
+					},
+				},
+			},
+			code:  codes.OK,
+			error: false,
+		},
+		{
+			name:     "Invalid UUID",
+			before:   func() {},
+			request:  &proto.ListMembershipsRequest{OrganisationId: "invalid-uuid"},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Organisation not found",
+			before: func() {
+				organisations.EXPECT().ListMemberships(ctx, membership.OrganisationID).Return(nil, errors.ErrOrganisationNotFound)
+			},
+			request:  &proto.ListMembershipsRequest{OrganisationId: membership.OrganisationID.String()},
+			expected: nil,
+			code:     codes.NotFound,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			resp, err := service.ListMemberships(ctx, tt.request)
+
+			if tt.error {
+				assert.Error(t, err)
+				assert.Equal(t, tt.code, status.Code(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, resp)
+			}
+		})
+	}
+}
+
+func Test_Organisations_AddMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	claim := &jwt.Payload{Permissions: []string{"write:organisations"}}
+	ctx := middlewares.NewContextModifier(context.Background()).WithClaim(claim).Context()
+	organisations := services.NewMockOrganisations(ctrl)
+	service := NewOrganisations(organisations, log)
+
+	membership := &models.Membership{
+		UserID:         uuid.MustParse("10000000-1000-1000-5000-000000000001"),
+		OrganisationID: uuid.MustParse("10000000-1000-1000-4000-000000000001"),
+		RoleID:         uuid.MustParse("10000000-1000-1000-1000-000000000002"),
+	}
+	request := &proto.AddMembershipRequest{
+		OrganisationId: membership.OrganisationID.String(),
+		UserId:         membership.UserID.String(),
+		RoleId:         membership.RoleID.String(),
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		request  *proto.AddMembershipRequest
+		expected *proto.AddMembershipResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				organisations.EXPECT().AddMembership(ctx, membership, claim).Return(membership, nil)
+			},
+			request: request,
+			expected: &proto.AddMembershipResponse{
+				Data: &proto.Membership{
+					UserId:         membership.UserID.String(),
+					OrganisationId: membership.OrganisationID.String(),
+					RoleId:         membership.RoleID.String(),
+				},
+			},
+			code:  codes.OK,
+			error: false,
+		},
+		{
+			name:   "Invalid role id",
+			before: func() {},
+			request: &proto.AddMembershipRequest{
+				OrganisationId: membership.OrganisationID.String(),
+				UserId:         membership.UserID.String(),
+				RoleId:         "invalid-uuid",
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Organisation not found",
+			before: func() {
+				organisations.EXPECT().AddMembership(ctx, membership, claim).Return(nil, errors.ErrOrganisationNotFound)
+			},
+			request:  request,
+			expected: nil,
+			code:     codes.NotFound,
+			error:    true,
+		},
+		{
+			name: "Role not found",
+			before: func() {
+				organisations.EXPECT().AddMembership(ctx, membership, claim).Return(nil, errors.ErrRoleNotFound)
+			},
+			request:  request,
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "User not found",
+			before: func() {
+				organisations.EXPECT().AddMembership(ctx, membership, claim).Return(nil, errors.ErrUserNotFound)
+			},
+			request:  request,
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Role exceeds caller permissions",
+			before: func() {
+				organisations.EXPECT().AddMembership(ctx, membership, claim).Return(nil, errors.ErrRoleExceedsCallerPermissions)
+			},
+			request:  request,
+			expected: nil,
+			code:     codes.PermissionDenied,
+			error:    true,
+		},
+		{
+			name: "Failed to create membership",
+			before: func() {
+				organisations.EXPECT().AddMembership(ctx, membership, claim).Return(nil, errors.ErrFailedToCreateRecord)
+			},
+			request:  request,
+			expected: nil,
+			code:     codes.Internal,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			resp, err := service.AddMembership(ctx, tt.request)
+
+			if tt.error {
+				assert.Error(t, err)
+				assert.Equal(t, tt.code, status.Code(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, resp)
+			}
+		})
+	}
+}
+
+func Test_Organisations_RemoveMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	organisations := services.NewMockOrganisations(ctrl)
+	service := NewOrganisations(organisations, log)
+
+	membership := &models.Membership{
+		UserID:         uuid.MustParse("10000000-1000-1000-5000-000000000001"),
+		OrganisationID: uuid.MustParse("10000000-1000-1000-4000-000000000001"),
+		RoleID:         uuid.MustParse("10000000-1000-1000-1000-000000000002"),
+	}
+	request := &proto.RemoveMembershipRequest{
+		OrganisationId: membership.OrganisationID.String(),
+		UserId:         membership.UserID.String(),
+		RoleId:         membership.RoleID.String(),
+	}
+
+	tests := []struct {
+		name   string
+		before func()
+		code   codes.Code
+		error  bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				organisations.EXPECT().RemoveMembership(ctx, membership).Return(true, nil)
+			},
+			code:  codes.OK,
+			error: false,
+		},
+		{
+			name: "Membership not found",
+			before: func() {
+				organisations.EXPECT().RemoveMembership(ctx, membership).Return(false, errors.ErrMembershipNotFound)
+			},
+			code:  codes.NotFound,
+			error: true,
+		},
+		{
+			name: "Failed to delete membership",
+			before: func() {
+				organisations.EXPECT().RemoveMembership(ctx, membership).Return(false, errors.ErrFailedToDeleteRecord)
+			},
+			code:  codes.Internal,
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			resp, err := service.RemoveMembership(ctx, request)
+
+			if tt.error {
+				assert.Error(t, err)
+				assert.Equal(t, tt.code, status.Code(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, &emptypb.Empty{}, resp)
+			}
+		})
+	}
+}
@@ -2,17 +2,21 @@ package services
 
 import (
 	"context"
+	"slices"
 
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/services"
 	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
 	proto "loki/internal/app/rpcs/proto/sso/v1"
 )
 
@@ -58,6 +62,7 @@ func (p *rolesService) List(ctx context.Context, req *proto.PaginatedListRequest
 			Id:          row.ID.String(),
 			Name:        row.Name,
 			Description: row.Description,
+			ParentId:    formatParentId(row.ParentID),
 		})
 	}
 
@@ -99,12 +104,19 @@ func (p *rolesService) Get(ctx context.Context, req *proto.GetRoleRequest) (*pro
 		permissionIds = append(permissionIds, permissionId.String())
 	}
 
+	inheritedPermissionIds := make([]string, 0, len(role.InheritedPermissionIDs))
+	for _, permissionId := range role.InheritedPermissionIDs {
+		inheritedPermissionIds = append(inheritedPermissionIds, permissionId.String())
+	}
+
 	return &proto.GetRoleResponse{
 		Data: &proto.Role{
-			Id:            role.ID.String(),
-			Name:          role.Name,
-			Description:   role.Description,
-			PermissionIds: permissionIds,
+			Id:                     role.ID.String(),
+			Name:                   role.Name,
+			Description:            role.Description,
+			ParentId:               formatParentId(role.ParentID),
+			PermissionIds:          permissionIds,
+			InheritedPermissionIds: inheritedPermissionIds,
 		},
 	}, nil
 }
@@ -124,15 +136,24 @@ func (p *rolesService) Create(ctx context.Context, req *proto.CreateRoleRequest)
 		permissionIDs = append(permissionIDs, id)
 	}
 
+	parentID, err := parseParentId(req.ParentId)
+	if err != nil {
+		p.log.Error().Err(err).Str("parent_id", req.ParentId).Msg("Invalid parent role ID format")
+		return nil, status.Error(codes.InvalidArgument, "invalid parent role ID format")
+	}
+
 	role, err := p.roles.Create(ctx, &models.Role{
 		Name:          req.Name,
 		Description:   req.Description,
+		ParentID:      parentID,
 		PermissionIDs: permissionIDs,
 	})
 	if err != nil {
 		p.log.Error().Err(err).Str("name", req.Name).Msg("Failed to create role")
 
 		switch {
+		case errors.Is(err, errors.ErrRoleNotFound), errors.Is(err, errors.ErrRoleHierarchyCycle):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		case errors.Is(err, errors.ErrFailedToCreateRecord):
 			return nil, status.Error(codes.Internal, err.Error())
 		default:
@@ -145,12 +166,18 @@ func (p *rolesService) Create(ctx context.Context, req *proto.CreateRoleRequest)
 			Id:          role.ID.String(),
 			Name:        role.Name,
 			Description: role.Description,
+			ParentId:    formatParentId(role.ParentID),
 		},
 	}, nil
 }
 
 func (p *rolesService) Update(ctx context.Context, req *proto.UpdateRoleRequest) (*proto.UpdateRoleResponse, error) {
-	if err := protovalidate.Validate(req); err != nil {
+	fields, err := updateMaskFields(req.GetUpdateMask(), "name", "description", "permission_ids", "parent_id")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateFields(req, fields); err != nil {
 		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
 	}
 
@@ -170,16 +197,25 @@ func (p *rolesService) Update(ctx context.Context, req *proto.UpdateRoleRequest)
 		return nil, status.Error(codes.InvalidArgument, "invalid role id format")
 	}
 
+	parentID, err := parseParentId(req.ParentId)
+	if err != nil {
+		p.log.Error().Err(err).Str("parent_id", req.ParentId).Msg("Invalid parent role ID format")
+		return nil, status.Error(codes.InvalidArgument, "invalid parent role ID format")
+	}
+
 	role, err := p.roles.Update(ctx, &models.Role{
 		ID:            id,
 		Name:          req.Name,
 		Description:   req.Description,
+		ParentID:      parentID,
 		PermissionIDs: permissionIDs,
-	})
+	}, fields)
 	if err != nil {
 		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to update role")
 
 		switch {
+		case errors.Is(err, errors.ErrRoleNotFound), errors.Is(err, errors.ErrRoleHierarchyCycle):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		case errors.Is(err, errors.ErrRecordNotFound):
 			return nil, status.Error(codes.NotFound, err.Error())
 		case errors.Is(err, errors.ErrFailedToUpdateRecord):
@@ -194,6 +230,7 @@ func (p *rolesService) Update(ctx context.Context, req *proto.UpdateRoleRequest)
 			Id:          role.ID.String(),
 			Name:        role.Name,
 			Description: role.Description,
+			ParentId:    formatParentId(role.ParentID),
 		},
 	}, nil
 }
@@ -224,3 +261,145 @@ func (p *rolesService) Delete(ctx context.Context, req *proto.DeleteRoleRequest)
 
 	return &emptypb.Empty{}, nil
 }
+
+func (p *rolesService) AddPermissions(ctx context.Context, req *proto.ChangeRolePermissionsRequest) (*proto.RolePermissionsResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	return p.changePermissions(ctx, req.Id, req.PermissionIds, p.roles.AddPermissions)
+}
+
+func (p *rolesService) RemovePermissions(ctx context.Context, req *proto.ChangeRolePermissionsRequest) (*proto.RolePermissionsResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	return p.changePermissions(ctx, req.Id, req.PermissionIds, p.roles.RemovePermissions)
+}
+
+func (p *rolesService) SetPermissions(ctx context.Context, req *proto.SetRolePermissionsRequest) (*proto.RolePermissionsResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	return p.changePermissions(ctx, req.Id, req.PermissionIds, p.roles.SetPermissions)
+}
+
+// changePermissions applies the permission change on behalf of the current user
+func (p *rolesService) changePermissions(
+	ctx context.Context,
+	rawId string,
+	rawPermissionIds []string,
+	change func(ctx context.Context, id uuid.UUID, permissionIds []uuid.UUID, actorId uuid.UUID) (*models.Role, error),
+) (*proto.RolePermissionsResponse, error) {
+	currentUser, ok := middlewares.CurrentUserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errors.ErrUnauthorized.Error())
+	}
+
+	id, err := uuid.Parse(rawId)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", rawId).Msg("Invalid UUID format")
+		return nil, status.Error(codes.InvalidArgument, "invalid role id format")
+	}
+
+	permissionIds, err := parseIds(rawPermissionIds)
+	if err != nil {
+		p.log.Error().Err(err).Msg("Invalid permission ID format")
+		return nil, status.Error(codes.InvalidArgument, "invalid permission ID format")
+	}
+
+	role, err := change(ctx, id, permissionIds, currentUser.ID)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", rawId).Msg("Failed to change role permissions")
+
+		switch {
+		case errors.Is(err, errors.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, errors.ErrFailedToUpdateRecord):
+			return nil, status.Error(codes.Internal, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to change role permissions")
+		}
+	}
+
+	return &proto.RolePermissionsResponse{
+		Data: &proto.Role{
+			Id:                     role.ID.String(),
+			Name:                   role.Name,
+			Description:            role.Description,
+			ParentId:               formatParentId(role.ParentID),
+			PermissionIds:          formatIds(role.PermissionIDs),
+			InheritedPermissionIds: formatIds(role.InheritedPermissionIDs),
+		},
+	}, nil
+}
+
+// updateMaskFields returns the fields named by the update mask, an empty mask updates every field
+func updateMaskFields(mask *fieldmaskpb.FieldMask, allowed ...string) ([]string, error) {
+	for _, path := range mask.GetPaths() {
+		if !slices.Contains(allowed, path) {
+			return nil, status.Error(codes.InvalidArgument, "invalid update mask")
+		}
+	}
+
+	return mask.GetPaths(), nil
+}
+
+// validateFields validates the request, ignoring violations of fields left out of a non-empty field list
+func validateFields(req protoreflect.ProtoMessage, fields []string) error {
+	err := protovalidate.Validate(req)
+
+	var validationErr *protovalidate.ValidationError
+	if len(fields) == 0 || !errors.As(err, &validationErr) {
+		return err
+	}
+
+	for _, violation := range validationErr.Violations {
+		elements := violation.Proto.GetField().GetElements()
+		if len(elements) == 0 || elements[0].GetFieldName() == "id" || slices.Contains(fields, elements[0].GetFieldName()) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseParentId(value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, nil
+	}
+
+	return uuid.Parse(value)
+}
+
+func formatParentId(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+
+	return id.String()
+}
+
+func parseIds(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func formatIds(ids []uuid.UUID) []string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+
+	return values
+}
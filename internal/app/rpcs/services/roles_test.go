@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"loki/internal/app/errors"
 	"loki/internal/app/models"
@@ -17,6 +18,7 @@ import (
 	"loki/internal/app/services"
 	"loki/internal/config"
 	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
 )
 
 func Test_Roles_List(t *testing.T) {
@@ -173,6 +175,7 @@ func Test_Roles_Get(t *testing.T) {
 	service := NewRoles(roles, log)
 
 	id := uuid.MustParse("10000000-1000-1000-1000-000000000001")
+	parentId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
 	permissionIds := []uuid.UUID{
 		uuid.MustParse("10000000-1000-1000-3000-000000000001"),
 		uuid.MustParse("10000000-1000-1000-3000-000000000002"),
@@ -201,10 +204,38 @@ func Test_Roles_Get(t *testing.T) {
 			},
 			expected: &proto.GetRoleResponse{
 				Data: &proto.Role{
-					Id:            id.String(),
-					Name:          "admin",
-					Description:   "Admin role",
-					PermissionIds: []string{"10000000-1000-1000-3000-000000000001", "10000000-1000-1000-3000-000000000002"},
+					Id:                     id.String(),
+					Name:                   "admin",
+					Description:            "Admin role",
+					PermissionIds:          []string{"10000000-1000-1000-3000-000000000001", "10000000-1000-1000-3000-000000000002"},
+					InheritedPermissionIds: []string{},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "Success with inherited permissions",
+			before: func() {
+				roles.EXPECT().FindRoleDetailsById(ctx, id).Return(&models.Role{
+					ID:                     id,
+					Name:                   models.AdminRoleType,
+					Description:            "Admin role",
+					ParentID:               parentId,
+					PermissionIDs:          permissionIds[:1],
+					InheritedPermissionIDs: permissionIds[1:],
+				}, nil)
+			},
+			req: &proto.GetRoleRequest{
+				Id: id.String(),
+			},
+			expected: &proto.GetRoleResponse{
+				Data: &proto.Role{
+					Id:                     id.String(),
+					Name:                   "admin",
+					Description:            "Admin role",
+					ParentId:               parentId.String(),
+					PermissionIds:          []string{"10000000-1000-1000-3000-000000000001"},
+					InheritedPermissionIds: []string{"10000000-1000-1000-3000-000000000002"},
 				},
 			},
 			error: false,
@@ -278,6 +309,7 @@ func Test_Roles_Create(t *testing.T) {
 	service := NewRoles(roles, log)
 
 	id := uuid.MustParse("10000000-1000-1000-1000-000000000001")
+	parentId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
 
 	tests := []struct {
 		name     string
@@ -333,6 +365,62 @@ func Test_Roles_Create(t *testing.T) {
 			code:     codes.Internal,
 			error:    true,
 		},
+		{
+			name: "Success with parent",
+			before: func() {
+				roles.EXPECT().Create(ctx, &models.Role{
+					Name:          "auditor",
+					Description:   "Auditor role",
+					ParentID:      parentId,
+					PermissionIDs: []uuid.UUID{},
+				}).Return(&models.Role{
+					ID:          id,
+					Name:        "auditor",
+					Description: "Auditor role",
+					ParentID:    parentId,
+				}, nil)
+			},
+			req: &proto.CreateRoleRequest{
+				Name:        "auditor",
+				Description: "Auditor role",
+				ParentId:    parentId.String(),
+			},
+			expected: &proto.CreateRoleResponse{
+				Data: &proto.Role{
+					Id:          id.String(),
+					Name:        "auditor",
+					Description: "Auditor role",
+					ParentId:    parentId.String(),
+				},
+			},
+			error: false,
+		},
+		{
+			name:   "Invalid parent ID format",
+			before: func() {},
+			req: &proto.CreateRoleRequest{
+				Name:        "auditor",
+				Description: "Auditor role",
+				ParentId:    "invalid-uuid",
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Parent not found",
+			before: func() {
+				roles.EXPECT().Create(ctx, gomock.Any()).Return(nil, errors.ErrRoleNotFound)
+			},
+			req: &proto.CreateRoleRequest{
+				Name:        "auditor",
+				Description: "Auditor role",
+				ParentId:    parentId.String(),
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
 		{
 			name: "Internal error",
 			before: func() {
@@ -381,6 +469,8 @@ func Test_Roles_Update(t *testing.T) {
 	service := NewRoles(roles, log)
 
 	id := uuid.MustParse("10000000-1000-1000-1000-000000000001")
+	parentId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+	permissionId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
 
 	tests := []struct {
 		name     string
@@ -393,7 +483,7 @@ func Test_Roles_Update(t *testing.T) {
 		{
 			name: "Success",
 			before: func() {
-				roles.EXPECT().Update(ctx, gomock.Any()).Return(&models.Role{
+				roles.EXPECT().Update(ctx, gomock.Any(), nil).Return(&models.Role{
 					ID:          id,
 					Name:        models.AdminRoleType,
 					Description: "Admin role updated",
@@ -439,7 +529,7 @@ func Test_Roles_Update(t *testing.T) {
 		{
 			name: "Not Found",
 			before: func() {
-				roles.EXPECT().Update(ctx, gomock.Any()).Return(nil, errors.ErrRecordNotFound)
+				roles.EXPECT().Update(ctx, gomock.Any(), nil).Return(nil, errors.ErrRecordNotFound)
 			},
 			req: &proto.UpdateRoleRequest{
 				Id:          id.String(),
@@ -450,10 +540,124 @@ func Test_Roles_Update(t *testing.T) {
 			code:     codes.NotFound,
 			error:    true,
 		},
+		{
+			name: "Update mask keeps unmasked fields",
+			before: func() {
+				roles.EXPECT().Update(ctx, &models.Role{
+					ID:            id,
+					Description:   "Admin role updated",
+					PermissionIDs: []uuid.UUID{},
+				}, []string{"description"}).Return(&models.Role{
+					ID:          id,
+					Name:        models.AdminRoleType,
+					Description: "Admin role updated",
+					ParentID:    parentId,
+				}, nil)
+			},
+			req: &proto.UpdateRoleRequest{
+				Id:          id.String(),
+				Description: "Admin role updated",
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+			},
+			expected: &proto.UpdateRoleResponse{
+				Data: &proto.Role{
+					Id:          id.String(),
+					Name:        "admin",
+					Description: "Admin role updated",
+					ParentId:    parentId.String(),
+				},
+			},
+			error: false,
+		},
+		{
+			name: "Update mask replaces permissions",
+			before: func() {
+				roles.EXPECT().Update(ctx, &models.Role{
+					ID:            id,
+					PermissionIDs: []uuid.UUID{permissionId},
+				}, []string{"permission_ids"}).Return(&models.Role{
+					ID:          id,
+					Name:        models.AdminRoleType,
+					Description: "Admin role",
+				}, nil)
+			},
+			req: &proto.UpdateRoleRequest{
+				Id:            id.String(),
+				PermissionIds: []string{permissionId.String()},
+				UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"permission_ids"}},
+			},
+			expected: &proto.UpdateRoleResponse{
+				Data: &proto.Role{
+					Id:          id.String(),
+					Name:        "admin",
+					Description: "Admin role",
+				},
+			},
+			error: false,
+		},
+		{
+			name:   "Update mask with unknown path",
+			before: func() {},
+			req: &proto.UpdateRoleRequest{
+				Id:         id.String(),
+				Name:       "admin",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"unknown"}},
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Update mask for missing role",
+			before: func() {
+				roles.EXPECT().Update(ctx, gomock.Any(), []string{"name"}).Return(nil, errors.ErrRecordNotFound)
+			},
+			req: &proto.UpdateRoleRequest{
+				Id:         id.String(),
+				Name:       "admin",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			},
+			expected: nil,
+			code:     codes.NotFound,
+			error:    true,
+		},
+		{
+			name:   "Update mask validates masked fields",
+			before: func() {},
+			req: &proto.UpdateRoleRequest{
+				Id:         id.String(),
+				Name:       "",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Cyclic hierarchy",
+			before: func() {
+				roles.EXPECT().Update(ctx, &models.Role{
+					ID:            id,
+					Name:          "admin",
+					Description:   "Admin role updated",
+					ParentID:      parentId,
+					PermissionIDs: []uuid.UUID{},
+				}, nil).Return(nil, errors.ErrRoleHierarchyCycle)
+			},
+			req: &proto.UpdateRoleRequest{
+				Id:          id.String(),
+				Name:        "admin",
+				Description: "Admin role updated",
+				ParentId:    parentId.String(),
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
 		{
 			name: "Error",
 			before: func() {
-				roles.EXPECT().Update(ctx, gomock.Any()).Return(nil, errors.ErrFailedToUpdateRecord)
+				roles.EXPECT().Update(ctx, gomock.Any(), nil).Return(nil, errors.ErrFailedToUpdateRecord)
 			},
 			req: &proto.UpdateRoleRequest{
 				Id:          id.String(),
@@ -467,7 +671,7 @@ func Test_Roles_Update(t *testing.T) {
 		{
 			name: "Internal error",
 			before: func() {
-				roles.EXPECT().Update(ctx, gomock.Any()).Return(nil, assert.AnError)
+				roles.EXPECT().Update(ctx, gomock.Any(), nil).Return(nil, assert.AnError)
 			},
 			req: &proto.UpdateRoleRequest{
 				Id:          id.String(),
@@ -585,3 +789,137 @@ func Test_Roles_Delete(t *testing.T) {
 		})
 	}
 }
+
+func Test_Roles_SetPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	roles := services.NewMockRoles(ctrl)
+	service := NewRoles(roles, log)
+
+	currentUser := &models.User{ID: uuid.MustParse("10000000-1000-1000-5000-000000000001")}
+	ctx := middlewares.NewContextModifier(context.Background()).WithCurrentUser(currentUser).Context()
+
+	id := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+	permissionId := uuid.MustParse("10000000-1000-1000-3000-000000000003")
+
+	tests := []struct {
+		name     string
+		before   func()
+		ctx      context.Context
+		req      *proto.SetRolePermissionsRequest
+		expected *proto.RolePermissionsResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				roles.EXPECT().SetPermissions(ctx, id, []uuid.UUID{permissionId}, currentUser.ID).Return(&models.Role{
+					ID:            id,
+					Name:          models.ManagerRoleType,
+					Description:   "Manager role",
+					PermissionIDs: []uuid.UUID{permissionId},
+				}, nil)
+			},
+			ctx: ctx,
+			req: &proto.SetRolePermissionsRequest{
+				Id:            id.String(),
+				PermissionIds: []string{permissionId.String()},
+			},
+			expected: &proto.RolePermissionsResponse{
+				Data: &proto.Role{
+					Id:                     id.String(),
+					Name:                   "manager",
+					Description:            "Manager role",
+					PermissionIds:          []string{permissionId.String()},
+					InheritedPermissionIds: []string{},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "Clear permissions",
+			before: func() {
+				roles.EXPECT().SetPermissions(ctx, id, []uuid.UUID{}, currentUser.ID).Return(&models.Role{
+					ID:          id,
+					Name:        models.ManagerRoleType,
+					Description: "Manager role",
+				}, nil)
+			},
+			ctx: ctx,
+			req: &proto.SetRolePermissionsRequest{Id: id.String()},
+			expected: &proto.RolePermissionsResponse{
+				Data: &proto.Role{
+					Id:                     id.String(),
+					Name:                   "manager",
+					Description:            "Manager role",
+					PermissionIds:          []string{},
+					InheritedPermissionIds: []string{},
+				},
+			},
+			error: false,
+		},
+		{
+			name:   "Unauthenticated",
+			before: func() {},
+			ctx:    context.Background(),
+			req: &proto.SetRolePermissionsRequest{
+				Id:            id.String(),
+				PermissionIds: []string{permissionId.String()},
+			},
+			expected: nil,
+			code:     codes.Unauthenticated,
+			error:    true,
+		},
+		{
+			name:   "Invalid permission ID format",
+			before: func() {},
+			ctx:    ctx,
+			req: &proto.SetRolePermissionsRequest{
+				Id:            id.String(),
+				PermissionIds: []string{"invalid-uuid"},
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Not Found",
+			before: func() {
+				roles.EXPECT().SetPermissions(ctx, id, []uuid.UUID{permissionId}, currentUser.ID).Return(nil, errors.ErrRecordNotFound)
+			},
+			ctx: ctx,
+			req: &proto.SetRolePermissionsRequest{
+				Id:            id.String(),
+				PermissionIds: []string{permissionId.String()},
+			},
+			expected: nil,
+			code:     codes.NotFound,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.SetPermissions(tt.ctx, tt.req)
+
+			if tt.error {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tt.code, st.Code())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
@@ -1,31 +1,52 @@
 package services
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"io"
+	"time"
 
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/services"
 	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
 	proto "loki/internal/app/rpcs/proto/sso/v1"
 )
 
+// exportChunkSize is the size of the chunks the export is streamed in
+const exportChunkSize = 64 * 1024
+
 type usersService struct {
 	proto.UnimplementedUserServiceServer
-	users services.Users
-	log   *logger.Logger
+	users          services.Users
+	transfer       services.UserTransfer
+	authenticators services.Authenticators
+	loginSessions  services.LoginSessions
+	log            *logger.Logger
 }
 
-func NewUsers(users services.Users, log *logger.Logger) proto.UserServiceServer {
+func NewUsers(
+	users services.Users,
+	transfer services.UserTransfer,
+	authenticators services.Authenticators,
+	loginSessions services.LoginSessions,
+	log *logger.Logger,
+) proto.UserServiceServer {
 	return &usersService{
-		users: users,
-		log:   log,
+		users:          users,
+		transfer:       transfer,
+		authenticators: authenticators,
+		loginSessions:  loginSessions,
+		log:            log,
 	}
 }
 
@@ -60,6 +81,10 @@ func (p *usersService) List(ctx context.Context, req *proto.PaginatedListRequest
 			PersonalCode:   row.PersonalCode,
 			FirstName:      row.FirstName,
 			LastName:       row.LastName,
+
+			Status:          row.Status,
+			StatusReason:    row.StatusReason,
+			StatusChangedAt: formatStatusChangedAt(row.StatusChangedAt),
 		})
 	}
 
@@ -113,8 +138,13 @@ func (p *usersService) Get(ctx context.Context, req *proto.GetUserRequest) (*pro
 			PersonalCode:   user.PersonalCode,
 			FirstName:      user.FirstName,
 			LastName:       user.LastName,
-			RoleIds:        roleIds,
-			ScopeIds:       scopeIds,
+
+			Status:          user.Status,
+			StatusReason:    user.StatusReason,
+			StatusChangedAt: formatStatusChangedAt(user.StatusChangedAt),
+
+			RoleIds:  roleIds,
+			ScopeIds: scopeIds,
 		},
 	}, nil
 }
@@ -148,12 +178,24 @@ func (p *usersService) Create(ctx context.Context, req *proto.CreateUserRequest)
 			PersonalCode:   user.PersonalCode,
 			FirstName:      user.FirstName,
 			LastName:       user.LastName,
+
+			Status:          user.Status,
+			StatusReason:    user.StatusReason,
+			StatusChangedAt: formatStatusChangedAt(user.StatusChangedAt),
 		},
 	}, nil
 }
 
 func (p *usersService) Update(ctx context.Context, req *proto.UpdateUserRequest) (*proto.UpdateUserResponse, error) {
-	if err := protovalidate.Validate(req); err != nil {
+	fields, err := updateMaskFields(
+		req.GetUpdateMask(),
+		"identity_number", "personal_code", "first_name", "last_name", "role_ids", "scope_ids",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateFields(req, fields); err != nil {
 		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
 	}
 
@@ -191,7 +233,7 @@ func (p *usersService) Update(ctx context.Context, req *proto.UpdateUserRequest)
 		LastName:       req.LastName,
 		RoleIDs:        roleIds,
 		ScopeIDs:       scopeIds,
-	})
+	}, fields)
 	if err != nil {
 		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to update user")
 
@@ -212,6 +254,10 @@ func (p *usersService) Update(ctx context.Context, req *proto.UpdateUserRequest)
 			PersonalCode:   user.PersonalCode,
 			FirstName:      user.FirstName,
 			LastName:       user.LastName,
+
+			Status:          user.Status,
+			StatusReason:    user.StatusReason,
+			StatusChangedAt: formatStatusChangedAt(user.StatusChangedAt),
 		},
 	}, nil
 }
@@ -242,3 +288,452 @@ func (p *usersService) Delete(ctx context.Context, req *proto.DeleteUserRequest)
 
 	return &emptypb.Empty{}, nil
 }
+
+func (p *usersService) AssignRoles(ctx context.Context, req *proto.ChangeUserRolesRequest) (*proto.UserLinksResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	return p.changeLinks(ctx, req.Id, req.RoleIds, "role", p.users.AssignRoles)
+}
+
+func (p *usersService) RevokeRoles(ctx context.Context, req *proto.ChangeUserRolesRequest) (*proto.UserLinksResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	return p.changeLinks(ctx, req.Id, req.RoleIds, "role", p.users.RevokeRoles)
+}
+
+func (p *usersService) SetRoles(ctx context.Context, req *proto.SetUserRolesRequest) (*proto.UserLinksResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	return p.changeLinks(ctx, req.Id, req.RoleIds, "role", p.users.SetRoles)
+}
+
+func (p *usersService) AssignScopes(ctx context.Context, req *proto.ChangeUserScopesRequest) (*proto.UserLinksResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	return p.changeLinks(ctx, req.Id, req.ScopeIds, "scope", p.users.AssignScopes)
+}
+
+func (p *usersService) RevokeScopes(ctx context.Context, req *proto.ChangeUserScopesRequest) (*proto.UserLinksResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	return p.changeLinks(ctx, req.Id, req.ScopeIds, "scope", p.users.RevokeScopes)
+}
+
+func (p *usersService) SetScopes(ctx context.Context, req *proto.SetUserScopesRequest) (*proto.UserLinksResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	return p.changeLinks(ctx, req.Id, req.ScopeIds, "scope", p.users.SetScopes)
+}
+
+func (p *usersService) GetEffectivePermissions(ctx context.Context, req *proto.GetEffectivePermissionsRequest) (*proto.GetEffectivePermissionsResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Invalid UUID format")
+		return nil, status.Error(codes.InvalidArgument, "invalid user id format")
+	}
+
+	permissions, err := p.users.FindEffectivePermissions(ctx, id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to find effective permissions")
+
+		switch {
+		case errors.Is(err, errors.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to find effective permissions")
+		}
+	}
+
+	data := make([]*proto.EffectivePermission, 0, len(permissions))
+	for _, permission := range permissions {
+		data = append(data, formatEffectivePermission(&permission))
+	}
+
+	return &proto.GetEffectivePermissionsResponse{Data: data}, nil
+}
+
+func (p *usersService) Explain(ctx context.Context, req *proto.ExplainRequest) (*proto.ExplainResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Invalid UUID format")
+		return nil, status.Error(codes.InvalidArgument, "invalid user id format")
+	}
+
+	permission, err := p.users.Explain(ctx, id, req.Permission)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to explain permission")
+
+		switch {
+		case errors.Is(err, errors.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to explain permission")
+		}
+	}
+
+	if permission == nil {
+		return &proto.ExplainResponse{Allowed: false}, nil
+	}
+
+	return &proto.ExplainResponse{
+		Allowed: true,
+		Data:    formatEffectivePermission(permission),
+	}, nil
+}
+
+// Import reads the options from the first message and pipes the following chunks into the importer,
+// the result of every record is sent back as soon as its batch is processed
+func (p *usersService) Import(stream proto.UserService_ImportServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	options := req.GetOptions()
+	if err = protovalidate.Validate(req); err != nil || options == nil {
+		return status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	reader, writer := io.Pipe()
+	defer reader.Close()
+
+	go receiveChunks(stream, writer)
+
+	err = p.transfer.Import(stream.Context(), options.Format, reader, options.DryRun, func(result *models.ImportResult) error {
+		response := &proto.ImportUsersResponse{
+			Line:           result.Line,
+			IdentityNumber: result.IdentityNumber,
+			Status:         result.Status,
+			Error:          result.Error,
+		}
+		if result.UserID != uuid.Nil {
+			response.Id = result.UserID.String()
+		}
+
+		return stream.Send(response)
+	})
+	if err != nil {
+		p.log.Error().Err(err).Msg("Failed to import users")
+
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+
+		switch {
+		case errors.Is(err, errors.ErrUnsupportedFormat), errors.Is(err, errors.ErrMalformedRecord):
+			return status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, errors.ErrFailedToFetchResults):
+			return status.Error(codes.Unavailable, err.Error())
+		default:
+			return status.Error(codes.Internal, "failed to import users")
+		}
+	}
+
+	return nil
+}
+
+// Export streams all users in chunks of the requested format
+func (p *usersService) Export(req *proto.ExportUsersRequest, stream proto.UserService_ExportServer) error {
+	if err := protovalidate.Validate(req); err != nil {
+		return status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	writer := bufio.NewWriterSize(&chunkWriter{stream: stream}, exportChunkSize)
+
+	err := p.transfer.Export(stream.Context(), req.Format, writer)
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		p.log.Error().Err(err).Msg("Failed to export users")
+
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+
+		switch {
+		case errors.Is(err, errors.ErrUnsupportedFormat):
+			return status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, errors.ErrFailedToFetchResults):
+			return status.Error(codes.Unavailable, err.Error())
+		default:
+			return status.Error(codes.Internal, "failed to export users")
+		}
+	}
+
+	return nil
+}
+
+// receiveChunks writes the chunks of the import stream to the pipe until the client closes its side
+func receiveChunks(stream proto.UserService_ImportServer, writer *io.PipeWriter) {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			writer.Close()
+			return
+		}
+		if err != nil {
+			writer.CloseWithError(err)
+			return
+		}
+
+		if err = protovalidate.Validate(req); err != nil || req.GetOptions() != nil {
+			writer.CloseWithError(status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error()))
+			return
+		}
+
+		if _, err = writer.Write(req.GetChunk()); err != nil {
+			return
+		}
+	}
+}
+
+// chunkWriter sends every write as a separate export message
+type chunkWriter struct {
+	stream proto.UserService_ExportServer
+}
+
+func (c *chunkWriter) Write(data []byte) (int, error) {
+	if err := c.stream.Send(&proto.ExportUsersResponse{Chunk: bytes.Clone(data)}); err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
+
+// changeLinks applies the role or scope change on behalf of the current user
+func (p *usersService) SetStatus(ctx context.Context, req *proto.SetUserStatusRequest) (*proto.SetUserStatusResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	currentUser, ok := middlewares.CurrentUserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errors.ErrUnauthorized.Error())
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Invalid UUID format")
+		return nil, status.Error(codes.InvalidArgument, "invalid user id format")
+	}
+
+	user, err := p.users.SetStatus(ctx, id, req.Status, req.Reason, currentUser.ID)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to change user status")
+
+		switch {
+		case errors.Is(err, errors.ErrInvalidUserStatus):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, errors.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, errors.ErrFailedToUpdateRecord):
+			return nil, status.Error(codes.Internal, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to change user status")
+		}
+	}
+
+	return &proto.SetUserStatusResponse{
+		Data: &proto.User{
+			Id:             user.ID.String(),
+			IdentityNumber: user.IdentityNumber,
+			PersonalCode:   user.PersonalCode,
+			FirstName:      user.FirstName,
+			LastName:       user.LastName,
+
+			Status:          user.Status,
+			StatusReason:    user.StatusReason,
+			StatusChangedAt: formatStatusChangedAt(user.StatusChangedAt),
+		},
+	}, nil
+}
+
+func (p *usersService) ListAuthenticators(ctx context.Context, req *proto.ListAuthenticatorsRequest) (*proto.ListAuthenticatorsResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Invalid UUID format")
+		return nil, status.Error(codes.InvalidArgument, "invalid user id format")
+	}
+
+	methods, err := p.authenticators.AllowedMethods(ctx, id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to fetch allowed authentication methods")
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	rows, err := p.authenticators.List(ctx, id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to fetch user authenticators")
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	data := make([]*proto.Authenticator, 0, len(rows))
+	for _, row := range rows {
+		data = append(data, &proto.Authenticator{
+			Id:          row.ID.String(),
+			Method:      row.Method,
+			Identifier:  row.Identifier,
+			Metadata:    row.Metadata,
+			FirstUsedAt: timestamppb.New(row.FirstUsedAt),
+			LastUsedAt:  timestamppb.New(row.LastUsedAt),
+		})
+	}
+
+	return &proto.ListAuthenticatorsResponse{Data: data, AllowedMethods: methods}, nil
+}
+
+func (p *usersService) ListSessions(ctx context.Context, req *proto.ListSessionsRequest) (*proto.ListSessionsResponse, error) {
+	if err := protovalidate.Validate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.ErrInvalidArguments.Error())
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Invalid UUID format")
+		return nil, status.Error(codes.InvalidArgument, "invalid user id format")
+	}
+
+	rows, err := p.loginSessions.List(ctx, id)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", req.Id).Msg("Failed to fetch login sessions")
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	data := make([]*proto.LoginSession, 0, len(rows))
+	for _, row := range rows {
+		session := &proto.LoginSession{
+			Id:              row.ID.String(),
+			Provider:        row.Provider,
+			IpAddress:       row.IPAddress,
+			UserAgent:       row.UserAgent,
+			Status:          row.Status(),
+			CreatedAt:       timestamppb.New(row.CreatedAt),
+			LastRefreshedAt: timestamppb.New(row.LastRefreshedAt),
+		}
+		if !row.RevokedAt.IsZero() {
+			session.RevokedAt = timestamppb.New(row.RevokedAt)
+		}
+
+		data = append(data, session)
+	}
+
+	return &proto.ListSessionsResponse{Data: data}, nil
+}
+
+func (p *usersService) changeLinks(
+	ctx context.Context,
+	rawId string,
+	rawLinkIds []string,
+	kind string,
+	change func(ctx context.Context, id uuid.UUID, linkIds []uuid.UUID, actorId uuid.UUID) (*models.User, error),
+) (*proto.UserLinksResponse, error) {
+	currentUser, ok := middlewares.CurrentUserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errors.ErrUnauthorized.Error())
+	}
+
+	id, err := uuid.Parse(rawId)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", rawId).Msg("Invalid UUID format")
+		return nil, status.Error(codes.InvalidArgument, "invalid user id format")
+	}
+
+	linkIds, err := parseIds(rawLinkIds)
+	if err != nil {
+		p.log.Error().Err(err).Msg("Invalid UUID format")
+		return nil, status.Error(codes.InvalidArgument, "invalid "+kind+" id format")
+	}
+
+	user, err := change(ctx, id, linkIds, currentUser.ID)
+	if err != nil {
+		p.log.Error().Err(err).Str("id", rawId).Msg("Failed to change user " + kind + "s")
+
+		switch {
+		case errors.Is(err, errors.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, errors.ErrFailedToUpdateRecord):
+			return nil, status.Error(codes.Internal, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to change user "+kind+"s")
+		}
+	}
+
+	return &proto.UserLinksResponse{
+		Data: &proto.User{
+			Id:             user.ID.String(),
+			IdentityNumber: user.IdentityNumber,
+			PersonalCode:   user.PersonalCode,
+			FirstName:      user.FirstName,
+			LastName:       user.LastName,
+
+			Status:          user.Status,
+			StatusReason:    user.StatusReason,
+			StatusChangedAt: formatStatusChangedAt(user.StatusChangedAt),
+
+			RoleIds:  formatIds(user.RoleIDs),
+			ScopeIds: formatIds(user.ScopeIDs),
+		},
+	}, nil
+}
+
+func formatEffectivePermission(permission *models.EffectivePermission) *proto.EffectivePermission {
+	paths := make([]*proto.PermissionPath, 0, len(permission.Paths))
+	for _, path := range permission.Paths {
+		roles := make([]*proto.PathRole, 0, len(path.Roles))
+		for _, role := range path.Roles {
+			roles = append(roles, &proto.PathRole{Id: role.ID.String(), Name: role.Name})
+		}
+
+		var expiresAt *timestamppb.Timestamp
+		if !path.ExpiresAt.IsZero() {
+			expiresAt = timestamppb.New(path.ExpiresAt)
+		}
+
+		var organisationId string
+		if path.OrganisationID != uuid.Nil {
+			organisationId = path.OrganisationID.String()
+		}
+
+		paths = append(paths, &proto.PermissionPath{Roles: roles, ExpiresAt: expiresAt, OrganisationId: organisationId})
+	}
+
+	return &proto.EffectivePermission{
+		Id:    permission.ID.String(),
+		Name:  permission.Name,
+		Paths: paths,
+	}
+}
+
+// formatStatusChangedAt leaves the timestamp empty for users whose status has never been changed
+func formatStatusChangedAt(changedAt time.Time) *timestamppb.Timestamp {
+	if changedAt.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(changedAt)
+}
@@ -2,14 +2,19 @@ package services
 
 import (
 	"context"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"loki/internal/app/errors"
 	"loki/internal/app/models"
@@ -17,6 +22,7 @@ import (
 	"loki/internal/app/services"
 	"loki/internal/config"
 	"loki/internal/config/logger"
+	"loki/internal/config/middlewares"
 )
 
 func Test_Users_List(t *testing.T) {
@@ -32,7 +38,7 @@ func Test_Users_List(t *testing.T) {
 
 	ctx := context.Background()
 	users := services.NewMockUsers(ctrl)
-	service := NewUsers(users, log)
+	service := NewUsers(users, services.NewMockUserTransfer(ctrl), services.NewMockAuthenticators(ctrl), services.NewMockLoginSessions(ctrl), log)
 
 	tests := []struct {
 		name     string
@@ -170,7 +176,7 @@ func Test_Users_Get(t *testing.T) {
 
 	ctx := context.Background()
 	users := services.NewMockUsers(ctrl)
-	service := NewUsers(users, log)
+	service := NewUsers(users, services.NewMockUserTransfer(ctrl), services.NewMockAuthenticators(ctrl), services.NewMockLoginSessions(ctrl), log)
 
 	id := uuid.MustParse("10000000-1000-1000-1234-000000000001")
 	roleIds := []uuid.UUID{
@@ -285,7 +291,7 @@ func Test_Users_Create(t *testing.T) {
 
 	ctx := context.Background()
 	users := services.NewMockUsers(ctrl)
-	service := NewUsers(users, log)
+	service := NewUsers(users, services.NewMockUserTransfer(ctrl), services.NewMockAuthenticators(ctrl), services.NewMockLoginSessions(ctrl), log)
 
 	id := uuid.MustParse("10000000-1000-1000-1234-000000000001")
 
@@ -405,9 +411,10 @@ func Test_Users_Update(t *testing.T) {
 
 	ctx := context.Background()
 	users := services.NewMockUsers(ctrl)
-	service := NewUsers(users, log)
+	service := NewUsers(users, services.NewMockUserTransfer(ctrl), services.NewMockAuthenticators(ctrl), services.NewMockLoginSessions(ctrl), log)
 
 	id := uuid.MustParse("10000000-1000-1000-1234-000000000001")
+	roleId := uuid.MustParse("10000000-1000-1000-1000-000000000003")
 
 	tests := []struct {
 		name     string
@@ -420,7 +427,7 @@ func Test_Users_Update(t *testing.T) {
 		{
 			name: "Success",
 			before: func() {
-				users.EXPECT().Update(ctx, gomock.Any()).Return(&models.User{
+				users.EXPECT().Update(ctx, gomock.Any(), nil).Return(&models.User{
 					ID:             id,
 					IdentityNumber: "PNOEE-60001017869",
 					PersonalCode:   "60001017869",
@@ -446,6 +453,92 @@ func Test_Users_Update(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "Update mask keeps unmasked fields",
+			before: func() {
+				users.EXPECT().Update(ctx, &models.User{
+					ID:        id,
+					FirstName: "JANE",
+					RoleIDs:   []uuid.UUID{},
+					ScopeIDs:  []uuid.UUID{},
+				}, []string{"first_name"}).Return(&models.User{
+					ID:             id,
+					IdentityNumber: "PNOEE-60001017869",
+					PersonalCode:   "60001017869",
+					FirstName:      "JANE",
+					LastName:       "DOE",
+				}, nil)
+			},
+			req: &proto.UpdateUserRequest{
+				Id:         id.String(),
+				FirstName:  "JANE",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"first_name"}},
+			},
+			expected: &proto.UpdateUserResponse{
+				Data: &proto.User{
+					Id:             id.String(),
+					IdentityNumber: "PNOEE-60001017869",
+					PersonalCode:   "60001017869",
+					FirstName:      "JANE",
+					LastName:       "DOE",
+				},
+			},
+			error: false,
+		},
+		{
+			name: "Update mask replaces roles only",
+			before: func() {
+				users.EXPECT().Update(ctx, &models.User{
+					ID:       id,
+					RoleIDs:  []uuid.UUID{roleId},
+					ScopeIDs: []uuid.UUID{},
+				}, []string{"role_ids"}).Return(&models.User{
+					ID:             id,
+					IdentityNumber: "PNOEE-60001017869",
+					PersonalCode:   "60001017869",
+					FirstName:      "JOHN",
+					LastName:       "DOE",
+				}, nil)
+			},
+			req: &proto.UpdateUserRequest{
+				Id:         id.String(),
+				RoleIds:    []string{roleId.String()},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role_ids"}},
+			},
+			expected: &proto.UpdateUserResponse{
+				Data: &proto.User{
+					Id:             id.String(),
+					IdentityNumber: "PNOEE-60001017869",
+					PersonalCode:   "60001017869",
+					FirstName:      "JOHN",
+					LastName:       "DOE",
+				},
+			},
+			error: false,
+		},
+		{
+			name:   "Update mask validates masked fields",
+			before: func() {},
+			req: &proto.UpdateUserRequest{
+				Id:         id.String(),
+				FirstName:  "",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"first_name"}},
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name:   "Update mask with unknown path",
+			before: func() {},
+			req: &proto.UpdateUserRequest{
+				Id:         id.String(),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"unknown"}},
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
 		{
 			name:   "Invalid ID format",
 			before: func() {},
@@ -463,7 +556,7 @@ func Test_Users_Update(t *testing.T) {
 		{
 			name: "Not Found",
 			before: func() {
-				users.EXPECT().Update(ctx, gomock.Any()).Return(nil, errors.ErrRecordNotFound)
+				users.EXPECT().Update(ctx, gomock.Any(), nil).Return(nil, errors.ErrRecordNotFound)
 			},
 			req: &proto.UpdateUserRequest{
 				Id:             id.String(),
@@ -479,7 +572,7 @@ func Test_Users_Update(t *testing.T) {
 		{
 			name: "Error",
 			before: func() {
-				users.EXPECT().Update(ctx, gomock.Any()).Return(nil, errors.ErrFailedToUpdateRecord)
+				users.EXPECT().Update(ctx, gomock.Any(), nil).Return(nil, errors.ErrFailedToUpdateRecord)
 			},
 			req: &proto.UpdateUserRequest{
 				Id:             id.String(),
@@ -495,7 +588,7 @@ func Test_Users_Update(t *testing.T) {
 		{
 			name: "Internal error",
 			before: func() {
-				users.EXPECT().Update(ctx, gomock.Any()).Return(nil, assert.AnError)
+				users.EXPECT().Update(ctx, gomock.Any(), nil).Return(nil, assert.AnError)
 			},
 			req: &proto.UpdateUserRequest{
 				Id:             id.String(),
@@ -540,7 +633,7 @@ func Test_Users_Delete(t *testing.T) {
 
 	ctx := context.Background()
 	users := services.NewMockUsers(ctrl)
-	service := NewUsers(users, log)
+	service := NewUsers(users, services.NewMockUserTransfer(ctrl), services.NewMockAuthenticators(ctrl), services.NewMockLoginSessions(ctrl), log)
 
 	id := uuid.MustParse("10000000-1000-1000-1234-000000000001")
 
@@ -615,3 +708,815 @@ func Test_Users_Delete(t *testing.T) {
 		})
 	}
 }
+
+func Test_Users_SetStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	users := services.NewMockUsers(ctrl)
+	service := NewUsers(users, services.NewMockUserTransfer(ctrl), services.NewMockAuthenticators(ctrl), services.NewMockLoginSessions(ctrl), log)
+
+	currentUser := &models.User{ID: uuid.MustParse("10000000-1000-1000-5000-000000000001")}
+	ctx := middlewares.NewContextModifier(context.Background()).WithCurrentUser(currentUser).Context()
+
+	id := uuid.MustParse("10000000-1000-1000-1234-000000000001")
+	changedAt := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		before   func()
+		req      *proto.SetUserStatusRequest
+		expected *proto.SetUserStatusResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				users.EXPECT().SetStatus(ctx, id, models.UserSuspended, "Fraud investigation", currentUser.ID).Return(&models.User{
+					ID:              id,
+					IdentityNumber:  "PNOEE-60001017869",
+					PersonalCode:    "60001017869",
+					FirstName:       "JOHN",
+					LastName:        "DOE",
+					Status:          models.UserSuspended,
+					StatusReason:    "Fraud investigation",
+					StatusChangedAt: changedAt,
+				}, nil)
+			},
+			req: &proto.SetUserStatusRequest{
+				Id:     id.String(),
+				Status: models.UserSuspended,
+				Reason: "Fraud investigation",
+			},
+			expected: &proto.SetUserStatusResponse{
+				Data: &proto.User{
+					Id:              id.String(),
+					IdentityNumber:  "PNOEE-60001017869",
+					PersonalCode:    "60001017869",
+					FirstName:       "JOHN",
+					LastName:        "DOE",
+					Status:          models.UserSuspended,
+					StatusReason:    "Fraud investigation",
+					StatusChangedAt: timestamppb.New(changedAt),
+				},
+			},
+			error: false,
+		},
+		{
+			name:   "Validation error",
+			before: func() {},
+			req: &proto.SetUserStatusRequest{
+				Id:     id.String(),
+				Status: "archived",
+				Reason: "Fraud investigation",
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Not Found",
+			before: func() {
+				users.EXPECT().SetStatus(ctx, id, models.UserLocked, "Too many failed attempts", currentUser.ID).Return(nil, errors.ErrRecordNotFound)
+			},
+			req: &proto.SetUserStatusRequest{
+				Id:     id.String(),
+				Status: models.UserLocked,
+				Reason: "Too many failed attempts",
+			},
+			expected: nil,
+			code:     codes.NotFound,
+			error:    true,
+		},
+		{
+			name: "Error",
+			before: func() {
+				users.EXPECT().SetStatus(ctx, id, models.UserActive, "Investigation closed", currentUser.ID).Return(nil, errors.ErrFailedToUpdateRecord)
+			},
+			req: &proto.SetUserStatusRequest{
+				Id:     id.String(),
+				Status: models.UserActive,
+				Reason: "Investigation closed",
+			},
+			expected: nil,
+			code:     codes.Internal,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.SetStatus(ctx, tt.req)
+
+			if tt.error {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tt.code, st.Code())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func Test_Users_ListAuthenticators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	authenticators := services.NewMockAuthenticators(ctrl)
+	service := NewUsers(services.NewMockUsers(ctrl), services.NewMockUserTransfer(ctrl), authenticators, services.NewMockLoginSessions(ctrl), log)
+
+	id := uuid.MustParse("10000000-1000-1000-1234-000000000001")
+	authenticatorId := uuid.MustParse("10000000-1000-1000-4000-000000000001")
+	usedAt := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		before   func()
+		req      *proto.ListAuthenticatorsRequest
+		expected *proto.ListAuthenticatorsResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				authenticators.EXPECT().AllowedMethods(ctx, id).Return([]string{models.AuthMethodMobileId}, nil)
+				authenticators.EXPECT().List(ctx, id).Return([]models.Authenticator{
+					{
+						ID:          authenticatorId,
+						UserID:      id,
+						Method:      models.AuthMethodMobileId,
+						Identifier:  "+37268000769",
+						Metadata:    map[string]string{"identity_number": "PNOEE-60001017869"},
+						FirstUsedAt: usedAt,
+						LastUsedAt:  usedAt,
+					},
+				}, nil)
+			},
+			req: &proto.ListAuthenticatorsRequest{Id: id.String()},
+			expected: &proto.ListAuthenticatorsResponse{
+				Data: []*proto.Authenticator{
+					{
+						Id:          authenticatorId.String(),
+						Method:      models.AuthMethodMobileId,
+						Identifier:  "+37268000769",
+						Metadata:    map[string]string{"identity_number": "PNOEE-60001017869"},
+						FirstUsedAt: timestamppb.New(usedAt),
+						LastUsedAt:  timestamppb.New(usedAt),
+					},
+				},
+				AllowedMethods: []string{models.AuthMethodMobileId},
+			},
+			error: false,
+		},
+		{
+			name:     "Validation error",
+			before:   func() {},
+			req:      &proto.ListAuthenticatorsRequest{Id: "invalid"},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Error",
+			before: func() {
+				authenticators.EXPECT().AllowedMethods(ctx, id).Return(nil, errors.ErrFailedToFetchResults)
+			},
+			req:      &proto.ListAuthenticatorsRequest{Id: id.String()},
+			expected: nil,
+			code:     codes.Unavailable,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.ListAuthenticators(ctx, tt.req)
+
+			if tt.error {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tt.code, st.Code())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func Test_Users_ListSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	loginSessions := services.NewMockLoginSessions(ctrl)
+	service := NewUsers(services.NewMockUsers(ctrl), services.NewMockUserTransfer(ctrl), services.NewMockAuthenticators(ctrl), loginSessions, log)
+
+	id := uuid.MustParse("10000000-1000-1000-1234-000000000001")
+	sessionId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	createdAt := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+	revokedAt := time.Date(2026, 10, 20, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		before   func()
+		req      *proto.ListSessionsRequest
+		expected *proto.ListSessionsResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				loginSessions.EXPECT().List(ctx, id).Return([]models.LoginSession{
+					{
+						ID:              sessionId,
+						UserID:          id,
+						Provider:        models.AuthMethodSmartId,
+						IPAddress:       "192.0.2.1",
+						UserAgent:       "Mozilla/5.0",
+						CreatedAt:       createdAt,
+						LastRefreshedAt: createdAt,
+						RevokedAt:       revokedAt,
+					},
+				}, nil)
+			},
+			req: &proto.ListSessionsRequest{Id: id.String()},
+			expected: &proto.ListSessionsResponse{
+				Data: []*proto.LoginSession{
+					{
+						Id:              sessionId.String(),
+						Provider:        models.AuthMethodSmartId,
+						IpAddress:       "192.0.2.1",
+						UserAgent:       "Mozilla/5.0",
+						Status:          models.LoginSessionRevoked,
+						CreatedAt:       timestamppb.New(createdAt),
+						LastRefreshedAt: timestamppb.New(createdAt),
+						RevokedAt:       timestamppb.New(revokedAt),
+					},
+				},
+			},
+			error: false,
+		},
+		{
+			name:     "Validation error",
+			before:   func() {},
+			req:      &proto.ListSessionsRequest{Id: "invalid"},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Error",
+			before: func() {
+				loginSessions.EXPECT().List(ctx, id).Return(nil, errors.ErrFailedToFetchResults)
+			},
+			req:      &proto.ListSessionsRequest{Id: id.String()},
+			expected: nil,
+			code:     codes.Unavailable,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.ListSessions(ctx, tt.req)
+
+			if tt.error {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tt.code, st.Code())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func Test_Users_AssignRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	users := services.NewMockUsers(ctrl)
+	service := NewUsers(users, services.NewMockUserTransfer(ctrl), services.NewMockAuthenticators(ctrl), services.NewMockLoginSessions(ctrl), log)
+
+	currentUser := &models.User{ID: uuid.MustParse("10000000-1000-1000-5000-000000000001")}
+	ctx := middlewares.NewContextModifier(context.Background()).WithCurrentUser(currentUser).Context()
+
+	id := uuid.MustParse("10000000-1000-1000-1234-000000000001")
+	roleId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+
+	tests := []struct {
+		name     string
+		before   func()
+		req      *proto.ChangeUserRolesRequest
+		expected *proto.UserLinksResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				users.EXPECT().AssignRoles(ctx, id, []uuid.UUID{roleId}, currentUser.ID).Return(&models.User{
+					ID:             id,
+					IdentityNumber: "PNOEE-60001017869",
+					PersonalCode:   "60001017869",
+					FirstName:      "JOHN",
+					LastName:       "DOE",
+					RoleIDs:        []uuid.UUID{roleId},
+				}, nil)
+			},
+			req: &proto.ChangeUserRolesRequest{
+				Id:      id.String(),
+				RoleIds: []string{roleId.String()},
+			},
+			expected: &proto.UserLinksResponse{
+				Data: &proto.User{
+					Id:             id.String(),
+					IdentityNumber: "PNOEE-60001017869",
+					PersonalCode:   "60001017869",
+					FirstName:      "JOHN",
+					LastName:       "DOE",
+					RoleIds:        []string{roleId.String()},
+					ScopeIds:       []string{},
+				},
+			},
+			error: false,
+		},
+		{
+			name:   "Validation error",
+			before: func() {},
+			req: &proto.ChangeUserRolesRequest{
+				Id: id.String(),
+			},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Not Found",
+			before: func() {
+				users.EXPECT().AssignRoles(ctx, id, []uuid.UUID{roleId}, currentUser.ID).Return(nil, errors.ErrRecordNotFound)
+			},
+			req: &proto.ChangeUserRolesRequest{
+				Id:      id.String(),
+				RoleIds: []string{roleId.String()},
+			},
+			expected: nil,
+			code:     codes.NotFound,
+			error:    true,
+		},
+		{
+			name: "Error",
+			before: func() {
+				users.EXPECT().AssignRoles(ctx, id, []uuid.UUID{roleId}, currentUser.ID).Return(nil, errors.ErrFailedToUpdateRecord)
+			},
+			req: &proto.ChangeUserRolesRequest{
+				Id:      id.String(),
+				RoleIds: []string{roleId.String()},
+			},
+			expected: nil,
+			code:     codes.Internal,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.AssignRoles(ctx, tt.req)
+
+			if tt.error {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tt.code, st.Code())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func Test_Users_Explain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	users := services.NewMockUsers(ctrl)
+	service := NewUsers(users, services.NewMockUserTransfer(ctrl), services.NewMockAuthenticators(ctrl), services.NewMockLoginSessions(ctrl), log)
+
+	id := uuid.MustParse("10000000-1000-1000-1234-000000000001")
+	permissionId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	managerId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+	userId := uuid.MustParse("10000000-1000-1000-1000-000000000003")
+	organisationId := uuid.MustParse("10000000-1000-1000-6000-000000000001")
+	expiresAt := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		before   func()
+		req      *proto.ExplainRequest
+		expected *proto.ExplainResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Granted through inheritance",
+			before: func() {
+				users.EXPECT().Explain(ctx, id, "read:self").Return(&models.EffectivePermission{
+					Permission: models.Permission{ID: permissionId, Name: "read:self"},
+					Paths: []models.PermissionPath{
+						{
+							Roles:     []models.Role{{ID: managerId, Name: "manager"}, {ID: userId, Name: "user"}},
+							ExpiresAt: expiresAt,
+						},
+					},
+				}, nil)
+			},
+			req: &proto.ExplainRequest{Id: id.String(), Permission: "read:self"},
+			expected: &proto.ExplainResponse{
+				Allowed: true,
+				Data: &proto.EffectivePermission{
+					Id:   permissionId.String(),
+					Name: "read:self",
+					Paths: []*proto.PermissionPath{
+						{
+							Roles: []*proto.PathRole{
+								{Id: managerId.String(), Name: "manager"},
+								{Id: userId.String(), Name: "user"},
+							},
+							ExpiresAt: timestamppb.New(expiresAt),
+						},
+					},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "Granted within an organisation",
+			before: func() {
+				users.EXPECT().Explain(ctx, id, "read:users").Return(&models.EffectivePermission{
+					Permission: models.Permission{ID: permissionId, Name: "read:users"},
+					Paths: []models.PermissionPath{
+						{
+							Roles:          []models.Role{{ID: managerId, Name: "manager"}},
+							OrganisationID: organisationId,
+						},
+					},
+				}, nil)
+			},
+			req: &proto.ExplainRequest{Id: id.String(), Permission: "read:users"},
+			expected: &proto.ExplainResponse{
+				Allowed: true,
+				Data: &proto.EffectivePermission{
+					Id:   permissionId.String(),
+					Name: "read:users",
+					Paths: []*proto.PermissionPath{
+						{
+							Roles:          []*proto.PathRole{{Id: managerId.String(), Name: "manager"}},
+							OrganisationId: organisationId.String(),
+						},
+					},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "Not granted",
+			before: func() {
+				users.EXPECT().Explain(ctx, id, "write:users").Return(nil, nil)
+			},
+			req:      &proto.ExplainRequest{Id: id.String(), Permission: "write:users"},
+			expected: &proto.ExplainResponse{Allowed: false},
+			error:    false,
+		},
+		{
+			name:     "Validation error",
+			before:   func() {},
+			req:      &proto.ExplainRequest{Id: id.String()},
+			expected: nil,
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Not Found",
+			before: func() {
+				users.EXPECT().Explain(ctx, id, "read:self").Return(nil, errors.ErrRecordNotFound)
+			},
+			req:      &proto.ExplainRequest{Id: id.String(), Permission: "read:self"},
+			expected: nil,
+			code:     codes.NotFound,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Explain(ctx, tt.req)
+
+			if tt.error {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tt.code, st.Code())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+// importStream feeds the requests to the Import handler and collects its responses
+type importStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  []*proto.ImportUsersRequest
+	responses []*proto.ImportUsersResponse
+}
+
+func (s *importStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *importStream) Recv() (*proto.ImportUsersRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return req, nil
+}
+
+func (s *importStream) Send(response *proto.ImportUsersResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+// exportStream collects the chunks sent by the Export handler
+type exportStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks []*proto.ExportUsersResponse
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(response *proto.ExportUsersResponse) error {
+	s.chunks = append(s.chunks, response)
+	return nil
+}
+
+func Test_Users_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	transfer := services.NewMockUserTransfer(ctrl)
+	service := NewUsers(services.NewMockUsers(ctrl), transfer, services.NewMockAuthenticators(ctrl), services.NewMockLoginSessions(ctrl), log)
+
+	id := uuid.MustParse("10000000-1000-1000-1234-000000000001")
+	input := "identity_number,first_name,last_name\nPNOEE-30303039914,John,Doe\n"
+
+	options := func(format string, dryRun bool) *proto.ImportUsersRequest {
+		return &proto.ImportUsersRequest{
+			Payload: &proto.ImportUsersRequest_Options{Options: &proto.ImportUsersOptions{Format: format, DryRun: dryRun}},
+		}
+	}
+	chunk := func(data string) *proto.ImportUsersRequest {
+		return &proto.ImportUsersRequest{Payload: &proto.ImportUsersRequest_Chunk{Chunk: []byte(data)}}
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		requests []*proto.ImportUsersRequest
+		expected []*proto.ImportUsersResponse
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				transfer.EXPECT().Import(ctx, models.UserRecordFormatCSV, gomock.Any(), false, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, r io.Reader, _ bool, report services.ImportReporter) error {
+						data, err := io.ReadAll(r)
+						assert.NoError(t, err)
+						assert.Equal(t, input, string(data))
+
+						return report(&models.ImportResult{
+							Line:           2,
+							IdentityNumber: "PNOEE-30303039914",
+							Status:         models.ImportStatusCreated,
+							UserID:         id,
+						})
+					})
+			},
+			requests: []*proto.ImportUsersRequest{options("csv", false), chunk(input[:20]), chunk(input[20:])},
+			expected: []*proto.ImportUsersResponse{
+				{Line: 2, IdentityNumber: "PNOEE-30303039914", Status: models.ImportStatusCreated, Id: id.String()},
+			},
+			error: false,
+		},
+		{
+			name: "Dry run",
+			before: func() {
+				transfer.EXPECT().Import(ctx, models.UserRecordFormatJSONL, gomock.Any(), true, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ io.Reader, _ bool, report services.ImportReporter) error {
+						return report(&models.ImportResult{Line: 1, Status: models.ImportStatusInvalid, Error: "malformed record"})
+					})
+			},
+			requests: []*proto.ImportUsersRequest{options("jsonl", true), chunk("{")},
+			expected: []*proto.ImportUsersResponse{
+				{Line: 1, Status: models.ImportStatusInvalid, Error: "malformed record"},
+			},
+			error: false,
+		},
+		{
+			name:     "Missing options",
+			before:   func() {},
+			requests: []*proto.ImportUsersRequest{chunk(input)},
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name:     "Validation error",
+			before:   func() {},
+			requests: []*proto.ImportUsersRequest{options("xml", false)},
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Malformed input",
+			before: func() {
+				transfer.EXPECT().Import(ctx, models.UserRecordFormatCSV, gomock.Any(), false, gomock.Any()).
+					Return(errors.ErrMalformedRecord)
+			},
+			requests: []*proto.ImportUsersRequest{options("csv", false)},
+			code:     codes.InvalidArgument,
+			error:    true,
+		},
+		{
+			name: "Unavailable",
+			before: func() {
+				transfer.EXPECT().Import(ctx, models.UserRecordFormatCSV, gomock.Any(), false, gomock.Any()).
+					Return(errors.ErrFailedToFetchResults)
+			},
+			requests: []*proto.ImportUsersRequest{options("csv", false), chunk(input)},
+			code:     codes.Unavailable,
+			error:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			stream := &importStream{ctx: ctx, requests: tt.requests}
+			err := service.Import(stream)
+
+			if tt.error {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tt.code, st.Code())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, stream.responses)
+			}
+		})
+	}
+}
+
+func Test_Users_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	transfer := services.NewMockUserTransfer(ctrl)
+	service := NewUsers(services.NewMockUsers(ctrl), transfer, services.NewMockAuthenticators(ctrl), services.NewMockLoginSessions(ctrl), log)
+
+	output := "identity_number,personal_code,first_name,last_name,roles,scopes\n"
+
+	tests := []struct {
+		name     string
+		before   func()
+		req      *proto.ExportUsersRequest
+		expected string
+		code     codes.Code
+		error    bool
+	}{
+		{
+			name: "Success",
+			before: func() {
+				transfer.EXPECT().Export(ctx, models.UserRecordFormatCSV, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, w io.Writer) error {
+						_, err := io.WriteString(w, output)
+						return err
+					})
+			},
+			req:      &proto.ExportUsersRequest{Format: "csv"},
+			expected: output,
+			error:    false,
+		},
+		{
+			name:   "Validation error",
+			before: func() {},
+			req:    &proto.ExportUsersRequest{Format: "xml"},
+			code:   codes.InvalidArgument,
+			error:  true,
+		},
+		{
+			name: "Unavailable",
+			before: func() {
+				transfer.EXPECT().Export(ctx, models.UserRecordFormatJSONL, gomock.Any()).Return(errors.ErrFailedToFetchResults)
+			},
+			req:   &proto.ExportUsersRequest{Format: "jsonl"},
+			code:  codes.Unavailable,
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			stream := &exportStream{ctx: ctx}
+			err := service.Export(tt.req, stream)
+
+			if tt.error {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tt.code, st.Code())
+			} else {
+				assert.NoError(t, err)
+
+				var result []byte
+				for _, chunk := range stream.chunks {
+					result = append(result, chunk.Chunk...)
+				}
+				assert.Equal(t, tt.expected, string(result))
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package serializers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuthenticatorSerializer struct {
+	ID          uuid.UUID         `json:"id"`
+	Method      string            `json:"method"`
+	Identifier  string            `json:"identifier"`
+	Metadata    map[string]string `json:"metadata"`
+	FirstUsedAt time.Time         `json:"first_used_at"`
+	LastUsedAt  time.Time         `json:"last_used_at"`
+}
+
+type AuthenticatorsSerializer struct {
+	Data           []AuthenticatorSerializer `json:"data"`
+	AllowedMethods []string                  `json:"allowed_methods"`
+}
@@ -0,0 +1,17 @@
+package serializers
+
+import "github.com/google/uuid"
+
+// ConfirmationSerializer holds the signature and signing certificate once the status is SUCCESS
+type ConfirmationSerializer struct {
+	ID          uuid.UUID `json:"id"`
+	Method      string    `json:"method"`
+	Code        string    `json:"code,omitempty"`
+	Status      string    `json:"status"`
+	Error       string    `json:"error,omitempty"`
+	Hash        string    `json:"hash"`
+	HashType    string    `json:"hash_type"`
+	Signature   string    `json:"signature,omitempty"`
+	Algorithm   string    `json:"algorithm,omitempty"`
+	Certificate string    `json:"certificate,omitempty"`
+}
@@ -1,5 +1,6 @@
 package serializers
 
 type ErrorSerializer struct {
-	Error string `json:"error"`
+	Error  string            `json:"error"`
+	Fields map[string]string `json:"fields,omitempty"`
 }
@@ -0,0 +1,21 @@
+package serializers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LoginSessionSerializer struct {
+	ID              uuid.UUID `json:"id"`
+	Provider        string    `json:"provider"`
+	IPAddress       string    `json:"ip_address"`
+	UserAgent       string    `json:"user_agent"`
+	Status          string    `json:"status"`
+	CreatedAt       time.Time `json:"created_at"`
+	LastRefreshedAt time.Time `json:"last_refreshed_at"`
+}
+
+type LoginSessionsSerializer struct {
+	Data []LoginSessionSerializer `json:"data"`
+}
@@ -0,0 +1,9 @@
+package serializers
+
+type ProfileSerializer struct {
+	Email         string         `json:"email,omitempty"`
+	EmailVerified bool           `json:"email_verified"`
+	PhoneNumber   string         `json:"phone_number,omitempty"`
+	Locale        string         `json:"locale,omitempty"`
+	Attributes    map[string]any `json:"attributes"`
+}
@@ -11,6 +11,12 @@ type TokensSerializer struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// StepUpSerializer holds the elevated access token, it expires in the given number of seconds
+type StepUpSerializer struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
 type TokenSerializer struct {
 	ID        uuid.UUID `json:"id"`
 	UserId    uuid.UUID `json:"user_id"`
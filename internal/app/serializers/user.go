@@ -14,4 +14,6 @@ type UserSerializer struct {
 
 	RoleIDs  []uuid.UUID `json:"role_ids,omitempty"`
 	ScopeIDs []uuid.UUID `json:"scope_ids,omitempty"`
+
+	Profile *ProfileSerializer `json:"profile,omitempty"`
 }
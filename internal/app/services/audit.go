@@ -0,0 +1,295 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/trace"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/app/repositories/db"
+	"loki/internal/config/logger"
+	"loki/pkg/jwt"
+)
+
+// AuditVerifyBatchSize is the number of events loaded at once when the live chain is verified
+const AuditVerifyBatchSize = 1000
+
+type Audit interface {
+	List(ctx context.Context, filter models.AuditFilter, pagination *Pagination) ([]models.AuditEvent, uint64, error)
+	Record(ctx context.Context, event *models.AuditEvent)
+
+	Export(ctx context.Context, sequence int64, limit uint64) ([]models.AuditEvent, []models.AuditCheckpoint, error)
+	Checkpoint(ctx context.Context) (*models.AuditCheckpoint, error)
+	Verify(ctx context.Context) (*models.AuditVerification, error)
+	VerifyRecords(prevHash string, events []models.AuditEvent, checkpoints []models.AuditCheckpoint) *models.AuditVerification
+}
+
+type audit struct {
+	repository repositories.AuditRepository
+	jwt        jwt.Jwt
+	log        *logger.Logger
+}
+
+func NewAudit(repository repositories.AuditRepository, jwt jwt.Jwt, log *logger.Logger) Audit {
+	return &audit{
+		repository: repository,
+		jwt:        jwt,
+		log:        log,
+	}
+}
+
+func (a *audit) List(ctx context.Context, filter models.AuditFilter, pagination *Pagination) ([]models.AuditEvent, uint64, error) {
+	collection, total, err := a.repository.List(ctx, filter, pagination.Limit(), pagination.Offset())
+
+	if err != nil {
+		a.log.Error().Err(err).Msg("Failed to fetch audit events")
+		return nil, 0, errors.ErrFailedToFetchResults
+	}
+
+	return collection, total, err
+}
+
+// Record stores the event, failures are logged and never interrupt the audited operation
+func (a *audit) Record(ctx context.Context, event *models.AuditEvent) {
+	traceId := event.TraceID
+	if spanCtx := trace.SpanContextFromContext(ctx); traceId == "" && spanCtx.HasTraceID() {
+		traceId = spanCtx.TraceID().String()
+	}
+
+	changes, err := marshalObject(event.Changes)
+	if err != nil {
+		a.log.Error().Err(err).Str("action", event.Action).Msg("Failed to encode audit event changes")
+		return
+	}
+
+	metadata, err := marshalObject(event.Metadata)
+	if err != nil {
+		a.log.Error().Err(err).Str("action", event.Action).Msg("Failed to encode audit event metadata")
+		return
+	}
+
+	_, err = a.repository.Create(ctx, db.CreateAuditEventParams{
+		Action:     event.Action,
+		ActorID:    event.ActorID,
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+		Status:     event.Status,
+		Changes:    changes,
+		Metadata:   metadata,
+		TraceID:    traceId,
+		RemoteAddr: event.RemoteAddr,
+	})
+	if err != nil {
+		a.log.Error().Err(err).Str("action", event.Action).Msg("Failed to record audit event")
+	}
+}
+
+// Export returns the events following the sequence in chain order with the checkpoints covering them
+func (a *audit) Export(ctx context.Context, sequence int64, limit uint64) ([]models.AuditEvent, []models.AuditCheckpoint, error) {
+	events, err := a.repository.ListAfter(ctx, sequence, limit)
+	if err != nil {
+		a.log.Error().Err(err).Msg("Failed to fetch audit events")
+		return nil, nil, errors.ErrFailedToFetchResults
+	}
+
+	if len(events) == 0 {
+		return events, []models.AuditCheckpoint{}, nil
+	}
+
+	checkpoints, err := a.repository.ListCheckpoints(ctx, sequence, events[len(events)-1].Sequence)
+	if err != nil {
+		a.log.Error().Err(err).Msg("Failed to fetch audit checkpoints")
+		return nil, nil, errors.ErrFailedToFetchResults
+	}
+
+	return events, checkpoints, nil
+}
+
+// Checkpoint signs the hash of the latest event, nil is returned when nothing was recorded since the last checkpoint
+func (a *audit) Checkpoint(ctx context.Context) (*models.AuditCheckpoint, error) {
+	event, err := a.repository.FindLast(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		a.log.Error().Err(err).Msg("Failed to find last audit event")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	last, err := a.repository.FindLastCheckpoint(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		a.log.Error().Err(err).Msg("Failed to find last audit checkpoint")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	if event.Hash == "" || (last != nil && last.Sequence >= event.Sequence) {
+		return nil, nil
+	}
+
+	checkpoint := &models.AuditCheckpoint{Sequence: event.Sequence, Hash: event.Hash}
+
+	checkpoint.Signature, err = a.jwt.Sign(checkpoint.Payload())
+	if err != nil {
+		a.log.Error().Err(err).Msg("Failed to sign audit checkpoint")
+		return nil, errors.ErrFailedToCreateRecord
+	}
+
+	result, err := a.repository.CreateCheckpoint(ctx, db.CreateAuditCheckpointParams{
+		Sequence:  checkpoint.Sequence,
+		Hash:      checkpoint.Hash,
+		Signature: checkpoint.Signature,
+	})
+	if err != nil {
+		a.log.Error().Err(err).Msg("Failed to create audit checkpoint")
+		return nil, errors.ErrFailedToCreateRecord
+	}
+
+	return result, nil
+}
+
+// Verify walks the live chain from the first event and checks every stored checkpoint
+func (a *audit) Verify(ctx context.Context) (*models.AuditVerification, error) {
+	checkpoints, err := a.repository.ListCheckpoints(ctx, 0, math.MaxInt64)
+	if err != nil {
+		a.log.Error().Err(err).Msg("Failed to fetch audit checkpoints")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	verifier := newChainVerifier("", checkpoints)
+
+	var sequence int64
+	for {
+		events, err := a.repository.ListAfter(ctx, sequence, AuditVerifyBatchSize)
+		if err != nil {
+			a.log.Error().Err(err).Msg("Failed to fetch audit events")
+			return nil, errors.ErrFailedToFetchResults
+		}
+
+		for i := range events {
+			if !verifier.event(&events[i]) {
+				return verifier.result, nil
+			}
+		}
+
+		if len(events) < AuditVerifyBatchSize {
+			break
+		}
+		sequence = events[len(events)-1].Sequence
+	}
+
+	return verifier.finish(a.jwt), nil
+}
+
+// VerifyRecords checks exported events, an empty previous hash anchors the chain at the first event
+func (a *audit) VerifyRecords(prevHash string, events []models.AuditEvent, checkpoints []models.AuditCheckpoint) *models.AuditVerification {
+	if prevHash == "" && len(events) > 0 {
+		prevHash = events[0].PrevHash
+	}
+
+	verifier := newChainVerifier(prevHash, checkpoints)
+
+	for i := range events {
+		if !verifier.event(&events[i]) {
+			return verifier.result
+		}
+	}
+
+	return verifier.finish(a.jwt)
+}
+
+// chainVerifier follows the chain event by event and keeps the links referenced by checkpoints
+type chainVerifier struct {
+	result      *models.AuditVerification
+	prevHash    string
+	started     bool
+	checkpoints []models.AuditCheckpoint
+	links       map[int64]*models.AuditEvent
+}
+
+func newChainVerifier(prevHash string, checkpoints []models.AuditCheckpoint) *chainVerifier {
+	links := make(map[int64]*models.AuditEvent, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		links[checkpoint.Sequence] = nil
+	}
+
+	return &chainVerifier{
+		result:      &models.AuditVerification{Valid: true},
+		prevHash:    prevHash,
+		checkpoints: checkpoints,
+		links:       links,
+	}
+}
+
+// event checks the link to the previous event and the event hash, false is returned on the first broken link
+func (v *chainVerifier) event(event *models.AuditEvent) bool {
+	// events recorded before the chain was introduced have no hash
+	if !v.started && event.Hash == "" {
+		return true
+	}
+	v.started = true
+
+	if event.PrevHash != v.prevHash {
+		return v.fail(event.Sequence, event.ID, "previous hash mismatch")
+	}
+
+	digest, err := event.Digest()
+	if err != nil || digest != event.Hash {
+		return v.fail(event.Sequence, event.ID, "hash mismatch")
+	}
+
+	if _, ok := v.links[event.Sequence]; ok {
+		v.links[event.Sequence] = event
+	}
+
+	v.prevHash = event.Hash
+	v.result.Events++
+
+	return true
+}
+
+// finish checks the checkpoints covering the verified events
+func (v *chainVerifier) finish(signer jwt.Jwt) *models.AuditVerification {
+	for _, checkpoint := range v.checkpoints {
+		event := v.links[checkpoint.Sequence]
+		if event == nil {
+			continue
+		}
+
+		if event.Hash != checkpoint.Hash {
+			v.fail(checkpoint.Sequence, event.ID, "checkpoint hash mismatch")
+			return v.result
+		}
+
+		if err := signer.VerifySignature(checkpoint.Payload(), checkpoint.Signature); err != nil {
+			v.fail(checkpoint.Sequence, event.ID, "invalid checkpoint signature")
+			return v.result
+		}
+
+		v.result.Checkpoints++
+	}
+
+	return v.result
+}
+
+func (v *chainVerifier) fail(sequence int64, id uuid.UUID, reason string) bool {
+	v.result.Valid = false
+	v.result.BrokenSequence = sequence
+	v.result.BrokenEventID = id
+	v.result.Reason = reason
+
+	return false
+}
+
+func marshalObject[T any](value map[string]T) ([]byte, error) {
+	if value == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(value)
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/audit.go -destination=internal/app/services/audit_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAudit is a mock of Audit interface.
+type MockAudit struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditMockRecorder is the mock recorder for MockAudit.
+type MockAuditMockRecorder struct {
+	mock *MockAudit
+}
+
+// NewMockAudit creates a new mock instance.
+func NewMockAudit(ctrl *gomock.Controller) *MockAudit {
+	mock := &MockAudit{ctrl: ctrl}
+	mock.recorder = &MockAuditMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAudit) EXPECT() *MockAuditMockRecorder {
+	return m.recorder
+}
+
+// Checkpoint mocks base method.
+func (m *MockAudit) Checkpoint(ctx context.Context) (*models.AuditCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkpoint", ctx)
+	ret0, _ := ret[0].(*models.AuditCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkpoint indicates an expected call of Checkpoint.
+func (mr *MockAuditMockRecorder) Checkpoint(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkpoint", reflect.TypeOf((*MockAudit)(nil).Checkpoint), ctx)
+}
+
+// Export mocks base method.
+func (m *MockAudit) Export(ctx context.Context, sequence int64, limit uint64) ([]models.AuditEvent, []models.AuditCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, sequence, limit)
+	ret0, _ := ret[0].([]models.AuditEvent)
+	ret1, _ := ret[1].([]models.AuditCheckpoint)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockAuditMockRecorder) Export(ctx, sequence, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockAudit)(nil).Export), ctx, sequence, limit)
+}
+
+// List mocks base method.
+func (m *MockAudit) List(ctx context.Context, filter models.AuditFilter, pagination *Pagination) ([]models.AuditEvent, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter, pagination)
+	ret0, _ := ret[0].([]models.AuditEvent)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditMockRecorder) List(ctx, filter, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAudit)(nil).List), ctx, filter, pagination)
+}
+
+// Record mocks base method.
+func (m *MockAudit) Record(ctx context.Context, event *models.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", ctx, event)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditMockRecorder) Record(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAudit)(nil).Record), ctx, event)
+}
+
+// Verify mocks base method.
+func (m *MockAudit) Verify(ctx context.Context) (*models.AuditVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx)
+	ret0, _ := ret[0].(*models.AuditVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockAuditMockRecorder) Verify(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockAudit)(nil).Verify), ctx)
+}
+
+// VerifyRecords mocks base method.
+func (m *MockAudit) VerifyRecords(prevHash string, events []models.AuditEvent, checkpoints []models.AuditCheckpoint) *models.AuditVerification {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyRecords", prevHash, events, checkpoints)
+	ret0, _ := ret[0].(*models.AuditVerification)
+	return ret0
+}
+
+// VerifyRecords indicates an expected call of VerifyRecords.
+func (mr *MockAuditMockRecorder) VerifyRecords(prevHash, events, checkpoints any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyRecords", reflect.TypeOf((*MockAudit)(nil).VerifyRecords), prevHash, events, checkpoints)
+}
@@ -0,0 +1,489 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/app/repositories/db"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/pkg/jwt"
+)
+
+func Test_Audit_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockAuditRepository(ctrl)
+	service := NewAudit(repository, jwt.NewMockJwt(ctrl), log)
+
+	filter := models.AuditFilter{Action: models.AuditLoginSucceeded}
+	createdAt := time.Now()
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected []models.AuditEvent
+		total    uint64
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().List(ctx, filter, uint64(10), uint64(0)).Return([]models.AuditEvent{
+					{
+						ID:         uuid.MustParse("10000000-1000-1000-7000-000000000001"),
+						Action:     models.AuditLoginSucceeded,
+						TargetType: "user",
+						Status:     models.AuditSuccess,
+						CreatedAt:  createdAt,
+					},
+				}, uint64(1), nil)
+			},
+			expected: []models.AuditEvent{
+				{
+					ID:         uuid.MustParse("10000000-1000-1000-7000-000000000001"),
+					Action:     models.AuditLoginSucceeded,
+					TargetType: "user",
+					Status:     models.AuditSuccess,
+					CreatedAt:  createdAt,
+				},
+			},
+			total: uint64(1),
+			error: nil,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().List(ctx, filter, uint64(10), uint64(0)).Return(nil, uint64(0), errors.ErrFailedToFetchResults)
+			},
+			expected: nil,
+			total:    0,
+			error:    errors.ErrFailedToFetchResults,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, total, err := service.List(ctx, filter, &Pagination{
+				Page:    uint64(1),
+				PerPage: uint64(10),
+			})
+
+			if tt.error != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.error, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+				assert.Equal(t, tt.total, total)
+			}
+		})
+	}
+}
+
+func Test_Audit_Record(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockAuditRepository(ctrl)
+	service := NewAudit(repository, jwt.NewMockJwt(ctrl), log)
+
+	actorId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+
+	tests := []struct {
+		name   string
+		event  *models.AuditEvent
+		before func()
+	}{
+		{
+			name: "Success",
+			event: &models.AuditEvent{
+				Action:     "/sso.v1.RoleService/Update",
+				ActorID:    actorId,
+				TargetType: "sso.v1.RoleService",
+				TargetID:   "10000000-1000-1000-1000-000000000001",
+				Status:     models.AuditSuccess,
+				Changes: map[string]any{
+					"name": map[string]any{"before": "admin", "after": "owner"},
+				},
+				TraceID:    "trace-id",
+				RemoteAddr: "127.0.0.1:50000",
+			},
+			before: func() {
+				repository.EXPECT().Create(ctx, db.CreateAuditEventParams{
+					Action:     "/sso.v1.RoleService/Update",
+					ActorID:    actorId,
+					TargetType: "sso.v1.RoleService",
+					TargetID:   "10000000-1000-1000-1000-000000000001",
+					Status:     models.AuditSuccess,
+					Changes:    []byte(`{"name":{"after":"owner","before":"admin"}}`),
+					Metadata:   []byte(`{}`),
+					TraceID:    "trace-id",
+					RemoteAddr: "127.0.0.1:50000",
+				}).Return(&models.AuditEvent{}, nil)
+			},
+		},
+		{
+			name: "Without changes",
+			event: &models.AuditEvent{
+				Action:   models.AuditLoginFailed,
+				Status:   models.AuditFailure,
+				Metadata: map[string]string{"provider": models.AuditProviderSmartId},
+			},
+			before: func() {
+				repository.EXPECT().Create(ctx, db.CreateAuditEventParams{
+					Action:   models.AuditLoginFailed,
+					Status:   models.AuditFailure,
+					Changes:  []byte(`{}`),
+					Metadata: []byte(`{"provider":"smart_id"}`),
+				}).Return(&models.AuditEvent{}, nil)
+			},
+		},
+		{
+			name: "Error",
+			event: &models.AuditEvent{
+				Action: models.AuditTokenIssued,
+				Status: models.AuditSuccess,
+			},
+			before: func() {
+				repository.EXPECT().Create(ctx, gomock.Any()).Return(nil, errors.ErrFailedToCreateRecord)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			service.Record(ctx, tt.event)
+		})
+	}
+}
+
+// buildAuditChain returns linked events with valid hashes
+func buildAuditChain(t *testing.T, size int) []models.AuditEvent {
+	events := make([]models.AuditEvent, 0, size)
+	prevHash := ""
+
+	for i := 1; i <= size; i++ {
+		event := models.AuditEvent{
+			ID:         uuid.New(),
+			Action:     models.AuditTokenIssued,
+			TargetType: "user",
+			Status:     models.AuditSuccess,
+			Metadata:   map[string]string{},
+			CreatedAt:  time.Date(2026, 10, 19, 12, 0, i, 0, time.UTC),
+			Sequence:   int64(i),
+			PrevHash:   prevHash,
+		}
+
+		hash, err := event.Digest()
+		require.NoError(t, err)
+		event.Hash = hash
+		prevHash = hash
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func Test_Audit_Checkpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockAuditRepository(ctrl)
+	jwtService := jwt.NewMockJwt(ctrl)
+	service := NewAudit(repository, jwtService, log)
+
+	last := &models.AuditEvent{Sequence: 42, Hash: "hash"}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.AuditCheckpoint
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().FindLast(ctx).Return(last, nil)
+				repository.EXPECT().FindLastCheckpoint(ctx).Return(&models.AuditCheckpoint{Sequence: 40}, nil)
+				jwtService.EXPECT().Sign([]byte("42:hash")).Return("signature", nil)
+				repository.EXPECT().CreateCheckpoint(ctx, db.CreateAuditCheckpointParams{
+					Sequence:  42,
+					Hash:      "hash",
+					Signature: "signature",
+				}).Return(&models.AuditCheckpoint{Sequence: 42, Hash: "hash", Signature: "signature"}, nil)
+			},
+			expected: &models.AuditCheckpoint{Sequence: 42, Hash: "hash", Signature: "signature"},
+		},
+		{
+			name: "First checkpoint",
+			before: func() {
+				repository.EXPECT().FindLast(ctx).Return(last, nil)
+				repository.EXPECT().FindLastCheckpoint(ctx).Return(nil, pgx.ErrNoRows)
+				jwtService.EXPECT().Sign([]byte("42:hash")).Return("signature", nil)
+				repository.EXPECT().CreateCheckpoint(ctx, gomock.Any()).Return(&models.AuditCheckpoint{Sequence: 42, Hash: "hash", Signature: "signature"}, nil)
+			},
+			expected: &models.AuditCheckpoint{Sequence: 42, Hash: "hash", Signature: "signature"},
+		},
+		{
+			name: "Nothing new since last checkpoint",
+			before: func() {
+				repository.EXPECT().FindLast(ctx).Return(last, nil)
+				repository.EXPECT().FindLastCheckpoint(ctx).Return(&models.AuditCheckpoint{Sequence: 42}, nil)
+			},
+			expected: nil,
+		},
+		{
+			name: "Empty chain",
+			before: func() {
+				repository.EXPECT().FindLast(ctx).Return(nil, pgx.ErrNoRows)
+			},
+			expected: nil,
+		},
+		{
+			name: "Failed to sign",
+			before: func() {
+				repository.EXPECT().FindLast(ctx).Return(last, nil)
+				repository.EXPECT().FindLastCheckpoint(ctx).Return(nil, pgx.ErrNoRows)
+				jwtService.EXPECT().Sign(gomock.Any()).Return("", assert.AnError)
+			},
+			error: errors.ErrFailedToCreateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Checkpoint(ctx)
+
+			if tt.error != nil {
+				assert.Equal(t, tt.error, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func Test_Audit_Verify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockAuditRepository(ctrl)
+	jwtService := jwt.NewMockJwt(ctrl)
+	service := NewAudit(repository, jwtService, log)
+
+	events := buildAuditChain(t, 3)
+	checkpoint := models.AuditCheckpoint{Sequence: 2, Hash: events[1].Hash, Signature: "signature"}
+
+	tampered := make([]models.AuditEvent, len(events))
+	copy(tampered, events)
+	tampered[1].Status = models.AuditFailure
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.AuditVerification
+		error    error
+	}{
+		{
+			name: "Valid chain",
+			before: func() {
+				repository.EXPECT().ListCheckpoints(ctx, int64(0), gomock.Any()).Return([]models.AuditCheckpoint{checkpoint}, nil)
+				repository.EXPECT().ListAfter(ctx, int64(0), uint64(AuditVerifyBatchSize)).Return(events, nil)
+				jwtService.EXPECT().VerifySignature(checkpoint.Payload(), "signature").Return(nil)
+			},
+			expected: &models.AuditVerification{Valid: true, Events: 3, Checkpoints: 1},
+		},
+		{
+			name: "Tampered event",
+			before: func() {
+				repository.EXPECT().ListCheckpoints(ctx, int64(0), gomock.Any()).Return([]models.AuditCheckpoint{}, nil)
+				repository.EXPECT().ListAfter(ctx, int64(0), uint64(AuditVerifyBatchSize)).Return(tampered, nil)
+			},
+			expected: &models.AuditVerification{
+				Valid:          false,
+				Events:         1,
+				BrokenSequence: 2,
+				BrokenEventID:  events[1].ID,
+				Reason:         "hash mismatch",
+			},
+		},
+		{
+			name: "Invalid checkpoint signature",
+			before: func() {
+				repository.EXPECT().ListCheckpoints(ctx, int64(0), gomock.Any()).Return([]models.AuditCheckpoint{checkpoint}, nil)
+				repository.EXPECT().ListAfter(ctx, int64(0), uint64(AuditVerifyBatchSize)).Return(events, nil)
+				jwtService.EXPECT().VerifySignature(gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			expected: &models.AuditVerification{
+				Valid:          false,
+				Events:         3,
+				BrokenSequence: 2,
+				BrokenEventID:  events[1].ID,
+				Reason:         "invalid checkpoint signature",
+			},
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().ListCheckpoints(ctx, int64(0), gomock.Any()).Return(nil, assert.AnError)
+			},
+			error: errors.ErrFailedToFetchResults,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Verify(ctx)
+
+			if tt.error != nil {
+				assert.Equal(t, tt.error, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func Test_Audit_VerifyRecords(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	repository := repositories.NewMockAuditRepository(ctrl)
+	jwtService := jwt.NewMockJwt(ctrl)
+	service := NewAudit(repository, jwtService, log)
+
+	events := buildAuditChain(t, 4)
+
+	removed := append([]models.AuditEvent{}, events[0], events[2], events[3])
+
+	tests := []struct {
+		name        string
+		prevHash    string
+		events      []models.AuditEvent
+		checkpoints []models.AuditCheckpoint
+		before      func()
+		expected    *models.AuditVerification
+	}{
+		{
+			name:     "Export starting mid chain",
+			prevHash: "",
+			events:   events[2:],
+			checkpoints: []models.AuditCheckpoint{
+				{Sequence: 4, Hash: events[3].Hash, Signature: "signature"},
+			},
+			before: func() {
+				jwtService.EXPECT().VerifySignature(gomock.Any(), "signature").Return(nil)
+			},
+			expected: &models.AuditVerification{Valid: true, Events: 2, Checkpoints: 1},
+		},
+		{
+			name:     "Wrong previous hash",
+			prevHash: events[0].Hash,
+			events:   events[2:],
+			expected: &models.AuditVerification{
+				Valid:          false,
+				BrokenSequence: 3,
+				BrokenEventID:  events[2].ID,
+				Reason:         "previous hash mismatch",
+			},
+		},
+		{
+			name:   "Removed event",
+			events: removed,
+			expected: &models.AuditVerification{
+				Valid:          false,
+				Events:         1,
+				BrokenSequence: 3,
+				BrokenEventID:  events[2].ID,
+				Reason:         "previous hash mismatch",
+			},
+		},
+		{
+			name:   "Checkpoint hash mismatch",
+			events: events,
+			checkpoints: []models.AuditCheckpoint{
+				{Sequence: 2, Hash: events[0].Hash, Signature: "signature"},
+			},
+			expected: &models.AuditVerification{
+				Valid:          false,
+				Events:         4,
+				BrokenSequence: 2,
+				BrokenEventID:  events[1].ID,
+				Reason:         "checkpoint hash mismatch",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.before != nil {
+				tt.before()
+			}
+
+			result := service.VerifyRecords(tt.prevHash, tt.events, tt.checkpoints)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
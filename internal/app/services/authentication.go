@@ -3,19 +3,22 @@ package services
 import (
 	"context"
 
+	"github.com/google/uuid"
+
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/config"
 	"loki/internal/config/logger"
 )
 
-const AuthenticationSuccess = "SUCCESS"
-
 type Authentication interface {
-	Complete(ctx context.Context, id string) (*models.User, error)
+	Complete(ctx context.Context, params *models.CompleteSessionParams) (*models.User, error)
+	StepUp(ctx context.Context, params *models.CompleteStepUpParams) (*models.User, error)
 }
 
 type authentication struct {
 	cfg      *config.Config
+	risk     Risk
 	sessions Sessions
 	tokens   Tokens
 	log      *logger.Logger
@@ -23,33 +26,105 @@ type authentication struct {
 
 func NewAuthentication(
 	cfg *config.Config,
+	risk Risk,
 	sessions Sessions,
 	tokens Tokens,
 	log *logger.Logger,
 ) Authentication {
 	return &authentication{
 		cfg:      cfg,
+		risk:     risk,
 		sessions: sessions,
 		tokens:   tokens,
 		log:      log,
 	}
 }
 
-func (a *authentication) Complete(ctx context.Context, sessionId string) (*models.User, error) {
-	session, err := a.sessions.FindById(ctx, sessionId)
+func (a *authentication) Complete(ctx context.Context, params *models.CompleteSessionParams) (*models.User, error) {
+	sessionId := params.SessionId
+
+	// a successful session is removed here, a concurrent request for the same session finds nothing
+	session, err := a.sessions.Consume(ctx, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkSessionStatus(session); err != nil {
+		a.log.Error().Err(err).Msgf("Session %s cannot be exchanged for tokens", sessionId)
+		return nil, err
+	}
+
+	// the user has just authenticated with Smart-ID or Mobile-ID, so only a blocking score refuses the login
+	assessment := a.risk.Evaluate(ctx, &models.RiskParams{
+		Stage:     models.RiskStageLogin,
+		UserID:    session.UserId,
+		IPAddress: params.IPAddress,
+		UserAgent: params.UserAgent,
+	})
+	if assessment.Action == models.RiskActionBlock {
+		a.log.Warn().Str("user_id", session.UserId.String()).Int("score", assessment.Score).Msgf("Session %s blocked as suspicious", sessionId)
+		return nil, errors.ErrSuspiciousLogin
+	}
+
+	user, err := a.tokens.Create(ctx, &models.CreateLoginSessionParams{
+		UserID:    session.UserId,
+		Provider:  session.Provider,
+		IPAddress: params.IPAddress,
+		UserAgent: params.UserAgent,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	user, err := a.tokens.Create(ctx, session.UserId)
+	return user, nil
+}
+
+// StepUp exchanges a successful session of the current user for an elevated access token of their login session
+func (a *authentication) StepUp(ctx context.Context, params *models.CompleteStepUpParams) (*models.User, error) {
+	sessionId := params.SessionId
+
+	// a successful session is removed here, a concurrent request for the same session finds nothing
+	session, err := a.sessions.Consume(ctx, sessionId)
 	if err != nil {
 		return nil, err
 	}
 
-	err = a.sessions.Delete(ctx, sessionId)
+	if err = checkSessionStatus(session); err != nil {
+		a.log.Error().Err(err).Msgf("Session %s cannot be exchanged for a step-up token", sessionId)
+		return nil, err
+	}
+
+	user, err := a.tokens.StepUp(ctx, &models.StepUpTokenParams{
+		UserID:         session.UserId,
+		IdentityNumber: params.User.IdentityNumber,
+		LoginSessionID: params.LoginSessionID,
+		Provider:       session.Provider,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return user, nil
 }
+
+func checkSessionStatus(session *models.Session) error {
+	switch session.Status {
+	case models.SessionSuccess:
+		if session.UserId == uuid.Nil {
+			return errors.ErrInvalidSessionTransition
+		}
+		return nil
+	case models.SessionRunning:
+		return errors.ErrSessionRunning
+	case models.SessionError:
+		return errors.ErrSessionFailed
+	case models.SessionCancelled:
+		return errors.ErrSessionCancelled
+	case models.SessionExpired:
+		return errors.ErrSessionExpired
+	case models.SessionRejected:
+		return errors.ErrSessionRejected
+	default:
+		return errors.ErrInvalidSessionTransition
+	}
+}
@@ -0,0 +1,135 @@
+package authentication
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/models/dto"
+	"loki/internal/app/services"
+	"loki/internal/app/workers"
+	"loki/internal/config/locales"
+	"loki/internal/config/logger"
+	"loki/pkg/signing"
+	"loki/pkg/validator"
+)
+
+type ConfirmationProvider interface {
+	CreateSession(ctx context.Context, user *models.User, params dto.CreateConfirmationRequest) (*models.Confirmation, error)
+}
+
+type confirmationProvider struct {
+	catalogue     locales.Catalogue
+	confirmations services.Confirmations
+	mobileId      signing.MobileId
+	profiles      services.Profiles
+	smartId       signing.SmartId
+	worker        workers.ConfirmationWorker
+	log           *logger.Logger
+}
+
+func NewConfirmation(
+	catalogue locales.Catalogue,
+	confirmations services.Confirmations,
+	mobileId signing.MobileId,
+	profiles services.Profiles,
+	smartId signing.SmartId,
+	worker workers.ConfirmationWorker,
+	log *logger.Logger,
+) ConfirmationProvider {
+	return &confirmationProvider{
+		catalogue:     catalogue,
+		confirmations: confirmations,
+		mobileId:      mobileId,
+		profiles:      profiles,
+		smartId:       smartId,
+		worker:        worker,
+		log:           log,
+	}
+}
+
+// CreateSession starts a signature session over the hash of the payload for the identity of the current user,
+// Mobile-ID uses the phone number from the request or the one saved in the profile
+func (s *confirmationProvider) CreateSession(ctx context.Context, user *models.User, params dto.CreateConfirmationRequest) (*models.Confirmation, error) {
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	personalCode, err := validator.IdentityNumber(user.IdentityNumber)
+	if err != nil {
+		s.log.Error().Err(err).Str("user_id", user.ID.String()).Msg("Failed to parse identity number for confirmation")
+		return nil, err
+	}
+
+	hash := signing.Hash([]byte(params.Payload))
+
+	request := &signing.Request{
+		IdentityNumber: user.IdentityNumber,
+		PersonalCode:   personalCode,
+		Hash:           hash,
+		DisplayText:    params.DisplayText,
+	}
+
+	var client signing.Client
+
+	switch params.Method {
+	case models.AuthMethodSmartId:
+		client = s.smartId
+	case models.AuthMethodMobileId:
+		client = s.mobileId
+
+		request.PhoneNumber, err = profilePhoneNumber(ctx, s.profiles, user, params.PhoneNumber)
+		if err != nil {
+			return nil, err
+		}
+		if request.PhoneNumber == "" {
+			return nil, errors.ErrEmptyPhoneNumber
+		}
+
+		request.TextFormat = locales.TextFormat(params.DisplayText)
+		if params.Locale != "" {
+			translation, err := s.catalogue.Resolve(params.Locale, locales.DefaultPurpose)
+			if err != nil {
+				return nil, err
+			}
+			request.Language = translation.MobileIdLanguage
+		}
+	default:
+		return nil, errors.ErrInvalidAuthenticationMethod
+	}
+
+	result, err := client.CreateSession(ctx, request)
+	if err != nil {
+		s.log.Error().Err(err).Msgf("Failed to create %s signature session", params.Method)
+		return nil, err
+	}
+
+	id, err := uuid.Parse(result.ID)
+	if err != nil {
+		s.log.Error().Err(err).Msg("Invalid signature session ID format")
+		return nil, err
+	}
+
+	confirmation, err := s.confirmations.Create(ctx, &models.Confirmation{
+		ID:          id,
+		UserID:      user.ID,
+		Method:      params.Method,
+		Code:        result.Code,
+		Hash:        base64.StdEncoding.EncodeToString(hash),
+		HashType:    signing.HashType,
+		Certificate: result.Certificate,
+	})
+	if err != nil {
+		s.log.Error().Err(err).Msg("Failed to save confirmation")
+		return nil, err
+	}
+
+	if err = s.worker.Enqueue(ctx, confirmation.ID, traceId); err != nil {
+		s.log.Error().Err(err).Msg("Failed to enqueue confirmation")
+		return nil, err
+	}
+
+	return confirmation, nil
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/authentication/confirmation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/authentication/confirmation.go -destination=internal/app/services/authentication/confirmation_mock.go -package=authentication
+//
+
+// Package authentication is a generated GoMock package.
+package authentication
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	dto "loki/internal/app/models/dto"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfirmationProvider is a mock of ConfirmationProvider interface.
+type MockConfirmationProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfirmationProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockConfirmationProviderMockRecorder is the mock recorder for MockConfirmationProvider.
+type MockConfirmationProviderMockRecorder struct {
+	mock *MockConfirmationProvider
+}
+
+// NewMockConfirmationProvider creates a new mock instance.
+func NewMockConfirmationProvider(ctrl *gomock.Controller) *MockConfirmationProvider {
+	mock := &MockConfirmationProvider{ctrl: ctrl}
+	mock.recorder = &MockConfirmationProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfirmationProvider) EXPECT() *MockConfirmationProviderMockRecorder {
+	return m.recorder
+}
+
+// CreateSession mocks base method.
+func (m *MockConfirmationProvider) CreateSession(ctx context.Context, user *models.User, params dto.CreateConfirmationRequest) (*models.Confirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, user, params)
+	ret0, _ := ret[0].(*models.Confirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockConfirmationProviderMockRecorder) CreateSession(ctx, user, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockConfirmationProvider)(nil).CreateSession), ctx, user, params)
+}
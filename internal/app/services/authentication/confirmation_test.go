@@ -0,0 +1,185 @@
+package authentication
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/models/dto"
+	"loki/internal/app/services"
+	"loki/internal/app/workers"
+	"loki/internal/config"
+	"loki/internal/config/locales"
+	"loki/internal/config/logger"
+	"loki/pkg/signing"
+)
+
+func Test_Confirmation_CreateSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	catalogueMock := locales.NewMockCatalogue(ctrl)
+	confirmationsMock := services.NewMockConfirmations(ctrl)
+	mobileIdMock := signing.NewMockMobileId(ctrl)
+	profilesMock := services.NewMockProfiles(ctrl)
+	smartIdMock := signing.NewMockSmartId(ctrl)
+	workerMock := workers.NewMockConfirmationWorker(ctrl)
+
+	service := NewConfirmation(catalogueMock, confirmationsMock, mobileIdMock, profilesMock, smartIdMock, workerMock, log)
+
+	user := &models.User{
+		ID:             uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac"),
+		IdentityNumber: "PNOEE-51307149560",
+		PersonalCode:   "51307149560",
+	}
+
+	id := uuid.MustParse("8fdb516d-1a82-43ba-b82d-be63df569b86")
+	payload := "Pay 100.00 EUR to Example OU"
+	hash := signing.Hash([]byte(payload))
+
+	tests := []struct {
+		name     string
+		params   dto.CreateConfirmationRequest
+		before   func()
+		expected *models.Confirmation
+		error    error
+	}{
+		{
+			name: "Smart-ID",
+			params: dto.CreateConfirmationRequest{
+				Method:      models.AuthMethodSmartId,
+				Payload:     payload,
+				DisplayText: "Pay 100.00 EUR",
+			},
+			before: func() {
+				smartIdMock.EXPECT().CreateSession(ctx, &signing.Request{
+					IdentityNumber: "PNOEE-51307149560",
+					PersonalCode:   "51307149560",
+					Hash:           hash,
+					DisplayText:    "Pay 100.00 EUR",
+				}).Return(&signing.Session{ID: id.String(), Code: "5463"}, nil)
+				confirmationsMock.EXPECT().Create(ctx, &models.Confirmation{
+					ID:       id,
+					UserID:   user.ID,
+					Method:   models.AuthMethodSmartId,
+					Code:     "5463",
+					Hash:     base64.StdEncoding.EncodeToString(hash),
+					HashType: signing.HashType,
+				}).Return(&models.Confirmation{ID: id, Code: "5463", Status: models.SessionRunning}, nil)
+				workerMock.EXPECT().Enqueue(ctx, id, gomock.Any()).Return(nil)
+			},
+			expected: &models.Confirmation{ID: id, Code: "5463", Status: models.SessionRunning},
+		},
+		{
+			name: "Mobile-ID with profile phone number",
+			params: dto.CreateConfirmationRequest{
+				Method:      models.AuthMethodMobileId,
+				Payload:     payload,
+				DisplayText: "Pay 100.00 EUR",
+				Locale:      "et",
+			},
+			before: func() {
+				profilesMock.EXPECT().FindByUserId(ctx, user.ID).Return(&models.Profile{PhoneNumber: "+37268000769"}, nil)
+				catalogueMock.EXPECT().Resolve("et", locales.DefaultPurpose).Return(&locales.Translation{MobileIdLanguage: "EST"}, nil)
+				mobileIdMock.EXPECT().CreateSession(ctx, &signing.Request{
+					IdentityNumber: "PNOEE-51307149560",
+					PersonalCode:   "51307149560",
+					PhoneNumber:    "+37268000769",
+					Hash:           hash,
+					DisplayText:    "Pay 100.00 EUR",
+					TextFormat:     locales.Gsm7,
+					Language:       "EST",
+				}).Return(&signing.Session{ID: id.String(), Code: "1821", Certificate: "MIIC"}, nil)
+				confirmationsMock.EXPECT().Create(ctx, &models.Confirmation{
+					ID:          id,
+					UserID:      user.ID,
+					Method:      models.AuthMethodMobileId,
+					Code:        "1821",
+					Hash:        base64.StdEncoding.EncodeToString(hash),
+					HashType:    signing.HashType,
+					Certificate: "MIIC",
+				}).Return(&models.Confirmation{ID: id, Code: "1821", Status: models.SessionRunning}, nil)
+				workerMock.EXPECT().Enqueue(ctx, id, gomock.Any()).Return(nil)
+			},
+			expected: &models.Confirmation{ID: id, Code: "1821", Status: models.SessionRunning},
+		},
+		{
+			name: "Mobile-ID without phone number",
+			params: dto.CreateConfirmationRequest{
+				Method:      models.AuthMethodMobileId,
+				Payload:     payload,
+				DisplayText: "Pay 100.00 EUR",
+			},
+			before: func() {
+				profilesMock.EXPECT().FindByUserId(ctx, user.ID).Return(&models.Profile{}, nil)
+			},
+			error: errors.ErrEmptyPhoneNumber,
+		},
+		{
+			name: "Provider error",
+			params: dto.CreateConfirmationRequest{
+				Method:      models.AuthMethodSmartId,
+				Payload:     payload,
+				DisplayText: "Pay 100.00 EUR",
+			},
+			before: func() {
+				smartIdMock.EXPECT().CreateSession(ctx, gomock.Any()).Return(nil, errors.ErrSigningProviderError)
+			},
+			error: errors.ErrSigningProviderError,
+		},
+		{
+			name: "Worker queue unavailable",
+			params: dto.CreateConfirmationRequest{
+				Method:      models.AuthMethodSmartId,
+				Payload:     payload,
+				DisplayText: "Pay 100.00 EUR",
+			},
+			before: func() {
+				smartIdMock.EXPECT().CreateSession(ctx, gomock.Any()).Return(&signing.Session{ID: id.String(), Code: "5463"}, nil)
+				confirmationsMock.EXPECT().Create(ctx, gomock.Any()).Return(&models.Confirmation{ID: id, Code: "5463", Status: models.SessionRunning}, nil)
+				workerMock.EXPECT().Enqueue(ctx, id, gomock.Any()).Return(context.Canceled)
+			},
+			error: context.Canceled,
+		},
+		{
+			name: "Invalid method",
+			params: dto.CreateConfirmationRequest{
+				Method:      "password",
+				Payload:     payload,
+				DisplayText: "Pay 100.00 EUR",
+			},
+			before: func() {},
+			error:  errors.ErrInvalidAuthenticationMethod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.CreateSession(ctx, user, tt.params)
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
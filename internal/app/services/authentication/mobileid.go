@@ -3,14 +3,15 @@ package authentication
 import (
 	"context"
 
-	"github.com/tab/mobileid"
 	"go.opentelemetry.io/otel/trace"
 
 	"loki/internal/app/models"
 	"loki/internal/app/models/dto"
 	"loki/internal/app/services"
 	"loki/internal/app/workers"
+	"loki/internal/config/locales"
 	"loki/internal/config/logger"
+	"loki/internal/config/telemetry"
 )
 
 type MobileIdProvider interface {
@@ -18,33 +19,43 @@ type MobileIdProvider interface {
 }
 
 type mobileIdProvider struct {
-	client   mobileid.Client
-	sessions services.Sessions
-	users    services.Users
-	worker   workers.MobileIdWorker
-	log      *logger.Logger
+	clients   MobileIdClientFactory
+	catalogue locales.Catalogue
+	sessions  services.Sessions
+	users     services.Users
+	worker    workers.MobileIdWorker
+	log       *logger.Logger
 }
 
 func NewMobileId(
-	client mobileid.Client,
+	clients MobileIdClientFactory,
+	catalogue locales.Catalogue,
 	sessions services.Sessions,
 	users services.Users,
 	worker workers.MobileIdWorker,
 	log *logger.Logger,
 ) MobileIdProvider {
 	return &mobileIdProvider{
-		client:   client,
-		sessions: sessions,
-		users:    users,
-		worker:   worker,
-		log:      log,
+		clients:   clients,
+		catalogue: catalogue,
+		sessions:  sessions,
+		users:     users,
+		worker:    worker,
+		log:       log,
 	}
 }
 
 func (s *mobileIdProvider) CreateSession(ctx context.Context, params dto.CreateMobileIdSessionRequest) (*models.Session, error) {
 	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
 
-	result, err := s.client.CreateSession(ctx, params.PhoneNumber, params.PersonalCode)
+	translation, err := s.catalogue.Resolve(params.Locale, params.Purpose)
+	if err != nil {
+		s.log.Error().Err(err).Msgf("Failed to resolve Mobile-ID display text for locale %q", params.Locale)
+		return nil, err
+	}
+	client := s.clients(translation)
+
+	result, err := client.CreateSession(ctx, params.PhoneNumber, params.PersonalCode)
 
 	if err != nil {
 		s.log.Error().Msgf("Failed to create Mobile-ID session: %v", err)
@@ -52,15 +63,20 @@ func (s *mobileIdProvider) CreateSession(ctx context.Context, params dto.CreateM
 	}
 
 	session, err := s.sessions.Create(ctx, &models.CreateSessionParams{
-		SessionId: result.Id,
-		Code:      result.Code,
+		SessionId:    result.Id,
+		Code:         result.Code,
+		PersonalCode: params.PersonalCode,
+		PhoneNumber:  params.PhoneNumber,
+		Provider:     models.AuthMethodMobileId,
 	})
 	if err != nil {
 		s.log.Error().Msgf("Failed to save Mobile-ID session: %v", err)
 		return nil, err
 	}
 
-	go s.worker.Perform(workers.Ctx, session.ID, traceId)
+	telemetry.RecordSessionStarted(ctx, models.AuthMethodMobileId)
+
+	go s.worker.Perform(workers.Detach(ctx), session.ID, traceId)
 
 	return &models.Session{
 		ID:     session.ID,
@@ -9,11 +9,13 @@ import (
 	"github.com/tab/mobileid"
 	"go.uber.org/mock/gomock"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/models/dto"
 	"loki/internal/app/services"
 	"loki/internal/app/workers"
 	"loki/internal/config"
+	"loki/internal/config/locales"
 	"loki/internal/config/logger"
 )
 
@@ -34,7 +36,22 @@ func Test_MobileId_CreateSession(t *testing.T) {
 	usersMock := services.NewMockUsers(ctrl)
 	workerMock := workers.NewMockMobileIdWorker(ctrl)
 
-	service := NewMobileId(clientMock, sessionsMock, usersMock, workerMock, log)
+	catalogueMock := locales.NewMockCatalogue(ctrl)
+
+	var translation *locales.Translation
+	factory := func(t *locales.Translation) mobileid.Client {
+		translation = t
+		return clientMock
+	}
+
+	service := NewMobileId(factory, catalogueMock, sessionsMock, usersMock, workerMock, log)
+
+	fallback := &locales.Translation{
+		SmartIdText:        "Enter PIN1",
+		MobileIdText:       "Enter PIN1",
+		MobileIdTextFormat: "GSM-7",
+		MobileIdLanguage:   "ENG",
+	}
 
 	personalCode := "51307149560"
 	phoneNumber := "+37269930366"
@@ -52,14 +69,19 @@ func Test_MobileId_CreateSession(t *testing.T) {
 		{
 			name: "Success",
 			before: func() {
+				catalogueMock.EXPECT().Resolve("", "").Return(fallback, nil)
+
 				clientMock.EXPECT().CreateSession(ctx, phoneNumber, personalCode).Return(&mobileid.Session{
 					Id:   sessionId,
 					Code: "1234",
 				}, nil)
 
 				sessionsMock.EXPECT().Create(ctx, &models.CreateSessionParams{
-					SessionId: sessionId,
-					Code:      "1234",
+					SessionId:    sessionId,
+					Code:         "1234",
+					PersonalCode: personalCode,
+					PhoneNumber:  phoneNumber,
+					Provider:     models.AuthMethodMobileId,
 				}).Return(&models.Session{
 					ID:   id,
 					Code: "1234",
@@ -78,9 +100,66 @@ func Test_MobileId_CreateSession(t *testing.T) {
 			},
 			error: nil,
 		},
+		{
+			name: "Success with locale",
+			before: func() {
+				catalogueMock.EXPECT().Resolve("lt", "login").Return(&locales.Translation{
+					Locale:             "lt",
+					SmartIdText:        "Įveskite PIN1",
+					MobileIdText:       "Įveskite PIN1",
+					MobileIdTextFormat: "UCS-2",
+					MobileIdLanguage:   "LIT",
+				}, nil)
+
+				clientMock.EXPECT().CreateSession(ctx, phoneNumber, personalCode).Return(&mobileid.Session{
+					Id:   sessionId,
+					Code: "1234",
+				}, nil)
+
+				sessionsMock.EXPECT().Create(ctx, &models.CreateSessionParams{
+					SessionId:    sessionId,
+					Code:         "1234",
+					PersonalCode: personalCode,
+					PhoneNumber:  phoneNumber,
+					Provider:     models.AuthMethodMobileId,
+				}).Return(&models.Session{
+					ID:   id,
+					Code: "1234",
+				}, nil)
+
+				workerMock.EXPECT().Perform(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			params: dto.CreateMobileIdSessionRequest{
+				PersonalCode: personalCode,
+				PhoneNumber:  phoneNumber,
+				Locale:       "lt",
+				Purpose:      "login",
+			},
+			expected: &models.Session{
+				ID:     id,
+				Code:   "1234",
+				Status: models.SessionRunning,
+			},
+			error: nil,
+		},
+		{
+			name: "Error unsupported locale",
+			before: func() {
+				catalogueMock.EXPECT().Resolve("fi", "").Return(nil, errors.ErrUnsupportedLocale)
+			},
+			params: dto.CreateMobileIdSessionRequest{
+				PersonalCode: personalCode,
+				PhoneNumber:  phoneNumber,
+				Locale:       "fi",
+			},
+			expected: nil,
+			error:    errors.ErrUnsupportedLocale,
+		},
 		{
 			name: "Error to create smart-id session",
 			before: func() {
+				catalogueMock.EXPECT().Resolve("", "").Return(fallback, nil)
+
 				clientMock.EXPECT().CreateSession(ctx, phoneNumber, personalCode).Return(nil, assert.AnError)
 			},
 			params: dto.CreateMobileIdSessionRequest{
@@ -93,14 +172,19 @@ func Test_MobileId_CreateSession(t *testing.T) {
 		{
 			name: "Error to save smart-id session",
 			before: func() {
+				catalogueMock.EXPECT().Resolve("", "").Return(fallback, nil)
+
 				clientMock.EXPECT().CreateSession(ctx, phoneNumber, personalCode).Return(&mobileid.Session{
 					Id:   sessionId,
 					Code: "1234",
 				}, nil)
 
 				sessionsMock.EXPECT().Create(ctx, &models.CreateSessionParams{
-					SessionId: sessionId,
-					Code:      "1234",
+					SessionId:    sessionId,
+					Code:         "1234",
+					PersonalCode: personalCode,
+					PhoneNumber:  phoneNumber,
+					Provider:     models.AuthMethodMobileId,
 				}).Return(nil, assert.AnError)
 			},
 			params: dto.CreateMobileIdSessionRequest{
@@ -118,6 +202,10 @@ func Test_MobileId_CreateSession(t *testing.T) {
 
 			result, err := service.CreateSession(ctx, tt.params)
 
+			if tt.params.Locale != "" && tt.error == nil {
+				assert.Equal(t, tt.params.Locale, translation.Locale)
+			}
+
 			if tt.error != nil {
 				assert.Error(t, err)
 				assert.Nil(t, result)
@@ -7,8 +7,11 @@ import (
 	"github.com/tab/smartid"
 	"go.uber.org/fx"
 
+	"loki/internal/app/workers"
 	"loki/internal/config"
+	"loki/internal/config/locales"
 	"loki/internal/config/logger"
+	"loki/internal/config/telemetry"
 )
 
 const (
@@ -16,23 +19,36 @@ const (
 	QueueSize   = 15
 )
 
+// SmartIdClientFactory builds a Smart-ID client showing the display text of the given translation
+type SmartIdClientFactory func(translation *locales.Translation) smartid.Client
+
+// MobileIdClientFactory builds a Mobile-ID client showing the display text of the given translation
+type MobileIdClientFactory func(translation *locales.Translation) mobileid.Client
+
 var Module = fx.Options(
 	fx.Provide(
-		func(cfg *config.Config, log *logger.Logger) (smartid.Client, error) {
+		func(cfg *config.Config, log *logger.Logger) (SmartIdClientFactory, error) {
 			certManager, err := smartid.NewCertificateManager(cfg.CertPath)
 			if err != nil {
 				return nil, err
 			}
-			client := smartid.NewClient().
-				WithRelyingPartyName(cfg.SmartId.RelyingPartyName).
-				WithRelyingPartyUUID(cfg.SmartId.RelyingPartyUUID).
-				WithCertificateLevel("QUALIFIED").
-				WithHashType("SHA512").
-				WithInteractionType("displayTextAndPIN").
-				WithText(cfg.SmartId.Text).
-				WithURL(cfg.SmartId.BaseURL).
-				WithTimeout(60 * time.Second).
-				WithTLSConfig(certManager.TLSConfig())
+			return func(translation *locales.Translation) smartid.Client {
+				return smartid.NewClient().
+					WithRelyingPartyName(cfg.SmartId.RelyingPartyName).
+					WithRelyingPartyUUID(cfg.SmartId.RelyingPartyUUID).
+					WithCertificateLevel("QUALIFIED").
+					WithHashType("SHA512").
+					WithInteractionType("displayTextAndPIN").
+					WithText(translation.SmartIdText).
+					WithURL(cfg.SmartId.BaseURL).
+					WithTimeout(60 * time.Second).
+					WithTLSConfig(certManager.TLSConfig())
+			}, nil
+		},
+	),
+	fx.Provide(
+		func(cfg *config.Config, factory SmartIdClientFactory) (smartid.Client, error) {
+			client := factory(&locales.Translation{SmartIdText: cfg.SmartId.Text})
 			if err := client.Validate(); err != nil {
 				return nil, err
 			}
@@ -44,6 +60,7 @@ var Module = fx.Options(
 			worker := smartid.NewWorker(client).
 				WithConcurrency(Concurrency).
 				WithQueueSize(QueueSize)
+			telemetry.RegisterWorker(workers.SmartIdWorkerName, Concurrency, QueueSize)
 
 			return worker
 		},
@@ -51,16 +68,27 @@ var Module = fx.Options(
 	fx.Provide(NewSmartId),
 
 	fx.Provide(
-		func(cfg *config.Config, log *logger.Logger) (mobileid.Client, error) {
-			client := mobileid.NewClient().
-				WithRelyingPartyName(cfg.MobileId.RelyingPartyName).
-				WithRelyingPartyUUID(cfg.MobileId.RelyingPartyUUID).
-				WithHashType("SHA512").
-				WithText(cfg.MobileId.Text).
-				WithTextFormat(cfg.MobileId.TextFormat).
-				WithLanguage(cfg.MobileId.Language).
-				WithURL(cfg.MobileId.BaseURL).
-				WithTimeout(60 * time.Second)
+		func(cfg *config.Config) MobileIdClientFactory {
+			return func(translation *locales.Translation) mobileid.Client {
+				return mobileid.NewClient().
+					WithRelyingPartyName(cfg.MobileId.RelyingPartyName).
+					WithRelyingPartyUUID(cfg.MobileId.RelyingPartyUUID).
+					WithHashType("SHA512").
+					WithText(translation.MobileIdText).
+					WithTextFormat(translation.MobileIdTextFormat).
+					WithLanguage(translation.MobileIdLanguage).
+					WithURL(cfg.MobileId.BaseURL).
+					WithTimeout(60 * time.Second)
+			}
+		},
+	),
+	fx.Provide(
+		func(cfg *config.Config, factory MobileIdClientFactory) (mobileid.Client, error) {
+			client := factory(&locales.Translation{
+				MobileIdText:       cfg.MobileId.Text,
+				MobileIdTextFormat: cfg.MobileId.TextFormat,
+				MobileIdLanguage:   cfg.MobileId.Language,
+			})
 			if err := client.Validate(); err != nil {
 				return nil, err
 			}
@@ -72,10 +100,12 @@ var Module = fx.Options(
 			worker := mobileid.NewWorker(client).
 				WithConcurrency(Concurrency).
 				WithQueueSize(QueueSize)
+			telemetry.RegisterWorker(workers.MobileIdWorkerName, Concurrency, QueueSize)
 
 			return worker
 		},
 	),
 	fx.Provide(NewMobileId),
-
+	fx.Provide(NewStepUp),
+	fx.Provide(NewConfirmation),
 )
@@ -10,7 +10,9 @@ import (
 	"loki/internal/app/models/dto"
 	"loki/internal/app/services"
 	"loki/internal/app/workers"
+	"loki/internal/config/locales"
 	"loki/internal/config/logger"
+	"loki/internal/config/telemetry"
 )
 
 type SmartIdProvider interface {
@@ -18,34 +20,44 @@ type SmartIdProvider interface {
 }
 
 type smartIdProvider struct {
-	client   smartid.Client
-	sessions services.Sessions
-	users    services.Users
-	worker   workers.SmartIdWorker
-	log      *logger.Logger
+	clients   SmartIdClientFactory
+	catalogue locales.Catalogue
+	sessions  services.Sessions
+	users     services.Users
+	worker    workers.SmartIdWorker
+	log       *logger.Logger
 }
 
 func NewSmartId(
-	client smartid.Client,
+	clients SmartIdClientFactory,
+	catalogue locales.Catalogue,
 	sessions services.Sessions,
 	users services.Users,
 	worker workers.SmartIdWorker,
 	log *logger.Logger,
 ) SmartIdProvider {
 	return &smartIdProvider{
-		client:   client,
-		sessions: sessions,
-		users:    users,
-		worker:   worker,
-		log:      log,
+		clients:   clients,
+		catalogue: catalogue,
+		sessions:  sessions,
+		users:     users,
+		worker:    worker,
+		log:       log,
 	}
 }
 
 func (s *smartIdProvider) CreateSession(ctx context.Context, params dto.CreateSmartIdSessionRequest) (*models.Session, error) {
 	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
 
+	translation, err := s.catalogue.Resolve(params.Locale, params.Purpose)
+	if err != nil {
+		s.log.Error().Err(err).Msgf("Failed to resolve Smart-ID display text for locale %q", params.Locale)
+		return nil, err
+	}
+	client := s.clients(translation)
+
 	identity := smartid.NewIdentity(smartid.TypePNO, params.Country, params.PersonalCode)
-	result, err := s.client.CreateSession(ctx, identity)
+	result, err := client.CreateSession(ctx, identity)
 
 	if err != nil {
 		s.log.Error().Msgf("Failed to create Smart-ID session: %v", err)
@@ -53,15 +65,19 @@ func (s *smartIdProvider) CreateSession(ctx context.Context, params dto.CreateSm
 	}
 
 	session, err := s.sessions.Create(ctx, &models.CreateSessionParams{
-		SessionId: result.Id,
-		Code:      result.Code,
+		SessionId:    result.Id,
+		Code:         result.Code,
+		PersonalCode: params.PersonalCode,
+		Provider:     models.AuthMethodSmartId,
 	})
 	if err != nil {
 		s.log.Error().Msgf("Failed to save Smart-ID session: %v", err)
 		return nil, err
 	}
 
-	go s.worker.Perform(workers.Ctx, session.ID, traceId)
+	telemetry.RecordSessionStarted(ctx, models.AuthMethodSmartId)
+
+	go s.worker.Perform(workers.Detach(ctx), session.ID, traceId)
 
 	return &models.Session{
 		ID:     session.ID,
@@ -9,11 +9,13 @@ import (
 	"github.com/tab/smartid"
 	"go.uber.org/mock/gomock"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/models/dto"
 	"loki/internal/app/services"
 	"loki/internal/app/workers"
 	"loki/internal/config"
+	"loki/internal/config/locales"
 	"loki/internal/config/logger"
 )
 
@@ -34,7 +36,22 @@ func Test_SmartId_CreateSession(t *testing.T) {
 	usersMock := services.NewMockUsers(ctrl)
 	workerMock := workers.NewMockSmartIdWorker(ctrl)
 
-	service := NewSmartId(clientMock, sessionsMock, usersMock, workerMock, log)
+	catalogueMock := locales.NewMockCatalogue(ctrl)
+
+	var translation *locales.Translation
+	factory := func(t *locales.Translation) smartid.Client {
+		translation = t
+		return clientMock
+	}
+
+	service := NewSmartId(factory, catalogueMock, sessionsMock, usersMock, workerMock, log)
+
+	fallback := &locales.Translation{
+		SmartIdText:        "Enter PIN1",
+		MobileIdText:       "Enter PIN1",
+		MobileIdTextFormat: "GSM-7",
+		MobileIdLanguage:   "ENG",
+	}
 
 	id := uuid.MustParse("8fdb516d-1a82-43ba-b82d-be63df569b86")
 	sessionId := id.String()
@@ -49,6 +66,8 @@ func Test_SmartId_CreateSession(t *testing.T) {
 		{
 			name: "Success",
 			before: func() {
+				catalogueMock.EXPECT().Resolve("", "").Return(fallback, nil)
+
 				identity := smartid.NewIdentity(smartid.TypePNO, "EE", "30303039914")
 
 				clientMock.EXPECT().CreateSession(ctx, identity).Return(&smartid.Session{
@@ -57,8 +76,10 @@ func Test_SmartId_CreateSession(t *testing.T) {
 				}, nil)
 
 				sessionsMock.EXPECT().Create(ctx, &models.CreateSessionParams{
-					SessionId: sessionId,
-					Code:      "1234",
+					SessionId:    sessionId,
+					Code:         "1234",
+					PersonalCode: "30303039914",
+					Provider:     models.AuthMethodSmartId,
 				}).Return(&models.Session{
 					ID:   id,
 					Code: "1234",
@@ -77,9 +98,67 @@ func Test_SmartId_CreateSession(t *testing.T) {
 			},
 			error: nil,
 		},
+		{
+			name: "Success with locale",
+			before: func() {
+				catalogueMock.EXPECT().Resolve("lv", "login").Return(&locales.Translation{
+					Locale:             "lv",
+					SmartIdText:        "Ievadiet PIN1",
+					MobileIdText:       "Ievadiet PIN1",
+					MobileIdTextFormat: "UCS-2",
+					MobileIdLanguage:   "ENG",
+				}, nil)
+
+				identity := smartid.NewIdentity(smartid.TypePNO, "EE", "30303039914")
+
+				clientMock.EXPECT().CreateSession(ctx, identity).Return(&smartid.Session{
+					Id:   sessionId,
+					Code: "1234",
+				}, nil)
+
+				sessionsMock.EXPECT().Create(ctx, &models.CreateSessionParams{
+					SessionId:    sessionId,
+					Code:         "1234",
+					PersonalCode: "30303039914",
+					Provider:     models.AuthMethodSmartId,
+				}).Return(&models.Session{
+					ID:   id,
+					Code: "1234",
+				}, nil)
+
+				workerMock.EXPECT().Perform(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			params: dto.CreateSmartIdSessionRequest{
+				Country:      "EE",
+				PersonalCode: "30303039914",
+				Locale:       "lv",
+				Purpose:      "login",
+			},
+			expected: &models.Session{
+				ID:     id,
+				Code:   "1234",
+				Status: models.SessionRunning,
+			},
+			error: nil,
+		},
+		{
+			name: "Error unsupported locale",
+			before: func() {
+				catalogueMock.EXPECT().Resolve("fi", "").Return(nil, errors.ErrUnsupportedLocale)
+			},
+			params: dto.CreateSmartIdSessionRequest{
+				Country:      "EE",
+				PersonalCode: "30303039914",
+				Locale:       "fi",
+			},
+			expected: nil,
+			error:    errors.ErrUnsupportedLocale,
+		},
 		{
 			name: "Error to create smart-id session",
 			before: func() {
+				catalogueMock.EXPECT().Resolve("", "").Return(fallback, nil)
+
 				identity := smartid.NewIdentity(smartid.TypePNO, "EE", "30303039914")
 
 				clientMock.EXPECT().CreateSession(ctx, identity).Return(nil, assert.AnError)
@@ -94,6 +173,8 @@ func Test_SmartId_CreateSession(t *testing.T) {
 		{
 			name: "Error to save smart-id session",
 			before: func() {
+				catalogueMock.EXPECT().Resolve("", "").Return(fallback, nil)
+
 				identity := smartid.NewIdentity(smartid.TypePNO, "EE", "30303039914")
 
 				clientMock.EXPECT().CreateSession(ctx, identity).Return(&smartid.Session{
@@ -102,8 +183,10 @@ func Test_SmartId_CreateSession(t *testing.T) {
 				}, nil)
 
 				sessionsMock.EXPECT().Create(ctx, &models.CreateSessionParams{
-					SessionId: sessionId,
-					Code:      "1234",
+					SessionId:    sessionId,
+					Code:         "1234",
+					PersonalCode: "30303039914",
+					Provider:     models.AuthMethodSmartId,
 				}).Return(nil, assert.AnError)
 			},
 			params: dto.CreateSmartIdSessionRequest{
@@ -121,6 +204,10 @@ func Test_SmartId_CreateSession(t *testing.T) {
 
 			result, err := service.CreateSession(ctx, tt.params)
 
+			if tt.params.Locale != "" && tt.error == nil {
+				assert.Equal(t, tt.params.Locale, translation.Locale)
+			}
+
 			if tt.error != nil {
 				assert.Error(t, err)
 				assert.Nil(t, result)
@@ -0,0 +1,92 @@
+package authentication
+
+import (
+	"context"
+	"strings"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/models/dto"
+	"loki/internal/app/services"
+	"loki/internal/config/logger"
+	"loki/pkg/validator"
+)
+
+type StepUpProvider interface {
+	CreateSession(ctx context.Context, user *models.User, params dto.CreateStepUpSessionRequest) (*models.Session, error)
+}
+
+type stepUpProvider struct {
+	mobileId MobileIdProvider
+	profiles services.Profiles
+	smartId  SmartIdProvider
+	log      *logger.Logger
+}
+
+func NewStepUp(
+	mobileId MobileIdProvider,
+	profiles services.Profiles,
+	smartId SmartIdProvider,
+	log *logger.Logger,
+) StepUpProvider {
+	return &stepUpProvider{
+		mobileId: mobileId,
+		profiles: profiles,
+		smartId:  smartId,
+		log:      log,
+	}
+}
+
+// CreateSession starts a Smart-ID or Mobile-ID session for the identity of the current user,
+// Mobile-ID uses the phone number from the request or the one saved in the profile
+func (s *stepUpProvider) CreateSession(ctx context.Context, user *models.User, params dto.CreateStepUpSessionRequest) (*models.Session, error) {
+	personalCode, err := validator.IdentityNumber(user.IdentityNumber)
+	if err != nil {
+		s.log.Error().Err(err).Str("user_id", user.ID.String()).Msg("Failed to parse identity number for step-up")
+		return nil, err
+	}
+
+	switch params.Method {
+	case models.AuthMethodSmartId:
+		prefix, _, _ := strings.Cut(user.IdentityNumber, "-")
+
+		return s.smartId.CreateSession(ctx, dto.CreateSmartIdSessionRequest{
+			Country:      strings.TrimPrefix(prefix, "PNO"),
+			PersonalCode: personalCode,
+			Locale:       params.Locale,
+			Purpose:      params.Purpose,
+		})
+	case models.AuthMethodMobileId:
+		phoneNumber, err := profilePhoneNumber(ctx, s.profiles, user, params.PhoneNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		if phoneNumber == "" {
+			return nil, errors.ErrStepUpPhoneNumberRequired
+		}
+
+		return s.mobileId.CreateSession(ctx, dto.CreateMobileIdSessionRequest{
+			PersonalCode: personalCode,
+			PhoneNumber:  phoneNumber,
+			Locale:       params.Locale,
+			Purpose:      params.Purpose,
+		})
+	default:
+		return nil, errors.ErrInvalidAuthenticationMethod
+	}
+}
+
+// profilePhoneNumber returns the requested phone number or the one saved in the profile of the user
+func profilePhoneNumber(ctx context.Context, profiles services.Profiles, user *models.User, phoneNumber string) (string, error) {
+	if phoneNumber != "" {
+		return phoneNumber, nil
+	}
+
+	profile, err := profiles.FindByUserId(ctx, user.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return profile.PhoneNumber, nil
+}
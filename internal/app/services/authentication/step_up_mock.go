@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/authentication/step_up.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/authentication/step_up.go -destination=internal/app/services/authentication/step_up_mock.go -package=authentication
+//
+
+// Package authentication is a generated GoMock package.
+package authentication
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	dto "loki/internal/app/models/dto"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStepUpProvider is a mock of StepUpProvider interface.
+type MockStepUpProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockStepUpProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockStepUpProviderMockRecorder is the mock recorder for MockStepUpProvider.
+type MockStepUpProviderMockRecorder struct {
+	mock *MockStepUpProvider
+}
+
+// NewMockStepUpProvider creates a new mock instance.
+func NewMockStepUpProvider(ctrl *gomock.Controller) *MockStepUpProvider {
+	mock := &MockStepUpProvider{ctrl: ctrl}
+	mock.recorder = &MockStepUpProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStepUpProvider) EXPECT() *MockStepUpProviderMockRecorder {
+	return m.recorder
+}
+
+// CreateSession mocks base method.
+func (m *MockStepUpProvider) CreateSession(ctx context.Context, user *models.User, params dto.CreateStepUpSessionRequest) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, user, params)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStepUpProviderMockRecorder) CreateSession(ctx, user, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStepUpProvider)(nil).CreateSession), ctx, user, params)
+}
@@ -0,0 +1,146 @@
+package authentication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/models/dto"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+func Test_StepUp_CreateSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	mobileIdMock := NewMockMobileIdProvider(ctrl)
+	profilesMock := services.NewMockProfiles(ctrl)
+	smartIdMock := NewMockSmartIdProvider(ctrl)
+
+	service := NewStepUp(mobileIdMock, profilesMock, smartIdMock, log)
+
+	user := &models.User{
+		ID:             uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac"),
+		IdentityNumber: "PNOEE-51307149560",
+		PersonalCode:   "51307149560",
+	}
+
+	session := &models.Session{
+		ID:     uuid.MustParse("8fdb516d-1a82-43ba-b82d-be63df569b86"),
+		Code:   "1234",
+		Status: models.SessionRunning,
+	}
+
+	tests := []struct {
+		name     string
+		user     *models.User
+		params   dto.CreateStepUpSessionRequest
+		before   func()
+		expected *models.Session
+		error    error
+	}{
+		{
+			name:   "Smart-ID",
+			user:   user,
+			params: dto.CreateStepUpSessionRequest{Method: models.AuthMethodSmartId, Locale: "et"},
+			before: func() {
+				smartIdMock.EXPECT().CreateSession(ctx, dto.CreateSmartIdSessionRequest{
+					Country:      "EE",
+					PersonalCode: "51307149560",
+					Locale:       "et",
+				}).Return(session, nil)
+			},
+			expected: session,
+		},
+		{
+			name:   "Mobile-ID with phone number",
+			user:   user,
+			params: dto.CreateStepUpSessionRequest{Method: models.AuthMethodMobileId, PhoneNumber: "+37269930366"},
+			before: func() {
+				mobileIdMock.EXPECT().CreateSession(ctx, dto.CreateMobileIdSessionRequest{
+					PersonalCode: "51307149560",
+					PhoneNumber:  "+37269930366",
+				}).Return(session, nil)
+			},
+			expected: session,
+		},
+		{
+			name:   "Mobile-ID with profile phone number",
+			user:   user,
+			params: dto.CreateStepUpSessionRequest{Method: models.AuthMethodMobileId},
+			before: func() {
+				profilesMock.EXPECT().FindByUserId(ctx, user.ID).Return(&models.Profile{UserID: user.ID, PhoneNumber: "+37268000769"}, nil)
+				mobileIdMock.EXPECT().CreateSession(ctx, dto.CreateMobileIdSessionRequest{
+					PersonalCode: "51307149560",
+					PhoneNumber:  "+37268000769",
+				}).Return(session, nil)
+			},
+			expected: session,
+		},
+		{
+			name:   "Mobile-ID without phone number",
+			user:   user,
+			params: dto.CreateStepUpSessionRequest{Method: models.AuthMethodMobileId},
+			before: func() {
+				profilesMock.EXPECT().FindByUserId(ctx, user.ID).Return(&models.Profile{UserID: user.ID}, nil)
+			},
+			error: errors.ErrStepUpPhoneNumberRequired,
+		},
+		{
+			name:   "Failed to find profile",
+			user:   user,
+			params: dto.CreateStepUpSessionRequest{Method: models.AuthMethodMobileId},
+			before: func() {
+				profilesMock.EXPECT().FindByUserId(ctx, user.ID).Return(nil, errors.ErrFailedToFetchResults)
+			},
+			error: errors.ErrFailedToFetchResults,
+		},
+		{
+			name:   "Invalid identity number",
+			user:   &models.User{ID: user.ID, IdentityNumber: "EE51307149560"},
+			params: dto.CreateStepUpSessionRequest{Method: models.AuthMethodSmartId},
+			before: func() {},
+			error:  errors.ErrInvalidIdentityNumber,
+		},
+		{
+			name:   "Provider failure",
+			user:   user,
+			params: dto.CreateStepUpSessionRequest{Method: models.AuthMethodSmartId},
+			before: func() {
+				smartIdMock.EXPECT().CreateSession(ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+			error: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.CreateSession(ctx, tt.user, tt.params)
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
@@ -42,16 +42,31 @@ func (m *MockAuthentication) EXPECT() *MockAuthenticationMockRecorder {
 }
 
 // Complete mocks base method.
-func (m *MockAuthentication) Complete(ctx context.Context, id string) (*models.User, error) {
+func (m *MockAuthentication) Complete(ctx context.Context, params *models.CompleteSessionParams) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Complete", ctx, id)
+	ret := m.ctrl.Call(m, "Complete", ctx, params)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Complete indicates an expected call of Complete.
-func (mr *MockAuthenticationMockRecorder) Complete(ctx, id any) *gomock.Call {
+func (mr *MockAuthenticationMockRecorder) Complete(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockAuthentication)(nil).Complete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockAuthentication)(nil).Complete), ctx, params)
+}
+
+// StepUp mocks base method.
+func (m *MockAuthentication) StepUp(ctx context.Context, params *models.CompleteStepUpParams) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StepUp", ctx, params)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StepUp indicates an expected call of StepUp.
+func (mr *MockAuthenticationMockRecorder) StepUp(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StepUp", reflect.TypeOf((*MockAuthentication)(nil).StepUp), ctx, params)
 }
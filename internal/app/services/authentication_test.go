@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/config"
 	"loki/internal/config/logger"
@@ -33,6 +34,7 @@ func Test_Authentication_Complete(t *testing.T) {
 		},
 		LogLevel: "info",
 	}
+	riskService := NewMockRisk(ctrl)
 	sessionsService := NewMockSessions(ctrl)
 	tokensService := NewMockTokens(ctrl)
 	log := logger.NewLogger(cfg)
@@ -41,7 +43,7 @@ func Test_Authentication_Complete(t *testing.T) {
 	sessionId := id.String()
 	userId := uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac")
 
-	service := NewAuthentication(cfg, sessionsService, tokensService, log)
+	service := NewAuthentication(cfg, riskService, sessionsService, tokensService, log)
 
 	tests := []struct {
 		name     string
@@ -52,13 +54,26 @@ func Test_Authentication_Complete(t *testing.T) {
 		{
 			name: "Success (smart-id)",
 			before: func() {
-				sessionsService.EXPECT().FindById(ctx, sessionId).Return(&models.Session{
-					ID:     id,
-					UserId: userId,
-					Status: AuthenticationSuccess,
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
+					ID:       id,
+					UserId:   userId,
+					Status:   models.SessionSuccess,
+					Provider: models.AuthMethodSmartId,
 				}, nil)
 
-				tokensService.EXPECT().Create(ctx, gomock.Any()).Return(&models.User{
+				riskService.EXPECT().Evaluate(ctx, &models.RiskParams{
+					Stage:     models.RiskStageLogin,
+					UserID:    userId,
+					IPAddress: "127.0.0.1",
+					UserAgent: "Mozilla/5.0",
+				}).Return(&models.RiskAssessment{Score: 50, Action: models.RiskActionReauthenticate})
+
+				tokensService.EXPECT().Create(ctx, &models.CreateLoginSessionParams{
+					UserID:    userId,
+					Provider:  models.AuthMethodSmartId,
+					IPAddress: "127.0.0.1",
+					UserAgent: "Mozilla/5.0",
+				}).Return(&models.User{
 					ID:             userId,
 					IdentityNumber: "PNOEE-30303039914",
 					PersonalCode:   "303039914",
@@ -68,7 +83,6 @@ func Test_Authentication_Complete(t *testing.T) {
 					RefreshToken:   "refresh-token",
 				}, nil)
 
-				sessionsService.EXPECT().Delete(ctx, sessionId).Return(nil)
 			},
 			expected: &models.User{
 				ID:             userId,
@@ -84,12 +98,14 @@ func Test_Authentication_Complete(t *testing.T) {
 		{
 			name: "Success (mobile-id)",
 			before: func() {
-				sessionsService.EXPECT().FindById(ctx, sessionId).Return(&models.Session{
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
 					ID:     id,
 					UserId: userId,
-					Status: AuthenticationSuccess,
+					Status: models.SessionSuccess,
 				}, nil)
 
+				riskService.EXPECT().Evaluate(ctx, gomock.Any()).Return(&models.RiskAssessment{Action: models.RiskActionAllow})
+
 				tokensService.EXPECT().Create(ctx, gomock.Any()).Return(&models.User{
 					ID:             userId,
 					IdentityNumber: "PNOEE-60001017869",
@@ -100,7 +116,6 @@ func Test_Authentication_Complete(t *testing.T) {
 					RefreshToken:   "refresh-token",
 				}, nil)
 
-				sessionsService.EXPECT().Delete(ctx, sessionId).Return(nil)
 			},
 			expected: &models.User{
 				ID:             userId,
@@ -116,48 +131,219 @@ func Test_Authentication_Complete(t *testing.T) {
 		{
 			name: "Error: session not found",
 			before: func() {
-				sessionsService.EXPECT().FindById(ctx, sessionId).Return(nil, assert.AnError)
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(nil, assert.AnError)
 			},
 			expected: nil,
 			error:    assert.AnError,
 		},
+		{
+			name: "Error: session already exchanged",
+			before: func() {
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(nil, errors.ErrSessionNotFound)
+			},
+			expected: nil,
+			error:    errors.ErrSessionNotFound,
+		},
+		{
+			name: "Error: session is running",
+			before: func() {
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionRunning,
+				}, nil)
+			},
+			expected: nil,
+			error:    errors.ErrSessionRunning,
+		},
+		{
+			name: "Error: session failed",
+			before: func() {
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionError,
+				}, nil)
+			},
+			expected: nil,
+			error:    errors.ErrSessionFailed,
+		},
+		{
+			name: "Error: session cancelled",
+			before: func() {
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionCancelled,
+				}, nil)
+			},
+			expected: nil,
+			error:    errors.ErrSessionCancelled,
+		},
+		{
+			name: "Error: session expired",
+			before: func() {
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionExpired,
+				}, nil)
+			},
+			expected: nil,
+			error:    errors.ErrSessionExpired,
+		},
+		{
+			name: "Error: session rejected",
+			before: func() {
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionRejected,
+				}, nil)
+			},
+			expected: nil,
+			error:    errors.ErrSessionRejected,
+		},
+		{
+			name: "Error: successful session without user",
+			before: func() {
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionSuccess,
+				}, nil)
+			},
+			expected: nil,
+			error:    errors.ErrInvalidSessionTransition,
+		},
+		{
+			name: "Error: suspicious login",
+			before: func() {
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
+					ID:     id,
+					UserId: userId,
+					Status: models.SessionSuccess,
+				}, nil)
+
+				riskService.EXPECT().Evaluate(ctx, gomock.Any()).Return(&models.RiskAssessment{Score: 100, Action: models.RiskActionBlock})
+			},
+			expected: nil,
+			error:    errors.ErrSuspiciousLogin,
+		},
 		{
 			name: "Error: failed to create tokens",
 			before: func() {
-				sessionsService.EXPECT().FindById(ctx, sessionId).Return(&models.Session{
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
 					ID:     id,
 					UserId: userId,
-					Status: AuthenticationSuccess,
+					Status: models.SessionSuccess,
 				}, nil)
 
+				riskService.EXPECT().Evaluate(ctx, gomock.Any()).Return(&models.RiskAssessment{Action: models.RiskActionAllow})
+
 				tokensService.EXPECT().Create(ctx, gomock.Any()).Return(nil, assert.AnError)
 			},
 			expected: nil,
 			error:    assert.AnError,
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Complete(ctx, &models.CompleteSessionParams{
+				SessionId: sessionId,
+				IPAddress: "127.0.0.1",
+				UserAgent: "Mozilla/5.0",
+			})
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func Test_Authentication_StepUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	cfg := &config.Config{
+		LogLevel: "info",
+	}
+	sessionsService := NewMockSessions(ctrl)
+	tokensService := NewMockTokens(ctrl)
+	log := logger.NewLogger(cfg)
+
+	id := uuid.MustParse("5eab0e6a-c3e7-4526-a47e-398f0d31f514")
+	sessionId := id.String()
+	loginSessionId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	userId := uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac")
+
+	currentUser := &models.User{
+		ID:             userId,
+		IdentityNumber: "PNOEE-30303039914",
+	}
+
+	service := NewAuthentication(cfg, NewMockRisk(ctrl), sessionsService, tokensService, log)
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.User
+		error    error
+	}{
 		{
-			name: "Error: failed to delete session",
+			name: "Success",
 			before: func() {
-				sessionsService.EXPECT().FindById(ctx, sessionId).Return(&models.Session{
-					ID:     id,
-					UserId: userId,
-					Status: AuthenticationSuccess,
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
+					ID:       id,
+					UserId:   userId,
+					Status:   models.SessionSuccess,
+					Provider: models.AuthMethodMobileId,
 				}, nil)
 
-				tokensService.EXPECT().Create(ctx, gomock.Any()).Return(&models.User{
+				tokensService.EXPECT().StepUp(ctx, &models.StepUpTokenParams{
+					UserID:         userId,
+					IdentityNumber: "PNOEE-30303039914",
+					LoginSessionID: loginSessionId,
+					Provider:       models.AuthMethodMobileId,
+				}).Return(&models.User{
 					ID:             userId,
 					IdentityNumber: "PNOEE-30303039914",
-					PersonalCode:   "303039914",
-					FirstName:      "TESTNUMBER",
-					LastName:       "OK",
-					AccessToken:    "access-token",
-					RefreshToken:   "refresh-token",
+					AccessToken:    "step-up-token",
 				}, nil)
 
-				sessionsService.EXPECT().Delete(ctx, sessionId).Return(assert.AnError)
 			},
-			expected: nil,
-			error:    assert.AnError,
+			expected: &models.User{
+				ID:             userId,
+				IdentityNumber: "PNOEE-30303039914",
+				AccessToken:    "step-up-token",
+			},
+		},
+		{
+			name: "Error: session is running",
+			before: func() {
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionRunning,
+				}, nil)
+			},
+			error: errors.ErrSessionRunning,
+		},
+		{
+			name: "Error: another identity",
+			before: func() {
+				sessionsService.EXPECT().Consume(ctx, sessionId).Return(&models.Session{
+					ID:       id,
+					UserId:   uuid.MustParse("6e3f1f9a-9d5c-4a43-8d1c-7f6b2f1a0b11"),
+					Status:   models.SessionSuccess,
+					Provider: models.AuthMethodSmartId,
+				}, nil)
+
+				tokensService.EXPECT().StepUp(ctx, gomock.Any()).Return(nil, errors.ErrStepUpIdentityMismatch)
+			},
+			error: errors.ErrStepUpIdentityMismatch,
 		},
 	}
 
@@ -165,10 +351,14 @@ func Test_Authentication_Complete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.before()
 
-			result, err := service.Complete(ctx, sessionId)
+			result, err := service.StepUp(ctx, &models.CompleteStepUpParams{
+				SessionId:      sessionId,
+				User:           currentUser,
+				LoginSessionID: loginSessionId,
+			})
 
 			if tt.error != nil {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, tt.error)
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
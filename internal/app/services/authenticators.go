@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/app/repositories/db"
+	"loki/internal/config/logger"
+)
+
+type Authenticators interface {
+	List(ctx context.Context, userId uuid.UUID) ([]models.Authenticator, error)
+	AllowedMethods(ctx context.Context, userId uuid.UUID) ([]string, error)
+	SetAllowedMethods(ctx context.Context, userId uuid.UUID, methods []string) ([]string, error)
+	Authorize(ctx context.Context, userId uuid.UUID, method string) error
+	Record(ctx context.Context, params *models.Authenticator) error
+}
+
+type authenticators struct {
+	repository repositories.AuthenticatorRepository
+	audit      Audit
+	log        *logger.Logger
+}
+
+func NewAuthenticators(repository repositories.AuthenticatorRepository, audit Audit, log *logger.Logger) Authenticators {
+	return &authenticators{
+		repository: repository,
+		audit:      audit,
+		log:        log,
+	}
+}
+
+func (a *authenticators) List(ctx context.Context, userId uuid.UUID) ([]models.Authenticator, error) {
+	result, err := a.repository.List(ctx, userId)
+	if err != nil {
+		a.log.Error().Err(err).Msg("Failed to fetch user authenticators")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	return result, nil
+}
+
+// AllowedMethods returns the methods the user has restricted their account to, an empty list allows every method
+func (a *authenticators) AllowedMethods(ctx context.Context, userId uuid.UUID) ([]string, error) {
+	methods, err := a.repository.FindAllowedMethods(ctx, userId)
+	if err != nil {
+		a.log.Error().Err(err).Msg("Failed to fetch allowed authentication methods")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	if methods == nil {
+		methods = []string{}
+	}
+
+	return methods, nil
+}
+
+func (a *authenticators) SetAllowedMethods(ctx context.Context, userId uuid.UUID, methods []string) ([]string, error) {
+	result := make([]string, 0, len(methods))
+	for _, method := range methods {
+		if !slices.Contains(models.AuthMethods, method) {
+			return nil, errors.ErrInvalidAuthenticationMethod
+		}
+
+		if !slices.Contains(result, method) {
+			result = append(result, method)
+		}
+	}
+	slices.Sort(result)
+
+	if err := a.repository.SetAllowedMethods(ctx, userId, result); err != nil {
+		a.log.Error().Err(err).Msg("Failed to update allowed authentication methods")
+		return nil, errors.ErrFailedToUpdateRecord
+	}
+
+	a.audit.Record(ctx, &models.AuditEvent{
+		Action:     models.AuditAllowedMethodsUpdated,
+		ActorID:    userId,
+		TargetType: "user",
+		TargetID:   userId.String(),
+		Status:     models.AuditSuccess,
+		Changes:    map[string]any{"allowed_methods": result},
+	})
+
+	return result, nil
+}
+
+// Authorize reports whether the user may log in with the method, a lookup failure refuses the login
+func (a *authenticators) Authorize(ctx context.Context, userId uuid.UUID, method string) error {
+	methods, err := a.AllowedMethods(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if len(methods) > 0 && !slices.Contains(methods, method) {
+		return errors.ErrAuthenticationMethodNotAllowed
+	}
+
+	return nil
+}
+
+// Record stores the authenticator the user has just logged in with
+func (a *authenticators) Record(ctx context.Context, params *models.Authenticator) error {
+	if params.Metadata == nil {
+		params.Metadata = map[string]string{}
+	}
+
+	metadata, err := json.Marshal(params.Metadata)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.repository.Upsert(ctx, db.UpsertUserAuthenticatorParams{
+		UserID:     params.UserID,
+		Method:     params.Method,
+		Identifier: params.Identifier,
+		Metadata:   metadata,
+	})
+	if err != nil {
+		a.log.Error().Err(err).Msg("Failed to record user authenticator")
+		return errors.ErrFailedToUpdateRecord
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/authenticators.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/authenticators.go -destination=internal/app/services/authenticators_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthenticators is a mock of Authenticators interface.
+type MockAuthenticators struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthenticatorsMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthenticatorsMockRecorder is the mock recorder for MockAuthenticators.
+type MockAuthenticatorsMockRecorder struct {
+	mock *MockAuthenticators
+}
+
+// NewMockAuthenticators creates a new mock instance.
+func NewMockAuthenticators(ctrl *gomock.Controller) *MockAuthenticators {
+	mock := &MockAuthenticators{ctrl: ctrl}
+	mock.recorder = &MockAuthenticatorsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthenticators) EXPECT() *MockAuthenticatorsMockRecorder {
+	return m.recorder
+}
+
+// AllowedMethods mocks base method.
+func (m *MockAuthenticators) AllowedMethods(ctx context.Context, userId uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowedMethods", ctx, userId)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowedMethods indicates an expected call of AllowedMethods.
+func (mr *MockAuthenticatorsMockRecorder) AllowedMethods(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowedMethods", reflect.TypeOf((*MockAuthenticators)(nil).AllowedMethods), ctx, userId)
+}
+
+// Authorize mocks base method.
+func (m *MockAuthenticators) Authorize(ctx context.Context, userId uuid.UUID, method string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, userId, method)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockAuthenticatorsMockRecorder) Authorize(ctx, userId, method any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthenticators)(nil).Authorize), ctx, userId, method)
+}
+
+// List mocks base method.
+func (m *MockAuthenticators) List(ctx context.Context, userId uuid.UUID) ([]models.Authenticator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userId)
+	ret0, _ := ret[0].([]models.Authenticator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuthenticatorsMockRecorder) List(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuthenticators)(nil).List), ctx, userId)
+}
+
+// Record mocks base method.
+func (m *MockAuthenticators) Record(ctx context.Context, params *models.Authenticator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuthenticatorsMockRecorder) Record(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuthenticators)(nil).Record), ctx, params)
+}
+
+// SetAllowedMethods mocks base method.
+func (m *MockAuthenticators) SetAllowedMethods(ctx context.Context, userId uuid.UUID, methods []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAllowedMethods", ctx, userId, methods)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAllowedMethods indicates an expected call of SetAllowedMethods.
+func (mr *MockAuthenticatorsMockRecorder) SetAllowedMethods(ctx, userId, methods any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllowedMethods", reflect.TypeOf((*MockAuthenticators)(nil).SetAllowedMethods), ctx, userId, methods)
+}
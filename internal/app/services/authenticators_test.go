@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/app/repositories/db"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+func Test_Authenticators_SetAllowedMethods(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockAuthenticatorRepository(ctrl)
+	audit := NewMockAudit(ctrl)
+	service := NewAuthenticators(repository, audit, log)
+
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+
+	tests := []struct {
+		name     string
+		methods  []string
+		before   func()
+		expected []string
+		error    error
+	}{
+		{
+			name:    "Success",
+			methods: []string{models.AuthMethodSmartId, models.AuthMethodMobileId, models.AuthMethodSmartId},
+			before: func() {
+				repository.EXPECT().SetAllowedMethods(ctx, userId, []string{models.AuthMethodMobileId, models.AuthMethodSmartId}).Return(nil)
+				audit.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditAllowedMethodsUpdated,
+					ActorID:    userId,
+					TargetType: "user",
+					TargetID:   userId.String(),
+					Status:     models.AuditSuccess,
+					Changes:    map[string]any{"allowed_methods": []string{models.AuthMethodMobileId, models.AuthMethodSmartId}},
+				})
+			},
+			expected: []string{models.AuthMethodMobileId, models.AuthMethodSmartId},
+		},
+		{
+			name:    "Lift restriction",
+			methods: nil,
+			before: func() {
+				repository.EXPECT().SetAllowedMethods(ctx, userId, []string{}).Return(nil)
+				audit.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditAllowedMethodsUpdated,
+					ActorID:    userId,
+					TargetType: "user",
+					TargetID:   userId.String(),
+					Status:     models.AuditSuccess,
+					Changes:    map[string]any{"allowed_methods": []string{}},
+				})
+			},
+			expected: []string{},
+		},
+		{
+			name:     "Invalid method",
+			methods:  []string{"password"},
+			before:   func() {},
+			expected: nil,
+			error:    errors.ErrInvalidAuthenticationMethod,
+		},
+		{
+			name:    "Error",
+			methods: []string{models.AuthMethodSmartId},
+			before: func() {
+				repository.EXPECT().SetAllowedMethods(ctx, userId, []string{models.AuthMethodSmartId}).Return(assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrFailedToUpdateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.SetAllowedMethods(ctx, userId, tt.methods)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Authenticators_Authorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockAuthenticatorRepository(ctrl)
+	service := NewAuthenticators(repository, NewMockAudit(ctrl), log)
+
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+
+	tests := []struct {
+		name   string
+		before func()
+		error  error
+	}{
+		{
+			name: "No restriction",
+			before: func() {
+				repository.EXPECT().FindAllowedMethods(ctx, userId).Return(nil, nil)
+			},
+		},
+		{
+			name: "Allowed",
+			before: func() {
+				repository.EXPECT().FindAllowedMethods(ctx, userId).Return([]string{models.AuthMethodMobileId}, nil)
+			},
+		},
+		{
+			name: "Not allowed",
+			before: func() {
+				repository.EXPECT().FindAllowedMethods(ctx, userId).Return([]string{models.AuthMethodSmartId}, nil)
+			},
+			error: errors.ErrAuthenticationMethodNotAllowed,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().FindAllowedMethods(ctx, userId).Return(nil, assert.AnError)
+			},
+			error: errors.ErrFailedToFetchResults,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			err := service.Authorize(ctx, userId, models.AuthMethodMobileId)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func Test_Authenticators_Record(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockAuthenticatorRepository(ctrl)
+	service := NewAuthenticators(repository, NewMockAudit(ctrl), log)
+
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+
+	tests := []struct {
+		name   string
+		params *models.Authenticator
+		before func()
+		error  error
+	}{
+		{
+			name: "Success",
+			params: &models.Authenticator{
+				UserID:     userId,
+				Method:     models.AuthMethodMobileId,
+				Identifier: "+37268000769",
+				Metadata:   map[string]string{"identity_number": "PNOEE-30303039914"},
+			},
+			before: func() {
+				repository.EXPECT().Upsert(ctx, db.UpsertUserAuthenticatorParams{
+					UserID:     userId,
+					Method:     models.AuthMethodMobileId,
+					Identifier: "+37268000769",
+					Metadata:   []byte(`{"identity_number":"PNOEE-30303039914"}`),
+				}).Return(&models.Authenticator{}, nil)
+			},
+		},
+		{
+			name: "Empty metadata",
+			params: &models.Authenticator{
+				UserID:     userId,
+				Method:     models.AuthMethodSmartId,
+				Identifier: "PNOEE-30303039914",
+			},
+			before: func() {
+				repository.EXPECT().Upsert(ctx, db.UpsertUserAuthenticatorParams{
+					UserID:     userId,
+					Method:     models.AuthMethodSmartId,
+					Identifier: "PNOEE-30303039914",
+					Metadata:   []byte(`{}`),
+				}).Return(&models.Authenticator{}, nil)
+			},
+		},
+		{
+			name: "Error",
+			params: &models.Authenticator{
+				UserID:     userId,
+				Method:     models.AuthMethodSmartId,
+				Identifier: "PNOEE-30303039914",
+			},
+			before: func() {
+				repository.EXPECT().Upsert(ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+			error: errors.ErrFailedToUpdateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			err := service.Record(ctx, tt.params)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config/logger"
+)
+
+type Confirmations interface {
+	Create(ctx context.Context, confirmation *models.Confirmation) (*models.Confirmation, error)
+	Update(ctx context.Context, params *models.UpdateConfirmationParams) (*models.Confirmation, error)
+	FindById(ctx context.Context, id uuid.UUID) (*models.Confirmation, error)
+	FindByUser(ctx context.Context, userId, id uuid.UUID) (*models.Confirmation, error)
+}
+
+type confirmations struct {
+	repository repositories.ConfirmationRepository
+	log        *logger.Logger
+}
+
+func NewConfirmations(repository repositories.ConfirmationRepository, log *logger.Logger) Confirmations {
+	return &confirmations{
+		repository: repository,
+		log:        log,
+	}
+}
+
+func (s *confirmations) Create(ctx context.Context, confirmation *models.Confirmation) (*models.Confirmation, error) {
+	confirmation.Status = models.SessionRunning
+	confirmation.CreatedAt = time.Now().UTC()
+
+	if err := s.repository.Save(ctx, confirmation); err != nil {
+		s.log.Error().Err(err).Msg("Failed to create confirmation")
+		return nil, err
+	}
+
+	return confirmation, nil
+}
+
+func (s *confirmations) Update(ctx context.Context, params *models.UpdateConfirmationParams) (*models.Confirmation, error) {
+	current, err := s.repository.FindById(ctx, params.ID)
+	if err != nil {
+		s.log.Error().Err(err).Msg("Failed to find confirmation")
+		return nil, err
+	}
+
+	if !current.CanTransitionTo(params.Status) {
+		s.log.Error().Msgf("Confirmation %s cannot transition from %s to %s", params.ID, current.Status, params.Status)
+		return nil, errors.ErrInvalidSessionTransition
+	}
+
+	current.Status = params.Status
+	current.Error = params.Error
+	current.Signature = params.Signature
+	current.Algorithm = params.Algorithm
+	if params.Certificate != "" {
+		current.Certificate = params.Certificate
+	}
+
+	if err = s.repository.Save(ctx, current); err != nil {
+		s.log.Error().Err(err).Msg("Failed to update confirmation")
+		return nil, err
+	}
+
+	return current, nil
+}
+
+func (s *confirmations) FindById(ctx context.Context, id uuid.UUID) (*models.Confirmation, error) {
+	result, err := s.repository.FindById(ctx, id)
+	if err != nil {
+		s.log.Error().Err(err).Msg("Failed to find confirmation")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// FindByUser returns the confirmation only to the user who has started it
+func (s *confirmations) FindByUser(ctx context.Context, userId, id uuid.UUID) (*models.Confirmation, error) {
+	result, err := s.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.UserID != userId {
+		return nil, errors.ErrConfirmationNotFound
+	}
+
+	return result, nil
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/confirmations.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/confirmations.go -destination=internal/app/services/confirmations_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfirmations is a mock of Confirmations interface.
+type MockConfirmations struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfirmationsMockRecorder
+	isgomock struct{}
+}
+
+// MockConfirmationsMockRecorder is the mock recorder for MockConfirmations.
+type MockConfirmationsMockRecorder struct {
+	mock *MockConfirmations
+}
+
+// NewMockConfirmations creates a new mock instance.
+func NewMockConfirmations(ctrl *gomock.Controller) *MockConfirmations {
+	mock := &MockConfirmations{ctrl: ctrl}
+	mock.recorder = &MockConfirmationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfirmations) EXPECT() *MockConfirmationsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockConfirmations) Create(ctx context.Context, confirmation *models.Confirmation) (*models.Confirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, confirmation)
+	ret0, _ := ret[0].(*models.Confirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockConfirmationsMockRecorder) Create(ctx, confirmation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockConfirmations)(nil).Create), ctx, confirmation)
+}
+
+// FindById mocks base method.
+func (m *MockConfirmations) FindById(ctx context.Context, id uuid.UUID) (*models.Confirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*models.Confirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockConfirmationsMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockConfirmations)(nil).FindById), ctx, id)
+}
+
+// FindByUser mocks base method.
+func (m *MockConfirmations) FindByUser(ctx context.Context, userId, id uuid.UUID) (*models.Confirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUser", ctx, userId, id)
+	ret0, _ := ret[0].(*models.Confirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUser indicates an expected call of FindByUser.
+func (mr *MockConfirmationsMockRecorder) FindByUser(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUser", reflect.TypeOf((*MockConfirmations)(nil).FindByUser), ctx, userId, id)
+}
+
+// Update mocks base method.
+func (m *MockConfirmations) Update(ctx context.Context, params *models.UpdateConfirmationParams) (*models.Confirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, params)
+	ret0, _ := ret[0].(*models.Confirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockConfirmationsMockRecorder) Update(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockConfirmations)(nil).Update), ctx, params)
+}
@@ -0,0 +1,251 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+func Test_Confirmations_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockConfirmationRepository(ctrl)
+	service := NewConfirmations(repository, log)
+
+	id := uuid.MustParse("5eab0e6a-c3e7-4526-a47e-398f0d31f514")
+	userId := uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac")
+
+	tests := []struct {
+		name   string
+		before func()
+		error  error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().Save(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			error: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Create(ctx, &models.Confirmation{
+				ID:     id,
+				UserID: userId,
+				Method: models.AuthMethodSmartId,
+				Code:   "1234",
+			})
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, models.SessionRunning, result.Status)
+				assert.False(t, result.CreatedAt.IsZero())
+			}
+		})
+	}
+}
+
+func Test_Confirmations_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockConfirmationRepository(ctrl)
+	service := NewConfirmations(repository, log)
+
+	id := uuid.MustParse("5eab0e6a-c3e7-4526-a47e-398f0d31f514")
+
+	tests := []struct {
+		name     string
+		before   func()
+		params   *models.UpdateConfirmationParams
+		expected *models.Confirmation
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Confirmation{
+					ID:          id,
+					Status:      models.SessionRunning,
+					Certificate: "MIIC",
+				}, nil)
+				repository.EXPECT().Save(ctx, &models.Confirmation{
+					ID:          id,
+					Status:      models.SessionSuccess,
+					Signature:   "c2lnbmF0dXJl",
+					Algorithm:   "sha512WithRSAEncryption",
+					Certificate: "MIIC",
+				}).Return(nil)
+			},
+			params: &models.UpdateConfirmationParams{
+				ID:        id,
+				Status:    models.SessionSuccess,
+				Signature: "c2lnbmF0dXJl",
+				Algorithm: "sha512WithRSAEncryption",
+			},
+			expected: &models.Confirmation{
+				ID:          id,
+				Status:      models.SessionSuccess,
+				Signature:   "c2lnbmF0dXJl",
+				Algorithm:   "sha512WithRSAEncryption",
+				Certificate: "MIIC",
+			},
+		},
+		{
+			name: "Invalid transition",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Confirmation{
+					ID:     id,
+					Status: models.SessionSuccess,
+				}, nil)
+			},
+			params: &models.UpdateConfirmationParams{
+				ID:     id,
+				Status: models.SessionError,
+			},
+			error: errors.ErrInvalidSessionTransition,
+		},
+		{
+			name: "Not found",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(nil, errors.ErrConfirmationNotFound)
+			},
+			params: &models.UpdateConfirmationParams{
+				ID:     id,
+				Status: models.SessionError,
+			},
+			error: errors.ErrConfirmationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Update(ctx, tt.params)
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func Test_Confirmations_FindByUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockConfirmationRepository(ctrl)
+	service := NewConfirmations(repository, log)
+
+	id := uuid.MustParse("5eab0e6a-c3e7-4526-a47e-398f0d31f514")
+	userId := uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac")
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.Confirmation
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Confirmation{
+					ID:     id,
+					UserID: userId,
+					Status: models.SessionRunning,
+				}, nil)
+			},
+			expected: &models.Confirmation{
+				ID:     id,
+				UserID: userId,
+				Status: models.SessionRunning,
+			},
+		},
+		{
+			name: "Other user",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Confirmation{
+					ID:     id,
+					UserID: uuid.MustParse("8e6f7a3c-5d2b-4f1e-9c0a-1b2c3d4e5f60"),
+					Status: models.SessionRunning,
+				}, nil)
+			},
+			error: errors.ErrConfirmationNotFound,
+		},
+		{
+			name: "Not found",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(nil, errors.ErrConfirmationNotFound)
+			},
+			error: errors.ErrConfirmationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.FindByUser(ctx, userId, id)
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
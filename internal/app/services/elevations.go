@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/app/repositories/db"
+	"loki/internal/config/logger"
+	"loki/pkg/jwt"
+	"loki/pkg/rbac"
+)
+
+type Elevations interface {
+	List(ctx context.Context, pagination *Pagination) ([]models.Elevation, uint64, error)
+	FindById(ctx context.Context, id uuid.UUID) (*models.Elevation, error)
+	Request(ctx context.Context, params *models.Elevation) (*models.Elevation, error)
+	Approve(ctx context.Context, id, approverId uuid.UUID, approver *jwt.Payload) (*models.Elevation, error)
+	Reject(ctx context.Context, id, approverId uuid.UUID) (*models.Elevation, error)
+	Expire(ctx context.Context) ([]models.ExpiredGrant, error)
+}
+
+type elevations struct {
+	repository repositories.ElevationRepository
+	role       repositories.RoleRepository
+	log        *logger.Logger
+}
+
+func NewElevations(
+	repository repositories.ElevationRepository,
+	role repositories.RoleRepository,
+	log *logger.Logger,
+) Elevations {
+	return &elevations{
+		repository: repository,
+		role:       role,
+		log:        log,
+	}
+}
+
+func (e *elevations) List(ctx context.Context, pagination *Pagination) ([]models.Elevation, uint64, error) {
+	collection, total, err := e.repository.List(ctx, pagination.Limit(), pagination.Offset())
+
+	if err != nil {
+		e.log.Error().Err(err).Msg("Failed to fetch elevations")
+		return nil, 0, errors.ErrFailedToFetchResults
+	}
+
+	return collection, total, err
+}
+
+func (e *elevations) FindById(ctx context.Context, id uuid.UUID) (*models.Elevation, error) {
+	elevation, err := e.repository.FindById(ctx, id)
+	if err != nil {
+		e.log.Error().Err(err).Msg("Failed to find elevation by id")
+		return nil, errors.ErrElevationNotFound
+	}
+
+	return elevation, nil
+}
+
+func (e *elevations) Request(ctx context.Context, params *models.Elevation) (*models.Elevation, error) {
+	if _, err := e.role.FindById(ctx, params.RoleID); err != nil {
+		e.log.Error().Err(err).Msg("Failed to find role by id")
+		return nil, errors.ErrRoleNotFound
+	}
+
+	elevation, err := e.repository.Create(ctx, db.CreateElevationParams{
+		UserID:          params.UserID,
+		RoleID:          params.RoleID,
+		Reason:          params.Reason,
+		DurationMinutes: int32(params.Duration / time.Minute),
+	})
+	if err != nil {
+		e.log.Error().Err(err).Msg("Failed to create elevation")
+		return nil, errors.ErrFailedToCreateRecord
+	}
+
+	return elevation, nil
+}
+
+// Approve grants the requested role, the approver must already hold every permission the role grants
+func (e *elevations) Approve(ctx context.Context, id, approverId uuid.UUID, approver *jwt.Payload) (*models.Elevation, error) {
+	pending, err := e.decidable(ctx, id, approverId)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := e.role.FindPermissionNames(ctx, pending.RoleID)
+	if err != nil {
+		e.log.Error().Err(err).Msg("Failed to fetch role permissions")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	for _, permission := range permissions {
+		if !rbac.HasPermission(approver, rbac.GlobalResource, permission) {
+			e.log.Warn().Msgf("Approver may not grant role %s without permission: %s", pending.RoleID, permission)
+			return nil, errors.ErrRoleExceedsCallerPermissions
+		}
+	}
+
+	elevation, err := e.repository.Approve(ctx, id, approverId)
+	if err != nil {
+		e.log.Error().Err(err).Msg("Failed to approve elevation")
+		return nil, errors.ErrFailedToUpdateRecord
+	}
+
+	e.log.Info().
+		Str("elevation_id", elevation.ID.String()).
+		Str("user_id", elevation.UserID.String()).
+		Str("role_id", elevation.RoleID.String()).
+		Str("approved_by", approverId.String()).
+		Dur("duration", elevation.Duration).
+		Msg("Role elevation approved")
+
+	return elevation, nil
+}
+
+func (e *elevations) Reject(ctx context.Context, id, approverId uuid.UUID) (*models.Elevation, error) {
+	if _, err := e.decidable(ctx, id, approverId); err != nil {
+		return nil, err
+	}
+
+	elevation, err := e.repository.Reject(ctx, id, approverId)
+	if err != nil {
+		e.log.Error().Err(err).Msg("Failed to reject elevation")
+		return nil, errors.ErrFailedToUpdateRecord
+	}
+
+	return elevation, nil
+}
+
+// Expire removes role and scope assignments whose expiry has passed
+func (e *elevations) Expire(ctx context.Context) ([]models.ExpiredGrant, error) {
+	grants, err := e.repository.DeleteExpiredGrants(ctx)
+	if err != nil {
+		e.log.Error().Err(err).Msg("Failed to delete expired grants")
+		return nil, errors.ErrFailedToDeleteRecord
+	}
+
+	return grants, nil
+}
+
+// decidable checks that the elevation is pending and was not requested by the approver
+func (e *elevations) decidable(ctx context.Context, id, approverId uuid.UUID) (*models.Elevation, error) {
+	elevation, err := e.repository.FindById(ctx, id)
+	if err != nil {
+		e.log.Error().Err(err).Msg("Failed to find elevation by id")
+		return nil, errors.ErrElevationNotFound
+	}
+
+	if elevation.Status != models.ElevationPending {
+		return nil, errors.ErrElevationAlreadyDecided
+	}
+
+	if elevation.UserID == approverId {
+		return nil, errors.ErrSelfApproval
+	}
+
+	return elevation, nil
+}
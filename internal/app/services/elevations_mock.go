@@ -0,0 +1,135 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/elevations.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/elevations.go -destination=internal/app/services/elevations_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	jwt "loki/pkg/jwt"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockElevations is a mock of Elevations interface.
+type MockElevations struct {
+	ctrl     *gomock.Controller
+	recorder *MockElevationsMockRecorder
+	isgomock struct{}
+}
+
+// MockElevationsMockRecorder is the mock recorder for MockElevations.
+type MockElevationsMockRecorder struct {
+	mock *MockElevations
+}
+
+// NewMockElevations creates a new mock instance.
+func NewMockElevations(ctrl *gomock.Controller) *MockElevations {
+	mock := &MockElevations{ctrl: ctrl}
+	mock.recorder = &MockElevationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockElevations) EXPECT() *MockElevationsMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockElevations) Approve(ctx context.Context, id, approverId uuid.UUID, approver *jwt.Payload) (*models.Elevation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, id, approverId, approver)
+	ret0, _ := ret[0].(*models.Elevation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockElevationsMockRecorder) Approve(ctx, id, approverId, approver any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockElevations)(nil).Approve), ctx, id, approverId, approver)
+}
+
+// Expire mocks base method.
+func (m *MockElevations) Expire(ctx context.Context) ([]models.ExpiredGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", ctx)
+	ret0, _ := ret[0].([]models.ExpiredGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockElevationsMockRecorder) Expire(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockElevations)(nil).Expire), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockElevations) FindById(ctx context.Context, id uuid.UUID) (*models.Elevation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*models.Elevation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockElevationsMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockElevations)(nil).FindById), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockElevations) List(ctx context.Context, pagination *Pagination) ([]models.Elevation, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, pagination)
+	ret0, _ := ret[0].([]models.Elevation)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockElevationsMockRecorder) List(ctx, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockElevations)(nil).List), ctx, pagination)
+}
+
+// Reject mocks base method.
+func (m *MockElevations) Reject(ctx context.Context, id, approverId uuid.UUID) (*models.Elevation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, id, approverId)
+	ret0, _ := ret[0].(*models.Elevation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockElevationsMockRecorder) Reject(ctx, id, approverId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockElevations)(nil).Reject), ctx, id, approverId)
+}
+
+// Request mocks base method.
+func (m *MockElevations) Request(ctx context.Context, params *models.Elevation) (*models.Elevation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Request", ctx, params)
+	ret0, _ := ret[0].(*models.Elevation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockElevationsMockRecorder) Request(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockElevations)(nil).Request), ctx, params)
+}
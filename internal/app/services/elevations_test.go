@@ -0,0 +1,350 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/app/repositories/db"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/pkg/jwt"
+	"loki/pkg/rbac"
+)
+
+func Test_Elevations_Request(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockElevationRepository(ctrl)
+	roleRepository := repositories.NewMockRoleRepository(ctrl)
+	service := NewElevations(repository, roleRepository, log)
+
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	roleId := uuid.MustParse("10000000-1000-1000-1000-000000000001")
+
+	params := &models.Elevation{
+		UserID:   userId,
+		RoleID:   roleId,
+		Reason:   "Incident response",
+		Duration: 2 * time.Hour,
+	}
+
+	elevation := &models.Elevation{
+		ID:       uuid.MustParse("10000000-1000-1000-5000-000000000001"),
+		UserID:   userId,
+		RoleID:   roleId,
+		Reason:   "Incident response",
+		Duration: 2 * time.Hour,
+		Status:   models.ElevationPending,
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.Elevation
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				roleRepository.EXPECT().FindById(ctx, roleId).Return(&models.Role{ID: roleId}, nil)
+				repository.EXPECT().Create(ctx, db.CreateElevationParams{
+					UserID:          userId,
+					RoleID:          roleId,
+					Reason:          "Incident response",
+					DurationMinutes: 120,
+				}).Return(elevation, nil)
+			},
+			expected: elevation,
+			error:    nil,
+		},
+		{
+			name: "Role not found",
+			before: func() {
+				roleRepository.EXPECT().FindById(ctx, roleId).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrRoleNotFound,
+		},
+		{
+			name: "Error",
+			before: func() {
+				roleRepository.EXPECT().FindById(ctx, roleId).Return(&models.Role{ID: roleId}, nil)
+				repository.EXPECT().Create(ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrFailedToCreateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Request(ctx, params)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Elevations_Approve(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockElevationRepository(ctrl)
+	roleRepository := repositories.NewMockRoleRepository(ctrl)
+	service := NewElevations(repository, roleRepository, log)
+
+	id := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	approverId := uuid.MustParse("10000000-1000-1000-3000-000000000002")
+
+	pending := &models.Elevation{
+		ID:       id,
+		UserID:   userId,
+		RoleID:   uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+		Duration: time.Hour,
+		Status:   models.ElevationPending,
+	}
+
+	approved := *pending
+	approved.Status = models.ElevationApproved
+	approved.DecidedBy = approverId
+
+	rejected := *pending
+	rejected.Status = models.ElevationRejected
+
+	approver := &jwt.Payload{ID: "PNOEE-60001017869", Permissions: []string{rbac.ReadUsers, rbac.WriteRoles}}
+
+	tests := []struct {
+		name       string
+		before     func()
+		approverId uuid.UUID
+		expected   *models.Elevation
+		error      error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(pending, nil)
+				roleRepository.EXPECT().FindPermissionNames(ctx, pending.RoleID).Return([]string{rbac.ReadUsers}, nil)
+				repository.EXPECT().Approve(ctx, id, approverId).Return(&approved, nil)
+			},
+			approverId: approverId,
+			expected:   &approved,
+			error:      nil,
+		},
+		{
+			name: "Not found",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(nil, assert.AnError)
+			},
+			approverId: approverId,
+			expected:   nil,
+			error:      errors.ErrElevationNotFound,
+		},
+		{
+			name: "Already decided",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&rejected, nil)
+			},
+			approverId: approverId,
+			expected:   nil,
+			error:      errors.ErrElevationAlreadyDecided,
+		},
+		{
+			name: "Self approval",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(pending, nil)
+			},
+			approverId: userId,
+			expected:   nil,
+			error:      errors.ErrSelfApproval,
+		},
+		{
+			name: "Role grants permissions the approver does not hold",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(pending, nil)
+				roleRepository.EXPECT().FindPermissionNames(ctx, pending.RoleID).Return([]string{rbac.ReadUsers, rbac.WriteUsers}, nil)
+			},
+			approverId: approverId,
+			expected:   nil,
+			error:      errors.ErrRoleExceedsCallerPermissions,
+		},
+		{
+			name: "Failed to fetch role permissions",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(pending, nil)
+				roleRepository.EXPECT().FindPermissionNames(ctx, pending.RoleID).Return(nil, assert.AnError)
+			},
+			approverId: approverId,
+			expected:   nil,
+			error:      errors.ErrFailedToFetchResults,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(pending, nil)
+				roleRepository.EXPECT().FindPermissionNames(ctx, pending.RoleID).Return([]string{rbac.ReadUsers}, nil)
+				repository.EXPECT().Approve(ctx, id, approverId).Return(nil, assert.AnError)
+			},
+			approverId: approverId,
+			expected:   nil,
+			error:      errors.ErrFailedToUpdateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Approve(ctx, id, tt.approverId, approver)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Elevations_Reject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockElevationRepository(ctrl)
+	service := NewElevations(repository, repositories.NewMockRoleRepository(ctrl), log)
+
+	id := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	approverId := uuid.MustParse("10000000-1000-1000-3000-000000000002")
+
+	pending := &models.Elevation{ID: id, UserID: userId, Status: models.ElevationPending}
+	rejected := &models.Elevation{ID: id, UserID: userId, Status: models.ElevationRejected, DecidedBy: approverId}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.Elevation
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(pending, nil)
+				repository.EXPECT().Reject(ctx, id, approverId).Return(rejected, nil)
+			},
+			expected: rejected,
+			error:    nil,
+		},
+		{
+			name: "Already decided",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(rejected, nil)
+			},
+			expected: nil,
+			error:    errors.ErrElevationAlreadyDecided,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Reject(ctx, id, approverId)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Elevations_Expire(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockElevationRepository(ctrl)
+	service := NewElevations(repository, repositories.NewMockRoleRepository(ctrl), log)
+
+	grants := []models.ExpiredGrant{
+		{
+			UserID: uuid.MustParse("10000000-1000-1000-3000-000000000001"),
+			RoleID: uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected []models.ExpiredGrant
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().DeleteExpiredGrants(ctx).Return(grants, nil)
+			},
+			expected: grants,
+			error:    nil,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().DeleteExpiredGrants(ctx).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrFailedToDeleteRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Expire(ctx)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
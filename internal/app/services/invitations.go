@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/app/repositories/db"
+	"loki/internal/config/logger"
+	"loki/pkg/validator"
+)
+
+// InvitationTTL is the time an invitation stays valid after it was created or extended
+const InvitationTTL = 14 * 24 * time.Hour
+
+type Invitations interface {
+	List(ctx context.Context, pagination *Pagination) ([]models.Invitation, uint64, error)
+	FindById(ctx context.Context, id uuid.UUID) (*models.Invitation, error)
+	Create(ctx context.Context, country string, params *models.Invitation) (*models.Invitation, error)
+	Extend(ctx context.Context, id, actorId uuid.UUID) (*models.Invitation, error)
+	Revoke(ctx context.Context, id, actorId uuid.UUID) (*models.Invitation, error)
+}
+
+type invitations struct {
+	repository repositories.InvitationRepository
+	users      repositories.UserRepository
+	audit      Audit
+	log        *logger.Logger
+}
+
+func NewInvitations(
+	repository repositories.InvitationRepository,
+	users repositories.UserRepository,
+	audit Audit,
+	log *logger.Logger,
+) Invitations {
+	return &invitations{
+		repository: repository,
+		users:      users,
+		audit:      audit,
+		log:        log,
+	}
+}
+
+func (i *invitations) List(ctx context.Context, pagination *Pagination) ([]models.Invitation, uint64, error) {
+	collection, total, err := i.repository.List(ctx, pagination.Limit(), pagination.Offset())
+
+	if err != nil {
+		i.log.Error().Err(err).Msg("Failed to fetch invitations")
+		return nil, 0, errors.ErrFailedToFetchResults
+	}
+
+	return collection, total, err
+}
+
+func (i *invitations) FindById(ctx context.Context, id uuid.UUID) (*models.Invitation, error) {
+	invitation, err := i.repository.FindById(ctx, id)
+	if err != nil {
+		i.log.Error().Err(err).Msg("Failed to find invitation by id")
+		return nil, errors.ErrInvitationNotFound
+	}
+
+	return invitation, nil
+}
+
+// Create invites the person identified by country and personal code, a person may hold a single pending invitation
+func (i *invitations) Create(ctx context.Context, country string, params *models.Invitation) (*models.Invitation, error) {
+	if err := validator.Country(country); err != nil {
+		return nil, fmt.Errorf("%w: %w", errors.ErrInvalidArguments, err)
+	}
+
+	if err := validator.PersonalCode(country, params.PersonalCode); err != nil {
+		return nil, fmt.Errorf("%w: %w", errors.ErrInvalidArguments, err)
+	}
+
+	identityNumber := identityNumberType + country + "-" + params.PersonalCode
+
+	_, err := i.users.FindByIdentityNumber(ctx, identityNumber)
+	switch {
+	case err == nil:
+		return nil, errors.ErrUserAlreadyRegistered
+	case !errors.Is(err, pgx.ErrNoRows):
+		i.log.Error().Err(err).Msg("Failed to find user by identity number")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	invitation, err := i.repository.Create(ctx, db.CreateInvitationParams{
+		IdentityNumber: identityNumber,
+		PersonalCode:   params.PersonalCode,
+		RoleIds:        params.RoleIDs,
+		ScopeIds:       params.ScopeIDs,
+		InvitedBy:      params.InvitedBy,
+		ExpiresAt:      pgtype.Timestamp{Time: time.Now().Add(InvitationTTL), Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.ErrInvitationAlreadyExists
+		}
+
+		i.log.Error().Err(err).Msg("Failed to create invitation")
+		return nil, errors.ErrFailedToCreateRecord
+	}
+
+	i.record(ctx, models.AuditInvitationCreated, params.InvitedBy, invitation, map[string]any{
+		"identity_number": invitation.IdentityNumber,
+		"role_ids":        uuidStrings(invitation.RoleIDs),
+		"scope_ids":       uuidStrings(invitation.ScopeIDs),
+	})
+
+	return invitation, nil
+}
+
+// Extend renews the expiry of a pending invitation, including one that has already expired.
+// Nothing is delivered to the invited person, the invitation is consumed on their first login.
+func (i *invitations) Extend(ctx context.Context, id, actorId uuid.UUID) (*models.Invitation, error) {
+	if err := i.pending(ctx, id); err != nil {
+		return nil, err
+	}
+
+	invitation, err := i.repository.Extend(ctx, id, time.Now().Add(InvitationTTL))
+	if err != nil {
+		i.log.Error().Err(err).Msg("Failed to extend invitation")
+		return nil, errors.ErrFailedToUpdateRecord
+	}
+
+	i.record(ctx, models.AuditInvitationExtended, actorId, invitation, map[string]any{
+		"expires_at": invitation.ExpiresAt.Format(time.RFC3339),
+	})
+
+	return invitation, nil
+}
+
+func (i *invitations) Revoke(ctx context.Context, id, actorId uuid.UUID) (*models.Invitation, error) {
+	if err := i.pending(ctx, id); err != nil {
+		return nil, err
+	}
+
+	invitation, err := i.repository.Revoke(ctx, id)
+	if err != nil {
+		i.log.Error().Err(err).Msg("Failed to revoke invitation")
+		return nil, errors.ErrFailedToUpdateRecord
+	}
+
+	i.record(ctx, models.AuditInvitationRevoked, actorId, invitation, map[string]any{
+		"status": invitation.Status,
+	})
+
+	return invitation, nil
+}
+
+// record writes the change of the invitation to the audit log
+func (i *invitations) record(ctx context.Context, action string, actorId uuid.UUID, invitation *models.Invitation, changes map[string]any) {
+	i.audit.Record(ctx, &models.AuditEvent{
+		Action:     action,
+		ActorID:    actorId,
+		TargetType: "invitation",
+		TargetID:   invitation.ID.String(),
+		Status:     models.AuditSuccess,
+		Changes:    changes,
+	})
+}
+
+// pending checks that the invitation has been neither accepted nor revoked
+func (i *invitations) pending(ctx context.Context, id uuid.UUID) error {
+	invitation, err := i.repository.FindById(ctx, id)
+	if err != nil {
+		i.log.Error().Err(err).Msg("Failed to find invitation by id")
+		return errors.ErrInvitationNotFound
+	}
+
+	if invitation.Status != models.InvitationPending {
+		return errors.ErrInvitationNotPending
+	}
+
+	return nil
+}
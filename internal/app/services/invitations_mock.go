@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/invitations.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/invitations.go -destination=internal/app/services/invitations_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInvitations is a mock of Invitations interface.
+type MockInvitations struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationsMockRecorder
+	isgomock struct{}
+}
+
+// MockInvitationsMockRecorder is the mock recorder for MockInvitations.
+type MockInvitationsMockRecorder struct {
+	mock *MockInvitations
+}
+
+// NewMockInvitations creates a new mock instance.
+func NewMockInvitations(ctrl *gomock.Controller) *MockInvitations {
+	mock := &MockInvitations{ctrl: ctrl}
+	mock.recorder = &MockInvitationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitations) EXPECT() *MockInvitationsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockInvitations) Create(ctx context.Context, country string, params *models.Invitation) (*models.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, country, params)
+	ret0, _ := ret[0].(*models.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInvitationsMockRecorder) Create(ctx, country, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInvitations)(nil).Create), ctx, country, params)
+}
+
+// Extend mocks base method.
+func (m *MockInvitations) Extend(ctx context.Context, id, actorId uuid.UUID) (*models.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extend", ctx, id, actorId)
+	ret0, _ := ret[0].(*models.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Extend indicates an expected call of Extend.
+func (mr *MockInvitationsMockRecorder) Extend(ctx, id, actorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extend", reflect.TypeOf((*MockInvitations)(nil).Extend), ctx, id, actorId)
+}
+
+// FindById mocks base method.
+func (m *MockInvitations) FindById(ctx context.Context, id uuid.UUID) (*models.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*models.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockInvitationsMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockInvitations)(nil).FindById), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockInvitations) List(ctx context.Context, pagination *Pagination) ([]models.Invitation, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, pagination)
+	ret0, _ := ret[0].([]models.Invitation)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockInvitationsMockRecorder) List(ctx, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInvitations)(nil).List), ctx, pagination)
+}
+
+// Revoke mocks base method.
+func (m *MockInvitations) Revoke(ctx context.Context, id, actorId uuid.UUID) (*models.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, actorId)
+	ret0, _ := ret[0].(*models.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockInvitationsMockRecorder) Revoke(ctx, id, actorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockInvitations)(nil).Revoke), ctx, id, actorId)
+}
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/app/repositories/db"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+func Test_Invitations_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockInvitationRepository(ctrl)
+	users := repositories.NewMockUserRepository(ctrl)
+	audit := NewMockAudit(ctrl)
+	service := NewInvitations(repository, users, audit, log)
+
+	adminId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	roleId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+	scopeId := uuid.MustParse("10000000-1000-1000-2000-000000000002")
+
+	invitation := &models.Invitation{
+		ID:             uuid.MustParse("10000000-1000-1000-6000-000000000001"),
+		IdentityNumber: "PNOEE-30303039914",
+		PersonalCode:   "30303039914",
+		RoleIDs:        []uuid.UUID{roleId},
+		ScopeIDs:       []uuid.UUID{scopeId},
+		Status:         models.InvitationPending,
+		InvitedBy:      adminId,
+	}
+
+	params := &models.Invitation{
+		PersonalCode: "30303039914",
+		RoleIDs:      []uuid.UUID{roleId},
+		ScopeIDs:     []uuid.UUID{scopeId},
+		InvitedBy:    adminId,
+	}
+
+	tests := []struct {
+		name     string
+		country  string
+		params   *models.Invitation
+		before   func()
+		expected *models.Invitation
+		error    error
+	}{
+		{
+			name:    "Success",
+			country: "EE",
+			params:  params,
+			before: func() {
+				users.EXPECT().FindByIdentityNumber(ctx, "PNOEE-30303039914").Return(nil, pgx.ErrNoRows)
+				repository.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, params db.CreateInvitationParams) (*models.Invitation, error) {
+						assert.Equal(t, "PNOEE-30303039914", params.IdentityNumber)
+						assert.Equal(t, "30303039914", params.PersonalCode)
+						assert.Equal(t, []uuid.UUID{roleId}, params.RoleIds)
+						assert.Equal(t, []uuid.UUID{scopeId}, params.ScopeIds)
+						assert.Equal(t, adminId, params.InvitedBy)
+						assert.WithinDuration(t, time.Now().Add(InvitationTTL), params.ExpiresAt.Time, time.Minute)
+
+						return invitation, nil
+					})
+				audit.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditInvitationCreated,
+					ActorID:    adminId,
+					TargetType: "invitation",
+					TargetID:   invitation.ID.String(),
+					Status:     models.AuditSuccess,
+					Changes: map[string]any{
+						"identity_number": "PNOEE-30303039914",
+						"role_ids":        []string{roleId.String()},
+						"scope_ids":       []string{scopeId.String()},
+					},
+				})
+			},
+			expected: invitation,
+		},
+		{
+			name:     "Invalid country",
+			country:  "FI",
+			params:   params,
+			before:   func() {},
+			expected: nil,
+			error:    errors.ErrInvalidArguments,
+		},
+		{
+			name:     "Invalid personal code",
+			country:  "EE",
+			params:   &models.Invitation{PersonalCode: "30303039915", InvitedBy: adminId},
+			before:   func() {},
+			expected: nil,
+			error:    errors.ErrInvalidArguments,
+		},
+		{
+			name:    "Already registered",
+			country: "EE",
+			params:  params,
+			before: func() {
+				users.EXPECT().FindByIdentityNumber(ctx, "PNOEE-30303039914").Return(&models.User{}, nil)
+			},
+			expected: nil,
+			error:    errors.ErrUserAlreadyRegistered,
+		},
+		{
+			name:    "Already invited",
+			country: "EE",
+			params:  params,
+			before: func() {
+				users.EXPECT().FindByIdentityNumber(ctx, "PNOEE-30303039914").Return(nil, pgx.ErrNoRows)
+				repository.EXPECT().Create(ctx, gomock.Any()).Return(nil, pgx.ErrNoRows)
+			},
+			expected: nil,
+			error:    errors.ErrInvitationAlreadyExists,
+		},
+		{
+			name:    "Error",
+			country: "EE",
+			params:  params,
+			before: func() {
+				users.EXPECT().FindByIdentityNumber(ctx, "PNOEE-30303039914").Return(nil, pgx.ErrNoRows)
+				repository.EXPECT().Create(ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrFailedToCreateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Create(ctx, tt.country, tt.params)
+
+			assert.ErrorIs(t, err, tt.error)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Invitations_Extend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockInvitationRepository(ctrl)
+	audit := NewMockAudit(ctrl)
+	service := NewInvitations(repository, repositories.NewMockUserRepository(ctrl), audit, log)
+
+	id := uuid.MustParse("10000000-1000-1000-6000-000000000001")
+	adminId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+
+	expiresAt := time.Date(2026, 11, 2, 12, 0, 0, 0, time.UTC)
+	invitation := &models.Invitation{ID: id, Status: models.InvitationPending, ExpiresAt: expiresAt}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.Invitation
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(invitation, nil)
+				repository.EXPECT().Extend(ctx, id, gomock.Any()).Return(invitation, nil)
+				audit.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditInvitationExtended,
+					ActorID:    adminId,
+					TargetType: "invitation",
+					TargetID:   id.String(),
+					Status:     models.AuditSuccess,
+					Changes:    map[string]any{"expires_at": "2026-11-02T12:00:00Z"},
+				})
+			},
+			expected: invitation,
+		},
+		{
+			name: "Not found",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(nil, pgx.ErrNoRows)
+			},
+			error: errors.ErrInvitationNotFound,
+		},
+		{
+			name: "Already accepted",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Invitation{ID: id, Status: models.InvitationAccepted}, nil)
+			},
+			error: errors.ErrInvitationNotPending,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(invitation, nil)
+				repository.EXPECT().Extend(ctx, id, gomock.Any()).Return(nil, assert.AnError)
+			},
+			error: errors.ErrFailedToUpdateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Extend(ctx, id, adminId)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Invitations_Revoke(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockInvitationRepository(ctrl)
+	audit := NewMockAudit(ctrl)
+	service := NewInvitations(repository, repositories.NewMockUserRepository(ctrl), audit, log)
+
+	id := uuid.MustParse("10000000-1000-1000-6000-000000000001")
+	adminId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.Invitation
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Invitation{ID: id, Status: models.InvitationPending}, nil)
+				repository.EXPECT().Revoke(ctx, id).Return(&models.Invitation{ID: id, Status: models.InvitationRevoked}, nil)
+				audit.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditInvitationRevoked,
+					ActorID:    adminId,
+					TargetType: "invitation",
+					TargetID:   id.String(),
+					Status:     models.AuditSuccess,
+					Changes:    map[string]any{"status": models.InvitationRevoked},
+				})
+			},
+			expected: &models.Invitation{ID: id, Status: models.InvitationRevoked},
+		},
+		{
+			name: "Already revoked",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Invitation{ID: id, Status: models.InvitationRevoked}, nil)
+			},
+			error: errors.ErrInvitationNotPending,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Invitation{ID: id, Status: models.InvitationPending}, nil)
+				repository.EXPECT().Revoke(ctx, id).Return(nil, assert.AnError)
+			},
+			error: errors.ErrFailedToUpdateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Revoke(ctx, id, adminId)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
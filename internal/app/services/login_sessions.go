@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config/logger"
+)
+
+// LoginHistoryLimit caps the number of logins returned in the history of a user
+const LoginHistoryLimit = 100
+
+type LoginSessions interface {
+	List(ctx context.Context, userId uuid.UUID) ([]models.LoginSession, error)
+	ListActive(ctx context.Context, userId uuid.UUID) ([]models.LoginSession, error)
+	Revoke(ctx context.Context, userId, id uuid.UUID) error
+}
+
+type loginSessions struct {
+	repository repositories.LoginSessionRepository
+	audit      Audit
+	log        *logger.Logger
+}
+
+func NewLoginSessions(repository repositories.LoginSessionRepository, audit Audit, log *logger.Logger) LoginSessions {
+	return &loginSessions{
+		repository: repository,
+		audit:      audit,
+		log:        log,
+	}
+}
+
+// List returns the most recent logins of the user including expired and revoked sessions
+func (l *loginSessions) List(ctx context.Context, userId uuid.UUID) ([]models.LoginSession, error) {
+	result, err := l.repository.List(ctx, userId, LoginHistoryLimit)
+	if err != nil {
+		l.log.Error().Err(err).Msg("Failed to fetch login sessions")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	return result, nil
+}
+
+// ListActive returns the sessions whose refresh token can still be used
+func (l *loginSessions) ListActive(ctx context.Context, userId uuid.UUID) ([]models.LoginSession, error) {
+	result, err := l.repository.ListActive(ctx, userId, time.Now().Add(-models.RefreshTokenExp))
+	if err != nil {
+		l.log.Error().Err(err).Msg("Failed to fetch active login sessions")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	return result, nil
+}
+
+// Revoke ends the session and invalidates every token issued for it
+func (l *loginSessions) Revoke(ctx context.Context, userId, id uuid.UUID) error {
+	if err := l.repository.Revoke(ctx, id, userId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.ErrRecordNotFound
+		}
+
+		l.log.Error().Err(err).Msg("Failed to revoke login session")
+		return errors.ErrFailedToUpdateRecord
+	}
+
+	l.audit.Record(ctx, &models.AuditEvent{
+		Action:     models.AuditLoginSessionRevoked,
+		ActorID:    userId,
+		TargetType: "login_session",
+		TargetID:   id.String(),
+		Status:     models.AuditSuccess,
+	})
+
+	return nil
+}
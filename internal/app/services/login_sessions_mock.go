@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/login_sessions.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/login_sessions.go -destination=internal/app/services/login_sessions_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginSessions is a mock of LoginSessions interface.
+type MockLoginSessions struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginSessionsMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginSessionsMockRecorder is the mock recorder for MockLoginSessions.
+type MockLoginSessionsMockRecorder struct {
+	mock *MockLoginSessions
+}
+
+// NewMockLoginSessions creates a new mock instance.
+func NewMockLoginSessions(ctrl *gomock.Controller) *MockLoginSessions {
+	mock := &MockLoginSessions{ctrl: ctrl}
+	mock.recorder = &MockLoginSessionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginSessions) EXPECT() *MockLoginSessionsMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockLoginSessions) List(ctx context.Context, userId uuid.UUID) ([]models.LoginSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userId)
+	ret0, _ := ret[0].([]models.LoginSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLoginSessionsMockRecorder) List(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoginSessions)(nil).List), ctx, userId)
+}
+
+// ListActive mocks base method.
+func (m *MockLoginSessions) ListActive(ctx context.Context, userId uuid.UUID) ([]models.LoginSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActive", ctx, userId)
+	ret0, _ := ret[0].([]models.LoginSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActive indicates an expected call of ListActive.
+func (mr *MockLoginSessionsMockRecorder) ListActive(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActive", reflect.TypeOf((*MockLoginSessions)(nil).ListActive), ctx, userId)
+}
+
+// Revoke mocks base method.
+func (m *MockLoginSessions) Revoke(ctx context.Context, userId, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockLoginSessionsMockRecorder) Revoke(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockLoginSessions)(nil).Revoke), ctx, userId, id)
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+func Test_LoginSessions_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockLoginSessionRepository(ctrl)
+	service := NewLoginSessions(repository, NewMockAudit(ctrl), log)
+
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	sessions := []models.LoginSession{
+		{
+			ID:       uuid.MustParse("10000000-1000-1000-5000-000000000001"),
+			UserID:   userId,
+			Provider: models.AuthMethodSmartId,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected []models.LoginSession
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().List(ctx, userId, uint64(LoginHistoryLimit)).Return(sessions, nil)
+			},
+			expected: sessions,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().List(ctx, userId, uint64(LoginHistoryLimit)).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrFailedToFetchResults,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.List(ctx, userId)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_LoginSessions_Revoke(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockLoginSessionRepository(ctrl)
+	audit := NewMockAudit(ctrl)
+	service := NewLoginSessions(repository, audit, log)
+
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	id := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+
+	tests := []struct {
+		name   string
+		before func()
+		error  error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().Revoke(ctx, id, userId).Return(nil)
+				audit.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditLoginSessionRevoked,
+					ActorID:    userId,
+					TargetType: "login_session",
+					TargetID:   id.String(),
+					Status:     models.AuditSuccess,
+				})
+			},
+		},
+		{
+			name: "Not found",
+			before: func() {
+				repository.EXPECT().Revoke(ctx, id, userId).Return(pgx.ErrNoRows)
+			},
+			error: errors.ErrRecordNotFound,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().Revoke(ctx, id, userId).Return(assert.AnError)
+			},
+			error: errors.ErrFailedToUpdateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			err := service.Revoke(ctx, userId, id)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -4,11 +4,24 @@ import "go.uber.org/fx"
 
 var Module = fx.Options(
 	fx.Provide(NewHealthChecker),
+	fx.Provide(NewAudit),
 	fx.Provide(NewAuthentication),
+	fx.Provide(NewAuthenticators),
 	fx.Provide(NewSessions),
+	fx.Provide(NewConfirmations),
+	fx.Provide(NewElevations),
+	fx.Provide(NewInvitations),
+	fx.Provide(NewLoginSessions),
+	fx.Provide(NewOrganisations),
 	fx.Provide(NewPermissions),
+	fx.Provide(NewPolicies),
+	fx.Provide(NewProfiles),
+	fx.Provide(NewProvisioning),
+	fx.Provide(NewRisk),
 	fx.Provide(NewRoles),
 	fx.Provide(NewScopes),
+	fx.Provide(NewThrottle),
 	fx.Provide(NewTokens),
 	fx.Provide(NewUsers),
+	fx.Provide(NewUserTransfer),
 )
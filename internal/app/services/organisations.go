@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/app/repositories/db"
+	"loki/internal/config/logger"
+	"loki/pkg/jwt"
+	"loki/pkg/rbac"
+)
+
+type Organisations interface {
+	List(ctx context.Context, pagination *Pagination) ([]models.Organisation, uint64, error)
+	Create(ctx context.Context, params *models.Organisation) (*models.Organisation, error)
+	Update(ctx context.Context, params *models.Organisation) (*models.Organisation, error)
+	FindById(ctx context.Context, id uuid.UUID) (*models.Organisation, error)
+	Delete(ctx context.Context, id uuid.UUID) (bool, error)
+
+	ListMemberships(ctx context.Context, id uuid.UUID) ([]models.Membership, error)
+	AddMembership(ctx context.Context, params *models.Membership, caller *jwt.Payload) (*models.Membership, error)
+	RemoveMembership(ctx context.Context, params *models.Membership) (bool, error)
+}
+
+type organisations struct {
+	repository repositories.OrganisationRepository
+	role       repositories.RoleRepository
+	user       repositories.UserRepository
+	log        *logger.Logger
+}
+
+func NewOrganisations(
+	repository repositories.OrganisationRepository,
+	role repositories.RoleRepository,
+	user repositories.UserRepository,
+	log *logger.Logger,
+) Organisations {
+	return &organisations{
+		repository: repository,
+		role:       role,
+		user:       user,
+		log:        log,
+	}
+}
+
+func (o *organisations) List(ctx context.Context, pagination *Pagination) ([]models.Organisation, uint64, error) {
+	collection, total, err := o.repository.List(ctx, pagination.Limit(), pagination.Offset())
+
+	if err != nil {
+		o.log.Error().Err(err).Msg("Failed to fetch organisations")
+		return nil, 0, errors.ErrFailedToFetchResults
+	}
+
+	return collection, total, err
+}
+
+func (o *organisations) Create(ctx context.Context, params *models.Organisation) (*models.Organisation, error) {
+	organisation, err := o.repository.Create(ctx, db.CreateOrganisationParams{
+		Name:        params.Name,
+		Description: params.Description,
+	})
+	if err != nil {
+		o.log.Error().Err(err).Msg("Failed to create organisation")
+		return nil, errors.ErrFailedToCreateRecord
+	}
+
+	return organisation, nil
+}
+
+func (o *organisations) Update(ctx context.Context, params *models.Organisation) (*models.Organisation, error) {
+	organisation, err := o.repository.Update(ctx, db.UpdateOrganisationParams{
+		ID:          params.ID,
+		Name:        params.Name,
+		Description: params.Description,
+	})
+	if err != nil {
+		o.log.Error().Err(err).Msg("Failed to update organisation")
+		return nil, errors.ErrFailedToUpdateRecord
+	}
+
+	return organisation, nil
+}
+
+func (o *organisations) FindById(ctx context.Context, id uuid.UUID) (*models.Organisation, error) {
+	organisation, err := o.repository.FindById(ctx, id)
+	if err != nil {
+		o.log.Error().Err(err).Msg("Failed to find organisation by id")
+		return nil, errors.ErrRecordNotFound
+	}
+
+	return organisation, nil
+}
+
+func (o *organisations) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
+	ok, err := o.repository.Delete(ctx, id)
+	if err != nil {
+		o.log.Error().Err(err).Msg("Failed to delete organisation")
+		return false, errors.ErrFailedToDeleteRecord
+	}
+
+	return ok, nil
+}
+
+func (o *organisations) ListMemberships(ctx context.Context, id uuid.UUID) ([]models.Membership, error) {
+	if _, err := o.repository.FindById(ctx, id); err != nil {
+		o.log.Error().Err(err).Msg("Failed to find organisation by id")
+		return nil, errors.ErrOrganisationNotFound
+	}
+
+	memberships, err := o.repository.FindMemberships(ctx, id)
+	if err != nil {
+		o.log.Error().Err(err).Msg("Failed to fetch memberships")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	return memberships, nil
+}
+
+// AddMembership assigns the role in the organisation, the caller must already hold every permission the role grants
+func (o *organisations) AddMembership(ctx context.Context, params *models.Membership, caller *jwt.Payload) (*models.Membership, error) {
+	if _, err := o.repository.FindById(ctx, params.OrganisationID); err != nil {
+		o.log.Error().Err(err).Msg("Failed to find organisation by id")
+		return nil, errors.ErrOrganisationNotFound
+	}
+
+	if _, err := o.role.FindById(ctx, params.RoleID); err != nil {
+		o.log.Error().Err(err).Msg("Failed to find role by id")
+		return nil, errors.ErrRoleNotFound
+	}
+
+	permissions, err := o.role.FindPermissionNames(ctx, params.RoleID)
+	if err != nil {
+		o.log.Error().Err(err).Msg("Failed to fetch role permissions")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	for _, permission := range permissions {
+		if !rbac.HasPermission(caller, params.OrganisationID.String(), permission) {
+			o.log.Warn().Msgf("Caller may not assign role %s without permission: %s", params.RoleID, permission)
+			return nil, errors.ErrRoleExceedsCallerPermissions
+		}
+	}
+
+	if _, err := o.user.FindById(ctx, params.UserID); err != nil {
+		o.log.Error().Err(err).Msg("Failed to find user by id")
+		return nil, errors.ErrUserNotFound
+	}
+
+	membership, err := o.repository.CreateMembership(ctx, db.CreateMembershipParams{
+		UserID:         params.UserID,
+		OrganisationID: params.OrganisationID,
+		RoleID:         params.RoleID,
+	})
+	if err != nil {
+		o.log.Error().Err(err).Msg("Failed to create membership")
+		return nil, errors.ErrFailedToCreateRecord
+	}
+
+	return membership, nil
+}
+
+func (o *organisations) RemoveMembership(ctx context.Context, params *models.Membership) (bool, error) {
+	ok, err := o.repository.DeleteMembership(ctx, db.DeleteMembershipParams{
+		UserID:         params.UserID,
+		OrganisationID: params.OrganisationID,
+		RoleID:         params.RoleID,
+	})
+	if err != nil {
+		o.log.Error().Err(err).Msg("Failed to delete membership")
+		return false, errors.ErrFailedToDeleteRecord
+	}
+
+	if !ok {
+		return false, errors.ErrMembershipNotFound
+	}
+
+	return true, nil
+}
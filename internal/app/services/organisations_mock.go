@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/organisations.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/organisations.go -destination=internal/app/services/organisations_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	jwt "loki/pkg/jwt"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrganisations is a mock of Organisations interface.
+type MockOrganisations struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganisationsMockRecorder
+	isgomock struct{}
+}
+
+// MockOrganisationsMockRecorder is the mock recorder for MockOrganisations.
+type MockOrganisationsMockRecorder struct {
+	mock *MockOrganisations
+}
+
+// NewMockOrganisations creates a new mock instance.
+func NewMockOrganisations(ctrl *gomock.Controller) *MockOrganisations {
+	mock := &MockOrganisations{ctrl: ctrl}
+	mock.recorder = &MockOrganisationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganisations) EXPECT() *MockOrganisationsMockRecorder {
+	return m.recorder
+}
+
+// AddMembership mocks base method.
+func (m *MockOrganisations) AddMembership(ctx context.Context, params *models.Membership, caller *jwt.Payload) (*models.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMembership", ctx, params, caller)
+	ret0, _ := ret[0].(*models.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMembership indicates an expected call of AddMembership.
+func (mr *MockOrganisationsMockRecorder) AddMembership(ctx, params, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMembership", reflect.TypeOf((*MockOrganisations)(nil).AddMembership), ctx, params, caller)
+}
+
+// Create mocks base method.
+func (m *MockOrganisations) Create(ctx context.Context, params *models.Organisation) (*models.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, params)
+	ret0, _ := ret[0].(*models.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrganisationsMockRecorder) Create(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganisations)(nil).Create), ctx, params)
+}
+
+// Delete mocks base method.
+func (m *MockOrganisations) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOrganisationsMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrganisations)(nil).Delete), ctx, id)
+}
+
+// FindById mocks base method.
+func (m *MockOrganisations) FindById(ctx context.Context, id uuid.UUID) (*models.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*models.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockOrganisationsMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockOrganisations)(nil).FindById), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockOrganisations) List(ctx context.Context, pagination *Pagination) ([]models.Organisation, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, pagination)
+	ret0, _ := ret[0].([]models.Organisation)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockOrganisationsMockRecorder) List(ctx, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrganisations)(nil).List), ctx, pagination)
+}
+
+// ListMemberships mocks base method.
+func (m *MockOrganisations) ListMemberships(ctx context.Context, id uuid.UUID) ([]models.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberships", ctx, id)
+	ret0, _ := ret[0].([]models.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberships indicates an expected call of ListMemberships.
+func (mr *MockOrganisationsMockRecorder) ListMemberships(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberships", reflect.TypeOf((*MockOrganisations)(nil).ListMemberships), ctx, id)
+}
+
+// RemoveMembership mocks base method.
+func (m *MockOrganisations) RemoveMembership(ctx context.Context, params *models.Membership) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMembership", ctx, params)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMembership indicates an expected call of RemoveMembership.
+func (mr *MockOrganisationsMockRecorder) RemoveMembership(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMembership", reflect.TypeOf((*MockOrganisations)(nil).RemoveMembership), ctx, params)
+}
+
+// Update mocks base method.
+func (m *MockOrganisations) Update(ctx context.Context, params *models.Organisation) (*models.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, params)
+	ret0, _ := ret[0].(*models.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOrganisationsMockRecorder) Update(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganisations)(nil).Update), ctx, params)
+}
@@ -0,0 +1,274 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/app/repositories/db"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/pkg/jwt"
+)
+
+func Test_Organisations_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockOrganisationRepository(ctrl)
+	service := NewOrganisations(repository, repositories.NewMockRoleRepository(ctrl), repositories.NewMockUserRepository(ctrl), log)
+
+	organisation := models.Organisation{
+		ID:          uuid.MustParse("10000000-1000-1000-4000-000000000001"),
+		Name:        "Acme",
+		Description: "Acme organisation",
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected []models.Organisation
+		total    uint64
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().List(ctx, uint64(10), uint64(0)).Return([]models.Organisation{organisation}, uint64(1), nil)
+			},
+			expected: []models.Organisation{organisation},
+			total:    uint64(1),
+			error:    nil,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().List(ctx, uint64(10), uint64(0)).Return(nil, uint64(0), assert.AnError)
+			},
+			expected: nil,
+			total:    0,
+			error:    errors.ErrFailedToFetchResults,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, total, err := service.List(ctx, &Pagination{Page: 1, PerPage: 10})
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.total, total)
+		})
+	}
+}
+
+func Test_Organisations_AddMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockOrganisationRepository(ctrl)
+	roleRepository := repositories.NewMockRoleRepository(ctrl)
+	userRepository := repositories.NewMockUserRepository(ctrl)
+	service := NewOrganisations(repository, roleRepository, userRepository, log)
+
+	membership := &models.Membership{
+		UserID:         uuid.MustParse("10000000-1000-1000-5000-000000000001"),
+		OrganisationID: uuid.MustParse("10000000-1000-1000-4000-000000000001"),
+		RoleID:         uuid.MustParse("10000000-1000-1000-1000-000000000002"),
+	}
+	caller := &jwt.Payload{
+		Organisations: map[string]jwt.Organisation{
+			membership.OrganisationID.String(): {Permissions: []string{"read:self", "write:organisations"}},
+		},
+	}
+	params := db.CreateMembershipParams{
+		UserID:         membership.UserID,
+		OrganisationID: membership.OrganisationID,
+		RoleID:         membership.RoleID,
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.Membership
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().FindById(ctx, membership.OrganisationID).Return(&models.Organisation{ID: membership.OrganisationID}, nil)
+				roleRepository.EXPECT().FindById(ctx, membership.RoleID).Return(&models.Role{ID: membership.RoleID}, nil)
+				roleRepository.EXPECT().FindPermissionNames(ctx, membership.RoleID).Return([]string{"read:self"}, nil)
+				userRepository.EXPECT().FindById(ctx, membership.UserID).Return(&models.User{ID: membership.UserID}, nil)
+				repository.EXPECT().CreateMembership(ctx, params).Return(membership, nil)
+			},
+			expected: membership,
+			error:    nil,
+		},
+		{
+			name: "Error organisation not found",
+			before: func() {
+				repository.EXPECT().FindById(ctx, membership.OrganisationID).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrOrganisationNotFound,
+		},
+		{
+			name: "Error role not found",
+			before: func() {
+				repository.EXPECT().FindById(ctx, membership.OrganisationID).Return(&models.Organisation{ID: membership.OrganisationID}, nil)
+				roleRepository.EXPECT().FindById(ctx, membership.RoleID).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrRoleNotFound,
+		},
+		{
+			name: "Error role grants permissions the caller does not hold",
+			before: func() {
+				repository.EXPECT().FindById(ctx, membership.OrganisationID).Return(&models.Organisation{ID: membership.OrganisationID}, nil)
+				roleRepository.EXPECT().FindById(ctx, membership.RoleID).Return(&models.Role{ID: membership.RoleID}, nil)
+				roleRepository.EXPECT().FindPermissionNames(ctx, membership.RoleID).Return([]string{"read:self", "write:roles", "write:users"}, nil)
+			},
+			expected: nil,
+			error:    errors.ErrRoleExceedsCallerPermissions,
+		},
+		{
+			name: "Error failed to fetch role permissions",
+			before: func() {
+				repository.EXPECT().FindById(ctx, membership.OrganisationID).Return(&models.Organisation{ID: membership.OrganisationID}, nil)
+				roleRepository.EXPECT().FindById(ctx, membership.RoleID).Return(&models.Role{ID: membership.RoleID}, nil)
+				roleRepository.EXPECT().FindPermissionNames(ctx, membership.RoleID).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrFailedToFetchResults,
+		},
+		{
+			name: "Error user not found",
+			before: func() {
+				repository.EXPECT().FindById(ctx, membership.OrganisationID).Return(&models.Organisation{ID: membership.OrganisationID}, nil)
+				roleRepository.EXPECT().FindById(ctx, membership.RoleID).Return(&models.Role{ID: membership.RoleID}, nil)
+				roleRepository.EXPECT().FindPermissionNames(ctx, membership.RoleID).Return([]string{"read:self"}, nil)
+				userRepository.EXPECT().FindById(ctx, membership.UserID).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrUserNotFound,
+		},
+		{
+			name: "Error failed to create membership",
+			before: func() {
+				repository.EXPECT().FindById(ctx, membership.OrganisationID).Return(&models.Organisation{ID: membership.OrganisationID}, nil)
+				roleRepository.EXPECT().FindById(ctx, membership.RoleID).Return(&models.Role{ID: membership.RoleID}, nil)
+				roleRepository.EXPECT().FindPermissionNames(ctx, membership.RoleID).Return([]string{"read:self"}, nil)
+				userRepository.EXPECT().FindById(ctx, membership.UserID).Return(&models.User{ID: membership.UserID}, nil)
+				repository.EXPECT().CreateMembership(ctx, params).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrFailedToCreateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.AddMembership(ctx, membership, caller)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Organisations_RemoveMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockOrganisationRepository(ctrl)
+	service := NewOrganisations(repository, repositories.NewMockRoleRepository(ctrl), repositories.NewMockUserRepository(ctrl), log)
+
+	membership := &models.Membership{
+		UserID:         uuid.MustParse("10000000-1000-1000-5000-000000000001"),
+		OrganisationID: uuid.MustParse("10000000-1000-1000-4000-000000000001"),
+		RoleID:         uuid.MustParse("10000000-1000-1000-1000-000000000002"),
+	}
+	params := db.DeleteMembershipParams{
+		UserID:         membership.UserID,
+		OrganisationID: membership.OrganisationID,
+		RoleID:         membership.RoleID,
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected bool
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().DeleteMembership(ctx, params).Return(true, nil)
+			},
+			expected: true,
+			error:    nil,
+		},
+		{
+			name: "Error membership not found",
+			before: func() {
+				repository.EXPECT().DeleteMembership(ctx, params).Return(false, nil)
+			},
+			expected: false,
+			error:    errors.ErrMembershipNotFound,
+		},
+		{
+			name: "Error failed to delete membership",
+			before: func() {
+				repository.EXPECT().DeleteMembership(ctx, params).Return(false, assert.AnError)
+			},
+			expected: false,
+			error:    errors.ErrFailedToDeleteRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.RemoveMembership(ctx, membership)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/repositories"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/pkg/policy"
+)
+
+type Policies interface {
+	Evaluate(ctx context.Context, req *policy.Request) (bool, error)
+	Reload(ctx context.Context) error
+}
+
+type policies struct {
+	cfg        *config.Config
+	repository repositories.PolicyRepository
+	engine     policy.Engine
+	log        *logger.Logger
+
+	mu     sync.Mutex
+	loaded atomic.Bool
+}
+
+// NewPolicies loads policies from POLICIES_PATH when configured, database policies are loaded by the policies worker
+// and every request is denied until the first load succeeds
+func NewPolicies(cfg *config.Config, repository repositories.PolicyRepository, log *logger.Logger) (Policies, error) {
+	engine, err := policy.NewEngine(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &policies{
+		cfg:        cfg,
+		repository: repository,
+		engine:     engine,
+		log:        log,
+	}
+
+	if cfg.PoliciesPath != "" {
+		if err = p.Reload(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+func (p *policies) Evaluate(_ context.Context, req *policy.Request) (bool, error) {
+	if !p.loaded.Load() {
+		p.log.Error().Str("action", req.Action).Msg("Policies are not loaded yet")
+		return false, errors.ErrPoliciesNotLoaded
+	}
+
+	allowed, err := p.engine.Evaluate(req)
+	if err != nil {
+		p.log.Error().Err(err).Str("action", req.Action).Msg("Failed to evaluate policies")
+		return false, err
+	}
+
+	return allowed, nil
+}
+
+func (p *policies) Reload(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var collection []policy.Policy
+
+	if p.cfg.PoliciesPath != "" {
+		result, err := policy.LoadFile(p.cfg.PoliciesPath)
+		if err != nil {
+			p.log.Error().Err(err).Str("path", p.cfg.PoliciesPath).Msg("Failed to read policies file")
+			return err
+		}
+		collection = result
+	} else {
+		rows, err := p.repository.List(ctx)
+		if err != nil {
+			p.log.Error().Err(err).Msg("Failed to fetch policies")
+			return errors.ErrFailedToFetchResults
+		}
+
+		collection = make([]policy.Policy, 0, len(rows))
+		for _, row := range rows {
+			collection = append(collection, policy.Policy{
+				Name:        row.Name,
+				Description: row.Description,
+				Actions:     row.Actions,
+				Effect:      policy.Effect(row.Effect),
+				Condition:   row.Condition,
+			})
+		}
+	}
+
+	if err := p.engine.Load(collection); err != nil {
+		p.log.Error().Err(err).Msg("Failed to compile policies")
+		return err
+	}
+	p.loaded.Store(true)
+
+	return nil
+}
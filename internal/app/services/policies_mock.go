@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/policies.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/policies.go -destination=internal/app/services/policies_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	policy "loki/pkg/policy"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPolicies is a mock of Policies interface.
+type MockPolicies struct {
+	ctrl     *gomock.Controller
+	recorder *MockPoliciesMockRecorder
+	isgomock struct{}
+}
+
+// MockPoliciesMockRecorder is the mock recorder for MockPolicies.
+type MockPoliciesMockRecorder struct {
+	mock *MockPolicies
+}
+
+// NewMockPolicies creates a new mock instance.
+func NewMockPolicies(ctrl *gomock.Controller) *MockPolicies {
+	mock := &MockPolicies{ctrl: ctrl}
+	mock.recorder = &MockPoliciesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicies) EXPECT() *MockPoliciesMockRecorder {
+	return m.recorder
+}
+
+// Evaluate mocks base method.
+func (m *MockPolicies) Evaluate(ctx context.Context, req *policy.Request) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", ctx, req)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockPoliciesMockRecorder) Evaluate(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockPolicies)(nil).Evaluate), ctx, req)
+}
+
+// Reload mocks base method.
+func (m *MockPolicies) Reload(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reload", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reload indicates an expected call of Reload.
+func (mr *MockPoliciesMockRecorder) Reload(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reload", reflect.TypeOf((*MockPolicies)(nil).Reload), ctx)
+}
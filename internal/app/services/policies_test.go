@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/pkg/jwt"
+	"loki/pkg/policy"
+)
+
+func Test_NewPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	tests := []struct {
+		name  string
+		path  string
+		error error
+	}{
+		{
+			name:  "Success with database source",
+			path:  "",
+			error: nil,
+		},
+		{
+			name:  "Success with policies file",
+			path:  write("valid.json", `[{"name": "deny-all", "actions": ["*"], "effect": "deny", "condition": "true"}]`),
+			error: nil,
+		},
+		{
+			name:  "Error invalid policy in file",
+			path:  write("invalid.json", `[{"name": "invalid", "actions": ["*"], "effect": "deny", "condition": "principal &&"}]`),
+			error: errors.ErrInvalidPolicy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				AppEnv:       "test",
+				LogLevel:     "info",
+				PoliciesPath: tt.path,
+			}
+
+			result, err := NewPolicies(cfg, repositories.NewMockPolicyRepository(ctrl), logger.NewLogger(cfg))
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+}
+
+func Test_Policies_Evaluate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	request := &policy.Request{
+		Principal: &jwt.Payload{ID: "PNOEE-60001017869", Roles: []string{models.UserRoleType}},
+		Action:    "write:tokens",
+	}
+
+	tests := []struct {
+		name     string
+		before   func(repository *repositories.MockPolicyRepository)
+		expected bool
+		error    error
+	}{
+		{
+			name: "Allowed without policies",
+			before: func(repository *repositories.MockPolicyRepository) {
+				repository.EXPECT().List(ctx).Return([]models.Policy{}, nil)
+			},
+			expected: true,
+			error:    nil,
+		},
+		{
+			name: "Denied by policy",
+			before: func(repository *repositories.MockPolicyRepository) {
+				repository.EXPECT().List(ctx).Return([]models.Policy{
+					{
+						Name:      "admins-only",
+						Actions:   []string{"write:tokens"},
+						Effect:    string(policy.Deny),
+						Condition: `!("admin" in principal.roles)`,
+					},
+				}, nil)
+			},
+			expected: false,
+			error:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository := repositories.NewMockPolicyRepository(ctrl)
+			service, err := NewPolicies(cfg, repository, log)
+			assert.NoError(t, err)
+
+			tt.before(repository)
+			assert.NoError(t, service.Reload(ctx))
+
+			result, err := service.Evaluate(ctx, request)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("Denied until policies are loaded", func(t *testing.T) {
+		repository := repositories.NewMockPolicyRepository(ctrl)
+		service, err := NewPolicies(cfg, repository, log)
+		assert.NoError(t, err)
+
+		result, err := service.Evaluate(ctx, request)
+		assert.Equal(t, errors.ErrPoliciesNotLoaded, err)
+		assert.False(t, result)
+	})
+
+	t.Run("Does not load policies on the request path", func(t *testing.T) {
+		repository := repositories.NewMockPolicyRepository(ctrl)
+		service, err := NewPolicies(cfg, repository, log)
+		assert.NoError(t, err)
+
+		repository.EXPECT().List(ctx).Return([]models.Policy{}, nil)
+		assert.NoError(t, service.Reload(ctx))
+
+		for i := 0; i < 3; i++ {
+			result, err := service.Evaluate(ctx, request)
+			assert.NoError(t, err)
+			assert.True(t, result)
+		}
+	})
+}
+
+func Test_Policies_Reload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	request := &policy.Request{
+		Principal: &jwt.Payload{ID: "PNOEE-60001017869", Roles: []string{models.UserRoleType}},
+		Action:    "write:tokens",
+	}
+
+	tests := []struct {
+		name     string
+		before   func(service Policies, repository *repositories.MockPolicyRepository)
+		expected bool
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func(_ Policies, repository *repositories.MockPolicyRepository) {
+				repository.EXPECT().List(ctx).Return([]models.Policy{}, nil)
+			},
+			expected: true,
+			error:    nil,
+		},
+		{
+			name: "Error failed to fetch policies",
+			before: func(_ Policies, repository *repositories.MockPolicyRepository) {
+				repository.EXPECT().List(ctx).Return(nil, assert.AnError)
+			},
+			expected: false,
+			error:    errors.ErrFailedToFetchResults,
+		},
+		{
+			name: "Error failed to fetch policies keeps the loaded policies",
+			before: func(service Policies, repository *repositories.MockPolicyRepository) {
+				repository.EXPECT().List(ctx).Return([]models.Policy{}, nil)
+				assert.NoError(t, service.Reload(ctx))
+				repository.EXPECT().List(ctx).Return(nil, assert.AnError)
+			},
+			expected: true,
+			error:    errors.ErrFailedToFetchResults,
+		},
+		{
+			name: "Error invalid policy",
+			before: func(_ Policies, repository *repositories.MockPolicyRepository) {
+				repository.EXPECT().List(ctx).Return([]models.Policy{
+					{
+						Name:      "invalid",
+						Actions:   []string{"write:tokens"},
+						Effect:    "maybe",
+						Condition: "true",
+					},
+				}, nil)
+			},
+			expected: false,
+			error:    errors.ErrInvalidPolicyEffect,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository := repositories.NewMockPolicyRepository(ctrl)
+			service, err := NewPolicies(cfg, repository, log)
+			assert.NoError(t, err)
+
+			tt.before(service, repository)
+
+			err = service.Reload(ctx)
+			assert.Equal(t, tt.error, err)
+
+			result, _ := service.Evaluate(ctx, request)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/app/repositories/db"
+	"loki/internal/config/logger"
+	"loki/pkg/mailer"
+)
+
+const (
+	// EmailVerificationTTL is the time a code sent to a new email address stays valid
+	EmailVerificationTTL = 24 * time.Hour
+	// EmailVerificationMaxAttempts is the number of guesses allowed per address before it must be changed
+	EmailVerificationMaxAttempts = 5
+	// EmailVerificationCooldown is the time to wait after a code was sent before another one is sent
+	EmailVerificationCooldown = time.Minute
+
+	profileAttributesMaxSize = 4096
+	verificationCodeMax      = 1000000
+)
+
+type Profiles interface {
+	FindByUserId(ctx context.Context, userId uuid.UUID) (*models.Profile, error)
+	Update(ctx context.Context, userId uuid.UUID, params *models.UpdateProfileParams) (*models.Profile, error)
+	VerifyEmail(ctx context.Context, userId uuid.UUID, code string) (*models.Profile, error)
+	SavePhoneNumber(ctx context.Context, userId uuid.UUID, phoneNumber string) error
+}
+
+type profiles struct {
+	mailer     mailer.Mailer
+	repository repositories.ProfileRepository
+	audit      Audit
+	log        *logger.Logger
+}
+
+func NewProfiles(mailer mailer.Mailer, repository repositories.ProfileRepository, audit Audit, log *logger.Logger) Profiles {
+	return &profiles{
+		mailer:     mailer,
+		repository: repository,
+		audit:      audit,
+		log:        log,
+	}
+}
+
+// FindByUserId returns the profile, a user who never saved one gets an empty profile
+func (p *profiles) FindByUserId(ctx context.Context, userId uuid.UUID) (*models.Profile, error) {
+	profile, err := p.repository.FindByUserId(ctx, userId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &models.Profile{UserID: userId, Attributes: map[string]any{}}, nil
+		}
+
+		p.log.Error().Err(err).Msg("Failed to find user profile")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	return profile, nil
+}
+
+// Update applies a partial update, only a changed email receives a verification code
+func (p *profiles) Update(ctx context.Context, userId uuid.UUID, params *models.UpdateProfileParams) (*models.Profile, error) {
+	current, err := p.FindByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	locale := current.Locale
+	if params.Locale != nil {
+		locale = *params.Locale
+	}
+
+	attributes, err := json.Marshal(mergeAttributes(current.Attributes, params.Attributes))
+	if err != nil || len(attributes) > profileAttributesMaxSize {
+		return nil, errors.ErrInvalidAttributes
+	}
+
+	var email *db.UpsertUserEmailParams
+	var code string
+
+	if params.Email != nil && *params.Email != current.Email {
+		email = &db.UpsertUserEmailParams{
+			UserID: userId,
+			Email:  pgtype.Text{String: *params.Email, Valid: *params.Email != ""},
+		}
+
+		if *params.Email != "" {
+			code, err = verificationCode()
+			if err != nil {
+				p.log.Error().Err(err).Msg("Failed to generate email verification code")
+				return nil, errors.ErrFailedToUpdateRecord
+			}
+
+			email.EmailVerificationDigest = pgtype.Text{String: verificationDigest(code), Valid: true}
+			email.EmailVerificationTtlSeconds = int32(EmailVerificationTTL.Seconds())
+			email.EmailVerificationCooldownSeconds = int32(EmailVerificationCooldown.Seconds())
+		}
+	}
+
+	profile, err := p.repository.Update(ctx, db.UpsertUserProfileParams{
+		UserID:     userId,
+		Locale:     pgtype.Text{String: locale, Valid: locale != ""},
+		Attributes: attributes,
+	}, email)
+	if err != nil {
+		if errors.Is(err, errors.ErrEmailVerificationCooldown) {
+			return nil, err
+		}
+
+		p.log.Error().Err(err).Msg("Failed to update user profile")
+		return nil, errors.ErrFailedToUpdateRecord
+	}
+
+	p.audit.Record(ctx, &models.AuditEvent{
+		Action:     models.AuditProfileUpdated,
+		ActorID:    userId,
+		TargetType: "user",
+		TargetID:   userId.String(),
+		Status:     models.AuditSuccess,
+		Changes:    map[string]any{"email_changed": email != nil},
+	})
+
+	if code != "" {
+		err = p.mailer.Send(ctx, mailer.Message{
+			To:      profile.Email,
+			Subject: "Verify your email address",
+			Body:    fmt.Sprintf("Your verification code is %s, it expires in %d hours.\r\n", code, int(EmailVerificationTTL.Hours())),
+		})
+		if err != nil {
+			p.log.Error().Err(err).Msg("Failed to send email verification code")
+			return nil, errors.ErrFailedToSendEmail
+		}
+	}
+
+	return profile, nil
+}
+
+// VerifyEmail confirms the pending email, a code is rejected after EmailVerificationMaxAttempts failed guesses
+func (p *profiles) VerifyEmail(ctx context.Context, userId uuid.UUID, code string) (*models.Profile, error) {
+	event := &models.AuditEvent{
+		Action:     models.AuditEmailVerified,
+		ActorID:    userId,
+		TargetType: "user",
+		TargetID:   userId.String(),
+		Status:     models.AuditSuccess,
+	}
+
+	profile, err := p.repository.VerifyEmail(ctx, userId, verificationDigest(code), EmailVerificationMaxAttempts)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			event.Status = models.AuditFailure
+			p.audit.Record(ctx, event)
+
+			return nil, errors.ErrInvalidVerificationCode
+		}
+
+		p.log.Error().Err(err).Msg("Failed to verify email")
+		return nil, errors.ErrFailedToUpdateRecord
+	}
+
+	p.audit.Record(ctx, event)
+
+	return profile, nil
+}
+
+// SavePhoneNumber stores the number the user has just authenticated with via Mobile-ID
+func (p *profiles) SavePhoneNumber(ctx context.Context, userId uuid.UUID, phoneNumber string) error {
+	if err := p.repository.SavePhoneNumber(ctx, userId, phoneNumber); err != nil {
+		p.log.Error().Err(err).Msg("Failed to save phone number")
+		return errors.ErrFailedToUpdateRecord
+	}
+
+	return nil
+}
+
+// mergeAttributes applies the patch as a JSON merge patch, a nil value removes the key
+func mergeAttributes(current, patch map[string]any) map[string]any {
+	result := make(map[string]any, len(current)+len(patch))
+	for key, value := range current {
+		result[key] = value
+	}
+
+	for key, value := range patch {
+		switch value := value.(type) {
+		case nil:
+			delete(result, key)
+		case map[string]any:
+			existing, _ := result[key].(map[string]any)
+			result[key] = mergeAttributes(existing, value)
+		default:
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+func verificationCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(verificationCodeMax))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func verificationDigest(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
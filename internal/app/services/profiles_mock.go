@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/profiles.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/profiles.go -destination=internal/app/services/profiles_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProfiles is a mock of Profiles interface.
+type MockProfiles struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfilesMockRecorder
+	isgomock struct{}
+}
+
+// MockProfilesMockRecorder is the mock recorder for MockProfiles.
+type MockProfilesMockRecorder struct {
+	mock *MockProfiles
+}
+
+// NewMockProfiles creates a new mock instance.
+func NewMockProfiles(ctrl *gomock.Controller) *MockProfiles {
+	mock := &MockProfiles{ctrl: ctrl}
+	mock.recorder = &MockProfilesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfiles) EXPECT() *MockProfilesMockRecorder {
+	return m.recorder
+}
+
+// FindByUserId mocks base method.
+func (m *MockProfiles) FindByUserId(ctx context.Context, userId uuid.UUID) (*models.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserId", ctx, userId)
+	ret0, _ := ret[0].(*models.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserId indicates an expected call of FindByUserId.
+func (mr *MockProfilesMockRecorder) FindByUserId(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockProfiles)(nil).FindByUserId), ctx, userId)
+}
+
+// SavePhoneNumber mocks base method.
+func (m *MockProfiles) SavePhoneNumber(ctx context.Context, userId uuid.UUID, phoneNumber string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePhoneNumber", ctx, userId, phoneNumber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePhoneNumber indicates an expected call of SavePhoneNumber.
+func (mr *MockProfilesMockRecorder) SavePhoneNumber(ctx, userId, phoneNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePhoneNumber", reflect.TypeOf((*MockProfiles)(nil).SavePhoneNumber), ctx, userId, phoneNumber)
+}
+
+// Update mocks base method.
+func (m *MockProfiles) Update(ctx context.Context, userId uuid.UUID, params *models.UpdateProfileParams) (*models.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, userId, params)
+	ret0, _ := ret[0].(*models.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProfilesMockRecorder) Update(ctx, userId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProfiles)(nil).Update), ctx, userId, params)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockProfiles) VerifyEmail(ctx context.Context, userId uuid.UUID, code string) (*models.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, userId, code)
+	ret0, _ := ret[0].(*models.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockProfilesMockRecorder) VerifyEmail(ctx, userId, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockProfiles)(nil).VerifyEmail), ctx, userId, code)
+}
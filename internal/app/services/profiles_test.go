@@ -0,0 +1,318 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/app/repositories/db"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/pkg/mailer"
+)
+
+func Test_Profiles_FindByUserId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockProfileRepository(ctrl)
+	audit := NewMockAudit(ctrl)
+	service := NewProfiles(mailer.NewMockMailer(ctrl), repository, audit, log)
+
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	profile := &models.Profile{UserID: userId, Email: "john.doe@example.com", Attributes: map[string]any{}}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.Profile
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().FindByUserId(ctx, userId).Return(profile, nil)
+			},
+			expected: profile,
+		},
+		{
+			name: "Empty profile",
+			before: func() {
+				repository.EXPECT().FindByUserId(ctx, userId).Return(nil, pgx.ErrNoRows)
+			},
+			expected: &models.Profile{UserID: userId, Attributes: map[string]any{}},
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().FindByUserId(ctx, userId).Return(nil, assert.AnError)
+			},
+			error: errors.ErrFailedToFetchResults,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.FindByUserId(ctx, userId)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Profiles_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	mailerMock := mailer.NewMockMailer(ctrl)
+	repository := repositories.NewMockProfileRepository(ctrl)
+	audit := NewMockAudit(ctrl)
+	service := NewProfiles(mailerMock, repository, audit, log)
+
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	email := "john.doe@example.com"
+	locale := "en-GB"
+
+	verified := &models.Profile{
+		UserID:          userId,
+		Email:           email,
+		EmailVerifiedAt: time.Now(),
+		Locale:          "et",
+		Attributes:      map[string]any{"department": "IT", "nickname": "johnny"},
+	}
+
+	tests := []struct {
+		name     string
+		params   *models.UpdateProfileParams
+		before   func()
+		expected *models.Profile
+		error    error
+	}{
+		{
+			name: "Merge attributes",
+			params: &models.UpdateProfileParams{
+				Email:      &email,
+				Locale:     &locale,
+				Attributes: map[string]any{"nickname": nil, "team": "SSO"},
+			},
+			before: func() {
+				repository.EXPECT().FindByUserId(ctx, userId).Return(verified, nil)
+				repository.EXPECT().Update(ctx, db.UpsertUserProfileParams{
+					UserID:     userId,
+					Locale:     pgtype.Text{String: locale, Valid: true},
+					Attributes: []byte(`{"department":"IT","team":"SSO"}`),
+				}, (*db.UpsertUserEmailParams)(nil)).Return(verified, nil)
+				audit.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditProfileUpdated,
+					ActorID:    userId,
+					TargetType: "user",
+					TargetID:   userId.String(),
+					Status:     models.AuditSuccess,
+					Changes:    map[string]any{"email_changed": false},
+				})
+			},
+			expected: verified,
+		},
+		{
+			name:   "New email",
+			params: &models.UpdateProfileParams{Email: &email},
+			before: func() {
+				repository.EXPECT().FindByUserId(ctx, userId).Return(nil, pgx.ErrNoRows)
+				repository.EXPECT().Update(ctx, db.UpsertUserProfileParams{
+					UserID:     userId,
+					Attributes: []byte(`{}`),
+				}, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ db.UpsertUserProfileParams, params *db.UpsertUserEmailParams) (*models.Profile, error) {
+						assert.Equal(t, email, params.Email.String)
+						assert.Len(t, params.EmailVerificationDigest.String, 64)
+						assert.Equal(t, int32(86400), params.EmailVerificationTtlSeconds)
+						assert.Equal(t, int32(60), params.EmailVerificationCooldownSeconds)
+
+						return &models.Profile{UserID: userId, Email: email}, nil
+					})
+				audit.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditProfileUpdated,
+					ActorID:    userId,
+					TargetType: "user",
+					TargetID:   userId.String(),
+					Status:     models.AuditSuccess,
+					Changes:    map[string]any{"email_changed": true},
+				})
+				mailerMock.EXPECT().Send(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, message mailer.Message) error {
+					assert.Equal(t, email, message.To)
+					assert.Regexp(t, `code is \d{6},`, message.Body)
+					return nil
+				})
+			},
+			expected: &models.Profile{UserID: userId, Email: email},
+		},
+		{
+			name:   "Same unverified email",
+			params: &models.UpdateProfileParams{Email: &email},
+			before: func() {
+				repository.EXPECT().FindByUserId(ctx, userId).Return(&models.Profile{UserID: userId, Email: email}, nil)
+				repository.EXPECT().Update(ctx, gomock.Any(), (*db.UpsertUserEmailParams)(nil)).Return(&models.Profile{UserID: userId, Email: email}, nil)
+				audit.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditProfileUpdated,
+					ActorID:    userId,
+					TargetType: "user",
+					TargetID:   userId.String(),
+					Status:     models.AuditSuccess,
+					Changes:    map[string]any{"email_changed": false},
+				})
+			},
+			expected: &models.Profile{UserID: userId, Email: email},
+		},
+		{
+			name:   "Code sent recently",
+			params: &models.UpdateProfileParams{Email: &email},
+			before: func() {
+				repository.EXPECT().FindByUserId(ctx, userId).Return(&models.Profile{UserID: userId, Email: "jane.doe@example.com"}, nil)
+				repository.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Return(nil, errors.ErrEmailVerificationCooldown)
+			},
+			error: errors.ErrEmailVerificationCooldown,
+		},
+		{
+			name:   "Attributes too large",
+			params: &models.UpdateProfileParams{Attributes: map[string]any{"notes": string(make([]byte, profileAttributesMaxSize))}},
+			before: func() {
+				repository.EXPECT().FindByUserId(ctx, userId).Return(verified, nil)
+			},
+			error: errors.ErrInvalidAttributes,
+		},
+		{
+			name:   "Failed to send email",
+			params: &models.UpdateProfileParams{Email: &email},
+			before: func() {
+				repository.EXPECT().FindByUserId(ctx, userId).Return(nil, pgx.ErrNoRows)
+				repository.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Return(&models.Profile{UserID: userId, Email: email}, nil)
+				audit.EXPECT().Record(ctx, gomock.Any())
+				mailerMock.EXPECT().Send(ctx, gomock.Any()).Return(assert.AnError)
+			},
+			error: errors.ErrFailedToSendEmail,
+		},
+		{
+			name:   "Error",
+			params: &models.UpdateProfileParams{Locale: &locale},
+			before: func() {
+				repository.EXPECT().FindByUserId(ctx, userId).Return(verified, nil)
+				repository.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			error: errors.ErrFailedToUpdateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Update(ctx, userId, tt.params)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Profiles_VerifyEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockProfileRepository(ctrl)
+	audit := NewMockAudit(ctrl)
+	service := NewProfiles(mailer.NewMockMailer(ctrl), repository, audit, log)
+
+	userId := uuid.MustParse("10000000-1000-1000-3000-000000000001")
+	digest := "8d969eef6ecad3c29a3a629280e686cf0c3f5d5a86aff3ca12020c923adc6c92"
+	profile := &models.Profile{UserID: userId, Email: "john.doe@example.com", EmailVerifiedAt: time.Now()}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.Profile
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().VerifyEmail(ctx, userId, digest, int32(EmailVerificationMaxAttempts)).Return(profile, nil)
+				audit.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditEmailVerified,
+					ActorID:    userId,
+					TargetType: "user",
+					TargetID:   userId.String(),
+					Status:     models.AuditSuccess,
+				})
+			},
+			expected: profile,
+		},
+		{
+			name: "Invalid code",
+			before: func() {
+				repository.EXPECT().VerifyEmail(ctx, userId, digest, int32(EmailVerificationMaxAttempts)).Return(nil, pgx.ErrNoRows)
+				audit.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditEmailVerified,
+					ActorID:    userId,
+					TargetType: "user",
+					TargetID:   userId.String(),
+					Status:     models.AuditFailure,
+				})
+			},
+			error: errors.ErrInvalidVerificationCode,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().VerifyEmail(ctx, userId, digest, int32(EmailVerificationMaxAttempts)).Return(nil, assert.AnError)
+			},
+			error: errors.ErrFailedToUpdateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.VerifyEmail(ctx, userId, "123456")
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+// identityNumberType is the ETSI semantics identifier type the country code follows, e.g. 'PNOEE'
+const identityNumberType = "PNO"
+
+type Provisioning interface {
+	Provision(ctx context.Context, person *models.User) (*models.User, error)
+}
+
+type provisioning struct {
+	cfg         config.Provisioning
+	pattern     *regexp.Regexp
+	users       repositories.UserRepository
+	invitations repositories.InvitationRepository
+	roles       repositories.RoleRepository
+	scopes      repositories.ScopeRepository
+	log         *logger.Logger
+}
+
+// NewProvisioning validates the provisioning policy, an unknown mode or invalid pattern prevents the start
+func NewProvisioning(
+	cfg *config.Config,
+	users repositories.UserRepository,
+	invitations repositories.InvitationRepository,
+	roles repositories.RoleRepository,
+	scopes repositories.ScopeRepository,
+	log *logger.Logger,
+) (Provisioning, error) {
+	switch cfg.Provisioning.Mode {
+	case config.ProvisioningOpen, config.ProvisioningInvite:
+	default:
+		return nil, errors.ErrInvalidProvisioningMode
+	}
+
+	var pattern *regexp.Regexp
+	if cfg.Provisioning.PersonalCodePattern != "" {
+		compiled, err := regexp.Compile(cfg.Provisioning.PersonalCodePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid provisioning personal code pattern: %w", err)
+		}
+		pattern = compiled
+	}
+
+	return &provisioning{
+		cfg:         cfg.Provisioning,
+		pattern:     pattern,
+		users:       users,
+		invitations: invitations,
+		roles:       roles,
+		scopes:      scopes,
+		log:         log,
+	}, nil
+}
+
+// Provision returns the known user with refreshed names. A new person with a pending invitation is registered
+// with the invited roles and scopes on top of the default ones, anybody else only when the policy allows it.
+func (p *provisioning) Provision(ctx context.Context, person *models.User) (*models.User, error) {
+	_, err := p.users.FindByIdentityNumber(ctx, person.IdentityNumber)
+	switch {
+	case err == nil:
+		return p.save(ctx, &models.User{
+			IdentityNumber: person.IdentityNumber,
+			PersonalCode:   person.PersonalCode,
+			FirstName:      person.FirstName,
+			LastName:       person.LastName,
+		})
+	case !errors.Is(err, pgx.ErrNoRows):
+		p.log.Error().Err(err).Msg("Failed to find user by identity number")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	roleIds, scopeIds, err := p.defaults(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &models.User{
+		IdentityNumber: person.IdentityNumber,
+		PersonalCode:   person.PersonalCode,
+		FirstName:      person.FirstName,
+		LastName:       person.LastName,
+		RoleIDs:        roleIds,
+		ScopeIDs:       scopeIds,
+	}
+
+	user, err := p.invitations.Accept(ctx, params)
+	switch {
+	case err == nil:
+		p.log.Info().Str("user_id", user.ID.String()).Msg("Invitation accepted")
+		return user, nil
+	case !errors.Is(err, pgx.ErrNoRows):
+		p.log.Error().Err(err).Msg("Failed to accept invitation")
+		return nil, errors.ErrFailedToCreateRecord
+	}
+
+	if err = p.allow(person); err != nil {
+		p.log.Warn().Err(err).Str("identity_number", person.IdentityNumber).Msg("Provisioning rejected")
+		return nil, err
+	}
+
+	return p.save(ctx, params)
+}
+
+// allow checks the policy for a person who has no account yet
+func (p *provisioning) allow(person *models.User) error {
+	if p.cfg.Mode == config.ProvisioningInvite {
+		return fmt.Errorf("%w: invitation required", errors.ErrProvisioningRejected)
+	}
+
+	if len(p.cfg.Countries) > 0 && !slices.Contains(p.cfg.Countries, identityNumberCountry(person.IdentityNumber)) {
+		return fmt.Errorf("%w: country not allowed", errors.ErrProvisioningRejected)
+	}
+
+	if p.pattern != nil && !p.pattern.MatchString(person.PersonalCode) {
+		return fmt.Errorf("%w: personal code not allowed", errors.ErrProvisioningRejected)
+	}
+
+	return nil
+}
+
+// defaults resolves the names of the default roles and scopes, unknown names are skipped
+func (p *provisioning) defaults(ctx context.Context) ([]uuid.UUID, []uuid.UUID, error) {
+	roles, err := p.roles.FindByNames(ctx, p.cfg.DefaultRoles)
+	if err != nil {
+		p.log.Error().Err(err).Msg("Failed to fetch default roles")
+		return nil, nil, errors.ErrFailedToFetchResults
+	}
+
+	scopes, err := p.scopes.FindByNames(ctx, p.cfg.DefaultScopes)
+	if err != nil {
+		p.log.Error().Err(err).Msg("Failed to fetch default scopes")
+		return nil, nil, errors.ErrFailedToFetchResults
+	}
+
+	if len(roles) != len(p.cfg.DefaultRoles) || len(scopes) != len(p.cfg.DefaultScopes) {
+		p.log.Warn().
+			Strs("roles", p.cfg.DefaultRoles).
+			Strs("scopes", p.cfg.DefaultScopes).
+			Msg("Some default roles or scopes do not exist")
+	}
+
+	roleIds := make([]uuid.UUID, 0, len(roles))
+	for _, role := range roles {
+		roleIds = append(roleIds, role.ID)
+	}
+
+	scopeIds := make([]uuid.UUID, 0, len(scopes))
+	for _, scope := range scopes {
+		scopeIds = append(scopeIds, scope.ID)
+	}
+
+	return roleIds, scopeIds, nil
+}
+
+func (p *provisioning) save(ctx context.Context, params *models.User) (*models.User, error) {
+	user, err := p.users.Provision(ctx, params)
+	if err != nil {
+		p.log.Error().Err(err).Msg("Failed to provision user")
+		return nil, errors.ErrFailedToCreateRecord
+	}
+
+	return user, nil
+}
+
+// identityNumberCountry returns the country code of the identity number, e.g. 'EE' for 'PNOEE-30303039914'
+func identityNumberCountry(identityNumber string) string {
+	prefix, _, _ := strings.Cut(identityNumber, "-")
+
+	return strings.TrimPrefix(prefix, identityNumberType)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/provisioning.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/provisioning.go -destination=internal/app/services/provisioning_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProvisioning is a mock of Provisioning interface.
+type MockProvisioning struct {
+	ctrl     *gomock.Controller
+	recorder *MockProvisioningMockRecorder
+	isgomock struct{}
+}
+
+// MockProvisioningMockRecorder is the mock recorder for MockProvisioning.
+type MockProvisioningMockRecorder struct {
+	mock *MockProvisioning
+}
+
+// NewMockProvisioning creates a new mock instance.
+func NewMockProvisioning(ctrl *gomock.Controller) *MockProvisioning {
+	mock := &MockProvisioning{ctrl: ctrl}
+	mock.recorder = &MockProvisioningMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProvisioning) EXPECT() *MockProvisioningMockRecorder {
+	return m.recorder
+}
+
+// Provision mocks base method.
+func (m *MockProvisioning) Provision(ctx context.Context, person *models.User) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Provision", ctx, person)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Provision indicates an expected call of Provision.
+func (mr *MockProvisioningMockRecorder) Provision(ctx, person any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Provision", reflect.TypeOf((*MockProvisioning)(nil).Provision), ctx, person)
+}
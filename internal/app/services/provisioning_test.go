@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+func Test_NewProvisioning(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := logger.NewLogger(&config.Config{AppEnv: "test", LogLevel: "info"})
+
+	tests := []struct {
+		name  string
+		cfg   config.Provisioning
+		error bool
+	}{
+		{
+			name: "Open",
+			cfg:  config.Provisioning{Mode: config.ProvisioningOpen, PersonalCodePattern: `^[3-6]`},
+		},
+		{
+			name: "Invite",
+			cfg:  config.Provisioning{Mode: config.ProvisioningInvite},
+		},
+		{
+			name:  "Unknown mode",
+			cfg:   config.Provisioning{Mode: "closed"},
+			error: true,
+		},
+		{
+			name:  "Invalid pattern",
+			cfg:   config.Provisioning{Mode: config.ProvisioningOpen, PersonalCodePattern: `^[3-6`},
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, err := NewProvisioning(
+				&config.Config{Provisioning: tt.cfg},
+				repositories.NewMockUserRepository(ctrl),
+				repositories.NewMockInvitationRepository(ctrl),
+				repositories.NewMockRoleRepository(ctrl),
+				repositories.NewMockScopeRepository(ctrl),
+				log,
+			)
+
+			if tt.error {
+				assert.Error(t, err)
+				assert.Nil(t, service)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, service)
+			}
+		})
+	}
+}
+
+func Test_Provisioning_Provision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	users := repositories.NewMockUserRepository(ctrl)
+	invitations := repositories.NewMockInvitationRepository(ctrl)
+	roles := repositories.NewMockRoleRepository(ctrl)
+	scopes := repositories.NewMockScopeRepository(ctrl)
+
+	id := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	roleId := uuid.MustParse("10000000-1000-1000-1000-000000000003")
+	scopeId := uuid.MustParse("10000000-1000-1000-2000-000000000002")
+
+	open := config.Provisioning{
+		Mode:          config.ProvisioningOpen,
+		DefaultRoles:  []string{models.UserRoleType},
+		DefaultScopes: []string{models.SelfServiceType},
+	}
+
+	person := &models.User{
+		IdentityNumber: "PNOEE-30303039914",
+		PersonalCode:   "30303039914",
+		FirstName:      "TESTNUMBER",
+		LastName:       "OK",
+	}
+
+	params := &models.User{
+		IdentityNumber: person.IdentityNumber,
+		PersonalCode:   person.PersonalCode,
+		FirstName:      person.FirstName,
+		LastName:       person.LastName,
+		RoleIDs:        []uuid.UUID{roleId},
+		ScopeIDs:       []uuid.UUID{scopeId},
+	}
+
+	newPerson := func() {
+		users.EXPECT().FindByIdentityNumber(ctx, person.IdentityNumber).Return(nil, pgx.ErrNoRows)
+		roles.EXPECT().FindByNames(ctx, gomock.Any()).Return([]models.Role{{ID: roleId}}, nil)
+		scopes.EXPECT().FindByNames(ctx, gomock.Any()).Return([]models.Scope{{ID: scopeId}}, nil)
+	}
+
+	tests := []struct {
+		name     string
+		cfg      config.Provisioning
+		before   func()
+		expected *models.User
+		error    error
+	}{
+		{
+			name: "Known user",
+			cfg:  config.Provisioning{Mode: config.ProvisioningInvite},
+			before: func() {
+				users.EXPECT().FindByIdentityNumber(ctx, person.IdentityNumber).Return(&models.User{ID: id}, nil)
+				users.EXPECT().Provision(ctx, person).Return(&models.User{ID: id, Status: models.UserActive}, nil)
+			},
+			expected: &models.User{ID: id, Status: models.UserActive},
+		},
+		{
+			name: "New user",
+			cfg:  open,
+			before: func() {
+				users.EXPECT().FindByIdentityNumber(ctx, person.IdentityNumber).Return(nil, pgx.ErrNoRows)
+				roles.EXPECT().FindByNames(ctx, []string{models.UserRoleType}).Return([]models.Role{{ID: roleId}}, nil)
+				scopes.EXPECT().FindByNames(ctx, []string{models.SelfServiceType}).Return([]models.Scope{{ID: scopeId}}, nil)
+				invitations.EXPECT().Accept(ctx, params).Return(nil, pgx.ErrNoRows)
+				users.EXPECT().Provision(ctx, params).Return(&models.User{ID: id, Status: models.UserActive}, nil)
+			},
+			expected: &models.User{ID: id, Status: models.UserActive},
+		},
+		{
+			name: "Invited user",
+			cfg:  config.Provisioning{Mode: config.ProvisioningInvite, Countries: []string{"LV"}},
+			before: func() {
+				newPerson()
+				invitations.EXPECT().Accept(ctx, params).Return(&models.User{ID: id, Status: models.UserActive}, nil)
+			},
+			expected: &models.User{ID: id, Status: models.UserActive},
+		},
+		{
+			name: "Invite only",
+			cfg:  config.Provisioning{Mode: config.ProvisioningInvite},
+			before: func() {
+				newPerson()
+				invitations.EXPECT().Accept(ctx, params).Return(nil, pgx.ErrNoRows)
+			},
+			error: errors.ErrProvisioningRejected,
+		},
+		{
+			name: "Country not allowed",
+			cfg:  config.Provisioning{Mode: config.ProvisioningOpen, Countries: []string{"LV", "LT"}},
+			before: func() {
+				newPerson()
+				invitations.EXPECT().Accept(ctx, params).Return(nil, pgx.ErrNoRows)
+			},
+			error: errors.ErrProvisioningRejected,
+		},
+		{
+			name: "Personal code not allowed",
+			cfg:  config.Provisioning{Mode: config.ProvisioningOpen, Countries: []string{"EE"}, PersonalCodePattern: `^[56]`},
+			before: func() {
+				newPerson()
+				invitations.EXPECT().Accept(ctx, params).Return(nil, pgx.ErrNoRows)
+			},
+			error: errors.ErrProvisioningRejected,
+		},
+		{
+			name: "Failed to accept invitation",
+			cfg:  open,
+			before: func() {
+				newPerson()
+				invitations.EXPECT().Accept(ctx, params).Return(nil, assert.AnError)
+			},
+			error: errors.ErrFailedToCreateRecord,
+		},
+		{
+			name: "Failed to find user",
+			cfg:  open,
+			before: func() {
+				users.EXPECT().FindByIdentityNumber(ctx, person.IdentityNumber).Return(nil, assert.AnError)
+			},
+			error: errors.ErrFailedToFetchResults,
+		},
+		{
+			name: "Failed to fetch default roles",
+			cfg:  open,
+			before: func() {
+				users.EXPECT().FindByIdentityNumber(ctx, person.IdentityNumber).Return(nil, pgx.ErrNoRows)
+				roles.EXPECT().FindByNames(ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+			error: errors.ErrFailedToFetchResults,
+		},
+		{
+			name: "Failed to provision user",
+			cfg:  open,
+			before: func() {
+				newPerson()
+				invitations.EXPECT().Accept(ctx, params).Return(nil, pgx.ErrNoRows)
+				users.EXPECT().Provision(ctx, params).Return(nil, assert.AnError)
+			},
+			error: errors.ErrFailedToCreateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			service, err := NewProvisioning(&config.Config{Provisioning: tt.cfg}, users, invitations, roles, scopes, log)
+			assert.NoError(t, err)
+
+			result, err := service.Provision(ctx, person)
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/pkg/geoip"
+	"loki/pkg/webhook"
+)
+
+const (
+	// RiskHistoryLimit is the number of recent logins the request is compared with
+	RiskHistoryLimit = 50
+	// RiskTravelSpeed is the fastest plausible travel between two logins in km/h
+	RiskTravelSpeed = 1000.0
+	// RiskTravelDistance ignores jumps within the accuracy of a GeoIP database in km
+	RiskTravelDistance = 500.0
+	// RiskMaxScore caps the sum of the signal weights
+	RiskMaxScore = 100
+)
+
+var riskWeights = map[string]int{
+	models.RiskNewCountry:       40,
+	models.RiskImpossibleTravel: 60,
+	models.RiskNewUserAgent:     10,
+	models.RiskRefreshSpike:     50,
+}
+
+type Risk interface {
+	Evaluate(ctx context.Context, params *models.RiskParams) *models.RiskAssessment
+}
+
+type risk struct {
+	cfg          *config.Config
+	audit        Audit
+	geoip        geoip.Database
+	loginSession repositories.LoginSessionRepository
+	rateLimit    repositories.RateLimitRepository
+	webhook      webhook.Webhook
+	log          *logger.Logger
+}
+
+func NewRisk(
+	cfg *config.Config,
+	audit Audit,
+	geoip geoip.Database,
+	loginSession repositories.LoginSessionRepository,
+	rateLimit repositories.RateLimitRepository,
+	webhook webhook.Webhook,
+	log *logger.Logger,
+) Risk {
+	return &risk{
+		cfg:          cfg,
+		audit:        audit,
+		geoip:        geoip,
+		loginSession: loginSession,
+		rateLimit:    rateLimit,
+		webhook:      webhook,
+		log:          log,
+	}
+}
+
+// Evaluate scores the request against the login history of the user and reports anything above zero
+// to the audit log and, in the background, the webhook; lookup failures are logged and never raise the score
+func (r *risk) Evaluate(ctx context.Context, params *models.RiskParams) *models.RiskAssessment {
+	assessment := &models.RiskAssessment{
+		Signals: make([]string, 0),
+		Action:  models.RiskActionAllow,
+	}
+
+	location, located := r.geoip.Lookup(params.IPAddress)
+	if located {
+		assessment.Country = location.Country
+	}
+
+	history, err := r.loginSession.List(ctx, params.UserID, RiskHistoryLimit)
+	if err != nil {
+		r.log.Error().Err(err).Msg("Failed to fetch login history")
+	} else if len(history) > 0 {
+		if located {
+			assessment.Signals = append(assessment.Signals, r.locationSignals(location, history, params.LoginSessionID)...)
+		}
+
+		if params.UserAgent != "" && !knownUserAgent(params.UserAgent, history) {
+			assessment.Signals = append(assessment.Signals, models.RiskNewUserAgent)
+		}
+	}
+
+	if params.Stage == models.RiskStageRefresh && r.refreshSpike(ctx, params.UserID) {
+		assessment.Signals = append(assessment.Signals, models.RiskRefreshSpike)
+	}
+
+	for _, signal := range assessment.Signals {
+		assessment.Score += riskWeights[signal]
+	}
+	assessment.Score = min(assessment.Score, RiskMaxScore)
+
+	switch {
+	case r.cfg.Risk.BlockScore > 0 && assessment.Score >= r.cfg.Risk.BlockScore:
+		assessment.Action = models.RiskActionBlock
+	case r.cfg.Risk.ReauthenticateScore > 0 && assessment.Score >= r.cfg.Risk.ReauthenticateScore:
+		assessment.Action = models.RiskActionReauthenticate
+	}
+
+	if assessment.Score > 0 {
+		r.report(ctx, params, assessment)
+	}
+
+	return assessment
+}
+
+// locationSignals compares the location with the countries of earlier logins and the most recent one. Only the
+// address a session was created from is stored, so travel is measured from its creation and the session being
+// refreshed is left out
+func (r *risk) locationSignals(location *geoip.Location, history []models.LoginSession, sessionId uuid.UUID) []string {
+	signals := make([]string, 0, 2)
+	countries := make(map[string]bool)
+
+	var last *geoip.Location
+	var lastSeen time.Time
+	for _, session := range history {
+		if sessionId != uuid.Nil && session.ID == sessionId {
+			continue
+		}
+
+		previous, ok := r.geoip.Lookup(session.IPAddress)
+		if !ok {
+			continue
+		}
+		countries[previous.Country] = true
+
+		if last == nil || session.CreatedAt.After(lastSeen) {
+			last, lastSeen = previous, session.CreatedAt
+		}
+	}
+
+	if last == nil {
+		return signals
+	}
+
+	if !countries[location.Country] {
+		signals = append(signals, models.RiskNewCountry)
+	}
+
+	if distance := location.Distance(last); distance > RiskTravelDistance {
+		hours := time.Since(lastSeen).Hours()
+		if hours <= 0 || distance/hours > RiskTravelSpeed {
+			signals = append(signals, models.RiskImpossibleTravel)
+		}
+	}
+
+	return signals
+}
+
+// refreshSpike counts the refresh within the configured window and reports whether the limit is exceeded
+func (r *risk) refreshSpike(ctx context.Context, userId uuid.UUID) bool {
+	if r.cfg.Risk.RefreshLimit <= 0 {
+		return false
+	}
+
+	count, err := r.rateLimit.Record(ctx, "risk:refresh:"+userId.String(), r.cfg.Risk.RefreshWindow)
+	if err != nil {
+		r.log.Error().Err(err).Msg("Failed to count token refreshes")
+		return false
+	}
+
+	return count > int64(r.cfg.Risk.RefreshLimit)
+}
+
+func (r *risk) report(ctx context.Context, params *models.RiskParams, assessment *models.RiskAssessment) {
+	r.log.Warn().
+		Str("user_id", params.UserID.String()).
+		Str("stage", params.Stage).
+		Int("score", assessment.Score).
+		Strs("signals", assessment.Signals).
+		Str("action", assessment.Action).
+		Msg("Risky authentication detected")
+
+	status := models.AuditSuccess
+	if assessment.Action != models.RiskActionAllow {
+		status = models.AuditFailure
+	}
+
+	r.audit.Record(ctx, &models.AuditEvent{
+		Action:     models.AuditRiskDetected,
+		ActorID:    params.UserID,
+		TargetType: "user",
+		TargetID:   params.UserID.String(),
+		Status:     status,
+		Metadata: map[string]string{
+			"stage":   params.Stage,
+			"score":   strconv.Itoa(assessment.Score),
+			"signals": strings.Join(assessment.Signals, ","),
+			"action":  assessment.Action,
+			"country": assessment.Country,
+		},
+		RemoteAddr: params.IPAddress,
+	})
+
+	event := &models.RiskEvent{
+		Event:      models.AuditRiskDetected,
+		UserID:     params.UserID,
+		Stage:      params.Stage,
+		Score:      assessment.Score,
+		Signals:    assessment.Signals,
+		Action:     assessment.Action,
+		Country:    assessment.Country,
+		IPAddress:  params.IPAddress,
+		UserAgent:  params.UserAgent,
+		OccurredAt: time.Now().UTC(),
+	}
+
+	// the delivery outlives the request, a slow endpoint must not hold up the login or refresh
+	go func() {
+		if err := r.webhook.Send(context.WithoutCancel(ctx), event); err != nil {
+			r.log.Error().Err(err).Msg("Failed to deliver risk webhook")
+		}
+	}()
+}
+
+func knownUserAgent(userAgent string, history []models.LoginSession) bool {
+	for _, session := range history {
+		if session.UserAgent == userAgent {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/risk.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/risk.go -destination=internal/app/services/risk_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRisk is a mock of Risk interface.
+type MockRisk struct {
+	ctrl     *gomock.Controller
+	recorder *MockRiskMockRecorder
+	isgomock struct{}
+}
+
+// MockRiskMockRecorder is the mock recorder for MockRisk.
+type MockRiskMockRecorder struct {
+	mock *MockRisk
+}
+
+// NewMockRisk creates a new mock instance.
+func NewMockRisk(ctrl *gomock.Controller) *MockRisk {
+	mock := &MockRisk{ctrl: ctrl}
+	mock.recorder = &MockRiskMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRisk) EXPECT() *MockRiskMockRecorder {
+	return m.recorder
+}
+
+// Evaluate mocks base method.
+func (m *MockRisk) Evaluate(ctx context.Context, params *models.RiskParams) *models.RiskAssessment {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", ctx, params)
+	ret0, _ := ret[0].(*models.RiskAssessment)
+	return ret0
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockRiskMockRecorder) Evaluate(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockRisk)(nil).Evaluate), ctx, params)
+}
@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/pkg/geoip"
+	"loki/pkg/webhook"
+)
+
+func Test_Risk_Evaluate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+		Risk: config.Risk{
+			ReauthenticateScore: 50,
+			BlockScore:          90,
+			RefreshLimit:        30,
+			RefreshWindow:       time.Hour,
+		},
+	}
+	log := logger.NewLogger(cfg)
+
+	database, err := geoip.Load(strings.NewReader(strings.Join([]string{
+		"192.0.2.0/24,EE,59.437,24.7536",
+		"198.51.100.0/24,LV,56.9496,24.1052",
+		"203.0.113.0/24,US,40.7128,-74.006",
+	}, "\n")))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	audit := NewMockAudit(ctrl)
+	loginSessionRepository := repositories.NewMockLoginSessionRepository(ctrl)
+	rateLimitRepository := repositories.NewMockRateLimitRepository(ctrl)
+	webhookService := webhook.NewMockWebhook(ctrl)
+	service := NewRisk(cfg, audit, database, loginSessionRepository, rateLimitRepository, webhookService, log)
+
+	userId := uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac")
+	sessionId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+
+	sent := make(chan struct{}, 1)
+	send := func(err error) {
+		webhookService.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, any) error {
+			sent <- struct{}{}
+			return err
+		})
+	}
+
+	history := func(ago time.Duration) []models.LoginSession {
+		return []models.LoginSession{
+			{
+				ID:              sessionId,
+				UserID:          userId,
+				IPAddress:       "192.0.2.10",
+				UserAgent:       "Mozilla/5.0",
+				CreatedAt:       time.Now().Add(-ago - time.Hour),
+				LastRefreshedAt: time.Now().Add(-ago),
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		params   *models.RiskParams
+		before   func()
+		webhook  bool
+		expected *models.RiskAssessment
+	}{
+		{
+			name: "First login",
+			params: &models.RiskParams{
+				Stage:     models.RiskStageLogin,
+				UserID:    userId,
+				IPAddress: "192.0.2.10",
+				UserAgent: "Mozilla/5.0",
+			},
+			before: func() {
+				loginSessionRepository.EXPECT().List(ctx, userId, uint64(RiskHistoryLimit)).Return([]models.LoginSession{}, nil)
+			},
+			expected: &models.RiskAssessment{
+				Signals: []string{},
+				Country: "EE",
+				Action:  models.RiskActionAllow,
+			},
+		},
+		{
+			name: "Known device and country",
+			params: &models.RiskParams{
+				Stage:     models.RiskStageLogin,
+				UserID:    userId,
+				IPAddress: "192.0.2.20",
+				UserAgent: "Mozilla/5.0",
+			},
+			before: func() {
+				loginSessionRepository.EXPECT().List(ctx, userId, uint64(RiskHistoryLimit)).Return(history(48*time.Hour), nil)
+			},
+			expected: &models.RiskAssessment{
+				Signals: []string{},
+				Country: "EE",
+				Action:  models.RiskActionAllow,
+			},
+		},
+		{
+			name: "New user agent",
+			params: &models.RiskParams{
+				Stage:     models.RiskStageLogin,
+				UserID:    userId,
+				IPAddress: "192.0.2.20",
+				UserAgent: "curl/8.0",
+			},
+			before: func() {
+				loginSessionRepository.EXPECT().List(ctx, userId, uint64(RiskHistoryLimit)).Return(history(48*time.Hour), nil)
+				audit.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditRiskDetected,
+					ActorID:    userId,
+					TargetType: "user",
+					TargetID:   userId.String(),
+					Status:     models.AuditSuccess,
+					Metadata: map[string]string{
+						"stage":   models.RiskStageLogin,
+						"score":   "10",
+						"signals": models.RiskNewUserAgent,
+						"action":  models.RiskActionAllow,
+						"country": "EE",
+					},
+					RemoteAddr: "192.0.2.20",
+				})
+				send(nil)
+			},
+			webhook: true,
+			expected: &models.RiskAssessment{
+				Score:   10,
+				Signals: []string{models.RiskNewUserAgent},
+				Country: "EE",
+				Action:  models.RiskActionAllow,
+			},
+		},
+		{
+			name: "New country within travel distance",
+			params: &models.RiskParams{
+				Stage:     models.RiskStageLogin,
+				UserID:    userId,
+				IPAddress: "198.51.100.7",
+				UserAgent: "Mozilla/5.0",
+			},
+			before: func() {
+				loginSessionRepository.EXPECT().List(ctx, userId, uint64(RiskHistoryLimit)).Return(history(time.Hour), nil)
+				audit.EXPECT().Record(ctx, gomock.Any())
+				send(nil)
+			},
+			webhook: true,
+			expected: &models.RiskAssessment{
+				Score:   40,
+				Signals: []string{models.RiskNewCountry},
+				Country: "LV",
+				Action:  models.RiskActionAllow,
+			},
+		},
+		{
+			name: "Impossible travel",
+			params: &models.RiskParams{
+				Stage:     models.RiskStageLogin,
+				UserID:    userId,
+				IPAddress: "203.0.113.5",
+				UserAgent: "Mozilla/5.0",
+			},
+			before: func() {
+				loginSessionRepository.EXPECT().List(ctx, userId, uint64(RiskHistoryLimit)).Return(history(time.Hour), nil)
+				audit.EXPECT().Record(ctx, gomock.Any())
+				send(assert.AnError)
+			},
+			webhook: true,
+			expected: &models.RiskAssessment{
+				Score:   100,
+				Signals: []string{models.RiskNewCountry, models.RiskImpossibleTravel},
+				Country: "US",
+				Action:  models.RiskActionBlock,
+			},
+		},
+		{
+			name: "Travel is measured from the login",
+			params: &models.RiskParams{
+				Stage:     models.RiskStageLogin,
+				UserID:    userId,
+				IPAddress: "203.0.113.5",
+				UserAgent: "Mozilla/5.0",
+			},
+			before: func() {
+				sessions := history(time.Minute)
+				sessions[0].CreatedAt = time.Now().Add(-48 * time.Hour)
+
+				loginSessionRepository.EXPECT().List(ctx, userId, uint64(RiskHistoryLimit)).Return(sessions, nil)
+				audit.EXPECT().Record(ctx, gomock.Any())
+				send(nil)
+			},
+			webhook: true,
+			expected: &models.RiskAssessment{
+				Score:   40,
+				Signals: []string{models.RiskNewCountry},
+				Country: "US",
+				Action:  models.RiskActionAllow,
+			},
+		},
+		{
+			name: "Refreshed session is left out",
+			params: &models.RiskParams{
+				Stage:          models.RiskStageRefresh,
+				UserID:         userId,
+				LoginSessionID: sessionId,
+				IPAddress:      "203.0.113.5",
+				UserAgent:      "Mozilla/5.0",
+			},
+			before: func() {
+				loginSessionRepository.EXPECT().List(ctx, userId, uint64(RiskHistoryLimit)).Return(history(time.Minute), nil)
+				rateLimitRepository.EXPECT().Record(ctx, "risk:refresh:"+userId.String(), time.Hour).Return(int64(1), nil)
+			},
+			expected: &models.RiskAssessment{
+				Signals: []string{},
+				Country: "US",
+				Action:  models.RiskActionAllow,
+			},
+		},
+		{
+			name: "Refresh spike",
+			params: &models.RiskParams{
+				Stage:          models.RiskStageRefresh,
+				UserID:         userId,
+				LoginSessionID: sessionId,
+				IPAddress:      "192.0.2.10",
+				UserAgent:      "Mozilla/5.0",
+			},
+			before: func() {
+				loginSessionRepository.EXPECT().List(ctx, userId, uint64(RiskHistoryLimit)).Return(history(time.Minute), nil)
+				rateLimitRepository.EXPECT().Record(ctx, "risk:refresh:"+userId.String(), time.Hour).Return(int64(31), nil)
+				audit.EXPECT().Record(ctx, gomock.Any())
+				send(nil)
+			},
+			webhook: true,
+			expected: &models.RiskAssessment{
+				Score:   50,
+				Signals: []string{models.RiskRefreshSpike},
+				Country: "EE",
+				Action:  models.RiskActionReauthenticate,
+			},
+		},
+		{
+			name: "Lookups unavailable",
+			params: &models.RiskParams{
+				Stage:          models.RiskStageRefresh,
+				UserID:         userId,
+				LoginSessionID: sessionId,
+				IPAddress:      "10.0.0.1",
+				UserAgent:      "curl/8.0",
+			},
+			before: func() {
+				loginSessionRepository.EXPECT().List(ctx, userId, uint64(RiskHistoryLimit)).Return(nil, assert.AnError)
+				rateLimitRepository.EXPECT().Record(ctx, "risk:refresh:"+userId.String(), time.Hour).Return(int64(0), assert.AnError)
+			},
+			expected: &models.RiskAssessment{
+				Signals: []string{},
+				Action:  models.RiskActionAllow,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result := service.Evaluate(ctx, tt.params)
+			assert.Equal(t, tt.expected, result)
+
+			if tt.webhook {
+				<-sent
+			}
+		})
+	}
+}
@@ -2,8 +2,10 @@ package services
 
 import (
 	"context"
+	"slices"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 
 	"loki/internal/app/errors"
 	"loki/internal/app/models"
@@ -15,11 +17,15 @@ import (
 type Roles interface {
 	List(ctx context.Context, pagination *Pagination) ([]models.Role, uint64, error)
 	Create(ctx context.Context, params *models.Role) (*models.Role, error)
-	Update(ctx context.Context, params *models.Role) (*models.Role, error)
+	Update(ctx context.Context, params *models.Role, fields []string) (*models.Role, error)
 	FindById(ctx context.Context, id uuid.UUID) (*models.Role, error)
 	Delete(ctx context.Context, id uuid.UUID) (bool, error)
 
 	FindRoleDetailsById(ctx context.Context, id uuid.UUID) (*models.Role, error)
+
+	AddPermissions(ctx context.Context, id uuid.UUID, permissionIds []uuid.UUID, actorId uuid.UUID) (*models.Role, error)
+	RemovePermissions(ctx context.Context, id uuid.UUID, permissionIds []uuid.UUID, actorId uuid.UUID) (*models.Role, error)
+	SetPermissions(ctx context.Context, id uuid.UUID, permissionIds []uuid.UUID, actorId uuid.UUID) (*models.Role, error)
 }
 
 type roles struct {
@@ -46,9 +52,14 @@ func (r *roles) List(ctx context.Context, pagination *Pagination) ([]models.Role
 }
 
 func (r *roles) Create(ctx context.Context, params *models.Role) (*models.Role, error) {
+	if err := r.checkParent(ctx, params); err != nil {
+		return nil, err
+	}
+
 	role, err := r.repository.Create(ctx, db.CreateRoleParams{
 		Name:          params.Name,
 		Description:   params.Description,
+		ParentID:      params.ParentID,
 		PermissionIDs: params.PermissionIDs,
 	})
 	if err != nil {
@@ -59,15 +70,33 @@ func (r *roles) Create(ctx context.Context, params *models.Role) (*models.Role,
 	return role, nil
 }
 
-func (r *roles) Update(ctx context.Context, params *models.Role) (*models.Role, error) {
+// Update changes the given fields of the role, an empty field list updates every field
+// and keeps the permissions unless new ones are given
+func (r *roles) Update(ctx context.Context, params *models.Role, fields []string) (*models.Role, error) {
+	if updatesField(fields, "parent_id") {
+		if err := r.checkParent(ctx, params); err != nil {
+			return nil, err
+		}
+	}
+
 	role, err := r.repository.Update(ctx, db.UpdateRoleParams{
-		ID:            params.ID,
-		Name:          params.Name,
-		Description:   params.Description,
-		PermissionIDs: params.PermissionIDs,
+		ID:                  params.ID,
+		UpdateName:          updatesField(fields, "name"),
+		Name:                params.Name,
+		UpdateDescription:   updatesField(fields, "description"),
+		Description:         params.Description,
+		UpdateParentID:      updatesField(fields, "parent_id"),
+		ParentID:            params.ParentID,
+		UpdatePermissionIDs: slices.Contains(fields, "permission_ids") || len(fields) == 0 && len(params.PermissionIDs) > 0,
+		PermissionIDs:       params.PermissionIDs,
 	})
 	if err != nil {
 		r.log.Error().Err(err).Msg("Failed to update role")
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.ErrRecordNotFound
+		}
+
 		return nil, errors.ErrFailedToUpdateRecord
 	}
 
@@ -103,3 +132,76 @@ func (r *roles) FindRoleDetailsById(ctx context.Context, id uuid.UUID) (*models.
 
 	return role, nil
 }
+
+func (r *roles) AddPermissions(ctx context.Context, id uuid.UUID, permissionIds []uuid.UUID, actorId uuid.UUID) (*models.Role, error) {
+	return r.changePermissions(ctx, id, func() (*models.Role, error) {
+		return r.repository.AddPermissions(ctx, db.AddRolePermissionsParams{
+			RoleID:        id,
+			PermissionIds: permissionIds,
+		})
+	})
+}
+
+func (r *roles) RemovePermissions(ctx context.Context, id uuid.UUID, permissionIds []uuid.UUID, actorId uuid.UUID) (*models.Role, error) {
+	return r.changePermissions(ctx, id, func() (*models.Role, error) {
+		return r.repository.RemovePermissions(ctx, db.RemoveRolePermissionsParams{
+			RoleID:        id,
+			PermissionIds: permissionIds,
+		})
+	})
+}
+
+func (r *roles) SetPermissions(ctx context.Context, id uuid.UUID, permissionIds []uuid.UUID, actorId uuid.UUID) (*models.Role, error) {
+	return r.changePermissions(ctx, id, func() (*models.Role, error) {
+		return r.repository.SetPermissions(ctx, db.CreateRolePermissionsParams{
+			RoleID:        id,
+			PermissionIds: permissionIds,
+		})
+	})
+}
+
+// changePermissions runs the change for an existing role, the gRPC audit interceptor records it
+func (r *roles) changePermissions(ctx context.Context, id uuid.UUID, change func() (*models.Role, error)) (*models.Role, error) {
+	if _, err := r.repository.FindById(ctx, id); err != nil {
+		r.log.Error().Err(err).Msg("Failed to find role by id")
+		return nil, errors.ErrRecordNotFound
+	}
+
+	role, err := change()
+	if err != nil {
+		r.log.Error().Err(err).Msg("Failed to change role permissions")
+		return nil, errors.ErrFailedToUpdateRecord
+	}
+
+	return role, nil
+}
+
+// updatesField reports whether an update of the given fields changes the named one
+func updatesField(fields []string, name string) bool {
+	return len(fields) == 0 || slices.Contains(fields, name)
+}
+
+// checkParent ensures the parent role exists and is not the role itself or one of its descendants
+func (r *roles) checkParent(ctx context.Context, params *models.Role) error {
+	if params.ParentID == uuid.Nil {
+		return nil
+	}
+
+	ancestors, err := r.repository.FindAncestorIds(ctx, params.ParentID)
+	if err != nil {
+		r.log.Error().Err(err).Msg("Failed to find role ancestors")
+		return errors.ErrFailedToFetchResults
+	}
+
+	if len(ancestors) == 0 {
+		return errors.ErrRoleNotFound
+	}
+
+	for _, id := range ancestors {
+		if id == params.ID {
+			return errors.ErrRoleHierarchyCycle
+		}
+	}
+
+	return nil
+}
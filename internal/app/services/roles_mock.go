@@ -42,6 +42,21 @@ func (m *MockRoles) EXPECT() *MockRolesMockRecorder {
 	return m.recorder
 }
 
+// AddPermissions mocks base method.
+func (m *MockRoles) AddPermissions(ctx context.Context, id uuid.UUID, permissionIds []uuid.UUID, actorId uuid.UUID) (*models.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPermissions", ctx, id, permissionIds, actorId)
+	ret0, _ := ret[0].(*models.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPermissions indicates an expected call of AddPermissions.
+func (mr *MockRolesMockRecorder) AddPermissions(ctx, id, permissionIds, actorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPermissions", reflect.TypeOf((*MockRoles)(nil).AddPermissions), ctx, id, permissionIds, actorId)
+}
+
 // Create mocks base method.
 func (m *MockRoles) Create(ctx context.Context, params *models.Role) (*models.Role, error) {
 	m.ctrl.T.Helper()
@@ -118,17 +133,47 @@ func (mr *MockRolesMockRecorder) List(ctx, pagination any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoles)(nil).List), ctx, pagination)
 }
 
+// RemovePermissions mocks base method.
+func (m *MockRoles) RemovePermissions(ctx context.Context, id uuid.UUID, permissionIds []uuid.UUID, actorId uuid.UUID) (*models.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePermissions", ctx, id, permissionIds, actorId)
+	ret0, _ := ret[0].(*models.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePermissions indicates an expected call of RemovePermissions.
+func (mr *MockRolesMockRecorder) RemovePermissions(ctx, id, permissionIds, actorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePermissions", reflect.TypeOf((*MockRoles)(nil).RemovePermissions), ctx, id, permissionIds, actorId)
+}
+
+// SetPermissions mocks base method.
+func (m *MockRoles) SetPermissions(ctx context.Context, id uuid.UUID, permissionIds []uuid.UUID, actorId uuid.UUID) (*models.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPermissions", ctx, id, permissionIds, actorId)
+	ret0, _ := ret[0].(*models.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPermissions indicates an expected call of SetPermissions.
+func (mr *MockRolesMockRecorder) SetPermissions(ctx, id, permissionIds, actorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPermissions", reflect.TypeOf((*MockRoles)(nil).SetPermissions), ctx, id, permissionIds, actorId)
+}
+
 // Update mocks base method.
-func (m *MockRoles) Update(ctx context.Context, params *models.Role) (*models.Role, error) {
+func (m *MockRoles) Update(ctx context.Context, params *models.Role, fields []string) (*models.Role, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, params)
+	ret := m.ctrl.Call(m, "Update", ctx, params, fields)
 	ret0, _ := ret[0].(*models.Role)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockRolesMockRecorder) Update(ctx, params any) *gomock.Call {
+func (mr *MockRolesMockRecorder) Update(ctx, params, fields any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoles)(nil).Update), ctx, params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoles)(nil).Update), ctx, params, fields)
 }
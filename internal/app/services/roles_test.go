@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
@@ -127,6 +128,8 @@ func Test_Roles_Create(t *testing.T) {
 	repository := repositories.NewMockRoleRepository(ctrl)
 	service := NewRoles(repository, log)
 
+	parentId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+
 	tests := []struct {
 		name     string
 		before   func()
@@ -171,6 +174,59 @@ func Test_Roles_Create(t *testing.T) {
 			expected: nil,
 			error:    errors.ErrFailedToCreateRecord,
 		},
+		{
+			name: "Success with parent",
+			params: &models.Role{
+				Name:        "auditor",
+				Description: "Auditor role",
+				ParentID:    parentId,
+			},
+			before: func() {
+				repository.EXPECT().FindAncestorIds(ctx, parentId).Return([]uuid.UUID{parentId}, nil)
+				repository.EXPECT().Create(ctx, db.CreateRoleParams{
+					Name:        "auditor",
+					Description: "Auditor role",
+					ParentID:    parentId,
+				}).Return(&models.Role{
+					ID:          uuid.MustParse("10000000-1000-1000-1000-000000000004"),
+					Name:        "auditor",
+					Description: "Auditor role",
+					ParentID:    parentId,
+				}, nil)
+			},
+			expected: &models.Role{
+				ID:          uuid.MustParse("10000000-1000-1000-1000-000000000004"),
+				Name:        "auditor",
+				Description: "Auditor role",
+				ParentID:    parentId,
+			},
+		},
+		{
+			name: "Parent not found",
+			params: &models.Role{
+				Name:        "auditor",
+				Description: "Auditor role",
+				ParentID:    parentId,
+			},
+			before: func() {
+				repository.EXPECT().FindAncestorIds(ctx, parentId).Return([]uuid.UUID{}, nil)
+			},
+			expected: nil,
+			error:    errors.ErrRoleNotFound,
+		},
+		{
+			name: "Failed to fetch parent",
+			params: &models.Role{
+				Name:        "auditor",
+				Description: "Auditor role",
+				ParentID:    parentId,
+			},
+			before: func() {
+				repository.EXPECT().FindAncestorIds(ctx, parentId).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrFailedToFetchResults,
+		},
 	}
 
 	for _, tt := range tests {
@@ -180,7 +236,7 @@ func Test_Roles_Create(t *testing.T) {
 			result, err := service.Create(ctx, tt.params)
 
 			if tt.error != nil {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, tt.error)
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
@@ -205,42 +261,191 @@ func Test_Roles_Update(t *testing.T) {
 	repository := repositories.NewMockRoleRepository(ctrl)
 	service := NewRoles(repository, log)
 
+	parentId := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+
 	tests := []struct {
 		name     string
 		before   func()
+		params   *models.Role
+		fields   []string
 		expected *models.Role
 		error    error
 	}{
 		{
 			name: "Success",
+			params: &models.Role{
+				ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				Name:        models.AdminRoleType,
+				Description: "Admin role",
+			},
 			before: func() {
 				repository.EXPECT().Update(ctx, db.UpdateRoleParams{
+					ID:                uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+					UpdateName:        true,
+					Name:              models.AdminRoleType,
+					UpdateDescription: true,
+					Description:       "Admin role",
+					UpdateParentID:    true,
+				}).Return(&models.Role{
 					ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
 					Name:        models.AdminRoleType,
 					Description: "Admin role",
+				}, nil)
+			},
+			expected: &models.Role{
+				ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				Name:        models.AdminRoleType,
+				Description: "Admin role",
+			},
+		},
+		{
+			name: "Error",
+			params: &models.Role{
+				ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				Name:        models.AdminRoleType,
+				Description: "Admin role",
+			},
+			before: func() {
+				repository.EXPECT().Update(ctx, db.UpdateRoleParams{
+					ID:                uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+					UpdateName:        true,
+					Name:              models.AdminRoleType,
+					UpdateDescription: true,
+					Description:       "Admin role",
+					UpdateParentID:    true,
+				}).Return(nil, errors.ErrFailedToUpdateRecord)
+			},
+			expected: nil,
+			error:    errors.ErrFailedToUpdateRecord,
+		},
+		{
+			name: "Success with parent",
+			params: &models.Role{
+				ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				Name:        models.AdminRoleType,
+				Description: "Admin role",
+				ParentID:    parentId,
+			},
+			before: func() {
+				repository.EXPECT().FindAncestorIds(ctx, parentId).Return([]uuid.UUID{
+					parentId,
+					uuid.MustParse("10000000-1000-1000-1000-000000000003"),
+				}, nil)
+				repository.EXPECT().Update(ctx, db.UpdateRoleParams{
+					ID:                uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+					UpdateName:        true,
+					Name:              models.AdminRoleType,
+					UpdateDescription: true,
+					Description:       "Admin role",
+					UpdateParentID:    true,
+					ParentID:          parentId,
 				}).Return(&models.Role{
 					ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
 					Name:        models.AdminRoleType,
 					Description: "Admin role",
+					ParentID:    parentId,
 				}, nil)
 			},
 			expected: &models.Role{
 				ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
 				Name:        models.AdminRoleType,
 				Description: "Admin role",
+				ParentID:    parentId,
 			},
 		},
 		{
-			name: "Error",
+			name: "Parent is the role itself",
+			params: &models.Role{
+				ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				Name:        models.AdminRoleType,
+				Description: "Admin role",
+				ParentID:    uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+			},
+			before: func() {
+				repository.EXPECT().FindAncestorIds(ctx, uuid.MustParse("10000000-1000-1000-1000-000000000001")).Return([]uuid.UUID{
+					uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				}, nil)
+			},
+			expected: nil,
+			error:    errors.ErrRoleHierarchyCycle,
+		},
+		{
+			name: "Parent is a descendant",
+			params: &models.Role{
+				ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				Name:        models.AdminRoleType,
+				Description: "Admin role",
+				ParentID:    parentId,
+			},
+			before: func() {
+				repository.EXPECT().FindAncestorIds(ctx, parentId).Return([]uuid.UUID{
+					parentId,
+					uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				}, nil)
+			},
+			expected: nil,
+			error:    errors.ErrRoleHierarchyCycle,
+		},
+		{
+			name: "Update mask keeps parent and permissions",
+			params: &models.Role{
+				ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				Description: "Admin role updated",
+				ParentID:    uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+			},
+			fields: []string{"description"},
 			before: func() {
 				repository.EXPECT().Update(ctx, db.UpdateRoleParams{
+					ID:                uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+					UpdateDescription: true,
+					Description:       "Admin role updated",
+					ParentID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				}).Return(&models.Role{
 					ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
 					Name:        models.AdminRoleType,
-					Description: "Admin role",
-				}).Return(nil, errors.ErrFailedToUpdateRecord)
+					Description: "Admin role updated",
+				}, nil)
+			},
+			expected: &models.Role{
+				ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				Name:        models.AdminRoleType,
+				Description: "Admin role updated",
+			},
+		},
+		{
+			name: "Update mask replaces permissions",
+			params: &models.Role{
+				ID:            uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				PermissionIDs: []uuid.UUID{},
+			},
+			fields: []string{"permission_ids"},
+			before: func() {
+				repository.EXPECT().Update(ctx, db.UpdateRoleParams{
+					ID:                  uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+					UpdatePermissionIDs: true,
+					PermissionIDs:       []uuid.UUID{},
+				}).Return(&models.Role{
+					ID:   uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+					Name: models.AdminRoleType,
+				}, nil)
+			},
+			expected: &models.Role{
+				ID:   uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				Name: models.AdminRoleType,
+			},
+		},
+		{
+			name: "Not found",
+			params: &models.Role{
+				ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+				Name:        models.AdminRoleType,
+				Description: "Admin role",
+			},
+			before: func() {
+				repository.EXPECT().Update(ctx, gomock.Any()).Return(nil, pgx.ErrNoRows)
 			},
 			expected: nil,
-			error:    errors.ErrFailedToUpdateRecord,
+			error:    errors.ErrRecordNotFound,
 		},
 	}
 
@@ -248,14 +453,10 @@ func Test_Roles_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.before()
 
-			result, err := service.Update(ctx, &models.Role{
-				ID:          uuid.MustParse("10000000-1000-1000-1000-000000000001"),
-				Name:        models.AdminRoleType,
-				Description: "Admin role",
-			})
+			result, err := service.Update(ctx, tt.params, tt.fields)
 
 			if tt.error != nil {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, tt.error)
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
@@ -451,3 +652,114 @@ func Test_Roles_FindRoleDetailsById(t *testing.T) {
 		})
 	}
 }
+
+func Test_Roles_SetPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockRoleRepository(ctrl)
+	service := NewRoles(repository, log)
+
+	id := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+	actorId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+
+	tests := []struct {
+		name          string
+		before        func()
+		permissionIds []uuid.UUID
+		expected      *models.Role
+		error         error
+	}{
+		{
+			name: "Remove all permissions",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Role{ID: id}, nil)
+				repository.EXPECT().SetPermissions(ctx, db.CreateRolePermissionsParams{
+					RoleID:        id,
+					PermissionIds: []uuid.UUID{},
+				}).Return(&models.Role{ID: id, Name: "manager", PermissionIDs: []uuid.UUID{}}, nil)
+			},
+			permissionIds: []uuid.UUID{},
+			expected:      &models.Role{ID: id, Name: "manager", PermissionIDs: []uuid.UUID{}},
+			error:         nil,
+		},
+		{
+			name: "Role not found",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(nil, assert.AnError)
+			},
+			permissionIds: []uuid.UUID{},
+			expected:      nil,
+			error:         errors.ErrRecordNotFound,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Role{ID: id}, nil)
+				repository.EXPECT().SetPermissions(ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+			permissionIds: []uuid.UUID{},
+			expected:      nil,
+			error:         errors.ErrFailedToUpdateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.SetPermissions(ctx, id, tt.permissionIds, actorId)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Roles_AddPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockRoleRepository(ctrl)
+	service := NewRoles(repository, log)
+
+	id := uuid.MustParse("10000000-1000-1000-1000-000000000002")
+	actorId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	permissionIds := []uuid.UUID{uuid.MustParse("10000000-1000-1000-3000-000000000003")}
+
+	repository.EXPECT().FindById(ctx, id).Return(&models.Role{ID: id}, nil)
+	repository.EXPECT().AddPermissions(ctx, db.AddRolePermissionsParams{
+		RoleID:        id,
+		PermissionIds: permissionIds,
+	}).Return(&models.Role{ID: id, PermissionIDs: permissionIds}, nil)
+
+	result, err := service.AddPermissions(ctx, id, permissionIds, actorId)
+	assert.NoError(t, err)
+	assert.Equal(t, permissionIds, result.PermissionIDs)
+
+	repository.EXPECT().FindById(ctx, id).Return(&models.Role{ID: id}, nil)
+	repository.EXPECT().RemovePermissions(ctx, db.RemoveRolePermissionsParams{
+		RoleID:        id,
+		PermissionIds: permissionIds,
+	}).Return(&models.Role{ID: id, PermissionIDs: []uuid.UUID{}}, nil)
+
+	result, err = service.RemovePermissions(ctx, id, permissionIds, actorId)
+	assert.NoError(t, err)
+	assert.Empty(t, result.PermissionIDs)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/repositories"
 	"loki/internal/config/logger"
@@ -14,6 +15,7 @@ type Sessions interface {
 	Create(ctx context.Context, params *models.CreateSessionParams) (*models.Session, error)
 	Update(ctx context.Context, params *models.UpdateSessionParams) (*models.Session, error)
 	Delete(ctx context.Context, sessionId string) error
+	Consume(ctx context.Context, sessionId string) (*models.Session, error)
 	FindById(ctx context.Context, sessionId string) (*models.Session, error)
 }
 
@@ -37,9 +39,12 @@ func (s *sessions) Create(ctx context.Context, params *models.CreateSessionParam
 	}
 
 	err = s.repository.Create(ctx, &models.Session{
-		ID:     id,
-		Code:   params.Code,
-		Status: models.SessionRunning,
+		ID:           id,
+		Code:         params.Code,
+		Status:       models.SessionRunning,
+		PersonalCode: params.PersonalCode,
+		PhoneNumber:  params.PhoneNumber,
+		Provider:     params.Provider,
 	})
 	if err != nil {
 		s.log.Error().Err(err).Msg("Failed to create session")
@@ -54,23 +59,40 @@ func (s *sessions) Create(ctx context.Context, params *models.CreateSessionParam
 }
 
 func (s *sessions) Update(ctx context.Context, params *models.UpdateSessionParams) (*models.Session, error) {
-	err := s.repository.Update(ctx, &models.Session{
-		ID:     params.ID,
-		UserId: params.UserId,
-		Status: params.Status,
-		Error:  params.Error,
-	})
+	current, err := s.repository.FindById(ctx, params.ID)
+	if err != nil {
+		s.log.Error().Err(err).Msg("Failed to find session")
+		return nil, err
+	}
+
+	if !current.CanTransitionTo(params.Status) {
+		s.log.Error().Msgf("Session %s cannot transition from %s to %s", params.ID, current.Status, params.Status)
+		return nil, errors.ErrInvalidSessionTransition
+	}
+
+	if params.Status == models.SessionSuccess && params.UserId == uuid.Nil {
+		s.log.Error().Msgf("Session %s cannot succeed without a user", params.ID)
+		return nil, errors.ErrInvalidSessionTransition
+	}
+
+	session := &models.Session{
+		ID:           params.ID,
+		UserId:       params.UserId,
+		Code:         current.Code,
+		Status:       params.Status,
+		Error:        params.Error,
+		PersonalCode: current.PersonalCode,
+		PhoneNumber:  current.PhoneNumber,
+		Provider:     current.Provider,
+	}
+
+	err = s.repository.Update(ctx, session)
 	if err != nil {
 		s.log.Error().Err(err).Msg("Failed to update session")
 		return nil, err
 	}
 
-	return &models.Session{
-		ID:     params.ID,
-		UserId: params.UserId,
-		Status: params.Status,
-		Error:  params.Error,
-	}, nil
+	return session, nil
 }
 
 func (s *sessions) Delete(ctx context.Context, sessionId string) error {
@@ -89,6 +111,23 @@ func (s *sessions) Delete(ctx context.Context, sessionId string) error {
 	return nil
 }
 
+// Consume returns the session and removes it when it succeeded, so it can be exchanged for tokens only once
+func (s *sessions) Consume(ctx context.Context, sessionId string) (*models.Session, error) {
+	id, err := uuid.Parse(sessionId)
+	if err != nil {
+		s.log.Error().Err(err).Msg("Invalid session ID format")
+		return nil, err
+	}
+
+	result, err := s.repository.Consume(ctx, id)
+	if err != nil {
+		s.log.Error().Err(err).Msg("Failed to consume session")
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (s *sessions) FindById(ctx context.Context, sessionId string) (*models.Session, error) {
 	id, err := uuid.Parse(sessionId)
 	if err != nil {
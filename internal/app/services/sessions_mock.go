@@ -41,6 +41,21 @@ func (m *MockSessions) EXPECT() *MockSessionsMockRecorder {
 	return m.recorder
 }
 
+// Consume mocks base method.
+func (m *MockSessions) Consume(ctx context.Context, sessionId string) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, sessionId)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockSessionsMockRecorder) Consume(ctx, sessionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockSessions)(nil).Consume), ctx, sessionId)
+}
+
 // Create mocks base method.
 func (m *MockSessions) Create(ctx context.Context, params *models.CreateSessionParams) (*models.Session, error) {
 	m.ctrl.T.Helper()
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/repositories"
 	"loki/internal/config"
@@ -109,6 +110,7 @@ func Test_Authentication_Update(t *testing.T) {
 	service := NewSessions(repository, log)
 
 	id := uuid.MustParse("5eab0e6a-c3e7-4526-a47e-398f0d31f514")
+	userId := uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac")
 
 	tests := []struct {
 		name     string
@@ -120,31 +122,146 @@ func Test_Authentication_Update(t *testing.T) {
 		{
 			name: "Success",
 			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Session{
+					ID:     id,
+					Code:   "1234",
+					Status: models.SessionRunning,
+				}, nil)
 				repository.EXPECT().Update(ctx, &models.Session{
 					ID:     id,
-					Status: "COMPLETE",
+					UserId: userId,
+					Code:   "1234",
+					Status: models.SessionSuccess,
 				}).Return(nil)
 			},
 			params: &models.UpdateSessionParams{
 				ID:     id,
-				Status: "COMPLETE",
+				UserId: userId,
+				Status: models.SessionSuccess,
 			},
 			expected: &models.Session{
 				ID:     id,
-				Status: "COMPLETE",
+				UserId: userId,
+				Code:   "1234",
+				Status: models.SessionSuccess,
+			},
+		},
+		{
+			name: "Success: keeps phone number",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Session{
+					ID:          id,
+					Code:        "1234",
+					Status:      models.SessionRunning,
+					PhoneNumber: "+37268000769",
+				}, nil)
+				repository.EXPECT().Update(ctx, &models.Session{
+					ID:          id,
+					UserId:      userId,
+					Code:        "1234",
+					Status:      models.SessionSuccess,
+					PhoneNumber: "+37268000769",
+				}).Return(nil)
+			},
+			params: &models.UpdateSessionParams{
+				ID:     id,
+				UserId: userId,
+				Status: models.SessionSuccess,
+			},
+			expected: &models.Session{
+				ID:          id,
+				UserId:      userId,
+				Code:        "1234",
+				Status:      models.SessionSuccess,
+				PhoneNumber: "+37268000769",
+			},
+		},
+		{
+			name: "Success: cancelled",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Session{
+					ID:     id,
+					Code:   "1234",
+					Status: models.SessionRunning,
+				}, nil)
+				repository.EXPECT().Update(ctx, &models.Session{
+					ID:     id,
+					Code:   "1234",
+					Status: models.SessionCancelled,
+					Error:  "authentication failed: USER_REFUSED",
+				}).Return(nil)
 			},
+			params: &models.UpdateSessionParams{
+				ID:     id,
+				Status: models.SessionCancelled,
+				Error:  "authentication failed: USER_REFUSED",
+			},
+			expected: &models.Session{
+				ID:     id,
+				Code:   "1234",
+				Status: models.SessionCancelled,
+				Error:  "authentication failed: USER_REFUSED",
+			},
+		},
+		{
+			name: "Error: session not found",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(nil, errors.ErrSessionNotFound)
+			},
+			params: &models.UpdateSessionParams{
+				ID:     id,
+				UserId: userId,
+				Status: models.SessionSuccess,
+			},
+			expected: nil,
+			error:    errors.ErrSessionNotFound,
+		},
+		{
+			name: "Error: session already completed",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionError,
+				}, nil)
+			},
+			params: &models.UpdateSessionParams{
+				ID:     id,
+				UserId: userId,
+				Status: models.SessionSuccess,
+			},
+			expected: nil,
+			error:    errors.ErrInvalidSessionTransition,
+		},
+		{
+			name: "Error: success without user",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionRunning,
+				}, nil)
+			},
+			params: &models.UpdateSessionParams{
+				ID:     id,
+				Status: models.SessionSuccess,
+			},
+			expected: nil,
+			error:    errors.ErrInvalidSessionTransition,
 		},
 		{
 			name: "Error",
 			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionRunning,
+				}, nil)
 				repository.EXPECT().Update(ctx, &models.Session{
 					ID:     id,
-					Status: "COMPLETE",
+					Status: models.SessionError,
 				}).Return(assert.AnError)
 			},
 			params: &models.UpdateSessionParams{
 				ID:     id,
-				Status: "COMPLETE",
+				Status: models.SessionError,
 			},
 			expected: nil,
 			error:    assert.AnError,
@@ -158,7 +275,7 @@ func Test_Authentication_Update(t *testing.T) {
 			result, err := service.Update(ctx, tt.params)
 
 			if tt.error != nil {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, tt.error)
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
@@ -222,6 +339,80 @@ func Test_Sessions_Delete(t *testing.T) {
 	}
 }
 
+func Test_Sessions_Consume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockSessionRepository(ctrl)
+	service := NewSessions(repository, log)
+
+	id := uuid.MustParse("5eab0e6a-c3e7-4526-a47e-398f0d31f514")
+	sessionId := id.String()
+
+	tests := []struct {
+		name      string
+		before    func()
+		sessionId string
+		expected  *models.Session
+		error     error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().Consume(ctx, id).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionSuccess,
+				}, nil)
+			},
+			sessionId: sessionId,
+			expected: &models.Session{
+				ID:     id,
+				Status: models.SessionSuccess,
+			},
+		},
+		{
+			name:      "Invalid session ID",
+			before:    func() {},
+			sessionId: "invalid",
+			expected:  nil,
+			error:     assert.AnError,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().Consume(ctx, id).Return(nil, errors.ErrSessionNotFound)
+			},
+			sessionId: sessionId,
+			expected:  nil,
+			error:     errors.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Consume(ctx, tt.sessionId)
+
+			if tt.error != nil {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
 func Test_Sessions_FindById(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+type Throttle interface {
+	Allow(ctx context.Context, params *models.ThrottleParams) (time.Duration, error)
+	AllowMethod(ctx context.Context, method, ip string) (time.Duration, error)
+	Refused(ctx context.Context, params *models.ThrottleParams)
+}
+
+type throttle struct {
+	cfg        *config.Config
+	repository repositories.RateLimitRepository
+	log        *logger.Logger
+}
+
+// counter is a single rate limited value such as the client address or the personal code
+type counter struct {
+	name  string
+	value string
+	limit int
+}
+
+func NewThrottle(cfg *config.Config, repository repositories.RateLimitRepository, log *logger.Logger) Throttle {
+	return &throttle{
+		cfg:        cfg,
+		repository: repository,
+		log:        log,
+	}
+}
+
+// Allow counts the start of an authentication and returns ErrTooManyRequests with the time to wait
+// when a limit is reached or the person is cooling down, Redis failures let the request through
+func (t *throttle) Allow(ctx context.Context, params *models.ThrottleParams) (time.Duration, error) {
+	for _, c := range persons(params) {
+		remaining, err := t.repository.Blocked(ctx, cooldownKey(c))
+		if err != nil {
+			t.log.Error().Err(err).Msg("Failed to check rate limit cooldown")
+			continue
+		}
+
+		if remaining > 0 {
+			t.log.Warn().Str("endpoint", params.Endpoint).Str("counter", c.name).Msg("Authentication refused during cooldown")
+			return remaining, errors.ErrTooManyRequests
+		}
+	}
+
+	limit := t.limit(params.Endpoint)
+	counters := []counter{
+		{name: "ip", value: params.IPAddress, limit: limit.IP},
+		{name: "personal_code", value: params.PersonalCode, limit: limit.PersonalCode},
+		{name: "phone_number", value: params.PhoneNumber, limit: limit.PhoneNumber},
+	}
+
+	for _, c := range counters {
+		if retryAfter, err := t.hit(ctx, params.Endpoint, c); err != nil {
+			return retryAfter, err
+		}
+	}
+
+	return 0, nil
+}
+
+// AllowMethod counts a gRPC call of the client address against the limit of the method, a method without
+// an override shares the default limit
+func (t *throttle) AllowMethod(ctx context.Context, method, ip string) (time.Duration, error) {
+	limit, ok := t.cfg.Throttling.GrpcMethods[method]
+	if !ok {
+		limit = t.cfg.Throttling.Grpc.IP
+	}
+
+	return t.hit(ctx, "grpc:"+method, counter{name: "ip", value: ip, limit: limit})
+}
+
+// Refused records that the person declined the authentication on their device, repeated refusals start a cooldown
+func (t *throttle) Refused(ctx context.Context, params *models.ThrottleParams) {
+	if t.cfg.Throttling.Refusals <= 0 {
+		return
+	}
+
+	for _, c := range persons(params) {
+		count, err := t.repository.Record(ctx, "refusals:"+c.name+":"+c.value, t.cfg.Throttling.Window)
+		if err != nil {
+			t.log.Error().Err(err).Msg("Failed to record authentication refusal")
+			continue
+		}
+
+		if count < int64(t.cfg.Throttling.Refusals) {
+			continue
+		}
+
+		if err = t.repository.Block(ctx, cooldownKey(c), t.cfg.Throttling.Cooldown); err != nil {
+			t.log.Error().Err(err).Msg("Failed to start rate limit cooldown")
+			continue
+		}
+
+		t.log.Warn().Str("endpoint", params.Endpoint).Str("counter", c.name).Msgf("Cooldown started after %d refusals", count)
+	}
+}
+
+func (t *throttle) hit(ctx context.Context, scope string, c counter) (time.Duration, error) {
+	if c.limit <= 0 || c.value == "" {
+		return 0, nil
+	}
+
+	retryAfter, err := t.repository.Hit(ctx, scope+":"+c.name+":"+c.value, c.limit, t.cfg.Throttling.Window)
+	if err != nil {
+		t.log.Error().Err(err).Msg("Failed to check rate limit")
+		return 0, nil
+	}
+
+	if retryAfter > 0 {
+		t.log.Warn().Str("endpoint", scope).Str("counter", c.name).Msg("Rate limit exceeded")
+		return retryAfter, errors.ErrTooManyRequests
+	}
+
+	return 0, nil
+}
+
+func (t *throttle) limit(endpoint string) config.RateLimit {
+	switch endpoint {
+	case models.AuthMethodSmartId:
+		return t.cfg.Throttling.SmartId
+	case models.AuthMethodMobileId:
+		return t.cfg.Throttling.MobileId
+	default:
+		return config.RateLimit{}
+	}
+}
+
+// persons returns the values identifying the person a push notification is sent to
+func persons(params *models.ThrottleParams) []counter {
+	result := make([]counter, 0, 2)
+	if params.PersonalCode != "" {
+		result = append(result, counter{name: "personal_code", value: params.PersonalCode})
+	}
+	if params.PhoneNumber != "" {
+		result = append(result, counter{name: "phone_number", value: params.PhoneNumber})
+	}
+
+	return result
+}
+
+func cooldownKey(c counter) string {
+	return "cooldown:" + c.name + ":" + c.value
+}
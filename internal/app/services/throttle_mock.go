@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/throttle.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/throttle.go -destination=internal/app/services/throttle_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockThrottle is a mock of Throttle interface.
+type MockThrottle struct {
+	ctrl     *gomock.Controller
+	recorder *MockThrottleMockRecorder
+	isgomock struct{}
+}
+
+// MockThrottleMockRecorder is the mock recorder for MockThrottle.
+type MockThrottleMockRecorder struct {
+	mock *MockThrottle
+}
+
+// NewMockThrottle creates a new mock instance.
+func NewMockThrottle(ctrl *gomock.Controller) *MockThrottle {
+	mock := &MockThrottle{ctrl: ctrl}
+	mock.recorder = &MockThrottleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockThrottle) EXPECT() *MockThrottleMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockThrottle) Allow(ctx context.Context, params *models.ThrottleParams) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", ctx, params)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockThrottleMockRecorder) Allow(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockThrottle)(nil).Allow), ctx, params)
+}
+
+// AllowMethod mocks base method.
+func (m *MockThrottle) AllowMethod(ctx context.Context, method, ip string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowMethod", ctx, method, ip)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowMethod indicates an expected call of AllowMethod.
+func (mr *MockThrottleMockRecorder) AllowMethod(ctx, method, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowMethod", reflect.TypeOf((*MockThrottle)(nil).AllowMethod), ctx, method, ip)
+}
+
+// Refused mocks base method.
+func (m *MockThrottle) Refused(ctx context.Context, params *models.ThrottleParams) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Refused", ctx, params)
+}
+
+// Refused indicates an expected call of Refused.
+func (mr *MockThrottleMockRecorder) Refused(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refused", reflect.TypeOf((*MockThrottle)(nil).Refused), ctx, params)
+}
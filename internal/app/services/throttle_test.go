@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+func Test_Throttle_Allow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+		Throttling: config.Throttling{
+			Window:   time.Minute,
+			MobileId: config.RateLimit{IP: 20, PersonalCode: 5, PhoneNumber: 5},
+		},
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockRateLimitRepository(ctrl)
+	service := NewThrottle(cfg, repository, log)
+
+	params := &models.ThrottleParams{
+		Endpoint:     models.AuthMethodMobileId,
+		IPAddress:    "192.0.2.1",
+		PersonalCode: "60001017869",
+		PhoneNumber:  "+37268000769",
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected time.Duration
+		error    error
+	}{
+		{
+			name: "Allowed",
+			before: func() {
+				repository.EXPECT().Blocked(ctx, "cooldown:personal_code:60001017869").Return(time.Duration(0), nil)
+				repository.EXPECT().Blocked(ctx, "cooldown:phone_number:+37268000769").Return(time.Duration(0), nil)
+				repository.EXPECT().Hit(ctx, "mobile_id:ip:192.0.2.1", 20, time.Minute).Return(time.Duration(0), nil)
+				repository.EXPECT().Hit(ctx, "mobile_id:personal_code:60001017869", 5, time.Minute).Return(time.Duration(0), nil)
+				repository.EXPECT().Hit(ctx, "mobile_id:phone_number:+37268000769", 5, time.Minute).Return(time.Duration(0), nil)
+			},
+			expected: 0,
+		},
+		{
+			name: "Cooldown",
+			before: func() {
+				repository.EXPECT().Blocked(ctx, "cooldown:personal_code:60001017869").Return(10*time.Minute, nil)
+			},
+			expected: 10 * time.Minute,
+			error:    errors.ErrTooManyRequests,
+		},
+		{
+			name: "Limit exceeded",
+			before: func() {
+				repository.EXPECT().Blocked(ctx, gomock.Any()).Return(time.Duration(0), nil).Times(2)
+				repository.EXPECT().Hit(ctx, "mobile_id:ip:192.0.2.1", 20, time.Minute).Return(time.Duration(0), nil)
+				repository.EXPECT().Hit(ctx, "mobile_id:personal_code:60001017869", 5, time.Minute).Return(30*time.Second, nil)
+			},
+			expected: 30 * time.Second,
+			error:    errors.ErrTooManyRequests,
+		},
+		{
+			name: "Redis failure",
+			before: func() {
+				repository.EXPECT().Blocked(ctx, gomock.Any()).Return(time.Duration(0), assert.AnError).Times(2)
+				repository.EXPECT().Hit(ctx, gomock.Any(), gomock.Any(), time.Minute).Return(time.Duration(0), assert.AnError).Times(3)
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Allow(ctx, params)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Throttle_AllowMethod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+		Throttling: config.Throttling{
+			Window: time.Minute,
+			Grpc:   config.RateLimit{IP: 600},
+			GrpcMethods: map[string]int{
+				"/sso.v1.AuditService/Export": 5,
+				"/sso.v1.AuditService/Verify": 0,
+			},
+		},
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockRateLimitRepository(ctrl)
+	service := NewThrottle(cfg, repository, log)
+
+	method := "/sso.v1.UserService/List"
+
+	tests := []struct {
+		name     string
+		method   string
+		ip       string
+		before   func()
+		expected time.Duration
+		error    error
+	}{
+		{
+			name:   "Allowed",
+			method: method,
+			ip:     "192.0.2.1",
+			before: func() {
+				repository.EXPECT().Hit(ctx, "grpc:"+method+":ip:192.0.2.1", 600, time.Minute).Return(time.Duration(0), nil)
+			},
+			expected: 0,
+		},
+		{
+			name:   "Limit exceeded",
+			method: method,
+			ip:     "192.0.2.1",
+			before: func() {
+				repository.EXPECT().Hit(ctx, "grpc:"+method+":ip:192.0.2.1", 600, time.Minute).Return(time.Second, nil)
+			},
+			expected: time.Second,
+			error:    errors.ErrTooManyRequests,
+		},
+		{
+			name:     "Unknown address",
+			method:   method,
+			ip:       "",
+			before:   func() {},
+			expected: 0,
+		},
+		{
+			name:   "Method override",
+			method: "/sso.v1.AuditService/Export",
+			ip:     "192.0.2.1",
+			before: func() {
+				repository.EXPECT().Hit(ctx, "grpc:/sso.v1.AuditService/Export:ip:192.0.2.1", 5, time.Minute).Return(time.Duration(0), nil)
+			},
+			expected: 0,
+		},
+		{
+			name:     "Method without limit",
+			method:   "/sso.v1.AuditService/Verify",
+			ip:       "192.0.2.1",
+			before:   func() {},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.AllowMethod(ctx, tt.method, tt.ip)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Throttle_Refused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+		Throttling: config.Throttling{
+			Window:   10 * time.Minute,
+			Refusals: 3,
+			Cooldown: 30 * time.Minute,
+		},
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockRateLimitRepository(ctrl)
+	service := NewThrottle(cfg, repository, log)
+
+	params := &models.ThrottleParams{
+		Endpoint:     models.AuthMethodSmartId,
+		PersonalCode: "30303039914",
+	}
+
+	tests := []struct {
+		name   string
+		before func()
+	}{
+		{
+			name: "Below threshold",
+			before: func() {
+				repository.EXPECT().Record(ctx, "refusals:personal_code:30303039914", 10*time.Minute).Return(int64(2), nil)
+			},
+		},
+		{
+			name: "Cooldown started",
+			before: func() {
+				repository.EXPECT().Record(ctx, "refusals:personal_code:30303039914", 10*time.Minute).Return(int64(3), nil)
+				repository.EXPECT().Block(ctx, "cooldown:personal_code:30303039914", 30*time.Minute).Return(nil)
+			},
+		},
+		{
+			name: "Redis failure",
+			before: func() {
+				repository.EXPECT().Record(ctx, "refusals:personal_code:30303039914", 10*time.Minute).Return(int64(0), assert.AnError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			service.Refused(ctx, params)
+		})
+	}
+}
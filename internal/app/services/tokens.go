@@ -2,38 +2,54 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/repositories"
 	"loki/internal/app/repositories/db"
+	"loki/internal/config"
 	"loki/pkg/jwt"
 	"loki/internal/config/logger"
+	"loki/internal/config/telemetry"
 )
 
 type Tokens interface {
 	List(ctx context.Context, pagination *Pagination) ([]models.Token, uint64, error)
-	Create(ctx context.Context, userId uuid.UUID) (*models.User, error)
-	Update(ctx context.Context, refreshToken string) (*models.User, error)
+	Create(ctx context.Context, params *models.CreateLoginSessionParams) (*models.User, error)
+	Update(ctx context.Context, params *models.RefreshTokenParams) (*models.User, error)
+	StepUp(ctx context.Context, params *models.StepUpTokenParams) (*models.User, error)
 	FindById(ctx context.Context, id uuid.UUID) (*models.Token, error)
 	Delete(ctx context.Context, id uuid.UUID) (bool, error)
 }
 
 type tokens struct {
-	jwt        jwt.Jwt
-	permission repositories.PermissionRepository
-	role       repositories.RoleRepository
-	scope      repositories.ScopeRepository
-	token      repositories.TokenRepository
-	user       repositories.UserRepository
-	log        *logger.Logger
+	cfg          *config.Config
+	jwt          jwt.Jwt
+	loginSession repositories.LoginSessionRepository
+	organisation repositories.OrganisationRepository
+	permission   repositories.PermissionRepository
+	profile      repositories.ProfileRepository
+	risk         Risk
+	role         repositories.RoleRepository
+	scope        repositories.ScopeRepository
+	token        repositories.TokenRepository
+	user         repositories.UserRepository
+	log          *logger.Logger
 }
 
 func NewTokens(
+	cfg *config.Config,
 	jwt jwt.Jwt,
+	loginSession repositories.LoginSessionRepository,
+	organisation repositories.OrganisationRepository,
 	permission repositories.PermissionRepository,
+	profile repositories.ProfileRepository,
+	risk Risk,
 	role repositories.RoleRepository,
 	scope repositories.ScopeRepository,
 	token repositories.TokenRepository,
@@ -41,13 +57,18 @@ func NewTokens(
 	log *logger.Logger,
 ) Tokens {
 	return &tokens{
-		jwt:        jwt,
-		permission: permission,
-		role:       role,
-		scope:      scope,
-		token:      token,
-		user:       user,
-		log:        log,
+		cfg:          cfg,
+		jwt:          jwt,
+		loginSession: loginSession,
+		organisation: organisation,
+		permission:   permission,
+		profile:      profile,
+		risk:         risk,
+		role:         role,
+		scope:        scope,
+		token:        token,
+		user:         user,
+		log:          log,
 	}
 }
 
@@ -62,18 +83,37 @@ func (t *tokens) List(ctx context.Context, pagination *Pagination) ([]models.Tok
 	return collection, total, err
 }
 
-func (t *tokens) Create(ctx context.Context, userId uuid.UUID) (*models.User, error) {
-	user, err := t.user.FindById(ctx, userId)
+// Create starts a new login session for the user and issues the first token pair of its family
+func (t *tokens) Create(ctx context.Context, params *models.CreateLoginSessionParams) (*models.User, error) {
+	user, err := t.user.FindById(ctx, params.UserID)
 	if err != nil {
 		t.log.Error().Err(err).Msg("Failed to find user")
 		return nil, errors.ErrRecordNotFound
 	}
 
-	accessToken, refreshToken, err := t.generate(ctx, user)
+	if !user.CanAuthenticate() {
+		t.log.Warn().Str("user_id", user.ID.String()).Str("status", user.Status).Msg("Refused tokens for inactive user")
+		return nil, errors.ErrUserInactive
+	}
+
+	session, err := t.loginSession.Create(ctx, db.CreateLoginSessionParams{
+		UserID:    user.ID,
+		Provider:  params.Provider,
+		IpAddress: pgtype.Text{String: params.IPAddress, Valid: params.IPAddress != ""},
+		UserAgent: pgtype.Text{String: params.UserAgent, Valid: params.UserAgent != ""},
+	})
+	if err != nil {
+		t.log.Error().Err(err).Msg("Failed to create login session")
+		return nil, errors.ErrFailedToCreateRecord
+	}
+
+	accessToken, refreshToken, err := t.generate(ctx, user, session)
 	if err != nil {
 		return nil, err
 	}
 
+	telemetry.RecordToken(ctx, telemetry.TokenIssued)
+
 	return &models.User{
 		ID:             user.ID,
 		IdentityNumber: user.IdentityNumber,
@@ -85,8 +125,8 @@ func (t *tokens) Create(ctx context.Context, userId uuid.UUID) (*models.User, er
 	}, nil
 }
 
-func (t *tokens) Update(ctx context.Context, refreshToken string) (*models.User, error) {
-	payload, err := t.jwt.Decode(refreshToken)
+func (t *tokens) Update(ctx context.Context, params *models.RefreshTokenParams) (*models.User, error) {
+	payload, err := t.jwt.Decode(params.RefreshToken)
 	if err != nil {
 		t.log.Error().Err(err).Msg("Failed to decode token")
 		return nil, err
@@ -98,11 +138,39 @@ func (t *tokens) Update(ctx context.Context, refreshToken string) (*models.User,
 		return nil, err
 	}
 
-	accessToken, refreshToken, err := t.generate(ctx, user)
+	if !user.CanAuthenticate() {
+		t.log.Warn().Str("user_id", user.ID.String()).Str("status", user.Status).Msg("Refused token refresh for inactive user")
+		return nil, errors.ErrUserInactive
+	}
+
+	sessionId, err := uuid.Parse(payload.SessionID)
 	if err != nil {
+		t.log.Warn().Str("user_id", user.ID.String()).Msg("Refused token refresh without login session")
+		return nil, errors.ErrInvalidToken
+	}
+
+	if err = t.checkRisk(ctx, user, sessionId, params); err != nil {
 		return nil, err
 	}
 
+	session, err := t.loginSession.Refresh(ctx, sessionId, user.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			t.log.Warn().Str("user_id", user.ID.String()).Str("login_session_id", sessionId.String()).Msg("Refused token refresh for revoked login session")
+			return nil, errors.ErrLoginSessionRevoked
+		}
+
+		t.log.Error().Err(err).Msg("Failed to refresh login session")
+		return nil, errors.ErrFailedToUpdateRecord
+	}
+
+	accessToken, refreshToken, err := t.generate(ctx, user, session)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.RecordToken(ctx, telemetry.TokenRefreshed)
+
 	return &models.User{
 		ID:             user.ID,
 		IdentityNumber: user.IdentityNumber,
@@ -114,46 +182,107 @@ func (t *tokens) Update(ctx context.Context, refreshToken string) (*models.User,
 	}, nil
 }
 
-func (t *tokens) generate(ctx context.Context, user *models.User) (string, string, error) {
-	userRoles, err := t.role.FindByUserId(ctx, user.ID)
+// checkRisk evaluates the refresh before the login session is touched, a risky refresh revokes the session
+func (t *tokens) checkRisk(ctx context.Context, user *models.User, sessionId uuid.UUID, params *models.RefreshTokenParams) error {
+	assessment := t.risk.Evaluate(ctx, &models.RiskParams{
+		Stage:          models.RiskStageRefresh,
+		UserID:         user.ID,
+		LoginSessionID: sessionId,
+		IPAddress:      params.IPAddress,
+		UserAgent:      params.UserAgent,
+	})
+	if assessment.Action == models.RiskActionAllow {
+		return nil
+	}
+
+	t.log.Warn().Str("user_id", user.ID.String()).Str("login_session_id", sessionId.String()).Int("score", assessment.Score).Msg("Refused risky token refresh")
+
+	if err := t.loginSession.Revoke(ctx, sessionId, user.ID); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		t.log.Error().Err(err).Msg("Failed to revoke login session")
+	}
+
+	if assessment.Action == models.RiskActionBlock {
+		return errors.ErrSuspiciousLogin
+	}
+
+	return errors.ErrReauthenticationRequired
+}
+
+// StepUp issues a short-lived access token for a fresh re-authentication of the same identity within a login
+// session that has not been revoked, the token is not stored and cannot be refreshed
+func (t *tokens) StepUp(ctx context.Context, params *models.StepUpTokenParams) (*models.User, error) {
+	user, err := t.user.FindById(ctx, params.UserID)
 	if err != nil {
-		return "", "", err
+		t.log.Error().Err(err).Msg("Failed to find user")
+		return nil, errors.ErrRecordNotFound
 	}
-	roles := make([]string, 0, len(userRoles))
-	for _, role := range userRoles {
-		roles = append(roles, role.Name)
+
+	if user.IdentityNumber != params.IdentityNumber {
+		t.log.Warn().Str("user_id", user.ID.String()).Msg("Refused step-up completed by another identity")
+		return nil, errors.ErrStepUpIdentityMismatch
 	}
 
-	userPermissions, err := t.permission.FindByUserId(ctx, user.ID)
+	if !user.CanAuthenticate() {
+		t.log.Warn().Str("user_id", user.ID.String()).Str("status", user.Status).Msg("Refused step-up for inactive user")
+		return nil, errors.ErrUserInactive
+	}
+
+	if _, err = t.loginSession.FindActive(ctx, params.LoginSessionID, user.ID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			t.log.Warn().Str("user_id", user.ID.String()).Str("login_session_id", params.LoginSessionID.String()).Msg("Refused step-up for revoked login session")
+			return nil, errors.ErrLoginSessionRevoked
+		}
+
+		t.log.Error().Err(err).Msg("Failed to find login session")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	payload, err := t.payload(ctx, user, params.LoginSessionID)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
-	permissions := make([]string, 0, len(userPermissions))
-	for _, permission := range userPermissions {
-		permissions = append(permissions, permission.Name)
+	payload.AuthTime = time.Now().Unix()
+	payload.AMR = []string{params.Provider}
+	payload.ACR = models.AcrStepUp
+
+	accessToken, err := t.jwt.Generate(*payload, models.StepUpTokenExp)
+	if err != nil {
+		return nil, err
 	}
 
-	userScopes, err := t.scope.FindByUserId(ctx, user.ID)
+	telemetry.RecordToken(ctx, telemetry.TokenStepUp)
+
+	return &models.User{
+		ID:             user.ID,
+		IdentityNumber: user.IdentityNumber,
+		PersonalCode:   user.PersonalCode,
+		FirstName:      user.FirstName,
+		LastName:       user.LastName,
+		AccessToken:    accessToken,
+	}, nil
+}
+
+func (t *tokens) generate(ctx context.Context, user *models.User, session *models.LoginSession) (string, string, error) {
+	payload, err := t.payload(ctx, user, session.ID)
 	if err != nil {
 		return "", "", err
 	}
-	scopes := make([]string, 0, len(userScopes))
-	for _, scope := range userScopes {
-		scopes = append(scopes, scope.Name)
+	if !session.CreatedAt.IsZero() {
+		payload.AuthTime = session.CreatedAt.Unix()
+	}
+	if session.Provider != "" {
+		payload.AMR = []string{session.Provider}
 	}
+	payload.ACR = models.AcrLogin
 
-	accessToken, err := t.jwt.Generate(jwt.Payload{
-		ID:          user.IdentityNumber,
-		Roles:       roles,
-		Permissions: permissions,
-		Scope:       scopes,
-	}, models.AccessTokenExp)
+	accessToken, err := t.jwt.Generate(*payload, models.AccessTokenExp)
 	if err != nil {
 		return "", "", err
 	}
 
 	refreshToken, err := t.jwt.Generate(jwt.Payload{
-		ID: user.IdentityNumber,
+		ID:        user.IdentityNumber,
+		SessionID: session.ID.String(),
 	}, models.RefreshTokenExp)
 	if err != nil {
 		return "", "", err
@@ -162,6 +291,7 @@ func (t *tokens) generate(ctx context.Context, user *models.User) (string, strin
 	_, err = t.token.Create(ctx, db.CreateTokensParams{
 		UserID:            user.ID,
 		AccessTokenValue:  accessToken,
+		LoginSessionID:    session.ID,
 		RefreshTokenValue: refreshToken,
 	})
 	if err != nil {
@@ -171,6 +301,68 @@ func (t *tokens) generate(ctx context.Context, user *models.User) (string, strin
 	return accessToken, refreshToken, nil
 }
 
+// payload collects the grants and profile claims of the user for an access token of the login session
+func (t *tokens) payload(ctx context.Context, user *models.User, sessionId uuid.UUID) (*jwt.Payload, error) {
+	userRoles, err := t.role.FindByUserId(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]string, 0, len(userRoles))
+	for _, role := range userRoles {
+		roles = append(roles, role.Name)
+	}
+
+	userPermissions, err := t.permission.FindByUserId(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	permissions := make([]string, 0, len(userPermissions))
+	for _, permission := range userPermissions {
+		permissions = append(permissions, permission.Name)
+	}
+
+	userScopes, err := t.scope.FindByUserId(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	scopes := make([]string, 0, len(userScopes))
+	for _, scope := range userScopes {
+		scopes = append(scopes, scope.Name)
+	}
+
+	grants, err := t.organisation.FindGrantsByUserId(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	var organisations map[string]jwt.Organisation
+	if len(grants) > 0 {
+		organisations = make(map[string]jwt.Organisation, len(grants))
+		for _, grant := range grants {
+			organisations[grant.OrganisationID.String()] = jwt.Organisation{
+				Roles:       grant.Roles,
+				Permissions: grant.Permissions,
+			}
+		}
+	}
+
+	payload := &jwt.Payload{
+		ID:            user.IdentityNumber,
+		SessionID:     sessionId.String(),
+		Roles:         roles,
+		Permissions:   permissions,
+		Scope:         scopes,
+		Organisations: organisations,
+	}
+
+	if t.cfg.ProfileClaims {
+		if err = t.profileClaims(ctx, user, payload); err != nil {
+			return nil, err
+		}
+	}
+
+	return payload, nil
+}
+
 func (t *tokens) FindById(ctx context.Context, id uuid.UUID) (*models.Token, error) {
 	token, err := t.token.FindById(ctx, id)
 	if err != nil {
@@ -190,3 +382,22 @@ func (t *tokens) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
 
 	return ok, nil
 }
+
+// profileClaims adds the contact data and locale of the user to the access token payload
+func (t *tokens) profileClaims(ctx context.Context, user *models.User, payload *jwt.Payload) error {
+	profile, err := t.profile.FindByUserId(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	payload.Email = profile.Email
+	payload.EmailVerified = profile.EmailVerified()
+	payload.PhoneNumber = profile.PhoneNumber
+	payload.Locale = profile.Locale
+
+	return nil
+}
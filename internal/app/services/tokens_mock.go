@@ -43,18 +43,18 @@ func (m *MockTokens) EXPECT() *MockTokensMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockTokens) Create(ctx context.Context, userId uuid.UUID) (*models.User, error) {
+func (m *MockTokens) Create(ctx context.Context, params *models.CreateLoginSessionParams) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, userId)
+	ret := m.ctrl.Call(m, "Create", ctx, params)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockTokensMockRecorder) Create(ctx, userId any) *gomock.Call {
+func (mr *MockTokensMockRecorder) Create(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTokens)(nil).Create), ctx, userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTokens)(nil).Create), ctx, params)
 }
 
 // Delete mocks base method.
@@ -103,17 +103,32 @@ func (mr *MockTokensMockRecorder) List(ctx, pagination any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTokens)(nil).List), ctx, pagination)
 }
 
+// StepUp mocks base method.
+func (m *MockTokens) StepUp(ctx context.Context, params *models.StepUpTokenParams) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StepUp", ctx, params)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StepUp indicates an expected call of StepUp.
+func (mr *MockTokensMockRecorder) StepUp(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StepUp", reflect.TypeOf((*MockTokens)(nil).StepUp), ctx, params)
+}
+
 // Update mocks base method.
-func (m *MockTokens) Update(ctx context.Context, refreshToken string) (*models.User, error) {
+func (m *MockTokens) Update(ctx context.Context, params *models.RefreshTokenParams) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, refreshToken)
+	ret := m.ctrl.Call(m, "Update", ctx, params)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockTokensMockRecorder) Update(ctx, refreshToken any) *gomock.Call {
+func (mr *MockTokensMockRecorder) Update(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTokens)(nil).Update), ctx, refreshToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTokens)(nil).Update), ctx, params)
 }
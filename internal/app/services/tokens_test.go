@@ -3,8 +3,10 @@ package services
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
@@ -28,6 +30,7 @@ func Test_Tokens_List(t *testing.T) {
 	log := logger.NewLogger(cfg)
 
 	ctx := context.Background()
+	organisationRepository := repositories.NewMockOrganisationRepository(ctrl)
 	permissionRepository := repositories.NewMockPermissionRepository(ctrl)
 	roleRepository := repositories.NewMockRoleRepository(ctrl)
 	scopeRepository := repositories.NewMockScopeRepository(ctrl)
@@ -36,8 +39,13 @@ func Test_Tokens_List(t *testing.T) {
 
 	jwtService := jwt.NewMockJwt(ctrl)
 	service := NewTokens(
+		cfg,
 		jwtService,
+		repositories.NewMockLoginSessionRepository(ctrl),
+		organisationRepository,
 		permissionRepository,
+		repositories.NewMockProfileRepository(ctrl),
+		NewMockRisk(ctrl),
 		roleRepository,
 		scopeRepository,
 		tokenRepository,
@@ -106,6 +114,8 @@ func Test_Tokens_Create(t *testing.T) {
 	log := logger.NewLogger(cfg)
 
 	ctx := context.Background()
+	loginSessionRepository := repositories.NewMockLoginSessionRepository(ctrl)
+	organisationRepository := repositories.NewMockOrganisationRepository(ctrl)
 	permissionRepository := repositories.NewMockPermissionRepository(ctrl)
 	roleRepository := repositories.NewMockRoleRepository(ctrl)
 	scopeRepository := repositories.NewMockScopeRepository(ctrl)
@@ -114,8 +124,13 @@ func Test_Tokens_Create(t *testing.T) {
 
 	jwtService := jwt.NewMockJwt(ctrl)
 	service := NewTokens(
+		cfg,
 		jwtService,
+		loginSessionRepository,
+		organisationRepository,
 		permissionRepository,
+		repositories.NewMockProfileRepository(ctrl),
+		NewMockRisk(ctrl),
 		roleRepository,
 		scopeRepository,
 		tokenRepository,
@@ -134,6 +149,9 @@ func Test_Tokens_Create(t *testing.T) {
 		LastName:       "Doe",
 	}
 
+	sessionId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	authTime := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name     string
 		before   func()
@@ -144,24 +162,35 @@ func Test_Tokens_Create(t *testing.T) {
 			name: "Success",
 			before: func() {
 				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().Create(ctx, gomock.Any()).Return(&models.LoginSession{
+					ID:        sessionId,
+					Provider:  models.AuthMethodSmartId,
+					CreatedAt: authTime,
+				}, nil)
 
 				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
 				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
 				scopeRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Scope{}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, user.ID).Return([]models.OrganisationGrant{}, nil)
 
 				jwtService.EXPECT().Generate(
 					jwt.Payload{
 						ID:          "PNOEE-123456789",
+						SessionID:   sessionId.String(),
 						Roles:       []string{},
 						Permissions: []string{},
 						Scope:       []string{},
+						AuthTime:    authTime.Unix(),
+						AMR:         []string{models.AuthMethodSmartId},
+						ACR:         models.AcrLogin,
 					},
 					models.AccessTokenExp,
 				).Return("access-token", nil)
 
 				jwtService.EXPECT().Generate(
 					jwt.Payload{
-						ID: "PNOEE-123456789",
+						ID:        "PNOEE-123456789",
+						SessionID: sessionId.String(),
 					},
 					models.RefreshTokenExp,
 				).Return("refresh-token", nil)
@@ -187,10 +216,28 @@ func Test_Tokens_Create(t *testing.T) {
 			expected: nil,
 			err:      errors.ErrRecordNotFound,
 		},
+		{
+			name: "Inactive user",
+			before: func() {
+				userRepository.EXPECT().FindById(ctx, user.ID).Return(&models.User{ID: user.ID, Status: models.UserSuspended}, nil)
+			},
+			expected: nil,
+			err:      errors.ErrUserInactive,
+		},
+		{
+			name: "Failed to create login session",
+			before: func() {
+				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().Create(ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			err:      errors.ErrFailedToCreateRecord,
+		},
 		{
 			name: "Failed to find user roles",
 			before: func() {
 				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().Create(ctx, gomock.Any()).Return(&models.LoginSession{ID: sessionId}, nil)
 				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return(nil, assert.AnError)
 			},
 			expected: nil,
@@ -200,6 +247,7 @@ func Test_Tokens_Create(t *testing.T) {
 			name: "Failed to find user permissions",
 			before: func() {
 				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().Create(ctx, gomock.Any()).Return(&models.LoginSession{ID: sessionId}, nil)
 
 				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
 				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return(nil, assert.AnError)
@@ -211,6 +259,7 @@ func Test_Tokens_Create(t *testing.T) {
 			name: "Failed to find user scopes",
 			before: func() {
 				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().Create(ctx, gomock.Any()).Return(&models.LoginSession{ID: sessionId}, nil)
 
 				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
 				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
@@ -219,21 +268,95 @@ func Test_Tokens_Create(t *testing.T) {
 			expected: nil,
 			err:      assert.AnError,
 		},
+		{
+			name: "Success with organisation grants",
+			before: func() {
+				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().Create(ctx, gomock.Any()).Return(&models.LoginSession{ID: sessionId}, nil)
+
+				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
+				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
+				scopeRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Scope{}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, user.ID).Return([]models.OrganisationGrant{
+					{
+						OrganisationID: uuid.MustParse("10000000-1000-1000-4000-000000000001"),
+						Roles:          []string{models.ManagerRoleType},
+						Permissions:    []string{"read:self", "read:users"},
+					},
+				}, nil)
+
+				jwtService.EXPECT().Generate(
+					jwt.Payload{
+						ID:          "PNOEE-123456789",
+						SessionID:   sessionId.String(),
+						Roles:       []string{},
+						Permissions: []string{},
+						Scope:       []string{},
+						ACR:         models.AcrLogin,
+						Organisations: map[string]jwt.Organisation{
+							"10000000-1000-1000-4000-000000000001": {
+								Roles:       []string{models.ManagerRoleType},
+								Permissions: []string{"read:self", "read:users"},
+							},
+						},
+					},
+					models.AccessTokenExp,
+				).Return("access-token", nil)
+
+				jwtService.EXPECT().Generate(
+					jwt.Payload{
+						ID:        "PNOEE-123456789",
+						SessionID: sessionId.String(),
+					},
+					models.RefreshTokenExp,
+				).Return("refresh-token", nil)
+
+				tokenRepository.EXPECT().Create(ctx, gomock.Any()).Return([]models.Token{}, nil)
+			},
+			expected: &models.User{
+				ID:             user.ID,
+				IdentityNumber: user.IdentityNumber,
+				PersonalCode:   user.PersonalCode,
+				FirstName:      user.FirstName,
+				LastName:       user.LastName,
+				AccessToken:    "access-token",
+				RefreshToken:   "refresh-token",
+			},
+			err: nil,
+		},
+		{
+			name: "Failed to find user organisations",
+			before: func() {
+				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().Create(ctx, gomock.Any()).Return(&models.LoginSession{ID: sessionId}, nil)
+
+				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
+				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
+				scopeRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Scope{}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, user.ID).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			err:      assert.AnError,
+		},
 		{
 			name: "Failed to generate access token",
 			before: func() {
 				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().Create(ctx, gomock.Any()).Return(&models.LoginSession{ID: sessionId}, nil)
 
 				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
 				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
 				scopeRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Scope{}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, user.ID).Return([]models.OrganisationGrant{}, nil)
 
 				jwtService.EXPECT().Generate(
 					jwt.Payload{
 						ID:          "PNOEE-123456789",
+						SessionID:   sessionId.String(),
 						Roles:       []string{},
 						Permissions: []string{},
 						Scope:       []string{},
+						ACR:         models.AcrLogin,
 					},
 					models.AccessTokenExp,
 				).Return("", assert.AnError)
@@ -245,24 +368,29 @@ func Test_Tokens_Create(t *testing.T) {
 			name: "Failed to save user tokens",
 			before: func() {
 				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().Create(ctx, gomock.Any()).Return(&models.LoginSession{ID: sessionId}, nil)
 
 				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
 				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
 				scopeRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Scope{}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, user.ID).Return([]models.OrganisationGrant{}, nil)
 
 				jwtService.EXPECT().Generate(
 					jwt.Payload{
 						ID:          "PNOEE-123456789",
+						SessionID:   sessionId.String(),
 						Roles:       []string{},
 						Permissions: []string{},
 						Scope:       []string{},
+						ACR:         models.AcrLogin,
 					},
 					models.AccessTokenExp,
 				).Return("access-token", nil)
 
 				jwtService.EXPECT().Generate(
 					jwt.Payload{
-						ID: "PNOEE-123456789",
+						ID:        "PNOEE-123456789",
+						SessionID: sessionId.String(),
 					},
 					models.RefreshTokenExp,
 				).Return("refresh-token", nil)
@@ -278,7 +406,10 @@ func Test_Tokens_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.before()
 
-			result, err := service.Create(ctx, user.ID)
+			result, err := service.Create(ctx, &models.CreateLoginSessionParams{
+				UserID:   user.ID,
+				Provider: models.AuthMethodSmartId,
+			})
 
 			if tt.err != nil {
 				assert.Error(t, err)
@@ -292,6 +423,136 @@ func Test_Tokens_Create(t *testing.T) {
 	}
 }
 
+func Test_Tokens_Create_ProfileClaims(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:        "test",
+		AppAddr:       "localhost:8080",
+		LogLevel:      "info",
+		ProfileClaims: true,
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	loginSessionRepository := repositories.NewMockLoginSessionRepository(ctrl)
+	organisationRepository := repositories.NewMockOrganisationRepository(ctrl)
+	permissionRepository := repositories.NewMockPermissionRepository(ctrl)
+	profileRepository := repositories.NewMockProfileRepository(ctrl)
+	roleRepository := repositories.NewMockRoleRepository(ctrl)
+	scopeRepository := repositories.NewMockScopeRepository(ctrl)
+	tokenRepository := repositories.NewMockTokenRepository(ctrl)
+	userRepository := repositories.NewMockUserRepository(ctrl)
+
+	jwtService := jwt.NewMockJwt(ctrl)
+	service := NewTokens(
+		cfg,
+		jwtService,
+		loginSessionRepository,
+		organisationRepository,
+		permissionRepository,
+		profileRepository,
+		NewMockRisk(ctrl),
+		roleRepository,
+		scopeRepository,
+		tokenRepository,
+		userRepository,
+		log,
+	)
+
+	user := &models.User{
+		ID:             uuid.MustParse("10000000-1000-1000-3000-000000000001"),
+		IdentityNumber: "PNOEE-123456789",
+		PersonalCode:   "123456789",
+		FirstName:      "John",
+		LastName:       "Doe",
+	}
+
+	sessionId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+
+	tests := []struct {
+		name    string
+		profile func() (*models.Profile, error)
+		payload jwt.Payload
+		err     error
+	}{
+		{
+			name: "Verified email",
+			profile: func() (*models.Profile, error) {
+				return &models.Profile{
+					UserID:          user.ID,
+					Email:           "john.doe@example.com",
+					EmailVerifiedAt: time.Now(),
+					PhoneNumber:     "+37268000769",
+					Locale:          "et",
+				}, nil
+			},
+			payload: jwt.Payload{
+				ID:            "PNOEE-123456789",
+				SessionID:     sessionId.String(),
+				Roles:         []string{},
+				Permissions:   []string{},
+				Scope:         []string{},
+				ACR:           models.AcrLogin,
+				Email:         "john.doe@example.com",
+				EmailVerified: true,
+				PhoneNumber:   "+37268000769",
+				Locale:        "et",
+			},
+		},
+		{
+			name: "No profile",
+			profile: func() (*models.Profile, error) {
+				return nil, pgx.ErrNoRows
+			},
+			payload: jwt.Payload{
+				ID:          "PNOEE-123456789",
+				SessionID:   sessionId.String(),
+				Roles:       []string{},
+				Permissions: []string{},
+				Scope:       []string{},
+				ACR:         models.AcrLogin,
+			},
+		},
+		{
+			name: "Failed to find profile",
+			profile: func() (*models.Profile, error) {
+				return nil, assert.AnError
+			},
+			err: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+			loginSessionRepository.EXPECT().Create(ctx, gomock.Any()).Return(&models.LoginSession{ID: sessionId}, nil)
+			roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
+			permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
+			scopeRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Scope{}, nil)
+			organisationRepository.EXPECT().FindGrantsByUserId(ctx, user.ID).Return([]models.OrganisationGrant{}, nil)
+			profileRepository.EXPECT().FindByUserId(ctx, user.ID).Return(tt.profile())
+
+			if tt.err == nil {
+				jwtService.EXPECT().Generate(tt.payload, models.AccessTokenExp).Return("access-token", nil)
+				jwtService.EXPECT().Generate(jwt.Payload{ID: "PNOEE-123456789", SessionID: sessionId.String()}, models.RefreshTokenExp).Return("refresh-token", nil)
+				tokenRepository.EXPECT().Create(ctx, gomock.Any()).Return([]models.Token{}, nil)
+			}
+
+			result, err := service.Create(ctx, &models.CreateLoginSessionParams{UserID: user.ID})
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "access-token", result.AccessToken)
+			}
+		})
+	}
+}
+
 func Test_Tokens_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -304,16 +565,24 @@ func Test_Tokens_Update(t *testing.T) {
 	log := logger.NewLogger(cfg)
 
 	ctx := context.Background()
+	loginSessionRepository := repositories.NewMockLoginSessionRepository(ctrl)
+	organisationRepository := repositories.NewMockOrganisationRepository(ctrl)
 	permissionRepository := repositories.NewMockPermissionRepository(ctrl)
 	roleRepository := repositories.NewMockRoleRepository(ctrl)
 	scopeRepository := repositories.NewMockScopeRepository(ctrl)
 	tokenRepository := repositories.NewMockTokenRepository(ctrl)
 	userRepository := repositories.NewMockUserRepository(ctrl)
 
+	riskService := NewMockRisk(ctrl)
 	jwtService := jwt.NewMockJwt(ctrl)
 	service := NewTokens(
+		cfg,
 		jwtService,
+		loginSessionRepository,
+		organisationRepository,
 		permissionRepository,
+		repositories.NewMockProfileRepository(ctrl),
+		riskService,
 		roleRepository,
 		scopeRepository,
 		tokenRepository,
@@ -334,6 +603,8 @@ func Test_Tokens_Update(t *testing.T) {
 		LastName:       "Doe",
 	}
 
+	sessionId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+
 	tests := []struct {
 		name     string
 		before   func()
@@ -344,26 +615,39 @@ func Test_Tokens_Update(t *testing.T) {
 			name: "Success",
 			before: func() {
 				jwtService.EXPECT().Decode(refreshTokenValue).Return(&jwt.Payload{
-					ID: "PNOEE-123456789",
+					ID:        "PNOEE-123456789",
+					SessionID: sessionId.String(),
 				}, nil)
 				userRepository.EXPECT().FindByIdentityNumber(ctx, "PNOEE-123456789").Return(user, nil)
+				riskService.EXPECT().Evaluate(ctx, &models.RiskParams{
+					Stage:          models.RiskStageRefresh,
+					UserID:         user.ID,
+					LoginSessionID: sessionId,
+					IPAddress:      "127.0.0.1",
+					UserAgent:      "Mozilla/5.0",
+				}).Return(&models.RiskAssessment{Score: 10, Signals: []string{models.RiskNewUserAgent}, Action: models.RiskActionAllow})
+				loginSessionRepository.EXPECT().Refresh(ctx, sessionId, user.ID).Return(&models.LoginSession{ID: sessionId}, nil)
 
 				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
 				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
 				scopeRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Scope{}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, user.ID).Return([]models.OrganisationGrant{}, nil)
 
 				jwtService.EXPECT().Generate(
 					jwt.Payload{
 						ID:          "PNOEE-123456789",
+						SessionID:   sessionId.String(),
 						Roles:       []string{},
 						Permissions: []string{},
 						Scope:       []string{},
+						ACR:         models.AcrLogin,
 					},
 					models.AccessTokenExp,
 				).Return("new-access-token", nil)
 				jwtService.EXPECT().Generate(
 					jwt.Payload{
-						ID: "PNOEE-123456789",
+						ID:        "PNOEE-123456789",
+						SessionID: sessionId.String(),
 					},
 					models.RefreshTokenExp,
 				).Return("new-refresh-token", nil)
@@ -385,7 +669,6 @@ func Test_Tokens_Update(t *testing.T) {
 			name: "Failed to decode token",
 			before: func() {
 				jwtService.EXPECT().Decode(refreshTokenValue).Return(nil, assert.AnError)
-				userRepository.EXPECT().FindByIdentityNumber(ctx, "PNOEE-123456789").Return(user, nil)
 			},
 			expected: nil,
 			err:      assert.AnError,
@@ -399,23 +682,97 @@ func Test_Tokens_Update(t *testing.T) {
 			err:      errors.ErrInvalidToken,
 		},
 		{
-			name: "Failed to generate access token",
+			name: "Deleted user",
+			before: func() {
+				jwtService.EXPECT().Decode(refreshTokenValue).Return(&jwt.Payload{
+					ID: "PNOEE-987654321",
+				}, nil)
+				userRepository.EXPECT().FindByIdentityNumber(ctx, "PNOEE-987654321").Return(&models.User{
+					ID:             id,
+					IdentityNumber: "PNOEE-987654321",
+					Status:         models.UserDeleted,
+				}, nil)
+			},
+			expected: nil,
+			err:      errors.ErrUserInactive,
+		},
+		{
+			name: "Missing login session",
 			before: func() {
 				jwtService.EXPECT().Decode(refreshTokenValue).Return(&jwt.Payload{
 					ID: "PNOEE-123456789",
 				}, nil)
 				userRepository.EXPECT().FindByIdentityNumber(ctx, "PNOEE-123456789").Return(user, nil)
+			},
+			expected: nil,
+			err:      errors.ErrInvalidToken,
+		},
+		{
+			name: "Revoked login session",
+			before: func() {
+				jwtService.EXPECT().Decode(refreshTokenValue).Return(&jwt.Payload{
+					ID:        "PNOEE-123456789",
+					SessionID: sessionId.String(),
+				}, nil)
+				userRepository.EXPECT().FindByIdentityNumber(ctx, "PNOEE-123456789").Return(user, nil)
+				riskService.EXPECT().Evaluate(ctx, gomock.Any()).Return(&models.RiskAssessment{Action: models.RiskActionAllow})
+				loginSessionRepository.EXPECT().Refresh(ctx, sessionId, user.ID).Return(nil, pgx.ErrNoRows)
+			},
+			expected: nil,
+			err:      errors.ErrLoginSessionRevoked,
+		},
+		{
+			name: "Re-authentication required",
+			before: func() {
+				jwtService.EXPECT().Decode(refreshTokenValue).Return(&jwt.Payload{
+					ID:        "PNOEE-123456789",
+					SessionID: sessionId.String(),
+				}, nil)
+				userRepository.EXPECT().FindByIdentityNumber(ctx, "PNOEE-123456789").Return(user, nil)
+				riskService.EXPECT().Evaluate(ctx, gomock.Any()).Return(&models.RiskAssessment{Score: 60, Action: models.RiskActionReauthenticate})
+				loginSessionRepository.EXPECT().Revoke(ctx, sessionId, user.ID).Return(nil)
+			},
+			expected: nil,
+			err:      errors.ErrReauthenticationRequired,
+		},
+		{
+			name: "Suspicious refresh",
+			before: func() {
+				jwtService.EXPECT().Decode(refreshTokenValue).Return(&jwt.Payload{
+					ID:        "PNOEE-123456789",
+					SessionID: sessionId.String(),
+				}, nil)
+				userRepository.EXPECT().FindByIdentityNumber(ctx, "PNOEE-123456789").Return(user, nil)
+				riskService.EXPECT().Evaluate(ctx, gomock.Any()).Return(&models.RiskAssessment{Score: 100, Action: models.RiskActionBlock})
+				loginSessionRepository.EXPECT().Revoke(ctx, sessionId, user.ID).Return(pgx.ErrNoRows)
+			},
+			expected: nil,
+			err:      errors.ErrSuspiciousLogin,
+		},
+		{
+			name: "Failed to generate access token",
+			before: func() {
+				jwtService.EXPECT().Decode(refreshTokenValue).Return(&jwt.Payload{
+					ID:        "PNOEE-123456789",
+					SessionID: sessionId.String(),
+				}, nil)
+				userRepository.EXPECT().FindByIdentityNumber(ctx, "PNOEE-123456789").Return(user, nil)
+				riskService.EXPECT().Evaluate(ctx, gomock.Any()).Return(&models.RiskAssessment{Action: models.RiskActionAllow})
+				loginSessionRepository.EXPECT().Refresh(ctx, sessionId, user.ID).Return(&models.LoginSession{ID: sessionId}, nil)
 
 				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
 				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
 				scopeRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Scope{}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, user.ID).Return([]models.OrganisationGrant{}, nil)
 
 				jwtService.EXPECT().Generate(
 					jwt.Payload{
 						ID:          "PNOEE-123456789",
+						SessionID:   sessionId.String(),
 						Roles:       []string{},
 						Permissions: []string{},
 						Scope:       []string{},
+						ACR:         models.AcrLogin,
 					},
 					models.AccessTokenExp,
 				).Return("", assert.AnError)
@@ -427,26 +784,33 @@ func Test_Tokens_Update(t *testing.T) {
 			name: "Failed to generate refresh token",
 			before: func() {
 				jwtService.EXPECT().Decode(refreshTokenValue).Return(&jwt.Payload{
-					ID: "PNOEE-123456789",
+					ID:        "PNOEE-123456789",
+					SessionID: sessionId.String(),
 				}, nil)
 				userRepository.EXPECT().FindByIdentityNumber(ctx, "PNOEE-123456789").Return(user, nil)
+				riskService.EXPECT().Evaluate(ctx, gomock.Any()).Return(&models.RiskAssessment{Action: models.RiskActionAllow})
+				loginSessionRepository.EXPECT().Refresh(ctx, sessionId, user.ID).Return(&models.LoginSession{ID: sessionId}, nil)
 
 				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
 				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
 				scopeRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Scope{}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, user.ID).Return([]models.OrganisationGrant{}, nil)
 
 				jwtService.EXPECT().Generate(
 					jwt.Payload{
 						ID:          "PNOEE-123456789",
+						SessionID:   sessionId.String(),
 						Roles:       []string{},
 						Permissions: []string{},
 						Scope:       []string{},
+						ACR:         models.AcrLogin,
 					},
 					models.AccessTokenExp,
 				).Return("new-access-token", nil)
 				jwtService.EXPECT().Generate(
 					jwt.Payload{
-						ID: "PNOEE-123456789",
+						ID:        "PNOEE-123456789",
+						SessionID: sessionId.String(),
 					},
 					models.RefreshTokenExp,
 				).Return("", assert.AnError)
@@ -458,25 +822,33 @@ func Test_Tokens_Update(t *testing.T) {
 			name: "Failed to create user tokens",
 			before: func() {
 				jwtService.EXPECT().Decode(refreshTokenValue).Return(&jwt.Payload{
-					ID: "PNOEE-123456789",
+					ID:        "PNOEE-123456789",
+					SessionID: sessionId.String(),
 				}, nil)
+				userRepository.EXPECT().FindByIdentityNumber(ctx, "PNOEE-123456789").Return(user, nil)
+				riskService.EXPECT().Evaluate(ctx, gomock.Any()).Return(&models.RiskAssessment{Action: models.RiskActionAllow})
+				loginSessionRepository.EXPECT().Refresh(ctx, sessionId, user.ID).Return(&models.LoginSession{ID: sessionId}, nil)
 
 				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
 				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
 				scopeRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Scope{}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, user.ID).Return([]models.OrganisationGrant{}, nil)
 
 				jwtService.EXPECT().Generate(
 					jwt.Payload{
 						ID:          "PNOEE-123456789",
+						SessionID:   sessionId.String(),
 						Roles:       []string{},
 						Permissions: []string{},
 						Scope:       []string{},
+						ACR:         models.AcrLogin,
 					},
 					models.AccessTokenExp,
 				).Return("new-access-token", nil)
 				jwtService.EXPECT().Generate(
 					jwt.Payload{
-						ID: "PNOEE-123456789",
+						ID:        "PNOEE-123456789",
+						SessionID: sessionId.String(),
 					},
 					models.RefreshTokenExp,
 				).Return("new-refresh-token", nil)
@@ -492,7 +864,11 @@ func Test_Tokens_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.before()
 
-			result, err := service.Update(ctx, refreshTokenValue)
+			result, err := service.Update(ctx, &models.RefreshTokenParams{
+				RefreshToken: refreshTokenValue,
+				IPAddress:    "127.0.0.1",
+				UserAgent:    "Mozilla/5.0",
+			})
 
 			if tt.err != nil {
 				assert.Error(t, err)
@@ -506,6 +882,181 @@ func Test_Tokens_Update(t *testing.T) {
 	}
 }
 
+func Test_Tokens_StepUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	organisationRepository := repositories.NewMockOrganisationRepository(ctrl)
+	permissionRepository := repositories.NewMockPermissionRepository(ctrl)
+	roleRepository := repositories.NewMockRoleRepository(ctrl)
+	scopeRepository := repositories.NewMockScopeRepository(ctrl)
+	userRepository := repositories.NewMockUserRepository(ctrl)
+
+	loginSessionRepository := repositories.NewMockLoginSessionRepository(ctrl)
+
+	jwtService := jwt.NewMockJwt(ctrl)
+	service := NewTokens(
+		cfg,
+		jwtService,
+		loginSessionRepository,
+		organisationRepository,
+		permissionRepository,
+		repositories.NewMockProfileRepository(ctrl),
+		NewMockRisk(ctrl),
+		roleRepository,
+		scopeRepository,
+		repositories.NewMockTokenRepository(ctrl),
+		userRepository,
+		log,
+	)
+
+	user := &models.User{
+		ID:             uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac"),
+		IdentityNumber: "PNOEE-30303039914",
+		PersonalCode:   "30303039914",
+		FirstName:      "TESTNUMBER",
+		LastName:       "OK",
+		Status:         models.UserActive,
+	}
+
+	sessionId := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+
+	params := &models.StepUpTokenParams{
+		UserID:         user.ID,
+		IdentityNumber: "PNOEE-30303039914",
+		LoginSessionID: sessionId,
+		Provider:       models.AuthMethodMobileId,
+	}
+
+	tests := []struct {
+		name     string
+		params   *models.StepUpTokenParams
+		before   func()
+		expected *models.User
+		err      error
+	}{
+		{
+			name:   "Success",
+			params: params,
+			before: func() {
+				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().FindActive(ctx, sessionId, user.ID).Return(&models.LoginSession{ID: sessionId, UserID: user.ID}, nil)
+				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
+				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
+				scopeRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Scope{}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, user.ID).Return([]models.OrganisationGrant{}, nil)
+
+				jwtService.EXPECT().Generate(gomock.Any(), models.StepUpTokenExp).
+					DoAndReturn(func(payload jwt.Payload, _ time.Duration) (string, error) {
+						assert.Equal(t, "PNOEE-30303039914", payload.ID)
+						assert.Equal(t, sessionId.String(), payload.SessionID)
+						assert.Equal(t, []string{models.AuthMethodMobileId}, payload.AMR)
+						assert.Equal(t, models.AcrStepUp, payload.ACR)
+						assert.WithinDuration(t, time.Now(), time.Unix(payload.AuthTime, 0), time.Minute)
+						return "step-up-token", nil
+					})
+			},
+			expected: &models.User{
+				ID:             user.ID,
+				IdentityNumber: user.IdentityNumber,
+				PersonalCode:   user.PersonalCode,
+				FirstName:      user.FirstName,
+				LastName:       user.LastName,
+				AccessToken:    "step-up-token",
+			},
+		},
+		{
+			name: "Another identity",
+			params: &models.StepUpTokenParams{
+				UserID:         user.ID,
+				IdentityNumber: "PNOLV-329999-99901",
+				LoginSessionID: sessionId,
+				Provider:       models.AuthMethodSmartId,
+			},
+			before: func() {
+				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+			},
+			err: errors.ErrStepUpIdentityMismatch,
+		},
+		{
+			name:   "Inactive user",
+			params: params,
+			before: func() {
+				userRepository.EXPECT().FindById(ctx, user.ID).Return(&models.User{
+					ID:             user.ID,
+					IdentityNumber: user.IdentityNumber,
+					Status:         models.UserSuspended,
+				}, nil)
+			},
+			err: errors.ErrUserInactive,
+		},
+		{
+			name:   "Login session revoked",
+			params: params,
+			before: func() {
+				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().FindActive(ctx, sessionId, user.ID).Return(nil, pgx.ErrNoRows)
+			},
+			err: errors.ErrLoginSessionRevoked,
+		},
+		{
+			name:   "Failed to find login session",
+			params: params,
+			before: func() {
+				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().FindActive(ctx, sessionId, user.ID).Return(nil, assert.AnError)
+			},
+			err: errors.ErrFailedToFetchResults,
+		},
+		{
+			name:   "User not found",
+			params: params,
+			before: func() {
+				userRepository.EXPECT().FindById(ctx, user.ID).Return(nil, assert.AnError)
+			},
+			err: errors.ErrRecordNotFound,
+		},
+		{
+			name:   "Failed to generate access token",
+			params: params,
+			before: func() {
+				userRepository.EXPECT().FindById(ctx, user.ID).Return(user, nil)
+				loginSessionRepository.EXPECT().FindActive(ctx, sessionId, user.ID).Return(&models.LoginSession{ID: sessionId, UserID: user.ID}, nil)
+				roleRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Role{}, nil)
+				permissionRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Permission{}, nil)
+				scopeRepository.EXPECT().FindByUserId(ctx, user.ID).Return([]models.Scope{}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, user.ID).Return([]models.OrganisationGrant{}, nil)
+				jwtService.EXPECT().Generate(gomock.Any(), models.StepUpTokenExp).Return("", assert.AnError)
+			},
+			err: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.StepUp(ctx, tt.params)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
 func Test_Tokens_FindById(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -518,6 +1069,7 @@ func Test_Tokens_FindById(t *testing.T) {
 	log := logger.NewLogger(cfg)
 
 	ctx := context.Background()
+	organisationRepository := repositories.NewMockOrganisationRepository(ctrl)
 	permissionRepository := repositories.NewMockPermissionRepository(ctrl)
 	roleRepository := repositories.NewMockRoleRepository(ctrl)
 	scopeRepository := repositories.NewMockScopeRepository(ctrl)
@@ -526,8 +1078,13 @@ func Test_Tokens_FindById(t *testing.T) {
 
 	jwtService := jwt.NewMockJwt(ctrl)
 	service := NewTokens(
+		cfg,
 		jwtService,
+		repositories.NewMockLoginSessionRepository(ctrl),
+		organisationRepository,
 		permissionRepository,
+		repositories.NewMockProfileRepository(ctrl),
+		NewMockRisk(ctrl),
 		roleRepository,
 		scopeRepository,
 		tokenRepository,
@@ -591,6 +1148,7 @@ func Test_Tokens_Delete(t *testing.T) {
 	log := logger.NewLogger(cfg)
 
 	ctx := context.Background()
+	organisationRepository := repositories.NewMockOrganisationRepository(ctrl)
 	permissionRepository := repositories.NewMockPermissionRepository(ctrl)
 	roleRepository := repositories.NewMockRoleRepository(ctrl)
 	scopeRepository := repositories.NewMockScopeRepository(ctrl)
@@ -599,8 +1157,13 @@ func Test_Tokens_Delete(t *testing.T) {
 
 	jwtService := jwt.NewMockJwt(ctrl)
 	service := NewTokens(
+		cfg,
 		jwtService,
+		repositories.NewMockLoginSessionRepository(ctrl),
+		organisationRepository,
 		permissionRepository,
+		repositories.NewMockProfileRepository(ctrl),
+		NewMockRisk(ctrl),
 		roleRepository,
 		scopeRepository,
 		tokenRepository,
@@ -0,0 +1,218 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+)
+
+const (
+	// userRecordSeparator joins role and scope names within a single CSV column
+	userRecordSeparator = ";"
+
+	// maxUserRecordSize limits the length of a single JSONL line
+	maxUserRecordSize = 1 << 20
+)
+
+var userRecordColumns = []string{"identity_number", "personal_code", "first_name", "last_name", "roles", "scopes"}
+
+// userRecordReader decodes user records one by one, a malformed record is returned as ErrMalformedRecord
+// so that the remaining records can still be read
+type userRecordReader interface {
+	Read() (uint64, *models.UserRecord, error)
+}
+
+type userRecordWriter interface {
+	Write(record *models.UserRecord) error
+	Flush() error
+}
+
+func newUserRecordReader(format string, input io.Reader) (userRecordReader, error) {
+	switch format {
+	case models.UserRecordFormatCSV:
+		return newCsvRecordReader(input)
+	case models.UserRecordFormatJSONL:
+		scanner := bufio.NewScanner(input)
+		scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxUserRecordSize)
+
+		return &jsonlRecordReader{scanner: scanner}, nil
+	default:
+		return nil, errors.ErrUnsupportedFormat
+	}
+}
+
+func newUserRecordWriter(format string, output io.Writer) (userRecordWriter, error) {
+	switch format {
+	case models.UserRecordFormatCSV:
+		writer := csv.NewWriter(output)
+		if err := writer.Write(userRecordColumns); err != nil {
+			return nil, err
+		}
+
+		return &csvRecordWriter{writer: writer}, nil
+	case models.UserRecordFormatJSONL:
+		return &jsonlRecordWriter{encoder: json.NewEncoder(output)}, nil
+	default:
+		return nil, errors.ErrUnsupportedFormat
+	}
+}
+
+type csvRecordReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+// newCsvRecordReader reads the header, the columns may be given in any order
+func newCsvRecordReader(input io.Reader) (*csvRecordReader, error) {
+	reader := csv.NewReader(input)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: missing header", errors.ErrMalformedRecord)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !slices.Contains(userRecordColumns, column) {
+			return nil, fmt.Errorf("%w: unknown column %q", errors.ErrMalformedRecord, column)
+		}
+
+		columns[column] = i
+	}
+
+	for _, column := range []string{"identity_number", "first_name", "last_name"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("%w: missing column %q", errors.ErrMalformedRecord, column)
+		}
+	}
+
+	return &csvRecordReader{reader: reader, columns: columns}, nil
+}
+
+func (c *csvRecordReader) Read() (uint64, *models.UserRecord, error) {
+	fields, err := c.reader.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return uint64(parseErr.StartLine), nil, fmt.Errorf("%w: %s", errors.ErrMalformedRecord, parseErr.Err)
+		}
+
+		return 0, nil, err
+	}
+
+	line, _ := c.reader.FieldPos(0)
+
+	return uint64(line), &models.UserRecord{
+		IdentityNumber: c.field(fields, "identity_number"),
+		PersonalCode:   c.field(fields, "personal_code"),
+		FirstName:      c.field(fields, "first_name"),
+		LastName:       c.field(fields, "last_name"),
+		Roles:          splitUserRecordNames(c.field(fields, "roles")),
+		Scopes:         splitUserRecordNames(c.field(fields, "scopes")),
+	}, nil
+}
+
+func (c *csvRecordReader) field(fields []string, column string) string {
+	i, ok := c.columns[column]
+	if !ok {
+		return ""
+	}
+
+	return strings.TrimSpace(fields[i])
+}
+
+type jsonlRecordReader struct {
+	scanner *bufio.Scanner
+	line    uint64
+}
+
+func (j *jsonlRecordReader) Read() (uint64, *models.UserRecord, error) {
+	for j.scanner.Scan() {
+		j.line++
+
+		data := bytes.TrimSpace(j.scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var record models.UserRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return j.line, nil, fmt.Errorf("%w: %s", errors.ErrMalformedRecord, err)
+		}
+
+		record.IdentityNumber = strings.TrimSpace(record.IdentityNumber)
+		record.PersonalCode = strings.TrimSpace(record.PersonalCode)
+		record.FirstName = strings.TrimSpace(record.FirstName)
+		record.LastName = strings.TrimSpace(record.LastName)
+
+		return j.line, &record, nil
+	}
+
+	if err := j.scanner.Err(); err != nil {
+		return j.line + 1, nil, err
+	}
+
+	return 0, nil, io.EOF
+}
+
+type csvRecordWriter struct {
+	writer *csv.Writer
+}
+
+func (c *csvRecordWriter) Write(record *models.UserRecord) error {
+	return c.writer.Write([]string{
+		record.IdentityNumber,
+		record.PersonalCode,
+		record.FirstName,
+		record.LastName,
+		strings.Join(record.Roles, userRecordSeparator),
+		strings.Join(record.Scopes, userRecordSeparator),
+	})
+}
+
+func (c *csvRecordWriter) Flush() error {
+	c.writer.Flush()
+
+	return c.writer.Error()
+}
+
+type jsonlRecordWriter struct {
+	encoder *json.Encoder
+}
+
+func (j *jsonlRecordWriter) Write(record *models.UserRecord) error {
+	exported := *record
+	if exported.Roles == nil {
+		exported.Roles = []string{}
+	}
+	if exported.Scopes == nil {
+		exported.Scopes = []string{}
+	}
+
+	return j.encoder.Encode(&exported)
+}
+
+func (j *jsonlRecordWriter) Flush() error {
+	return nil
+}
+
+func splitUserRecordNames(value string) []string {
+	names := make([]string, 0)
+	for _, name := range strings.Split(value, userRecordSeparator) {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
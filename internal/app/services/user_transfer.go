@@ -0,0 +1,325 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config/logger"
+	"loki/pkg/validator"
+)
+
+const (
+	// UserImportBatchSize is the number of records upserted within a single transaction
+	UserImportBatchSize = 100
+
+	// UserExportBatchSize is the number of users loaded at once during an export
+	UserExportBatchSize = 500
+)
+
+// ImportReporter receives the result of every imported record in input order
+type ImportReporter func(result *models.ImportResult) error
+
+type UserTransfer interface {
+	Import(ctx context.Context, format string, input io.Reader, dryRun bool, report ImportReporter) error
+	Export(ctx context.Context, format string, output io.Writer) error
+}
+
+type userTransfer struct {
+	users  repositories.UserRepository
+	roles  repositories.RoleRepository
+	scopes repositories.ScopeRepository
+	log    *logger.Logger
+}
+
+func NewUserTransfer(
+	users repositories.UserRepository,
+	roles repositories.RoleRepository,
+	scopes repositories.ScopeRepository,
+	log *logger.Logger,
+) UserTransfer {
+	return &userTransfer{
+		users:  users,
+		roles:  roles,
+		scopes: scopes,
+		log:    log,
+	}
+}
+
+// importRow is a decoded record waiting for its batch to be validated and written
+type importRow struct {
+	line   uint64
+	record *models.UserRecord
+	err    error
+	user   *models.User
+	result models.ImportResult
+}
+
+// Import validates the records and upserts the valid ones in batches, every batch is written in its own
+// transaction so a failing batch does not roll back the previous ones. In dry-run mode nothing is written.
+func (u *userTransfer) Import(ctx context.Context, format string, input io.Reader, dryRun bool, report ImportReporter) error {
+	reader, err := newUserRecordReader(format, input)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]uint64)
+	batch := make([]*importRow, 0, UserImportBatchSize)
+
+	for {
+		line, record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, errors.ErrMalformedRecord) {
+			u.log.Error().Err(err).Msg("Failed to read import records")
+			return err
+		}
+
+		row := &importRow{line: line, record: record, err: err}
+		if record != nil {
+			if first, ok := seen[record.IdentityNumber]; ok && record.IdentityNumber != "" {
+				row.err = fmt.Errorf("%w: identity number already given on line %d", errors.ErrDuplicateRecord, first)
+			} else {
+				seen[record.IdentityNumber] = line
+			}
+		}
+
+		batch = append(batch, row)
+		if len(batch) < UserImportBatchSize {
+			continue
+		}
+
+		if err = u.importBatch(ctx, batch, dryRun, report); err != nil {
+			return err
+		}
+		batch = batch[:0]
+	}
+
+	return u.importBatch(ctx, batch, dryRun, report)
+}
+
+func (u *userTransfer) importBatch(ctx context.Context, batch []*importRow, dryRun bool, report ImportReporter) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if err := u.validate(ctx, batch); err != nil {
+		return err
+	}
+
+	if !dryRun {
+		if err := u.write(ctx, batch); err != nil {
+			return err
+		}
+	}
+
+	for _, row := range batch {
+		row.result.Line = row.line
+		if row.record != nil {
+			row.result.IdentityNumber = row.record.IdentityNumber
+		}
+
+		switch {
+		case row.err != nil:
+			row.result.Status = models.ImportStatusInvalid
+			row.result.Error = row.err.Error()
+		case dryRun:
+			row.result.Status = models.ImportStatusValid
+		}
+
+		if err := report(&row.result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validate checks the rows of the batch and resolves role and scope names to their ids
+func (u *userTransfer) validate(ctx context.Context, batch []*importRow) error {
+	roleNames, scopeNames := make([]string, 0), make([]string, 0)
+	for _, row := range batch {
+		if row.record != nil {
+			roleNames = append(roleNames, row.record.Roles...)
+			scopeNames = append(scopeNames, row.record.Scopes...)
+		}
+	}
+
+	roles, err := u.roles.FindByNames(ctx, roleNames)
+	if err != nil {
+		u.log.Error().Err(err).Msg("Failed to fetch import roles")
+		return errors.ErrFailedToFetchResults
+	}
+
+	scopes, err := u.scopes.FindByNames(ctx, scopeNames)
+	if err != nil {
+		u.log.Error().Err(err).Msg("Failed to fetch import scopes")
+		return errors.ErrFailedToFetchResults
+	}
+
+	roleIds := make(map[string]uuid.UUID, len(roles))
+	for _, role := range roles {
+		roleIds[role.Name] = role.ID
+	}
+
+	scopeIds := make(map[string]uuid.UUID, len(scopes))
+	for _, scope := range scopes {
+		scopeIds[scope.Name] = scope.ID
+	}
+
+	for _, row := range batch {
+		if row.err != nil {
+			continue
+		}
+
+		row.user, row.err = toImportUser(row.record, roleIds, scopeIds)
+	}
+
+	return nil
+}
+
+// write upserts the valid rows of the batch, a failed transaction marks all of them as failed
+func (u *userTransfer) write(ctx context.Context, batch []*importRow) error {
+	valid := make([]*importRow, 0, len(batch))
+	identityNumbers := make([]string, 0, len(batch))
+	for _, row := range batch {
+		if row.err == nil {
+			valid = append(valid, row)
+			identityNumbers = append(identityNumbers, row.user.IdentityNumber)
+		}
+	}
+
+	if len(valid) == 0 {
+		return nil
+	}
+
+	existing, err := u.users.FindByIdentityNumbers(ctx, identityNumbers)
+	if err != nil {
+		u.log.Error().Err(err).Msg("Failed to fetch imported users")
+		return errors.ErrFailedToFetchResults
+	}
+
+	known := make(map[string]bool, len(existing))
+	for _, user := range existing {
+		known[user.IdentityNumber] = true
+	}
+
+	users := make([]models.User, 0, len(valid))
+	for _, row := range valid {
+		users = append(users, *row.user)
+	}
+
+	imported, err := u.users.Import(ctx, users)
+	if err != nil {
+		u.log.Error().Err(err).Int("records", len(users)).Msg("Failed to import users")
+
+		for _, row := range valid {
+			row.result.Status = models.ImportStatusFailed
+			row.result.Error = errors.ErrFailedToCreateRecord.Error()
+		}
+
+		return nil
+	}
+
+	for i, row := range valid {
+		row.result.UserID = imported[i].ID
+		row.result.Status = models.ImportStatusCreated
+		if known[row.user.IdentityNumber] {
+			row.result.Status = models.ImportStatusUpdated
+		}
+	}
+
+	return nil
+}
+
+// Export writes all users with their permanent roles and scopes, ordered by identity number
+func (u *userTransfer) Export(ctx context.Context, format string, output io.Writer) error {
+	writer, err := newUserRecordWriter(format, output)
+	if err != nil {
+		return err
+	}
+
+	var after string
+	for {
+		records, err := u.users.ListRecords(ctx, after, UserExportBatchSize)
+		if err != nil {
+			u.log.Error().Err(err).Msg("Failed to fetch exported users")
+			return errors.ErrFailedToFetchResults
+		}
+
+		for i := range records {
+			if err = writer.Write(&records[i]); err != nil {
+				return err
+			}
+		}
+
+		if len(records) < UserExportBatchSize {
+			break
+		}
+
+		after = records[len(records)-1].IdentityNumber
+	}
+
+	return writer.Flush()
+}
+
+// toImportUser validates the record, the personal code is derived from the identity number when omitted
+func toImportUser(record *models.UserRecord, roleIds, scopeIds map[string]uuid.UUID) (*models.User, error) {
+	var errs validator.Errors
+
+	personalCode, err := validator.IdentityNumber(record.IdentityNumber)
+	errs.Add("identity_number", err)
+
+	if err == nil && record.PersonalCode != "" && record.PersonalCode != personalCode {
+		errs.Add("personal_code", errors.ErrInvalidPersonalCode)
+	}
+
+	if record.FirstName == "" {
+		errs.Add("first_name", errors.ErrEmptyFirstName)
+	}
+
+	if record.LastName == "" {
+		errs.Add("last_name", errors.ErrEmptyLastName)
+	}
+
+	user := &models.User{
+		IdentityNumber: record.IdentityNumber,
+		PersonalCode:   personalCode,
+		FirstName:      record.FirstName,
+		LastName:       record.LastName,
+		RoleIDs:        make([]uuid.UUID, 0, len(record.Roles)),
+		ScopeIDs:       make([]uuid.UUID, 0, len(record.Scopes)),
+	}
+
+	for _, name := range record.Roles {
+		id, ok := roleIds[name]
+		if !ok {
+			errs.Add("roles", fmt.Errorf("%w: %s", errors.ErrRoleNotFound, name))
+			continue
+		}
+
+		user.RoleIDs = append(user.RoleIDs, id)
+	}
+
+	for _, name := range record.Scopes {
+		id, ok := scopeIds[name]
+		if !ok {
+			errs.Add("scopes", fmt.Errorf("%w: %s", errors.ErrScopeNotFound, name))
+			continue
+		}
+
+		user.ScopeIDs = append(user.ScopeIDs, id)
+	}
+
+	if err = errs.Err(); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/services/user_transfer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/services/user_transfer.go -destination=internal/app/services/user_transfer_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserTransfer is a mock of UserTransfer interface.
+type MockUserTransfer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserTransferMockRecorder
+	isgomock struct{}
+}
+
+// MockUserTransferMockRecorder is the mock recorder for MockUserTransfer.
+type MockUserTransferMockRecorder struct {
+	mock *MockUserTransfer
+}
+
+// NewMockUserTransfer creates a new mock instance.
+func NewMockUserTransfer(ctrl *gomock.Controller) *MockUserTransfer {
+	mock := &MockUserTransfer{ctrl: ctrl}
+	mock.recorder = &MockUserTransferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserTransfer) EXPECT() *MockUserTransferMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockUserTransfer) Export(ctx context.Context, format string, output io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, format, output)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockUserTransferMockRecorder) Export(ctx, format, output any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockUserTransfer)(nil).Export), ctx, format, output)
+}
+
+// Import mocks base method.
+func (m *MockUserTransfer) Import(ctx context.Context, format string, input io.Reader, dryRun bool, report ImportReporter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, format, input, dryRun, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockUserTransferMockRecorder) Import(ctx, format, input, dryRun, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockUserTransfer)(nil).Import), ctx, format, input, dryRun, report)
+}
@@ -0,0 +1,274 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/repositories"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+func Test_UserTransfer_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	users := repositories.NewMockUserRepository(ctrl)
+	roles := repositories.NewMockRoleRepository(ctrl)
+	scopes := repositories.NewMockScopeRepository(ctrl)
+	service := NewUserTransfer(users, roles, scopes, log)
+
+	adminId := uuid.MustParse("10000000-1000-1000-1000-000000000001")
+	selfServiceId := uuid.MustParse("20000000-2000-2000-2000-000000000001")
+	johnId := uuid.MustParse("30000000-3000-3000-3000-000000000001")
+	janeId := uuid.MustParse("30000000-3000-3000-3000-000000000002")
+
+	resolveNames := func() {
+		roles.EXPECT().FindByNames(ctx, gomock.Any()).Return([]models.Role{{ID: adminId, Name: "admin"}}, nil)
+		scopes.EXPECT().FindByNames(ctx, gomock.Any()).Return([]models.Scope{{ID: selfServiceId, Name: "self-service"}}, nil)
+	}
+
+	csvInput := "identity_number,first_name,last_name,roles,scopes\n" +
+		"PNOEE-30303039914,John,Doe,admin,self-service\n" +
+		"PNOEE-60001017869,Jane,Doe,,\n"
+
+	tests := []struct {
+		name     string
+		format   string
+		input    string
+		dryRun   bool
+		before   func()
+		expected []models.ImportResult
+		error    error
+	}{
+		{
+			name:   "CSV",
+			format: models.UserRecordFormatCSV,
+			input:  csvInput,
+			before: func() {
+				resolveNames()
+				users.EXPECT().FindByIdentityNumbers(ctx, []string{"PNOEE-30303039914", "PNOEE-60001017869"}).
+					Return([]models.User{{ID: janeId, IdentityNumber: "PNOEE-60001017869"}}, nil)
+				users.EXPECT().Import(ctx, []models.User{
+					{
+						IdentityNumber: "PNOEE-30303039914",
+						PersonalCode:   "30303039914",
+						FirstName:      "John",
+						LastName:       "Doe",
+						RoleIDs:        []uuid.UUID{adminId},
+						ScopeIDs:       []uuid.UUID{selfServiceId},
+					},
+					{
+						IdentityNumber: "PNOEE-60001017869",
+						PersonalCode:   "60001017869",
+						FirstName:      "Jane",
+						LastName:       "Doe",
+						RoleIDs:        []uuid.UUID{},
+						ScopeIDs:       []uuid.UUID{},
+					},
+				}).Return([]models.User{{ID: johnId}, {ID: janeId}}, nil)
+			},
+			expected: []models.ImportResult{
+				{Line: 2, IdentityNumber: "PNOEE-30303039914", Status: models.ImportStatusCreated, UserID: johnId},
+				{Line: 3, IdentityNumber: "PNOEE-60001017869", Status: models.ImportStatusUpdated, UserID: janeId},
+			},
+		},
+		{
+			name:   "JSONL dry run",
+			format: models.UserRecordFormatJSONL,
+			input: `{"identity_number":"PNOEE-30303039914","first_name":"John","last_name":"Doe","roles":["admin"],"scopes":[]}` + "\n\n" +
+				`{"identity_number":"PNOEE-60001017869","first_name":"Jane","last_name":"Doe","roles":["unknown"]}` + "\n",
+			dryRun: true,
+			before: resolveNames,
+			expected: []models.ImportResult{
+				{Line: 1, IdentityNumber: "PNOEE-30303039914", Status: models.ImportStatusValid},
+				{Line: 3, IdentityNumber: "PNOEE-60001017869", Status: models.ImportStatusInvalid, Error: "role not found: unknown"},
+			},
+		},
+		{
+			name:   "Invalid records",
+			format: models.UserRecordFormatCSV,
+			input: "identity_number,personal_code,first_name,last_name\n" +
+				"PNOEE-30303039915,,John,Doe\n" +
+				"PNOEE-30303039914,60001017869,John,\n" +
+				"PNOEE-60001017869,,Jane\n" +
+				"PNOEE-39001010110,,Jim,Doe\n" +
+				"PNOEE-39001010110,,Jim,Doe\n",
+			dryRun: true,
+			before: resolveNames,
+			expected: []models.ImportResult{
+				{Line: 2, IdentityNumber: "PNOEE-30303039915", Status: models.ImportStatusInvalid, Error: "invalid personal code"},
+				{Line: 3, IdentityNumber: "PNOEE-30303039914", Status: models.ImportStatusInvalid, Error: "invalid personal code; empty last name"},
+				{Line: 4, Status: models.ImportStatusInvalid, Error: "malformed record: wrong number of fields"},
+				{Line: 5, IdentityNumber: "PNOEE-39001010110", Status: models.ImportStatusValid},
+				{Line: 6, IdentityNumber: "PNOEE-39001010110", Status: models.ImportStatusInvalid, Error: "duplicate record: identity number already given on line 5"},
+			},
+		},
+		{
+			name:   "Failed batch",
+			format: models.UserRecordFormatCSV,
+			input:  csvInput,
+			before: func() {
+				resolveNames()
+				users.EXPECT().FindByIdentityNumbers(ctx, gomock.Any()).Return(nil, nil)
+				users.EXPECT().Import(ctx, gomock.Any()).Return(nil, errors.ErrFailedToCreateRecord)
+			},
+			expected: []models.ImportResult{
+				{Line: 2, IdentityNumber: "PNOEE-30303039914", Status: models.ImportStatusFailed, Error: "failed to create record"},
+				{Line: 3, IdentityNumber: "PNOEE-60001017869", Status: models.ImportStatusFailed, Error: "failed to create record"},
+			},
+		},
+		{
+			name:   "Roles error",
+			format: models.UserRecordFormatCSV,
+			input:  csvInput,
+			before: func() {
+				roles.EXPECT().FindByNames(ctx, gomock.Any()).Return(nil, errors.ErrFailedToFetchResults)
+			},
+			expected: []models.ImportResult{},
+			error:    errors.ErrFailedToFetchResults,
+		},
+		{
+			name:     "Unknown column",
+			format:   models.UserRecordFormatCSV,
+			input:    "identity_number,first_name,last_name,email\n",
+			before:   func() {},
+			expected: []models.ImportResult{},
+			error:    errors.ErrMalformedRecord,
+		},
+		{
+			name:     "Unsupported format",
+			format:   "xml",
+			input:    "",
+			before:   func() {},
+			expected: []models.ImportResult{},
+			error:    errors.ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			results := make([]models.ImportResult, 0)
+			err := service.Import(ctx, tt.format, strings.NewReader(tt.input), tt.dryRun, func(result *models.ImportResult) error {
+				results = append(results, *result)
+				return nil
+			})
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, results)
+		})
+	}
+}
+
+func Test_UserTransfer_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	users := repositories.NewMockUserRepository(ctrl)
+	service := NewUserTransfer(users, repositories.NewMockRoleRepository(ctrl), repositories.NewMockScopeRepository(ctrl), log)
+
+	records := []models.UserRecord{
+		{
+			IdentityNumber: "PNOEE-30303039914",
+			PersonalCode:   "30303039914",
+			FirstName:      "John",
+			LastName:       "Doe, Jr.",
+			Roles:          []string{"admin", "user"},
+			Scopes:         []string{"self-service"},
+		},
+		{
+			IdentityNumber: "PNOEE-60001017869",
+			PersonalCode:   "60001017869",
+			FirstName:      "Jane",
+			LastName:       "Doe",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		format   string
+		before   func()
+		expected string
+		error    error
+	}{
+		{
+			name:   "CSV",
+			format: models.UserRecordFormatCSV,
+			before: func() {
+				users.EXPECT().ListRecords(ctx, "", uint64(UserExportBatchSize)).Return(records, nil)
+			},
+			expected: "identity_number,personal_code,first_name,last_name,roles,scopes\n" +
+				"PNOEE-30303039914,30303039914,John,\"Doe, Jr.\",admin;user,self-service\n" +
+				"PNOEE-60001017869,60001017869,Jane,Doe,,\n",
+		},
+		{
+			name:   "JSONL",
+			format: models.UserRecordFormatJSONL,
+			before: func() {
+				users.EXPECT().ListRecords(ctx, "", uint64(UserExportBatchSize)).Return(records, nil)
+			},
+			expected: `{"identity_number":"PNOEE-30303039914","personal_code":"30303039914","first_name":"John","last_name":"Doe, Jr.","roles":["admin","user"],"scopes":["self-service"]}` + "\n" +
+				`{"identity_number":"PNOEE-60001017869","personal_code":"60001017869","first_name":"Jane","last_name":"Doe","roles":[],"scopes":[]}` + "\n",
+		},
+		{
+			name:   "Error",
+			format: models.UserRecordFormatJSONL,
+			before: func() {
+				users.EXPECT().ListRecords(ctx, "", uint64(UserExportBatchSize)).Return(nil, errors.ErrFailedToFetchResults)
+			},
+			error: errors.ErrFailedToFetchResults,
+		},
+		{
+			name:   "Unsupported format",
+			format: "xml",
+			before: func() {},
+			error:  errors.ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			var output bytes.Buffer
+			err := service.Export(ctx, tt.format, &output)
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, output.String())
+			}
+		})
+	}
+}
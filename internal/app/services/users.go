@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"loki/internal/app/errors"
 	"loki/internal/app/models"
@@ -15,23 +17,46 @@ import (
 type Users interface {
 	List(ctx context.Context, pagination *Pagination) ([]models.User, uint64, error)
 	Create(ctx context.Context, params *models.User) (*models.User, error)
-	Update(ctx context.Context, params *models.User) (*models.User, error)
+	Update(ctx context.Context, params *models.User, fields []string) (*models.User, error)
 	FindById(ctx context.Context, id uuid.UUID) (*models.User, error)
 	Delete(ctx context.Context, id uuid.UUID) (bool, error)
+	SetStatus(ctx context.Context, id uuid.UUID, status string, reason string, actorId uuid.UUID) (*models.User, error)
 
 	FindByIdentityNumber(ctx context.Context, identityNumber string) (*models.User, error)
 	FindUserDetailsById(ctx context.Context, id uuid.UUID) (*models.User, error)
+
+	AssignRoles(ctx context.Context, id uuid.UUID, roleIds []uuid.UUID, actorId uuid.UUID) (*models.User, error)
+	RevokeRoles(ctx context.Context, id uuid.UUID, roleIds []uuid.UUID, actorId uuid.UUID) (*models.User, error)
+	SetRoles(ctx context.Context, id uuid.UUID, roleIds []uuid.UUID, actorId uuid.UUID) (*models.User, error)
+
+	AssignScopes(ctx context.Context, id uuid.UUID, scopeIds []uuid.UUID, actorId uuid.UUID) (*models.User, error)
+	RevokeScopes(ctx context.Context, id uuid.UUID, scopeIds []uuid.UUID, actorId uuid.UUID) (*models.User, error)
+	SetScopes(ctx context.Context, id uuid.UUID, scopeIds []uuid.UUID, actorId uuid.UUID) (*models.User, error)
+
+	FindEffectivePermissions(ctx context.Context, id uuid.UUID) ([]models.EffectivePermission, error)
+	Explain(ctx context.Context, id uuid.UUID, permission string) (*models.EffectivePermission, error)
+
+	FindPolicyAttributes(ctx context.Context, id uuid.UUID) (map[string]interface{}, error)
 }
 
 type users struct {
-	repository repositories.UserRepository
-	log        *logger.Logger
+	repository   repositories.UserRepository
+	organisation repositories.OrganisationRepository
+	permission   repositories.PermissionRepository
+	log          *logger.Logger
 }
 
-func NewUsers(repository repositories.UserRepository, log *logger.Logger) Users {
+func NewUsers(
+	repository repositories.UserRepository,
+	organisation repositories.OrganisationRepository,
+	permission repositories.PermissionRepository,
+	log *logger.Logger,
+) Users {
 	return &users{
-		repository: repository,
-		log:        log,
+		repository:   repository,
+		organisation: organisation,
+		permission:   permission,
+		log:          log,
 	}
 }
 
@@ -61,18 +86,30 @@ func (u *users) Create(ctx context.Context, params *models.User) (*models.User,
 	return user, nil
 }
 
-func (u *users) Update(ctx context.Context, params *models.User) (*models.User, error) {
+// Update changes the given fields of the user, an empty field list updates every field
+func (u *users) Update(ctx context.Context, params *models.User, fields []string) (*models.User, error) {
 	user, err := u.repository.Update(ctx, db.UpdateUserParams{
-		ID:             params.ID,
-		IdentityNumber: params.IdentityNumber,
-		PersonalCode:   params.PersonalCode,
-		FirstName:      params.FirstName,
-		LastName:       params.LastName,
-		RoleIDs:        params.RoleIDs,
-		ScopeIDs:       params.ScopeIDs,
+		ID:                   params.ID,
+		UpdateIdentityNumber: updatesField(fields, "identity_number"),
+		IdentityNumber:       params.IdentityNumber,
+		UpdatePersonalCode:   updatesField(fields, "personal_code"),
+		PersonalCode:         params.PersonalCode,
+		UpdateFirstName:      updatesField(fields, "first_name"),
+		FirstName:            params.FirstName,
+		UpdateLastName:       updatesField(fields, "last_name"),
+		LastName:             params.LastName,
+		UpdateRoleIDs:        updatesField(fields, "role_ids"),
+		RoleIDs:              params.RoleIDs,
+		UpdateScopeIDs:       updatesField(fields, "scope_ids"),
+		ScopeIDs:             params.ScopeIDs,
 	})
 	if err != nil {
 		u.log.Error().Err(err).Msg("Failed to update user")
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.ErrRecordNotFound
+		}
+
 		return nil, errors.ErrFailedToUpdateRecord
 	}
 
@@ -89,14 +126,49 @@ func (u *users) FindById(ctx context.Context, id uuid.UUID) (*models.User, error
 	return user, nil
 }
 
+// Delete marks the user as deleted, the row is kept so that the history of the user is preserved
 func (u *users) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
-	ok, err := u.repository.Delete(ctx, id)
+	_, err := u.repository.UpdateStatus(ctx, db.UpdateUserStatusParams{
+		ID:     id,
+		Status: db.UserStatusDeleted,
+	})
 	if err != nil {
 		u.log.Error().Err(err).Msg("Failed to delete user")
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, errors.ErrRecordNotFound
+		}
+
 		return false, errors.ErrFailedToDeleteRecord
 	}
 
-	return ok, nil
+	return true, nil
+}
+
+// SetStatus moves the user to the given lifecycle state, the gRPC audit interceptor records the change
+func (u *users) SetStatus(ctx context.Context, id uuid.UUID, status string, reason string, actorId uuid.UUID) (*models.User, error) {
+	switch status {
+	case models.UserActive, models.UserSuspended, models.UserLocked, models.UserDeleted:
+	default:
+		return nil, errors.ErrInvalidUserStatus
+	}
+
+	if _, err := u.repository.FindById(ctx, id); err != nil {
+		u.log.Error().Err(err).Msg("Failed to find user by id")
+		return nil, errors.ErrRecordNotFound
+	}
+
+	user, err := u.repository.UpdateStatus(ctx, db.UpdateUserStatusParams{
+		ID:           id,
+		Status:       db.UserStatus(status),
+		StatusReason: pgtype.Text{String: reason, Valid: reason != ""},
+	})
+	if err != nil {
+		u.log.Error().Err(err).Msg("Failed to update user status")
+		return nil, errors.ErrFailedToUpdateRecord
+	}
+
+	return user, nil
 }
 
 func (u *users) FindByIdentityNumber(ctx context.Context, identityNumber string) (*models.User, error) {
@@ -118,3 +190,143 @@ func (u *users) FindUserDetailsById(ctx context.Context, id uuid.UUID) (*models.
 
 	return user, nil
 }
+
+func (u *users) AssignRoles(ctx context.Context, id uuid.UUID, roleIds []uuid.UUID, actorId uuid.UUID) (*models.User, error) {
+	return u.changeLinks(ctx, id, func() (*models.User, error) {
+		return u.repository.AssignRoles(ctx, db.AddUserRolesParams{
+			UserID:    id,
+			GrantedBy: actorId,
+			RoleIds:   roleIds,
+		})
+	})
+}
+
+func (u *users) RevokeRoles(ctx context.Context, id uuid.UUID, roleIds []uuid.UUID, actorId uuid.UUID) (*models.User, error) {
+	return u.changeLinks(ctx, id, func() (*models.User, error) {
+		return u.repository.RevokeRoles(ctx, db.RemoveUserRolesParams{
+			UserID:  id,
+			RoleIds: roleIds,
+		})
+	})
+}
+
+func (u *users) SetRoles(ctx context.Context, id uuid.UUID, roleIds []uuid.UUID, actorId uuid.UUID) (*models.User, error) {
+	return u.changeLinks(ctx, id, func() (*models.User, error) {
+		return u.repository.SetRoles(ctx, db.CreateUserRolesParams{
+			UserID:    id,
+			RoleIds:   roleIds,
+			GrantedBy: actorId,
+		})
+	})
+}
+
+func (u *users) AssignScopes(ctx context.Context, id uuid.UUID, scopeIds []uuid.UUID, actorId uuid.UUID) (*models.User, error) {
+	return u.changeLinks(ctx, id, func() (*models.User, error) {
+		return u.repository.AssignScopes(ctx, db.AddUserScopesParams{
+			UserID:    id,
+			GrantedBy: actorId,
+			ScopeIds:  scopeIds,
+		})
+	})
+}
+
+func (u *users) RevokeScopes(ctx context.Context, id uuid.UUID, scopeIds []uuid.UUID, actorId uuid.UUID) (*models.User, error) {
+	return u.changeLinks(ctx, id, func() (*models.User, error) {
+		return u.repository.RevokeScopes(ctx, db.RemoveUserScopesParams{
+			UserID:   id,
+			ScopeIds: scopeIds,
+		})
+	})
+}
+
+func (u *users) SetScopes(ctx context.Context, id uuid.UUID, scopeIds []uuid.UUID, actorId uuid.UUID) (*models.User, error) {
+	return u.changeLinks(ctx, id, func() (*models.User, error) {
+		return u.repository.SetScopes(ctx, db.CreateUserScopesParams{
+			UserID:    id,
+			ScopeIds:  scopeIds,
+			GrantedBy: actorId,
+		})
+	})
+}
+
+// FindEffectivePermissions resolves the user permissions the same way as they are issued in access tokens
+func (u *users) FindEffectivePermissions(ctx context.Context, id uuid.UUID) ([]models.EffectivePermission, error) {
+	if _, err := u.repository.FindById(ctx, id); err != nil {
+		u.log.Error().Err(err).Msg("Failed to find user by id")
+		return nil, errors.ErrRecordNotFound
+	}
+
+	permissions, err := u.permission.FindEffectiveByUserId(ctx, id)
+	if err != nil {
+		u.log.Error().Err(err).Msg("Failed to find effective permissions")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	return permissions, nil
+}
+
+// Explain returns the paths that grant the permission to the user, nil when it is not granted
+func (u *users) Explain(ctx context.Context, id uuid.UUID, permission string) (*models.EffectivePermission, error) {
+	permissions, err := u.FindEffectivePermissions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range permissions {
+		if permissions[i].Name == permission {
+			return &permissions[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// FindPolicyAttributes describes the user as a policy resource: its status and the organisations it is a member of
+func (u *users) FindPolicyAttributes(ctx context.Context, id uuid.UUID) (map[string]interface{}, error) {
+	user, err := u.repository.FindById(ctx, id)
+	if err != nil {
+		u.log.Error().Err(err).Msg("Failed to find user by id")
+		return nil, errors.ErrRecordNotFound
+	}
+
+	grants, err := u.organisation.FindGrantsByUserId(ctx, id)
+	if err != nil {
+		u.log.Error().Err(err).Msg("Failed to find organisation grants")
+		return nil, errors.ErrFailedToFetchResults
+	}
+
+	organisations := make([]string, 0, len(grants))
+	for _, grant := range grants {
+		organisations = append(organisations, grant.OrganisationID.String())
+	}
+
+	return map[string]interface{}{
+		"status":        user.Status,
+		"organisations": organisations,
+	}, nil
+}
+
+// changeLinks runs the change for an existing user, the gRPC audit interceptor records it
+func (u *users) changeLinks(ctx context.Context, id uuid.UUID, change func() (*models.User, error)) (*models.User, error) {
+	if _, err := u.repository.FindById(ctx, id); err != nil {
+		u.log.Error().Err(err).Msg("Failed to find user by id")
+		return nil, errors.ErrRecordNotFound
+	}
+
+	user, err := change()
+	if err != nil {
+		u.log.Error().Err(err).Msg("Failed to change user links")
+		return nil, errors.ErrFailedToUpdateRecord
+	}
+
+	return user, nil
+}
+
+func uuidStrings(ids []uuid.UUID) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+
+	return result
+}
@@ -42,6 +42,36 @@ func (m *MockUsers) EXPECT() *MockUsersMockRecorder {
 	return m.recorder
 }
 
+// AssignRoles mocks base method.
+func (m *MockUsers) AssignRoles(ctx context.Context, id uuid.UUID, roleIds []uuid.UUID, actorId uuid.UUID) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRoles", ctx, id, roleIds, actorId)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignRoles indicates an expected call of AssignRoles.
+func (mr *MockUsersMockRecorder) AssignRoles(ctx, id, roleIds, actorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRoles", reflect.TypeOf((*MockUsers)(nil).AssignRoles), ctx, id, roleIds, actorId)
+}
+
+// AssignScopes mocks base method.
+func (m *MockUsers) AssignScopes(ctx context.Context, id uuid.UUID, scopeIds []uuid.UUID, actorId uuid.UUID) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignScopes", ctx, id, scopeIds, actorId)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignScopes indicates an expected call of AssignScopes.
+func (mr *MockUsersMockRecorder) AssignScopes(ctx, id, scopeIds, actorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignScopes", reflect.TypeOf((*MockUsers)(nil).AssignScopes), ctx, id, scopeIds, actorId)
+}
+
 // Create mocks base method.
 func (m *MockUsers) Create(ctx context.Context, params *models.User) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +102,21 @@ func (mr *MockUsersMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUsers)(nil).Delete), ctx, id)
 }
 
+// Explain mocks base method.
+func (m *MockUsers) Explain(ctx context.Context, id uuid.UUID, permission string) (*models.EffectivePermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Explain", ctx, id, permission)
+	ret0, _ := ret[0].(*models.EffectivePermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Explain indicates an expected call of Explain.
+func (mr *MockUsersMockRecorder) Explain(ctx, id, permission any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Explain", reflect.TypeOf((*MockUsers)(nil).Explain), ctx, id, permission)
+}
+
 // FindById mocks base method.
 func (m *MockUsers) FindById(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +147,36 @@ func (mr *MockUsersMockRecorder) FindByIdentityNumber(ctx, identityNumber any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdentityNumber", reflect.TypeOf((*MockUsers)(nil).FindByIdentityNumber), ctx, identityNumber)
 }
 
+// FindEffectivePermissions mocks base method.
+func (m *MockUsers) FindEffectivePermissions(ctx context.Context, id uuid.UUID) ([]models.EffectivePermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEffectivePermissions", ctx, id)
+	ret0, _ := ret[0].([]models.EffectivePermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEffectivePermissions indicates an expected call of FindEffectivePermissions.
+func (mr *MockUsersMockRecorder) FindEffectivePermissions(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEffectivePermissions", reflect.TypeOf((*MockUsers)(nil).FindEffectivePermissions), ctx, id)
+}
+
+// FindPolicyAttributes mocks base method.
+func (m *MockUsers) FindPolicyAttributes(ctx context.Context, id uuid.UUID) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPolicyAttributes", ctx, id)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPolicyAttributes indicates an expected call of FindPolicyAttributes.
+func (mr *MockUsersMockRecorder) FindPolicyAttributes(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPolicyAttributes", reflect.TypeOf((*MockUsers)(nil).FindPolicyAttributes), ctx, id)
+}
+
 // FindUserDetailsById mocks base method.
 func (m *MockUsers) FindUserDetailsById(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -133,17 +208,92 @@ func (mr *MockUsersMockRecorder) List(ctx, pagination any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUsers)(nil).List), ctx, pagination)
 }
 
+// RevokeRoles mocks base method.
+func (m *MockUsers) RevokeRoles(ctx context.Context, id uuid.UUID, roleIds []uuid.UUID, actorId uuid.UUID) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRoles", ctx, id, roleIds, actorId)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeRoles indicates an expected call of RevokeRoles.
+func (mr *MockUsersMockRecorder) RevokeRoles(ctx, id, roleIds, actorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRoles", reflect.TypeOf((*MockUsers)(nil).RevokeRoles), ctx, id, roleIds, actorId)
+}
+
+// RevokeScopes mocks base method.
+func (m *MockUsers) RevokeScopes(ctx context.Context, id uuid.UUID, scopeIds []uuid.UUID, actorId uuid.UUID) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeScopes", ctx, id, scopeIds, actorId)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeScopes indicates an expected call of RevokeScopes.
+func (mr *MockUsersMockRecorder) RevokeScopes(ctx, id, scopeIds, actorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeScopes", reflect.TypeOf((*MockUsers)(nil).RevokeScopes), ctx, id, scopeIds, actorId)
+}
+
+// SetRoles mocks base method.
+func (m *MockUsers) SetRoles(ctx context.Context, id uuid.UUID, roleIds []uuid.UUID, actorId uuid.UUID) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoles", ctx, id, roleIds, actorId)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRoles indicates an expected call of SetRoles.
+func (mr *MockUsersMockRecorder) SetRoles(ctx, id, roleIds, actorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoles", reflect.TypeOf((*MockUsers)(nil).SetRoles), ctx, id, roleIds, actorId)
+}
+
+// SetScopes mocks base method.
+func (m *MockUsers) SetScopes(ctx context.Context, id uuid.UUID, scopeIds []uuid.UUID, actorId uuid.UUID) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScopes", ctx, id, scopeIds, actorId)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScopes indicates an expected call of SetScopes.
+func (mr *MockUsersMockRecorder) SetScopes(ctx, id, scopeIds, actorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScopes", reflect.TypeOf((*MockUsers)(nil).SetScopes), ctx, id, scopeIds, actorId)
+}
+
+// SetStatus mocks base method.
+func (m *MockUsers) SetStatus(ctx context.Context, id uuid.UUID, status, reason string, actorId uuid.UUID) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStatus", ctx, id, status, reason, actorId)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStatus indicates an expected call of SetStatus.
+func (mr *MockUsersMockRecorder) SetStatus(ctx, id, status, reason, actorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatus", reflect.TypeOf((*MockUsers)(nil).SetStatus), ctx, id, status, reason, actorId)
+}
+
 // Update mocks base method.
-func (m *MockUsers) Update(ctx context.Context, params *models.User) (*models.User, error) {
+func (m *MockUsers) Update(ctx context.Context, params *models.User, fields []string) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, params)
+	ret := m.ctrl.Call(m, "Update", ctx, params, fields)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockUsersMockRecorder) Update(ctx, params any) *gomock.Call {
+func (mr *MockUsersMockRecorder) Update(ctx, params, fields any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUsers)(nil).Update), ctx, params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUsers)(nil).Update), ctx, params, fields)
 }
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
@@ -29,7 +31,7 @@ func Test_Users_List(t *testing.T) {
 
 	ctx := context.Background()
 	repository := repositories.NewMockUserRepository(ctrl)
-	service := NewUsers(repository, log)
+	service := NewUsers(repository, repositories.NewMockOrganisationRepository(ctrl), repositories.NewMockPermissionRepository(ctrl), log)
 
 	tests := []struct {
 		name     string
@@ -123,7 +125,7 @@ func Test_Users_Create(t *testing.T) {
 
 	ctx := context.Background()
 	repository := repositories.NewMockUserRepository(ctrl)
-	service := NewUsers(repository, log)
+	service := NewUsers(repository, repositories.NewMockOrganisationRepository(ctrl), repositories.NewMockPermissionRepository(ctrl), log)
 
 	id, err := uuid.NewRandom()
 	assert.NoError(t, err)
@@ -212,7 +214,7 @@ func Test_Users_Update(t *testing.T) {
 
 	ctx := context.Background()
 	repository := repositories.NewMockUserRepository(ctrl)
-	service := NewUsers(repository, log)
+	service := NewUsers(repository, repositories.NewMockOrganisationRepository(ctrl), repositories.NewMockPermissionRepository(ctrl), log)
 
 	id, err := uuid.NewRandom()
 	assert.NoError(t, err)
@@ -227,11 +229,17 @@ func Test_Users_Update(t *testing.T) {
 			name: "Success",
 			before: func() {
 				repository.EXPECT().Update(ctx, db.UpdateUserParams{
-					ID:             id,
-					IdentityNumber: "PNOEE-123456789",
-					PersonalCode:   "123456789",
-					FirstName:      "John",
-					LastName:       "Doe",
+					ID:                   id,
+					UpdateIdentityNumber: true,
+					IdentityNumber:       "PNOEE-123456789",
+					UpdatePersonalCode:   true,
+					PersonalCode:         "123456789",
+					UpdateFirstName:      true,
+					FirstName:            "John",
+					UpdateLastName:       true,
+					LastName:             "Doe",
+					UpdateRoleIDs:        true,
+					UpdateScopeIDs:       true,
 				}).Return(&models.User{
 					ID:             id,
 					IdentityNumber: "PNOEE-123456789",
@@ -256,16 +264,30 @@ func Test_Users_Update(t *testing.T) {
 			name: "Error",
 			before: func() {
 				repository.EXPECT().Update(ctx, db.UpdateUserParams{
-					ID:             id,
-					IdentityNumber: "PNOEE-123456789",
-					PersonalCode:   "123456789",
-					FirstName:      "John",
-					LastName:       "Doe",
+					ID:                   id,
+					UpdateIdentityNumber: true,
+					IdentityNumber:       "PNOEE-123456789",
+					UpdatePersonalCode:   true,
+					PersonalCode:         "123456789",
+					UpdateFirstName:      true,
+					FirstName:            "John",
+					UpdateLastName:       true,
+					LastName:             "Doe",
+					UpdateRoleIDs:        true,
+					UpdateScopeIDs:       true,
 				}).Return(nil, errors.ErrFailedToUpdateRecord)
 			},
 			expected: nil,
 			error:    errors.ErrFailedToUpdateRecord,
 		},
+		{
+			name: "Not found",
+			before: func() {
+				repository.EXPECT().Update(ctx, gomock.Any()).Return(nil, pgx.ErrNoRows)
+			},
+			expected: nil,
+			error:    errors.ErrRecordNotFound,
+		},
 	}
 
 	for _, tt := range tests {
@@ -278,10 +300,10 @@ func Test_Users_Update(t *testing.T) {
 				PersonalCode:   "123456789",
 				FirstName:      "John",
 				LastName:       "Doe",
-			})
+			}, nil)
 
 			if tt.error != nil {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, tt.error)
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
@@ -304,7 +326,7 @@ func Test_Users_FindById(t *testing.T) {
 
 	ctx := context.Background()
 	repository := repositories.NewMockUserRepository(ctrl)
-	service := NewUsers(repository, log)
+	service := NewUsers(repository, repositories.NewMockOrganisationRepository(ctrl), repositories.NewMockPermissionRepository(ctrl), log)
 
 	id, err := uuid.NewRandom()
 	assert.NoError(t, err)
@@ -374,7 +396,7 @@ func Test_Users_Delete(t *testing.T) {
 
 	ctx := context.Background()
 	repository := repositories.NewMockUserRepository(ctrl)
-	service := NewUsers(repository, log)
+	service := NewUsers(repository, repositories.NewMockOrganisationRepository(ctrl), repositories.NewMockPermissionRepository(ctrl), log)
 
 	id, err := uuid.NewRandom()
 	assert.NoError(t, err)
@@ -388,14 +410,25 @@ func Test_Users_Delete(t *testing.T) {
 		{
 			name: "Success",
 			before: func() {
-				repository.EXPECT().Delete(ctx, id).Return(true, nil)
+				repository.EXPECT().UpdateStatus(ctx, db.UpdateUserStatusParams{
+					ID:     id,
+					Status: db.UserStatusDeleted,
+				}).Return(&models.User{ID: id, Status: models.UserDeleted}, nil)
 			},
 			expected: true,
 		},
+		{
+			name: "Not found",
+			before: func() {
+				repository.EXPECT().UpdateStatus(ctx, gomock.Any()).Return(nil, pgx.ErrNoRows)
+			},
+			expected: false,
+			error:    errors.ErrRecordNotFound,
+		},
 		{
 			name: "Error",
 			before: func() {
-				repository.EXPECT().Delete(ctx, id).Return(false, errors.ErrFailedToDeleteRecord)
+				repository.EXPECT().UpdateStatus(ctx, gomock.Any()).Return(nil, assert.AnError)
 			},
 			expected: false,
 			error:    errors.ErrFailedToDeleteRecord,
@@ -409,7 +442,7 @@ func Test_Users_Delete(t *testing.T) {
 			result, err := service.Delete(ctx, id)
 
 			if tt.error != nil {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, tt.error)
 				assert.False(t, result)
 			} else {
 				assert.NoError(t, err)
@@ -419,6 +452,84 @@ func Test_Users_Delete(t *testing.T) {
 	}
 }
 
+func Test_Users_SetStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockUserRepository(ctrl)
+	service := NewUsers(repository, repositories.NewMockOrganisationRepository(ctrl), repositories.NewMockPermissionRepository(ctrl), log)
+
+	id := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	actorId := uuid.MustParse("10000000-1000-1000-5000-000000000002")
+
+	tests := []struct {
+		name     string
+		status   string
+		before   func()
+		expected *models.User
+		error    error
+	}{
+		{
+			name:   "Success",
+			status: models.UserSuspended,
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.User{ID: id, Status: models.UserActive}, nil)
+				repository.EXPECT().UpdateStatus(ctx, db.UpdateUserStatusParams{
+					ID:           id,
+					Status:       db.UserStatusSuspended,
+					StatusReason: pgtype.Text{String: "Fraud investigation", Valid: true},
+				}).Return(&models.User{ID: id, Status: models.UserSuspended, StatusReason: "Fraud investigation"}, nil)
+			},
+			expected: &models.User{ID: id, Status: models.UserSuspended, StatusReason: "Fraud investigation"},
+		},
+		{
+			name:     "Invalid status",
+			status:   "archived",
+			before:   func() {},
+			expected: nil,
+			error:    errors.ErrInvalidUserStatus,
+		},
+		{
+			name:   "User not found",
+			status: models.UserLocked,
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrRecordNotFound,
+		},
+		{
+			name:   "Error",
+			status: models.UserActive,
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.User{ID: id}, nil)
+				repository.EXPECT().UpdateStatus(ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrFailedToUpdateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.SetStatus(ctx, id, tt.status, "Fraud investigation", actorId)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func Test_Users_FindByIdentityNumber(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -432,7 +543,7 @@ func Test_Users_FindByIdentityNumber(t *testing.T) {
 
 	ctx := context.Background()
 	repository := repositories.NewMockUserRepository(ctrl)
-	service := NewUsers(repository, log)
+	service := NewUsers(repository, repositories.NewMockOrganisationRepository(ctrl), repositories.NewMockPermissionRepository(ctrl), log)
 
 	id, err := uuid.NewRandom()
 	assert.NoError(t, err)
@@ -504,7 +615,7 @@ func Test_Users_FindUserDetailsById(t *testing.T) {
 
 	ctx := context.Background()
 	repository := repositories.NewMockUserRepository(ctrl)
-	service := NewUsers(repository, log)
+	service := NewUsers(repository, repositories.NewMockOrganisationRepository(ctrl), repositories.NewMockPermissionRepository(ctrl), log)
 
 	id, err := uuid.NewRandom()
 	assert.NoError(t, err)
@@ -592,3 +703,256 @@ func Test_Users_FindUserDetailsById(t *testing.T) {
 		})
 	}
 }
+
+func Test_Users_AssignRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockUserRepository(ctrl)
+	service := NewUsers(repository, repositories.NewMockOrganisationRepository(ctrl), repositories.NewMockPermissionRepository(ctrl), log)
+
+	id := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	actorId := uuid.MustParse("10000000-1000-1000-5000-000000000002")
+	roleIds := []uuid.UUID{uuid.MustParse("10000000-1000-1000-1000-000000000002")}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.User
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.User{ID: id}, nil)
+				repository.EXPECT().AssignRoles(ctx, db.AddUserRolesParams{
+					UserID:    id,
+					GrantedBy: actorId,
+					RoleIds:   roleIds,
+				}).Return(&models.User{ID: id, RoleIDs: roleIds}, nil)
+			},
+			expected: &models.User{ID: id, RoleIDs: roleIds},
+			error:    nil,
+		},
+		{
+			name: "User not found",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrRecordNotFound,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.User{ID: id}, nil)
+				repository.EXPECT().AssignRoles(ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrFailedToUpdateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.AssignRoles(ctx, id, roleIds, actorId)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Users_SetScopes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockUserRepository(ctrl)
+	service := NewUsers(repository, repositories.NewMockOrganisationRepository(ctrl), repositories.NewMockPermissionRepository(ctrl), log)
+
+	id := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	actorId := uuid.MustParse("10000000-1000-1000-5000-000000000002")
+
+	repository.EXPECT().FindById(ctx, id).Return(&models.User{ID: id}, nil)
+	repository.EXPECT().SetScopes(ctx, db.CreateUserScopesParams{
+		UserID:    id,
+		ScopeIds:  []uuid.UUID{},
+		GrantedBy: actorId,
+	}).Return(&models.User{ID: id, ScopeIDs: []uuid.UUID{}}, nil)
+
+	result, err := service.SetScopes(ctx, id, []uuid.UUID{}, actorId)
+	assert.NoError(t, err)
+	assert.Empty(t, result.ScopeIDs)
+}
+
+func Test_Users_Explain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockUserRepository(ctrl)
+	permissionRepository := repositories.NewMockPermissionRepository(ctrl)
+	service := NewUsers(repository, repositories.NewMockOrganisationRepository(ctrl), permissionRepository, log)
+
+	id := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	readSelf := models.EffectivePermission{
+		Permission: models.Permission{ID: uuid.MustParse("10000000-1000-1000-3000-000000000001"), Name: "read:self"},
+		Paths: []models.PermissionPath{
+			{Roles: []models.Role{{ID: uuid.MustParse("10000000-1000-1000-1000-000000000003"), Name: "user"}}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		before     func()
+		permission string
+		expected   *models.EffectivePermission
+		error      error
+	}{
+		{
+			name: "Granted",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.User{ID: id}, nil)
+				permissionRepository.EXPECT().FindEffectiveByUserId(ctx, id).Return([]models.EffectivePermission{readSelf}, nil)
+			},
+			permission: "read:self",
+			expected:   &readSelf,
+			error:      nil,
+		},
+		{
+			name: "Not granted",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.User{ID: id}, nil)
+				permissionRepository.EXPECT().FindEffectiveByUserId(ctx, id).Return([]models.EffectivePermission{readSelf}, nil)
+			},
+			permission: "write:users",
+			expected:   nil,
+			error:      nil,
+		},
+		{
+			name: "User not found",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(nil, assert.AnError)
+			},
+			permission: "read:self",
+			expected:   nil,
+			error:      errors.ErrRecordNotFound,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.User{ID: id}, nil)
+				permissionRepository.EXPECT().FindEffectiveByUserId(ctx, id).Return(nil, assert.AnError)
+			},
+			permission: "read:self",
+			expected:   nil,
+			error:      errors.ErrFailedToFetchResults,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.Explain(ctx, id, tt.permission)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Users_FindPolicyAttributes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	repository := repositories.NewMockUserRepository(ctrl)
+	organisationRepository := repositories.NewMockOrganisationRepository(ctrl)
+	service := NewUsers(repository, organisationRepository, repositories.NewMockPermissionRepository(ctrl), log)
+
+	id := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+	organisationId := uuid.MustParse("10000000-1000-1000-4000-000000000001")
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected map[string]interface{}
+		error    error
+	}{
+		{
+			name: "Success",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.User{ID: id, Status: models.UserActive}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, id).Return([]models.OrganisationGrant{
+					{OrganisationID: organisationId, Roles: []string{"manager"}},
+				}, nil)
+			},
+			expected: map[string]interface{}{
+				"status":        models.UserActive,
+				"organisations": []string{organisationId.String()},
+			},
+			error: nil,
+		},
+		{
+			name: "User not found",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrRecordNotFound,
+		},
+		{
+			name: "Error",
+			before: func() {
+				repository.EXPECT().FindById(ctx, id).Return(&models.User{ID: id, Status: models.UserActive}, nil)
+				organisationRepository.EXPECT().FindGrantsByUserId(ctx, id).Return(nil, assert.AnError)
+			},
+			expected: nil,
+			error:    errors.ErrFailedToFetchResults,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result, err := service.FindPolicyAttributes(ctx, id)
+
+			assert.Equal(t, tt.error, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
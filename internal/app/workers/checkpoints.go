@@ -0,0 +1,77 @@
+package workers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"loki/internal/app/services"
+	"loki/internal/config/logger"
+)
+
+type CheckpointsWorker interface {
+	Start(ctx context.Context)
+	Stop()
+	Perform(ctx context.Context)
+}
+
+type checkpointsWorker struct {
+	audit    services.Audit
+	interval time.Duration
+	done     chan struct{}
+	once     sync.Once
+	wg       sync.WaitGroup
+	log      *logger.Logger
+}
+
+func NewCheckpointsWorker(audit services.Audit, log *logger.Logger) CheckpointsWorker {
+	return &checkpointsWorker{
+		audit:    audit,
+		interval: AuditCheckpointInterval,
+		done:     make(chan struct{}),
+		log:      log,
+	}
+}
+
+// Start periodically signs the audit chain until the context is cancelled or the worker is stopped
+func (w *checkpointsWorker) Start(ctx context.Context) {
+	w.wg.Add(1)
+
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-w.done:
+				return
+			case <-ticker.C:
+				w.Perform(ctx)
+			}
+		}
+	}()
+}
+
+func (w *checkpointsWorker) Stop() {
+	w.once.Do(func() {
+		close(w.done)
+	})
+	w.wg.Wait()
+}
+
+// Perform stores a signed checkpoint of the latest audit event
+func (w *checkpointsWorker) Perform(ctx context.Context) {
+	checkpoint, err := w.audit.Checkpoint(ctx)
+	if err != nil {
+		w.log.Error().Err(err).Msgf("%s failed to create checkpoint", CheckpointsWorkerName)
+		return
+	}
+
+	if checkpoint != nil {
+		w.log.Info().Msgf("%s signed audit chain at sequence %d", CheckpointsWorkerName, checkpoint.Sequence)
+	}
+}
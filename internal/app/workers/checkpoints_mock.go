@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/workers/checkpoints.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/workers/checkpoints.go -destination=internal/app/workers/checkpoints_mock.go -package=workers
+//
+
+// Package workers is a generated GoMock package.
+package workers
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCheckpointsWorker is a mock of CheckpointsWorker interface.
+type MockCheckpointsWorker struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckpointsWorkerMockRecorder
+	isgomock struct{}
+}
+
+// MockCheckpointsWorkerMockRecorder is the mock recorder for MockCheckpointsWorker.
+type MockCheckpointsWorkerMockRecorder struct {
+	mock *MockCheckpointsWorker
+}
+
+// NewMockCheckpointsWorker creates a new mock instance.
+func NewMockCheckpointsWorker(ctrl *gomock.Controller) *MockCheckpointsWorker {
+	mock := &MockCheckpointsWorker{ctrl: ctrl}
+	mock.recorder = &MockCheckpointsWorkerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckpointsWorker) EXPECT() *MockCheckpointsWorkerMockRecorder {
+	return m.recorder
+}
+
+// Perform mocks base method.
+func (m *MockCheckpointsWorker) Perform(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Perform", ctx)
+}
+
+// Perform indicates an expected call of Perform.
+func (mr *MockCheckpointsWorkerMockRecorder) Perform(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Perform", reflect.TypeOf((*MockCheckpointsWorker)(nil).Perform), ctx)
+}
+
+// Start mocks base method.
+func (m *MockCheckpointsWorker) Start(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", ctx)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockCheckpointsWorkerMockRecorder) Start(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockCheckpointsWorker)(nil).Start), ctx)
+}
+
+// Stop mocks base method.
+func (m *MockCheckpointsWorker) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockCheckpointsWorkerMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockCheckpointsWorker)(nil).Stop))
+}
@@ -0,0 +1,91 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+func Test_CheckpointsWorker_Perform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	auditMock := services.NewMockAudit(ctrl)
+
+	worker := NewCheckpointsWorker(auditMock, log)
+
+	tests := []struct {
+		name   string
+		before func()
+	}{
+		{
+			name: "Success",
+			before: func() {
+				auditMock.EXPECT().Checkpoint(ctx).Return(&models.AuditCheckpoint{Sequence: 42, Hash: "hash"}, nil)
+			},
+		},
+		{
+			name: "Nothing recorded",
+			before: func() {
+				auditMock.EXPECT().Checkpoint(ctx).Return(nil, nil)
+			},
+		},
+		{
+			name: "Error",
+			before: func() {
+				auditMock.EXPECT().Checkpoint(ctx).Return(nil, errors.ErrFailedToCreateRecord)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			worker.Perform(ctx)
+		})
+	}
+}
+
+func Test_CheckpointsWorker_StartStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	auditMock := services.NewMockAudit(ctrl)
+	auditMock.EXPECT().Checkpoint(gomock.Any()).Return(nil, nil).AnyTimes()
+
+	worker := &checkpointsWorker{
+		audit:    auditMock,
+		interval: time.Millisecond,
+		done:     make(chan struct{}),
+		log:      log,
+	}
+
+	worker.Start(context.Background())
+	time.Sleep(10 * time.Millisecond)
+	worker.Stop()
+	worker.Stop()
+}
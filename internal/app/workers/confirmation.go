@@ -0,0 +1,295 @@
+package workers
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/services"
+	"loki/internal/config/logger"
+	"loki/internal/config/telemetry"
+	"loki/pkg/signing"
+)
+
+type ConfirmationWorker interface {
+	Start(ctx context.Context)
+	Stop()
+	Enqueue(ctx context.Context, id uuid.UUID, traceId string) error
+	Perform(ctx context.Context, id uuid.UUID, traceId string) *models.Confirmation
+}
+
+type confirmationJob struct {
+	ctx     context.Context
+	id      uuid.UUID
+	traceId string
+}
+
+type confirmationWorker struct {
+	audit         services.Audit
+	confirmations services.Confirmations
+	users         services.Users
+	mobileId      signing.MobileId
+	smartId       signing.SmartId
+	verifier      signing.Verifier
+	queue         chan confirmationJob
+	retryDelay    time.Duration
+	done          chan struct{}
+	once          sync.Once
+	wg            sync.WaitGroup
+	log           *logger.Logger
+}
+
+func NewConfirmationWorker(
+	audit services.Audit,
+	confirmations services.Confirmations,
+	users services.Users,
+	mobileId signing.MobileId,
+	smartId signing.SmartId,
+	verifier signing.Verifier,
+	log *logger.Logger,
+) ConfirmationWorker {
+	telemetry.RegisterWorker(ConfirmationWorkerName, ConfirmationConcurrency, ConfirmationQueueSize)
+
+	return &confirmationWorker{
+		audit:         audit,
+		confirmations: confirmations,
+		users:         users,
+		mobileId:      mobileId,
+		smartId:       smartId,
+		verifier:      verifier,
+		queue:         make(chan confirmationJob, ConfirmationQueueSize),
+		retryDelay:    ConfirmationPollRetryDelay,
+		done:          make(chan struct{}),
+		log:           log,
+	}
+}
+
+// Start runs a bounded pool of goroutines performing the queued confirmations
+func (w *confirmationWorker) Start(ctx context.Context) {
+	for i := 0; i < ConfirmationConcurrency; i++ {
+		w.wg.Add(1)
+
+		go func() {
+			defer w.wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-w.done:
+					return
+				case job := <-w.queue:
+					w.Perform(job.ctx, job.id, job.traceId)
+					telemetry.RecordWorkerJob(ConfirmationWorkerName, -1)
+				}
+			}
+		}()
+	}
+}
+
+func (w *confirmationWorker) Stop() {
+	w.once.Do(func() {
+		close(w.done)
+	})
+	w.wg.Wait()
+}
+
+// Enqueue hands the confirmation to the pool, waiting while the queue is full until the request is cancelled
+func (w *confirmationWorker) Enqueue(ctx context.Context, id uuid.UUID, traceId string) error {
+	job := confirmationJob{ctx: Detach(ctx), id: id, traceId: traceId}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case w.queue <- job:
+		telemetry.RecordWorkerJob(ConfirmationWorkerName, 1)
+		return nil
+	}
+}
+
+// Perform polls the signature session until the person has signed or the provider has ended it
+func (w *confirmationWorker) Perform(ctx context.Context, id uuid.UUID, traceId string) *models.Confirmation {
+	w.log.Info().Msgf("%s perform %s", ConfirmationWorkerName, id)
+	ctx, span := startSpan(ctx, ConfirmationWorkerName, "perform")
+	defer span.End()
+
+	confirmation, err := w.confirmations.FindById(ctx, id)
+	if err != nil {
+		w.log.Error().Err(err).Msgf("%s failed to find confirmation", ConfirmationWorkerName)
+		return nil
+	}
+
+	client := w.client(confirmation.Method)
+
+	result, err := w.poll(ctx, client, id.String())
+	if err != nil {
+		w.log.Error().Err(err).Msgf("%s failed to get signature session status", ConfirmationWorkerName)
+		w.record(ctx, traceId, confirmation, err)
+		return w.update(ctx, &models.UpdateConfirmationParams{
+			ID:     id,
+			Status: confirmationStatus(err),
+			Error:  err.Error(),
+		})
+	}
+
+	if err = w.verify(ctx, confirmation, result); err != nil {
+		w.log.Error().Err(err).Msgf("%s failed to verify signature", ConfirmationWorkerName)
+		w.record(ctx, traceId, confirmation, err)
+		return w.update(ctx, &models.UpdateConfirmationParams{
+			ID:     id,
+			Status: models.SessionError,
+			Error:  err.Error(),
+		})
+	}
+
+	w.record(ctx, traceId, confirmation, nil)
+
+	return w.update(ctx, &models.UpdateConfirmationParams{
+		ID:          id,
+		Status:      models.SessionSuccess,
+		Signature:   result.Signature,
+		Algorithm:   result.Algorithm,
+		Certificate: result.Certificate,
+	})
+}
+
+func (w *confirmationWorker) client(method string) signing.Client {
+	if method == models.AuthMethodMobileId {
+		return w.mobileId
+	}
+
+	return w.smartId
+}
+
+// poll repeats the long poll request while the session is running, the provider ends it with TIMEOUT,
+// a failed request is repeated up to ConfirmationPollRetries times in a row
+func (w *confirmationWorker) poll(ctx context.Context, client signing.Client, sessionId string) (result *signing.Result, err error) {
+	ctx, span := startSpan(ctx, ConfirmationWorkerName, "poll")
+	defer func() { endSpan(span, err) }()
+
+	retries := 0
+	for {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		result, err = client.FetchSession(ctx, sessionId)
+		if err != nil {
+			if !transient(err) || ctx.Err() != nil || retries >= ConfirmationPollRetries {
+				return nil, err
+			}
+			retries++
+
+			w.log.Warn().Err(err).Msgf("%s retrying signature session status %d/%d", ConfirmationWorkerName, retries, ConfirmationPollRetries)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(w.retryDelay):
+			}
+			continue
+		}
+		retries = 0
+
+		if result.State != signing.StateRunning {
+			return result, nil
+		}
+	}
+}
+
+// verify checks the signature over the hash with the certificate of the user, Mobile-ID returns the
+// certificate when the session is created and Smart-ID with the signature
+func (w *confirmationWorker) verify(ctx context.Context, confirmation *models.Confirmation, result *signing.Result) (err error) {
+	ctx, span := startSpan(ctx, ConfirmationWorkerName, "verify")
+	defer func() { endSpan(span, err) }()
+
+	user, err := w.users.FindById(ctx, confirmation.UserID)
+	if err != nil {
+		return err
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(confirmation.Hash)
+	if err != nil {
+		return err
+	}
+
+	signed := *result
+	if signed.Certificate == "" {
+		signed.Certificate = confirmation.Certificate
+	}
+
+	return w.verifier.Verify(hash, &signed, user.IdentityNumber)
+}
+
+func (w *confirmationWorker) update(ctx context.Context, params *models.UpdateConfirmationParams) *models.Confirmation {
+	ctx, span := startSpan(ctx, ConfirmationWorkerName, "update confirmation")
+
+	confirmation, err := w.confirmations.Update(ctx, params)
+	if err != nil {
+		w.log.Error().Err(err).Msgf("%s failed to update confirmation", ConfirmationWorkerName)
+	}
+
+	endSpan(span, err)
+	return confirmation
+}
+
+// record stores the signature outcome with the provider error code in the audit log
+func (w *confirmationWorker) record(ctx context.Context, traceId string, confirmation *models.Confirmation, err error) {
+	event := &models.AuditEvent{
+		ActorID:    confirmation.UserID,
+		Action:     models.AuditConfirmationSigned,
+		TargetType: "confirmation",
+		TargetID:   confirmation.ID.String(),
+		Status:     models.AuditSuccess,
+		Metadata: map[string]string{
+			"provider": confirmation.Method,
+			"hash":     confirmation.Hash,
+		},
+		TraceID: traceId,
+	}
+
+	if err != nil {
+		event.Action = models.AuditConfirmationFailed
+		event.Status = models.AuditFailure
+		event.Metadata["error"] = err.Error()
+
+		var providerErr *signing.Error
+		if errors.As(err, &providerErr) {
+			event.Metadata["code"] = providerErr.Code
+		}
+	}
+
+	w.audit.Record(ctx, event)
+}
+
+// transient reports whether a failed status request may succeed when repeated, the provider knows the session
+// and has not ended it
+func transient(err error) bool {
+	var providerErr *signing.Error
+	if errors.As(err, &providerErr) {
+		return false
+	}
+
+	return !errors.Is(err, errors.ErrSigningSessionNotFound)
+}
+
+func confirmationStatus(err error) string {
+	var providerErr *signing.Error
+	if !errors.As(err, &providerErr) {
+		return models.SessionError
+	}
+
+	switch {
+	case strings.HasPrefix(providerErr.Code, "USER_REFUSED"), providerErr.Code == "USER_CANCELLED":
+		return models.SessionCancelled
+	case providerErr.Code == "TIMEOUT":
+		return models.SessionExpired
+	default:
+		return models.SessionError
+	}
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/workers/confirmation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/workers/confirmation.go -destination=internal/app/workers/confirmation_mock.go -package=workers
+//
+
+// Package workers is a generated GoMock package.
+package workers
+
+import (
+	context "context"
+	models "loki/internal/app/models"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfirmationWorker is a mock of ConfirmationWorker interface.
+type MockConfirmationWorker struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfirmationWorkerMockRecorder
+	isgomock struct{}
+}
+
+// MockConfirmationWorkerMockRecorder is the mock recorder for MockConfirmationWorker.
+type MockConfirmationWorkerMockRecorder struct {
+	mock *MockConfirmationWorker
+}
+
+// NewMockConfirmationWorker creates a new mock instance.
+func NewMockConfirmationWorker(ctrl *gomock.Controller) *MockConfirmationWorker {
+	mock := &MockConfirmationWorker{ctrl: ctrl}
+	mock.recorder = &MockConfirmationWorkerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfirmationWorker) EXPECT() *MockConfirmationWorkerMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockConfirmationWorker) Enqueue(ctx context.Context, id uuid.UUID, traceId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, id, traceId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockConfirmationWorkerMockRecorder) Enqueue(ctx, id, traceId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockConfirmationWorker)(nil).Enqueue), ctx, id, traceId)
+}
+
+// Perform mocks base method.
+func (m *MockConfirmationWorker) Perform(ctx context.Context, id uuid.UUID, traceId string) *models.Confirmation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Perform", ctx, id, traceId)
+	ret0, _ := ret[0].(*models.Confirmation)
+	return ret0
+}
+
+// Perform indicates an expected call of Perform.
+func (mr *MockConfirmationWorkerMockRecorder) Perform(ctx, id, traceId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Perform", reflect.TypeOf((*MockConfirmationWorker)(nil).Perform), ctx, id, traceId)
+}
+
+// Start mocks base method.
+func (m *MockConfirmationWorker) Start(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", ctx)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockConfirmationWorkerMockRecorder) Start(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockConfirmationWorker)(nil).Start), ctx)
+}
+
+// Stop mocks base method.
+func (m *MockConfirmationWorker) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockConfirmationWorkerMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockConfirmationWorker)(nil).Stop))
+}
@@ -0,0 +1,320 @@
+package workers
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/pkg/signing"
+)
+
+func Test_ConfirmationWorker_Perform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	confirmationsMock := services.NewMockConfirmations(ctrl)
+	usersMock := services.NewMockUsers(ctrl)
+	mobileIdMock := signing.NewMockMobileId(ctrl)
+	smartIdMock := signing.NewMockSmartId(ctrl)
+	verifierMock := signing.NewMockVerifier(ctrl)
+
+	auditMock := services.NewMockAudit(ctrl)
+	auditMock.EXPECT().Record(gomock.Any(), gomock.Any()).AnyTimes()
+
+	worker := &confirmationWorker{
+		audit:         auditMock,
+		confirmations: confirmationsMock,
+		users:         usersMock,
+		mobileId:      mobileIdMock,
+		smartId:       smartIdMock,
+		verifier:      verifierMock,
+		log:           log,
+	}
+
+	id := uuid.MustParse("8fdb516d-1a82-43ba-b82d-be63df569b86")
+	userId := uuid.MustParse("320284a1-8c96-4984-b492-b060310cfdac")
+	traceId := uuid.New().String()
+	hash := signing.Hash([]byte("Pay 100.00 EUR to Example OU"))
+
+	user := &models.User{ID: userId, IdentityNumber: "PNOEE-30303039914"}
+
+	smartIdConfirmation := &models.Confirmation{
+		ID:     id,
+		UserID: userId,
+		Method: models.AuthMethodSmartId,
+		Hash:   base64.StdEncoding.EncodeToString(hash),
+		Status: models.SessionRunning,
+	}
+	mobileIdConfirmation := &models.Confirmation{
+		ID:          id,
+		UserID:      userId,
+		Method:      models.AuthMethodMobileId,
+		Hash:        base64.StdEncoding.EncodeToString(hash),
+		Status:      models.SessionRunning,
+		Certificate: "MIIC",
+	}
+
+	tests := []struct {
+		name     string
+		before   func()
+		expected *models.Confirmation
+	}{
+		{
+			name: "Smart-ID success",
+			before: func() {
+				confirmationsMock.EXPECT().FindById(gomock.Any(), id).Return(smartIdConfirmation, nil)
+				gomock.InOrder(
+					smartIdMock.EXPECT().FetchSession(gomock.Any(), id.String()).Return(&signing.Result{State: signing.StateRunning}, nil),
+					smartIdMock.EXPECT().FetchSession(gomock.Any(), id.String()).Return(&signing.Result{
+						State:       signing.StateComplete,
+						Signature:   "c2lnbmF0dXJl",
+						Algorithm:   "sha512WithRSAEncryption",
+						Certificate: "MIID",
+					}, nil),
+				)
+				usersMock.EXPECT().FindById(gomock.Any(), userId).Return(user, nil)
+				verifierMock.EXPECT().Verify(hash, &signing.Result{
+					State:       signing.StateComplete,
+					Signature:   "c2lnbmF0dXJl",
+					Algorithm:   "sha512WithRSAEncryption",
+					Certificate: "MIID",
+				}, "PNOEE-30303039914").Return(nil)
+				confirmationsMock.EXPECT().Update(gomock.Any(), &models.UpdateConfirmationParams{
+					ID:          id,
+					Status:      models.SessionSuccess,
+					Signature:   "c2lnbmF0dXJl",
+					Algorithm:   "sha512WithRSAEncryption",
+					Certificate: "MIID",
+				}).Return(&models.Confirmation{ID: id, Status: models.SessionSuccess}, nil)
+			},
+			expected: &models.Confirmation{ID: id, Status: models.SessionSuccess},
+		},
+		{
+			name: "Mobile-ID success",
+			before: func() {
+				confirmationsMock.EXPECT().FindById(gomock.Any(), id).Return(mobileIdConfirmation, nil)
+				mobileIdMock.EXPECT().FetchSession(gomock.Any(), id.String()).Return(&signing.Result{
+					State:     signing.StateComplete,
+					Signature: "c2lnbmF0dXJl",
+					Algorithm: "SHA512WithECEncryption",
+				}, nil)
+				usersMock.EXPECT().FindById(gomock.Any(), userId).Return(user, nil)
+				verifierMock.EXPECT().Verify(hash, &signing.Result{
+					State:       signing.StateComplete,
+					Signature:   "c2lnbmF0dXJl",
+					Algorithm:   "SHA512WithECEncryption",
+					Certificate: "MIIC",
+				}, "PNOEE-30303039914").Return(nil)
+				confirmationsMock.EXPECT().Update(gomock.Any(), &models.UpdateConfirmationParams{
+					ID:        id,
+					Status:    models.SessionSuccess,
+					Signature: "c2lnbmF0dXJl",
+					Algorithm: "SHA512WithECEncryption",
+				}).Return(&models.Confirmation{ID: id, Status: models.SessionSuccess}, nil)
+			},
+			expected: &models.Confirmation{ID: id, Status: models.SessionSuccess},
+		},
+		{
+			name: "Signature of another person",
+			before: func() {
+				confirmationsMock.EXPECT().FindById(gomock.Any(), id).Return(smartIdConfirmation, nil)
+				smartIdMock.EXPECT().FetchSession(gomock.Any(), id.String()).Return(&signing.Result{
+					State:       signing.StateComplete,
+					Signature:   "c2lnbmF0dXJl",
+					Algorithm:   "sha512WithRSAEncryption",
+					Certificate: "MIID",
+				}, nil)
+				usersMock.EXPECT().FindById(gomock.Any(), userId).Return(user, nil)
+				verifierMock.EXPECT().Verify(hash, gomock.Any(), "PNOEE-30303039914").Return(errors.ErrSignerMismatch)
+				confirmationsMock.EXPECT().Update(gomock.Any(), &models.UpdateConfirmationParams{
+					ID:     id,
+					Status: models.SessionError,
+					Error:  errors.ErrSignerMismatch.Error(),
+				}).Return(&models.Confirmation{ID: id, Status: models.SessionError}, nil)
+			},
+			expected: &models.Confirmation{ID: id, Status: models.SessionError},
+		},
+		{
+			name: "Untrusted certificate",
+			before: func() {
+				confirmationsMock.EXPECT().FindById(gomock.Any(), id).Return(mobileIdConfirmation, nil)
+				mobileIdMock.EXPECT().FetchSession(gomock.Any(), id.String()).Return(&signing.Result{
+					State:     signing.StateComplete,
+					Signature: "c2lnbmF0dXJl",
+					Algorithm: "SHA512WithECEncryption",
+				}, nil)
+				usersMock.EXPECT().FindById(gomock.Any(), userId).Return(user, nil)
+				verifierMock.EXPECT().Verify(hash, gomock.Any(), "PNOEE-30303039914").Return(errors.ErrUntrustedSigningCertificate)
+				confirmationsMock.EXPECT().Update(gomock.Any(), &models.UpdateConfirmationParams{
+					ID:     id,
+					Status: models.SessionError,
+					Error:  errors.ErrUntrustedSigningCertificate.Error(),
+				}).Return(&models.Confirmation{ID: id, Status: models.SessionError}, nil)
+			},
+			expected: &models.Confirmation{ID: id, Status: models.SessionError},
+		},
+		{
+			name: "Transient error is retried",
+			before: func() {
+				confirmationsMock.EXPECT().FindById(gomock.Any(), id).Return(smartIdConfirmation, nil)
+				gomock.InOrder(
+					smartIdMock.EXPECT().FetchSession(gomock.Any(), id.String()).Return(nil, errors.ErrSigningProviderError),
+					smartIdMock.EXPECT().FetchSession(gomock.Any(), id.String()).Return(&signing.Result{
+						State:       signing.StateComplete,
+						Signature:   "c2lnbmF0dXJl",
+						Algorithm:   "sha512WithRSAEncryption",
+						Certificate: "MIID",
+					}, nil),
+				)
+				usersMock.EXPECT().FindById(gomock.Any(), userId).Return(user, nil)
+				verifierMock.EXPECT().Verify(hash, gomock.Any(), "PNOEE-30303039914").Return(nil)
+				confirmationsMock.EXPECT().Update(gomock.Any(), &models.UpdateConfirmationParams{
+					ID:          id,
+					Status:      models.SessionSuccess,
+					Signature:   "c2lnbmF0dXJl",
+					Algorithm:   "sha512WithRSAEncryption",
+					Certificate: "MIID",
+				}).Return(&models.Confirmation{ID: id, Status: models.SessionSuccess}, nil)
+			},
+			expected: &models.Confirmation{ID: id, Status: models.SessionSuccess},
+		},
+		{
+			name: "User refused",
+			before: func() {
+				err := &signing.Error{Code: "USER_REFUSED_CONFIRMATIONMESSAGE"}
+
+				confirmationsMock.EXPECT().FindById(gomock.Any(), id).Return(smartIdConfirmation, nil)
+				smartIdMock.EXPECT().FetchSession(gomock.Any(), id.String()).Return(nil, err)
+				confirmationsMock.EXPECT().Update(gomock.Any(), &models.UpdateConfirmationParams{
+					ID:     id,
+					Status: models.SessionCancelled,
+					Error:  err.Error(),
+				}).Return(&models.Confirmation{ID: id, Status: models.SessionCancelled}, nil)
+			},
+			expected: &models.Confirmation{ID: id, Status: models.SessionCancelled},
+		},
+		{
+			name: "Timeout",
+			before: func() {
+				err := &signing.Error{Code: "TIMEOUT"}
+
+				confirmationsMock.EXPECT().FindById(gomock.Any(), id).Return(mobileIdConfirmation, nil)
+				mobileIdMock.EXPECT().FetchSession(gomock.Any(), id.String()).Return(nil, err)
+				confirmationsMock.EXPECT().Update(gomock.Any(), &models.UpdateConfirmationParams{
+					ID:     id,
+					Status: models.SessionExpired,
+					Error:  err.Error(),
+				}).Return(&models.Confirmation{ID: id, Status: models.SessionExpired}, nil)
+			},
+			expected: &models.Confirmation{ID: id, Status: models.SessionExpired},
+		},
+		{
+			name: "Provider error",
+			before: func() {
+				confirmationsMock.EXPECT().FindById(gomock.Any(), id).Return(smartIdConfirmation, nil)
+				smartIdMock.EXPECT().FetchSession(gomock.Any(), id.String()).Return(nil, errors.ErrSigningProviderError).Times(ConfirmationPollRetries + 1)
+				confirmationsMock.EXPECT().Update(gomock.Any(), &models.UpdateConfirmationParams{
+					ID:     id,
+					Status: models.SessionError,
+					Error:  errors.ErrSigningProviderError.Error(),
+				}).Return(&models.Confirmation{ID: id, Status: models.SessionError}, nil)
+			},
+			expected: &models.Confirmation{ID: id, Status: models.SessionError},
+		},
+		{
+			name: "Confirmation not found",
+			before: func() {
+				confirmationsMock.EXPECT().FindById(gomock.Any(), id).Return(nil, errors.ErrConfirmationNotFound)
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			result := worker.Perform(ctx, id, traceId)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ConfirmationWorker_Enqueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	id := uuid.MustParse("8fdb516d-1a82-43ba-b82d-be63df569b86")
+	performed := make(chan struct{})
+
+	confirmationsMock := services.NewMockConfirmations(ctrl)
+	confirmationsMock.EXPECT().FindById(gomock.Any(), id).DoAndReturn(func(_ context.Context, _ uuid.UUID) (*models.Confirmation, error) {
+		close(performed)
+		return nil, errors.ErrConfirmationNotFound
+	})
+
+	worker := &confirmationWorker{
+		confirmations: confirmationsMock,
+		queue:         make(chan confirmationJob, 1),
+		done:          make(chan struct{}),
+		log:           log,
+	}
+
+	worker.Start(context.Background())
+	assert.NoError(t, worker.Enqueue(context.Background(), id, uuid.New().String()))
+
+	select {
+	case <-performed:
+	case <-time.After(time.Second):
+		t.Fatal("confirmation was not performed")
+	}
+
+	worker.Stop()
+	worker.Stop()
+}
+
+func Test_ConfirmationWorker_Enqueue_QueueFull(t *testing.T) {
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	worker := &confirmationWorker{
+		queue: make(chan confirmationJob),
+		done:  make(chan struct{}),
+		log:   log,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := worker.Enqueue(ctx, uuid.New(), uuid.New().String())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,102 @@
+package workers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"loki/internal/app/models"
+	"loki/internal/app/services"
+	"loki/internal/config/logger"
+)
+
+type GrantsWorker interface {
+	Start(ctx context.Context)
+	Stop()
+	Perform(ctx context.Context)
+}
+
+type grantsWorker struct {
+	audit      services.Audit
+	elevations services.Elevations
+	interval   time.Duration
+	done       chan struct{}
+	once       sync.Once
+	wg         sync.WaitGroup
+	log        *logger.Logger
+}
+
+func NewGrantsWorker(audit services.Audit, elevations services.Elevations, log *logger.Logger) GrantsWorker {
+	return &grantsWorker{
+		audit:      audit,
+		elevations: elevations,
+		interval:   GrantsCleanupInterval,
+		done:       make(chan struct{}),
+		log:        log,
+	}
+}
+
+// Start periodically removes expired grants until the context is cancelled or the worker is stopped
+func (w *grantsWorker) Start(ctx context.Context) {
+	w.wg.Add(1)
+
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-w.done:
+				return
+			case <-ticker.C:
+				w.Perform(ctx)
+			}
+		}
+	}()
+}
+
+func (w *grantsWorker) Stop() {
+	w.once.Do(func() {
+		close(w.done)
+	})
+	w.wg.Wait()
+}
+
+// Perform removes expired grants and records every expiry in the audit log
+func (w *grantsWorker) Perform(ctx context.Context) {
+	grants, err := w.elevations.Expire(ctx)
+	if err != nil {
+		w.log.Error().Err(err).Msgf("%s failed to expire grants", GrantsWorkerName)
+		return
+	}
+
+	for _, grant := range grants {
+		metadata := map[string]string{
+			"expires_at": grant.ExpiresAt.Format(time.RFC3339),
+		}
+
+		if grant.RoleID != uuid.Nil {
+			metadata["role_id"] = grant.RoleID.String()
+		}
+		if grant.ScopeID != uuid.Nil {
+			metadata["scope_id"] = grant.ScopeID.String()
+		}
+		if grant.GrantedBy != uuid.Nil {
+			metadata["granted_by"] = grant.GrantedBy.String()
+		}
+
+		w.audit.Record(ctx, &models.AuditEvent{
+			Action:     models.AuditGrantExpired,
+			TargetType: "user",
+			TargetID:   grant.UserID.String(),
+			Status:     models.AuditSuccess,
+			Metadata:   metadata,
+		})
+	}
+}
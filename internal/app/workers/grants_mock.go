@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/workers/grants.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/workers/grants.go -destination=internal/app/workers/grants_mock.go -package=workers
+//
+
+// Package workers is a generated GoMock package.
+package workers
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGrantsWorker is a mock of GrantsWorker interface.
+type MockGrantsWorker struct {
+	ctrl     *gomock.Controller
+	recorder *MockGrantsWorkerMockRecorder
+	isgomock struct{}
+}
+
+// MockGrantsWorkerMockRecorder is the mock recorder for MockGrantsWorker.
+type MockGrantsWorkerMockRecorder struct {
+	mock *MockGrantsWorker
+}
+
+// NewMockGrantsWorker creates a new mock instance.
+func NewMockGrantsWorker(ctrl *gomock.Controller) *MockGrantsWorker {
+	mock := &MockGrantsWorker{ctrl: ctrl}
+	mock.recorder = &MockGrantsWorkerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGrantsWorker) EXPECT() *MockGrantsWorkerMockRecorder {
+	return m.recorder
+}
+
+// Perform mocks base method.
+func (m *MockGrantsWorker) Perform(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Perform", ctx)
+}
+
+// Perform indicates an expected call of Perform.
+func (mr *MockGrantsWorkerMockRecorder) Perform(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Perform", reflect.TypeOf((*MockGrantsWorker)(nil).Perform), ctx)
+}
+
+// Start mocks base method.
+func (m *MockGrantsWorker) Start(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", ctx)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockGrantsWorkerMockRecorder) Start(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockGrantsWorker)(nil).Start), ctx)
+}
+
+// Stop mocks base method.
+func (m *MockGrantsWorker) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockGrantsWorkerMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockGrantsWorker)(nil).Stop))
+}
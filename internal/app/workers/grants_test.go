@@ -0,0 +1,127 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+func Test_GrantsWorker_Perform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	auditMock := services.NewMockAudit(ctrl)
+	elevationsMock := services.NewMockElevations(ctrl)
+
+	worker := NewGrantsWorker(auditMock, elevationsMock, log)
+	expiresAt := time.Now()
+
+	tests := []struct {
+		name   string
+		before func()
+	}{
+		{
+			name: "Success",
+			before: func() {
+				elevationsMock.EXPECT().Expire(ctx).Return([]models.ExpiredGrant{
+					{
+						UserID:    uuid.MustParse("10000000-1000-1000-5000-000000000001"),
+						RoleID:    uuid.MustParse("10000000-1000-1000-1000-000000000001"),
+						GrantedBy: uuid.MustParse("10000000-1000-1000-5000-000000000002"),
+						ExpiresAt: time.Now(),
+					},
+					{
+						UserID:  uuid.MustParse("10000000-1000-1000-5000-000000000001"),
+						ScopeID: uuid.MustParse("10000000-1000-1000-2000-000000000001"),
+					},
+				}, nil)
+
+				auditMock.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditGrantExpired,
+					TargetType: "user",
+					TargetID:   "10000000-1000-1000-5000-000000000001",
+					Status:     models.AuditSuccess,
+					Metadata: map[string]string{
+						"expires_at": expiresAt.Format(time.RFC3339),
+						"role_id":    "10000000-1000-1000-1000-000000000001",
+						"granted_by": "10000000-1000-1000-5000-000000000002",
+					},
+				})
+				auditMock.EXPECT().Record(ctx, &models.AuditEvent{
+					Action:     models.AuditGrantExpired,
+					TargetType: "user",
+					TargetID:   "10000000-1000-1000-5000-000000000001",
+					Status:     models.AuditSuccess,
+					Metadata: map[string]string{
+						"expires_at": time.Time{}.Format(time.RFC3339),
+						"scope_id":   "10000000-1000-1000-2000-000000000001",
+					},
+				})
+			},
+		},
+		{
+			name: "Nothing expired",
+			before: func() {
+				elevationsMock.EXPECT().Expire(ctx).Return([]models.ExpiredGrant{}, nil)
+			},
+		},
+		{
+			name: "Error",
+			before: func() {
+				elevationsMock.EXPECT().Expire(ctx).Return(nil, errors.ErrFailedToDeleteRecord)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			worker.Perform(ctx)
+		})
+	}
+}
+
+func Test_GrantsWorker_StartStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	elevationsMock := services.NewMockElevations(ctrl)
+	elevationsMock.EXPECT().Expire(gomock.Any()).Return(nil, nil).AnyTimes()
+
+	worker := &grantsWorker{
+		elevations: elevationsMock,
+		interval:   time.Millisecond,
+		done:       make(chan struct{}),
+		log:        log,
+	}
+
+	worker.Start(context.Background())
+	time.Sleep(10 * time.Millisecond)
+	worker.Stop()
+	worker.Stop()
+}
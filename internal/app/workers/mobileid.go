@@ -2,16 +2,15 @@ package workers
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/tab/mobileid"
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/trace"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/services"
 	"loki/internal/config/logger"
+	"loki/internal/config/telemetry"
 )
 
 type MobileIdWorker interface {
@@ -19,84 +18,129 @@ type MobileIdWorker interface {
 }
 
 type mobileIdWorker struct {
-	sessions services.Sessions
-	users    services.Users
-	worker   mobileid.Worker
-	log      *logger.Logger
+	audit          services.Audit
+	authenticators services.Authenticators
+	profiles       services.Profiles
+	provisioning   services.Provisioning
+	sessions       services.Sessions
+	throttle       services.Throttle
+	worker         mobileid.Worker
+	log            *logger.Logger
 }
 
 func NewMobileIdWorker(
+	audit services.Audit,
+	authenticators services.Authenticators,
+	profiles services.Profiles,
+	provisioning services.Provisioning,
 	sessions services.Sessions,
-	users services.Users,
+	throttle services.Throttle,
 	worker mobileid.Worker,
 	log *logger.Logger,
 ) MobileIdWorker {
 	return &mobileIdWorker{
-		sessions: sessions,
-		users:    users,
-		worker:   worker,
-		log:      log,
+		audit:          audit,
+		authenticators: authenticators,
+		profiles:       profiles,
+		provisioning:   provisioning,
+		sessions:       sessions,
+		throttle:       throttle,
+		worker:         worker,
+		log:            log,
 	}
 }
 
 func (w *mobileIdWorker) Perform(ctx context.Context, sessionId uuid.UUID, traceId string) *models.Session {
 	w.log.Info().Msgf("%s perform %s", MobileIdWorkerName, sessionId)
-	w.trace(ctx, traceId)
-
-	resultCh := w.worker.Process(ctx, sessionId.String())
+	ctx, span := startSpan(ctx, MobileIdWorkerName, "perform")
+	defer span.End()
 
-	result := <-resultCh
+	result := w.poll(ctx, sessionId)
 	if result.Err != nil {
 		w.log.Error().Err(result.Err).Msgf("%s failed to get session status", MobileIdWorkerName)
-		return w.updateSession(ctx, &models.UpdateSessionParams{
+		w.recordLogin(ctx, traceId, nil, result.Err)
+		status := mobileIdSessionStatus(result.Err)
+		session := w.updateSession(ctx, &models.UpdateSessionParams{
 			ID:     sessionId,
-			Status: Error,
+			Status: status,
 			Error:  result.Err.Error(),
 		})
+		if status == models.SessionCancelled {
+			w.recordRefusal(ctx, session)
+		}
+		return session
 	}
 
-	user, err := w.users.Create(ctx, &models.User{
-		IdentityNumber: result.Person.IdentityNumber,
-		PersonalCode:   result.Person.PersonalCode,
-		FirstName:      result.Person.FirstName,
-		LastName:       result.Person.LastName,
-	})
-
+	user, err := w.provision(ctx, result.Person)
 	if err != nil {
-		w.log.Error().Err(err).Msgf("%s failed to create user", MobileIdWorkerName)
+		w.log.Error().Err(err).Msgf("%s failed to provision user", MobileIdWorkerName)
+		w.recordLogin(ctx, traceId, nil, err)
+		return w.updateSession(ctx, &models.UpdateSessionParams{
+			ID:     sessionId,
+			Status: provisioningSessionStatus(err),
+			Error:  err.Error(),
+		})
+	}
+
+	if !user.CanAuthenticate() {
+		w.log.Warn().Msgf("%s refused login for %s user %s", MobileIdWorkerName, user.Status, user.ID)
+		w.recordLogin(ctx, traceId, user, errors.ErrUserInactive)
+		return w.updateSession(ctx, &models.UpdateSessionParams{
+			ID:     sessionId,
+			Status: models.SessionRejected,
+			Error:  errors.ErrUserInactive.Error(),
+		})
+	}
+
+	if err = w.authenticators.Authorize(ctx, user.ID, models.AuthMethodMobileId); err != nil {
+		w.log.Warn().Err(err).Msgf("%s refused login for user %s", MobileIdWorkerName, user.ID)
+		w.recordLogin(ctx, traceId, user, err)
 		return w.updateSession(ctx, &models.UpdateSessionParams{
 			ID:     sessionId,
-			Status: Error,
+			Status: authorizationSessionStatus(err),
 			Error:  err.Error(),
 		})
 	}
 
+	w.recordLogin(ctx, traceId, user, nil)
+	w.recordAuthenticator(ctx, sessionId, user, result.Person)
+
 	return w.updateSession(ctx, &models.UpdateSessionParams{
 		ID:     sessionId,
 		UserId: user.ID,
-		Status: Success,
+		Status: models.SessionSuccess,
 	})
 }
 
-func (w *mobileIdWorker) trace(ctx context.Context, traceId string) {
-	tracer := otel.Tracer(TraceName)
-	id, _ := trace.TraceIDFromHex(traceId)
+// poll waits for the provider to finish the session
+func (w *mobileIdWorker) poll(ctx context.Context, sessionId uuid.UUID) mobileid.Result {
+	ctx, span := startSpan(ctx, MobileIdWorkerName, "poll")
 
-	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
-		TraceID:    id,
-		Remote:     true,
-		TraceFlags: trace.FlagsSampled,
-	})
+	telemetry.RecordWorkerJob(MobileIdWorkerName, 1)
+	result := <-w.worker.Process(ctx, sessionId.String())
+	telemetry.RecordWorkerJob(MobileIdWorkerName, -1)
 
-	operationName := fmt.Sprintf("%s perform {id}", SmartIdWorkerName)
+	endSpan(span, result.Err)
+	return result
+}
 
-	_, span := tracer.Start(
-		trace.ContextWithSpanContext(ctx, spanCtx),
-		operationName)
-	defer span.End()
+func (w *mobileIdWorker) provision(ctx context.Context, person *mobileid.Person) (*models.User, error) {
+	ctx, span := startSpan(ctx, MobileIdWorkerName, "provision")
+
+	user, err := w.provisioning.Provision(ctx, &models.User{
+		IdentityNumber: person.IdentityNumber,
+		PersonalCode:   person.PersonalCode,
+		FirstName:      person.FirstName,
+		LastName:       person.LastName,
+	})
+
+	endSpan(span, err)
+	return user, err
 }
 
 func (w *mobileIdWorker) updateSession(ctx context.Context, params *models.UpdateSessionParams) *models.Session {
+	ctx, span := startSpan(ctx, MobileIdWorkerName, "update session")
+
 	session, err := w.sessions.Update(ctx, &models.UpdateSessionParams{
 		ID:     params.ID,
 		UserId: params.UserId,
@@ -107,5 +151,87 @@ func (w *mobileIdWorker) updateSession(ctx context.Context, params *models.Updat
 		w.log.Error().Err(err).Msgf("%s failed to update session", MobileIdWorkerName)
 	}
 
+	endSpan(span, err)
 	return session
 }
+
+// recordAuthenticator keeps the phone number the user has authenticated with, a failure does not fail the login
+func (w *mobileIdWorker) recordAuthenticator(ctx context.Context, sessionId uuid.UUID, user *models.User, person *mobileid.Person) {
+	session, err := w.sessions.FindById(ctx, sessionId.String())
+	if err != nil || session.PhoneNumber == "" {
+		return
+	}
+
+	if err = w.profiles.SavePhoneNumber(ctx, user.ID, session.PhoneNumber); err != nil {
+		w.log.Error().Err(err).Msgf("%s failed to save phone number", MobileIdWorkerName)
+	}
+
+	err = w.authenticators.Record(ctx, &models.Authenticator{
+		UserID:     user.ID,
+		Method:     models.AuthMethodMobileId,
+		Identifier: session.PhoneNumber,
+		Metadata:   map[string]string{"identity_number": person.IdentityNumber},
+	})
+	if err != nil {
+		w.log.Error().Err(err).Msgf("%s failed to record authenticator", MobileIdWorkerName)
+	}
+}
+
+// recordRefusal counts a login the user declined on their device towards the Mobile-ID cooldown
+func (w *mobileIdWorker) recordRefusal(ctx context.Context, session *models.Session) {
+	if session == nil {
+		return
+	}
+
+	w.throttle.Refused(ctx, &models.ThrottleParams{
+		Endpoint:     models.AuthMethodMobileId,
+		PersonalCode: session.PersonalCode,
+		PhoneNumber:  session.PhoneNumber,
+	})
+}
+
+// recordLogin stores the authentication outcome with the provider error code in the audit log
+func (w *mobileIdWorker) recordLogin(ctx context.Context, traceId string, user *models.User, err error) {
+	event := &models.AuditEvent{
+		Action:     models.AuditLoginSucceeded,
+		TargetType: "user",
+		Status:     models.AuditSuccess,
+		Metadata:   map[string]string{"provider": models.AuditProviderMobileId},
+		TraceID:    traceId,
+	}
+
+	if user != nil {
+		event.ActorID = user.ID
+		event.TargetID = user.ID.String()
+	}
+
+	if err != nil {
+		event.Action = models.AuditLoginFailed
+		event.Status = models.AuditFailure
+		event.Metadata["error"] = err.Error()
+
+		var providerErr *mobileid.Error
+		if errors.As(err, &providerErr) {
+			event.Metadata["code"] = providerErr.Code
+		}
+	}
+
+	w.audit.Record(ctx, event)
+	telemetry.RecordSessionFinished(ctx, models.AuthMethodMobileId, err, event.Metadata["code"])
+}
+
+func mobileIdSessionStatus(err error) string {
+	var providerErr *mobileid.Error
+	if !errors.As(err, &providerErr) {
+		return models.SessionError
+	}
+
+	switch providerErr.Code {
+	case mobileid.USER_CANCELLED:
+		return models.SessionCancelled
+	case mobileid.TIMEOUT:
+		return models.SessionExpired
+	default:
+		return models.SessionError
+	}
+}
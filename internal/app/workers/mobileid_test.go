@@ -9,6 +9,7 @@ import (
 	"github.com/tab/mobileid"
 	"go.uber.org/mock/gomock"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/services"
 	"loki/internal/config"
@@ -28,10 +29,16 @@ func Test_MobileIdWorker_Perform(t *testing.T) {
 
 	ctx := context.Background()
 	sessionsMock := services.NewMockSessions(ctrl)
-	usersMock := services.NewMockUsers(ctrl)
+	provisioningMock := services.NewMockProvisioning(ctrl)
 	workerMock := mobileid.NewMockWorker(ctrl)
 
-	worker := NewMobileIdWorker(sessionsMock, usersMock, workerMock, log)
+	auditMock := services.NewMockAudit(ctrl)
+	auditMock.EXPECT().Record(gomock.Any(), gomock.Any()).AnyTimes()
+
+	authenticatorsMock := services.NewMockAuthenticators(ctrl)
+	profilesMock := services.NewMockProfiles(ctrl)
+	throttleMock := services.NewMockThrottle(ctrl)
+	worker := NewMobileIdWorker(auditMock, authenticatorsMock, profilesMock, provisioningMock, sessionsMock, throttleMock, workerMock, log)
 
 	id := uuid.MustParse("8fdb516d-1a82-43ba-b82d-be63df569b86")
 	sessionId := id.String()
@@ -59,12 +66,12 @@ func Test_MobileIdWorker_Perform(t *testing.T) {
 
 				workerMock.
 					EXPECT().
-					Process(ctx, sessionId).
+					Process(gomock.Any(), sessionId).
 					Return(resultChan)
 
-				usersMock.
+				provisioningMock.
 					EXPECT().
-					Create(ctx, &models.User{
+					Provision(gomock.Any(), &models.User{
 						IdentityNumber: "PNOEE-60001017869",
 						PersonalCode:   "60001017869",
 						FirstName:      "EID2016",
@@ -78,23 +85,48 @@ func Test_MobileIdWorker_Perform(t *testing.T) {
 						LastName:       "TESTNUMBER",
 					}, nil)
 
+				authenticatorsMock.
+					EXPECT().
+					Authorize(gomock.Any(), userId, models.AuthMethodMobileId).
+					Return(nil)
+
 				sessionsMock.
 					EXPECT().
-					Update(ctx, &models.UpdateSessionParams{
+					FindById(gomock.Any(), sessionId).
+					Return(&models.Session{ID: id, Status: models.SessionRunning, PhoneNumber: "+37268000769"}, nil)
+
+				profilesMock.
+					EXPECT().
+					SavePhoneNumber(gomock.Any(), userId, "+37268000769").
+					Return(nil)
+
+				authenticatorsMock.
+					EXPECT().
+					Record(gomock.Any(), &models.Authenticator{
+						UserID:     userId,
+						Method:     models.AuthMethodMobileId,
+						Identifier: "+37268000769",
+						Metadata:   map[string]string{"identity_number": "PNOEE-60001017869"},
+					}).
+					Return(nil)
+
+				sessionsMock.
+					EXPECT().
+					Update(gomock.Any(), &models.UpdateSessionParams{
 						ID:     id,
 						UserId: userId,
-						Status: Success,
+						Status: models.SessionSuccess,
 					}).
 					Return(&models.Session{
 						ID:     id,
 						UserId: userId,
-						Status: Success,
+						Status: models.SessionSuccess,
 					}, nil)
 			},
 			expected: &models.Session{
 				ID:     id,
 				UserId: userId,
-				Status: Success,
+				Status: models.SessionSuccess,
 			},
 		},
 		{
@@ -108,29 +140,105 @@ func Test_MobileIdWorker_Perform(t *testing.T) {
 
 				workerMock.
 					EXPECT().
-					Process(ctx, sessionId).
+					Process(gomock.Any(), sessionId).
 					Return(resultChan)
 
 				sessionsMock.
 					EXPECT().
-					Update(ctx, &models.UpdateSessionParams{
+					Update(gomock.Any(), &models.UpdateSessionParams{
 						ID:     id,
-						Status: Error,
+						Status: models.SessionError,
 						Error:  assert.AnError.Error(),
 					}).Return(&models.Session{
 					ID:     id,
-					Status: Error,
+					Status: models.SessionError,
 					Error:  assert.AnError.Error(),
 				}, nil)
 			},
 			expected: &models.Session{
 				ID:     id,
-				Status: Error,
+				Status: models.SessionError,
 				Error:  assert.AnError.Error(),
 			},
 		},
 		{
-			name: "Failed to create user",
+			name: "User cancelled",
+			before: func() {
+				resultChan := make(chan mobileid.Result, 1)
+				resultChan <- mobileid.Result{
+					Err: &mobileid.Error{Code: mobileid.USER_CANCELLED},
+				}
+				close(resultChan)
+
+				workerMock.
+					EXPECT().
+					Process(gomock.Any(), sessionId).
+					Return(resultChan)
+
+				sessionsMock.
+					EXPECT().
+					Update(gomock.Any(), &models.UpdateSessionParams{
+						ID:     id,
+						Status: models.SessionCancelled,
+						Error:  "authentication failed: USER_CANCELLED",
+					}).Return(&models.Session{
+					ID:           id,
+					Status:       models.SessionCancelled,
+					Error:        "authentication failed: USER_CANCELLED",
+					PersonalCode: "60001017869",
+					PhoneNumber:  "+37268000769",
+				}, nil)
+
+				throttleMock.
+					EXPECT().
+					Refused(gomock.Any(), &models.ThrottleParams{
+						Endpoint:     models.AuthMethodMobileId,
+						PersonalCode: "60001017869",
+						PhoneNumber:  "+37268000769",
+					})
+			},
+			expected: &models.Session{
+				ID:           id,
+				Status:       models.SessionCancelled,
+				Error:        "authentication failed: USER_CANCELLED",
+				PersonalCode: "60001017869",
+				PhoneNumber:  "+37268000769",
+			},
+		},
+		{
+			name: "Timeout",
+			before: func() {
+				resultChan := make(chan mobileid.Result, 1)
+				resultChan <- mobileid.Result{
+					Err: &mobileid.Error{Code: mobileid.TIMEOUT},
+				}
+				close(resultChan)
+
+				workerMock.
+					EXPECT().
+					Process(gomock.Any(), sessionId).
+					Return(resultChan)
+
+				sessionsMock.
+					EXPECT().
+					Update(gomock.Any(), &models.UpdateSessionParams{
+						ID:     id,
+						Status: models.SessionExpired,
+						Error:  "authentication failed: TIMEOUT",
+					}).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionExpired,
+					Error:  "authentication failed: TIMEOUT",
+				}, nil)
+			},
+			expected: &models.Session{
+				ID:     id,
+				Status: models.SessionExpired,
+				Error:  "authentication failed: TIMEOUT",
+			},
+		},
+		{
+			name: "Failed to provision user",
 			before: func() {
 				resultChan := make(chan mobileid.Result, 1)
 				resultChan <- mobileid.Result{
@@ -145,12 +253,12 @@ func Test_MobileIdWorker_Perform(t *testing.T) {
 
 				workerMock.
 					EXPECT().
-					Process(ctx, sessionId).
+					Process(gomock.Any(), sessionId).
 					Return(resultChan)
 
-				usersMock.
+				provisioningMock.
 					EXPECT().
-					Create(ctx, &models.User{
+					Provision(gomock.Any(), &models.User{
 						IdentityNumber: "PNOEE-60001017869",
 						PersonalCode:   "60001017869",
 						FirstName:      "EID2016",
@@ -160,23 +268,122 @@ func Test_MobileIdWorker_Perform(t *testing.T) {
 
 				sessionsMock.
 					EXPECT().
-					Update(ctx, &models.UpdateSessionParams{
+					Update(gomock.Any(), &models.UpdateSessionParams{
 						ID:     id,
-						Status: Error,
+						Status: models.SessionError,
 						Error:  assert.AnError.Error(),
 					}).
 					Return(&models.Session{
 						ID:     id,
-						Status: Error,
+						Status: models.SessionError,
 						Error:  assert.AnError.Error(),
 					}, nil)
 			},
 			expected: &models.Session{
 				ID:     id,
-				Status: Error,
+				Status: models.SessionError,
 				Error:  assert.AnError.Error(),
 			},
 		},
+		{
+			name: "Deleted user",
+			before: func() {
+				resultChan := make(chan mobileid.Result, 1)
+				resultChan <- mobileid.Result{
+					Person: &mobileid.Person{
+						IdentityNumber: "PNOEE-60001017869",
+						PersonalCode:   "60001017869",
+						FirstName:      "EID2016",
+						LastName:       "TESTNUMBER",
+					},
+				}
+				close(resultChan)
+
+				workerMock.
+					EXPECT().
+					Process(gomock.Any(), sessionId).
+					Return(resultChan)
+
+				provisioningMock.
+					EXPECT().
+					Provision(gomock.Any(), gomock.Any()).
+					Return(&models.User{
+						ID:             userId,
+						IdentityNumber: "PNOEE-60001017869",
+						Status:         models.UserDeleted,
+					}, nil)
+
+				sessionsMock.
+					EXPECT().
+					Update(gomock.Any(), &models.UpdateSessionParams{
+						ID:     id,
+						Status: models.SessionRejected,
+						Error:  errors.ErrUserInactive.Error(),
+					}).
+					Return(&models.Session{
+						ID:     id,
+						Status: models.SessionRejected,
+						Error:  errors.ErrUserInactive.Error(),
+					}, nil)
+			},
+			expected: &models.Session{
+				ID:     id,
+				Status: models.SessionRejected,
+				Error:  errors.ErrUserInactive.Error(),
+			},
+		},
+		{
+			name: "Method not allowed",
+			before: func() {
+				resultChan := make(chan mobileid.Result, 1)
+				resultChan <- mobileid.Result{
+					Person: &mobileid.Person{
+						IdentityNumber: "PNOEE-60001017869",
+						PersonalCode:   "60001017869",
+						FirstName:      "EID2016",
+						LastName:       "TESTNUMBER",
+					},
+				}
+				close(resultChan)
+
+				workerMock.
+					EXPECT().
+					Process(gomock.Any(), sessionId).
+					Return(resultChan)
+
+				provisioningMock.
+					EXPECT().
+					Provision(gomock.Any(), gomock.Any()).
+					Return(&models.User{
+						ID:             userId,
+						IdentityNumber: "PNOEE-60001017869",
+						Status:         models.UserActive,
+					}, nil)
+
+				authenticatorsMock.
+					EXPECT().
+					Authorize(gomock.Any(), userId, models.AuthMethodMobileId).
+					Return(errors.ErrAuthenticationMethodNotAllowed)
+
+				sessionsMock.
+					EXPECT().
+					Update(gomock.Any(), &models.UpdateSessionParams{
+						ID:     id,
+						Status: models.SessionRejected,
+						Error:  errors.ErrAuthenticationMethodNotAllowed.Error(),
+					}).
+					Return(&models.Session{
+						ID:     id,
+						Status: models.SessionRejected,
+						Error:  errors.ErrAuthenticationMethodNotAllowed.Error(),
+					}, nil)
+			},
+			expected: &models.Session{
+				ID:     id,
+				Status: models.SessionRejected,
+				Error:  errors.ErrAuthenticationMethodNotAllowed.Error(),
+			},
+		},
 		{
 			name: "Failed to update session",
 			before: func() {
@@ -193,12 +400,12 @@ func Test_MobileIdWorker_Perform(t *testing.T) {
 
 				workerMock.
 					EXPECT().
-					Process(ctx, sessionId).
+					Process(gomock.Any(), sessionId).
 					Return(resultChan)
 
-				usersMock.
+				provisioningMock.
 					EXPECT().
-					Create(ctx, &models.User{
+					Provision(gomock.Any(), &models.User{
 						IdentityNumber: "PNOEE-60001017869",
 						PersonalCode:   "60001017869",
 						FirstName:      "EID2016",
@@ -212,12 +419,22 @@ func Test_MobileIdWorker_Perform(t *testing.T) {
 						LastName:       "TESTNUMBER",
 					}, nil)
 
+				authenticatorsMock.
+					EXPECT().
+					Authorize(gomock.Any(), userId, models.AuthMethodMobileId).
+					Return(nil)
+
+				sessionsMock.
+					EXPECT().
+					FindById(gomock.Any(), sessionId).
+					Return(&models.Session{ID: id, Status: models.SessionRunning}, nil)
+
 				sessionsMock.
 					EXPECT().
-					Update(ctx, &models.UpdateSessionParams{
+					Update(gomock.Any(), &models.UpdateSessionParams{
 						ID:     id,
 						UserId: userId,
-						Status: Success,
+						Status: models.SessionSuccess,
 					}).
 					Return(nil, assert.AnError)
 			},
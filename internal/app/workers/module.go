@@ -2,22 +2,99 @@ package workers
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
 )
 
 var Ctx context.Context
 
 const (
-	Success = "SUCCESS"
-	Error   = "ERROR"
-
 	TraceName          = "authentication"
 	SmartIdWorkerName  = "SmartId::Worker"
 	MobileIdWorkerName = "MobileId::Worker"
+	GrantsWorkerName   = "Grants::Worker"
+
+	ConfirmationWorkerName = "Confirmation::Worker"
+
+	// ConfirmationConcurrency and ConfirmationQueueSize bound the signature sessions polled at once
+	ConfirmationConcurrency = 5
+	ConfirmationQueueSize   = 15
+
+	// ConfirmationPollRetries is how many times a failed status request is repeated before the confirmation fails
+	ConfirmationPollRetries = 3
+	// ConfirmationPollRetryDelay is the pause before a failed status request is repeated
+	ConfirmationPollRetryDelay = 2 * time.Second
+
+	CheckpointsWorkerName = "Checkpoints::Worker"
+
+	PoliciesWorkerName = "Policies::Worker"
+
+	// GrantsCleanupInterval is how often expired role and scope grants are removed
+	GrantsCleanupInterval = time.Minute
+
+	// AuditCheckpointInterval is how often the head of the audit chain is signed
+	AuditCheckpointInterval = time.Hour
+
+	// PoliciesReloadInterval is how often access policies are reloaded
+	PoliciesReloadInterval = time.Minute
 )
 
+// Detach returns the workers context carrying the span of the request, so the worker spans join the request trace
+func Detach(ctx context.Context) context.Context {
+	parent := Ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+
+	return trace.ContextWithSpanContext(parent, trace.SpanContextFromContext(ctx))
+}
+
+// startSpan opens a span for a step of the worker, a child of the request span the worker was started from
+func startSpan(ctx context.Context, worker string, step string) (context.Context, trace.Span) {
+	return otel.Tracer(TraceName).Start(ctx, fmt.Sprintf("%s %s", worker, step))
+}
+
+// endSpan ends the span of a step, marking it as failed when the step returned an error
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// provisioningSessionStatus ends the session as rejected when the provisioning policy refused the person
+func provisioningSessionStatus(err error) string {
+	if errors.Is(err, errors.ErrProvisioningRejected) {
+		return models.SessionRejected
+	}
+
+	return models.SessionError
+}
+
+// authorizationSessionStatus ends the session as rejected when the user has not allowed the authentication method
+func authorizationSessionStatus(err error) string {
+	if errors.Is(err, errors.ErrAuthenticationMethodNotAllowed) {
+		return models.SessionRejected
+	}
+
+	return models.SessionError
+}
+
 var Module = fx.Options(
 	fx.Provide(NewSmartIdWorker),
 	fx.Provide(NewMobileIdWorker),
+	fx.Provide(NewConfirmationWorker),
+	fx.Provide(NewGrantsWorker),
+	fx.Provide(NewCheckpointsWorker),
+	fx.Provide(NewPoliciesWorker),
 )
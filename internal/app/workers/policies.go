@@ -0,0 +1,73 @@
+package workers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"loki/internal/app/services"
+	"loki/internal/config/logger"
+)
+
+type PoliciesWorker interface {
+	Start(ctx context.Context)
+	Stop()
+	Perform(ctx context.Context)
+}
+
+type policiesWorker struct {
+	policies services.Policies
+	interval time.Duration
+	done     chan struct{}
+	once     sync.Once
+	wg       sync.WaitGroup
+	log      *logger.Logger
+}
+
+func NewPoliciesWorker(policies services.Policies, log *logger.Logger) PoliciesWorker {
+	return &policiesWorker{
+		policies: policies,
+		interval: PoliciesReloadInterval,
+		done:     make(chan struct{}),
+		log:      log,
+	}
+}
+
+// Start loads the policies before requests are served and reloads them until the context is cancelled or the worker is stopped
+func (w *policiesWorker) Start(ctx context.Context) {
+	w.Perform(ctx)
+
+	w.wg.Add(1)
+
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-w.done:
+				return
+			case <-ticker.C:
+				w.Perform(ctx)
+			}
+		}
+	}()
+}
+
+func (w *policiesWorker) Stop() {
+	w.once.Do(func() {
+		close(w.done)
+	})
+	w.wg.Wait()
+}
+
+// Perform reloads the policies, a failed reload keeps the previously loaded policies
+func (w *policiesWorker) Perform(ctx context.Context) {
+	if err := w.policies.Reload(ctx); err != nil {
+		w.log.Error().Err(err).Msgf("%s failed to reload policies", PoliciesWorkerName)
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/workers/policies.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/app/workers/policies.go -destination=internal/app/workers/policies_mock.go -package=workers
+//
+
+// Package workers is a generated GoMock package.
+package workers
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPoliciesWorker is a mock of PoliciesWorker interface.
+type MockPoliciesWorker struct {
+	ctrl     *gomock.Controller
+	recorder *MockPoliciesWorkerMockRecorder
+	isgomock struct{}
+}
+
+// MockPoliciesWorkerMockRecorder is the mock recorder for MockPoliciesWorker.
+type MockPoliciesWorkerMockRecorder struct {
+	mock *MockPoliciesWorker
+}
+
+// NewMockPoliciesWorker creates a new mock instance.
+func NewMockPoliciesWorker(ctrl *gomock.Controller) *MockPoliciesWorker {
+	mock := &MockPoliciesWorker{ctrl: ctrl}
+	mock.recorder = &MockPoliciesWorkerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPoliciesWorker) EXPECT() *MockPoliciesWorkerMockRecorder {
+	return m.recorder
+}
+
+// Perform mocks base method.
+func (m *MockPoliciesWorker) Perform(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Perform", ctx)
+}
+
+// Perform indicates an expected call of Perform.
+func (mr *MockPoliciesWorkerMockRecorder) Perform(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Perform", reflect.TypeOf((*MockPoliciesWorker)(nil).Perform), ctx)
+}
+
+// Start mocks base method.
+func (m *MockPoliciesWorker) Start(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", ctx)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockPoliciesWorkerMockRecorder) Start(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockPoliciesWorker)(nil).Start), ctx)
+}
+
+// Stop mocks base method.
+func (m *MockPoliciesWorker) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockPoliciesWorkerMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockPoliciesWorker)(nil).Stop))
+}
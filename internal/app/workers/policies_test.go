@@ -0,0 +1,84 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+)
+
+func Test_PoliciesWorker_Perform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	ctx := context.Background()
+	policiesMock := services.NewMockPolicies(ctrl)
+
+	worker := NewPoliciesWorker(policiesMock, log)
+
+	tests := []struct {
+		name   string
+		before func()
+	}{
+		{
+			name: "Success",
+			before: func() {
+				policiesMock.EXPECT().Reload(ctx).Return(nil)
+			},
+		},
+		{
+			name: "Error",
+			before: func() {
+				policiesMock.EXPECT().Reload(ctx).Return(errors.ErrFailedToFetchResults)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			worker.Perform(ctx)
+		})
+	}
+}
+
+func Test_PoliciesWorker_StartStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	policiesMock := services.NewMockPolicies(ctrl)
+	policiesMock.EXPECT().Reload(gomock.Any()).Return(nil).MinTimes(1)
+
+	worker := &policiesWorker{
+		policies: policiesMock,
+		interval: time.Millisecond,
+		done:     make(chan struct{}),
+		log:      log,
+	}
+
+	worker.Start(context.Background())
+	time.Sleep(10 * time.Millisecond)
+	worker.Stop()
+	worker.Stop()
+}
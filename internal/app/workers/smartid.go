@@ -2,16 +2,15 @@ package workers
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/tab/smartid"
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/trace"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/services"
 	"loki/internal/config/logger"
+	"loki/internal/config/telemetry"
 )
 
 type SmartIdWorker interface {
@@ -19,84 +18,126 @@ type SmartIdWorker interface {
 }
 
 type smartIdWorker struct {
-	sessions services.Sessions
-	users    services.Users
-	worker   smartid.Worker
-	log      *logger.Logger
+	audit          services.Audit
+	authenticators services.Authenticators
+	provisioning   services.Provisioning
+	sessions       services.Sessions
+	throttle       services.Throttle
+	worker         smartid.Worker
+	log            *logger.Logger
 }
 
 func NewSmartIdWorker(
+	audit services.Audit,
+	authenticators services.Authenticators,
+	provisioning services.Provisioning,
 	sessions services.Sessions,
-	users services.Users,
+	throttle services.Throttle,
 	worker smartid.Worker,
 	log *logger.Logger,
 ) SmartIdWorker {
 	return &smartIdWorker{
-		sessions: sessions,
-		users:    users,
-		worker:   worker,
-		log:      log,
+		audit:          audit,
+		authenticators: authenticators,
+		provisioning:   provisioning,
+		sessions:       sessions,
+		throttle:       throttle,
+		worker:         worker,
+		log:            log,
 	}
 }
 
 func (w *smartIdWorker) Perform(ctx context.Context, sessionId uuid.UUID, traceId string) *models.Session {
 	w.log.Info().Msgf("%s perform %s", SmartIdWorkerName, sessionId)
-	w.trace(ctx, traceId)
-
-	resultCh := w.worker.Process(ctx, sessionId.String())
+	ctx, span := startSpan(ctx, SmartIdWorkerName, "perform")
+	defer span.End()
 
-	result := <-resultCh
+	result := w.poll(ctx, sessionId)
 	if result.Err != nil {
 		w.log.Error().Err(result.Err).Msgf("%s failed to get session status", SmartIdWorkerName)
-		return w.updateSession(ctx, &models.UpdateSessionParams{
+		w.recordLogin(ctx, traceId, nil, result.Err)
+		status := smartIdSessionStatus(result.Err)
+		session := w.updateSession(ctx, &models.UpdateSessionParams{
 			ID:     sessionId,
-			Status: Error,
+			Status: status,
 			Error:  result.Err.Error(),
 		})
+		if status == models.SessionCancelled {
+			w.recordRefusal(ctx, session)
+		}
+		return session
 	}
 
-	user, err := w.users.Create(ctx, &models.User{
-		IdentityNumber: result.Person.IdentityNumber,
-		PersonalCode:   result.Person.PersonalCode,
-		FirstName:      result.Person.FirstName,
-		LastName:       result.Person.LastName,
-	})
-
+	user, err := w.provision(ctx, result.Person)
 	if err != nil {
-		w.log.Error().Err(err).Msgf("%s failed to create user", SmartIdWorkerName)
+		w.log.Error().Err(err).Msgf("%s failed to provision user", SmartIdWorkerName)
+		w.recordLogin(ctx, traceId, nil, err)
+		return w.updateSession(ctx, &models.UpdateSessionParams{
+			ID:     sessionId,
+			Status: provisioningSessionStatus(err),
+			Error:  err.Error(),
+		})
+	}
+
+	if !user.CanAuthenticate() {
+		w.log.Warn().Msgf("%s refused login for %s user %s", SmartIdWorkerName, user.Status, user.ID)
+		w.recordLogin(ctx, traceId, user, errors.ErrUserInactive)
+		return w.updateSession(ctx, &models.UpdateSessionParams{
+			ID:     sessionId,
+			Status: models.SessionRejected,
+			Error:  errors.ErrUserInactive.Error(),
+		})
+	}
+
+	if err = w.authenticators.Authorize(ctx, user.ID, models.AuthMethodSmartId); err != nil {
+		w.log.Warn().Err(err).Msgf("%s refused login for user %s", SmartIdWorkerName, user.ID)
+		w.recordLogin(ctx, traceId, user, err)
 		return w.updateSession(ctx, &models.UpdateSessionParams{
 			ID:     sessionId,
-			Status: Error,
+			Status: authorizationSessionStatus(err),
 			Error:  err.Error(),
 		})
 	}
 
+	w.recordLogin(ctx, traceId, user, nil)
+	w.recordAuthenticator(ctx, user, result.Person)
+
 	return w.updateSession(ctx, &models.UpdateSessionParams{
 		ID:     sessionId,
 		UserId: user.ID,
-		Status: Success,
+		Status: models.SessionSuccess,
 	})
 }
 
-func (w *smartIdWorker) trace(ctx context.Context, traceId string) {
-	tracer := otel.Tracer(TraceName)
-	id, _ := trace.TraceIDFromHex(traceId)
+// poll waits for the provider to finish the session
+func (w *smartIdWorker) poll(ctx context.Context, sessionId uuid.UUID) smartid.Result {
+	ctx, span := startSpan(ctx, SmartIdWorkerName, "poll")
 
-	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
-		TraceID:    id,
-		Remote:     true,
-		TraceFlags: trace.FlagsSampled,
-	})
+	telemetry.RecordWorkerJob(SmartIdWorkerName, 1)
+	result := <-w.worker.Process(ctx, sessionId.String())
+	telemetry.RecordWorkerJob(SmartIdWorkerName, -1)
 
-	operationName := fmt.Sprintf("%s perform {id}", SmartIdWorkerName)
+	endSpan(span, result.Err)
+	return result
+}
 
-	_, span := tracer.Start(
-		trace.ContextWithSpanContext(ctx, spanCtx),
-		operationName)
-	defer span.End()
+func (w *smartIdWorker) provision(ctx context.Context, person *smartid.Person) (*models.User, error) {
+	ctx, span := startSpan(ctx, SmartIdWorkerName, "provision")
+
+	user, err := w.provisioning.Provision(ctx, &models.User{
+		IdentityNumber: person.IdentityNumber,
+		PersonalCode:   person.PersonalCode,
+		FirstName:      person.FirstName,
+		LastName:       person.LastName,
+	})
+
+	endSpan(span, err)
+	return user, err
 }
 
 func (w *smartIdWorker) updateSession(ctx context.Context, params *models.UpdateSessionParams) *models.Session {
+	ctx, span := startSpan(ctx, SmartIdWorkerName, "update session")
+
 	session, err := w.sessions.Update(ctx, &models.UpdateSessionParams{
 		ID:     params.ID,
 		UserId: params.UserId,
@@ -107,5 +148,82 @@ func (w *smartIdWorker) updateSession(ctx context.Context, params *models.Update
 		w.log.Error().Err(err).Msgf("%s failed to update session", SmartIdWorkerName)
 	}
 
+	endSpan(span, err)
 	return session
 }
+
+// recordAuthenticator keeps the Smart-ID account the user has authenticated with, a failure does not fail the login
+func (w *smartIdWorker) recordAuthenticator(ctx context.Context, user *models.User, person *smartid.Person) {
+	err := w.authenticators.Record(ctx, &models.Authenticator{
+		UserID:     user.ID,
+		Method:     models.AuthMethodSmartId,
+		Identifier: person.IdentityNumber,
+		Metadata:   map[string]string{"personal_code": person.PersonalCode},
+	})
+	if err != nil {
+		w.log.Error().Err(err).Msgf("%s failed to record authenticator", SmartIdWorkerName)
+	}
+}
+
+// recordRefusal counts a login the user declined on their device towards the Smart-ID cooldown
+func (w *smartIdWorker) recordRefusal(ctx context.Context, session *models.Session) {
+	if session == nil {
+		return
+	}
+
+	w.throttle.Refused(ctx, &models.ThrottleParams{
+		Endpoint:     models.AuthMethodSmartId,
+		PersonalCode: session.PersonalCode,
+	})
+}
+
+// recordLogin stores the authentication outcome with the provider error code in the audit log
+func (w *smartIdWorker) recordLogin(ctx context.Context, traceId string, user *models.User, err error) {
+	event := &models.AuditEvent{
+		Action:     models.AuditLoginSucceeded,
+		TargetType: "user",
+		Status:     models.AuditSuccess,
+		Metadata:   map[string]string{"provider": models.AuditProviderSmartId},
+		TraceID:    traceId,
+	}
+
+	if user != nil {
+		event.ActorID = user.ID
+		event.TargetID = user.ID.String()
+	}
+
+	if err != nil {
+		event.Action = models.AuditLoginFailed
+		event.Status = models.AuditFailure
+		event.Metadata["error"] = err.Error()
+
+		var providerErr *smartid.Error
+		if errors.As(err, &providerErr) {
+			event.Metadata["code"] = providerErr.Code
+		}
+	}
+
+	w.audit.Record(ctx, event)
+	telemetry.RecordSessionFinished(ctx, models.AuthMethodSmartId, err, event.Metadata["code"])
+}
+
+func smartIdSessionStatus(err error) string {
+	var providerErr *smartid.Error
+	if !errors.As(err, &providerErr) {
+		return models.SessionError
+	}
+
+	switch providerErr.Code {
+	case smartid.USER_REFUSED,
+		smartid.USER_REFUSED_DISPLAYTEXTANDPIN,
+		smartid.USER_REFUSED_VC_CHOICE,
+		smartid.USER_REFUSED_CONFIRMATIONMESSAGE,
+		smartid.USER_REFUSED_CONFIRMATIONMESSAGE_WITH_VC_CHOICE,
+		smartid.USER_REFUSED_CERT_CHOICE:
+		return models.SessionCancelled
+	case smartid.TIMEOUT:
+		return models.SessionExpired
+	default:
+		return models.SessionError
+	}
+}
@@ -2,6 +2,7 @@ package workers
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/uuid"
@@ -9,6 +10,7 @@ import (
 	"github.com/tab/smartid"
 	"go.uber.org/mock/gomock"
 
+	"loki/internal/app/errors"
 	"loki/internal/app/models"
 	"loki/internal/app/services"
 	"loki/internal/config"
@@ -28,10 +30,14 @@ func Test_SmartIdWorker_Perform(t *testing.T) {
 
 	ctx := context.Background()
 	sessionsMock := services.NewMockSessions(ctrl)
-	usersMock := services.NewMockUsers(ctrl)
+	provisioningMock := services.NewMockProvisioning(ctrl)
 	workerMock := smartid.NewMockWorker(ctrl)
 
-	worker := NewSmartIdWorker(sessionsMock, usersMock, workerMock, log)
+	auditMock := services.NewMockAudit(ctrl)
+	authenticatorsMock := services.NewMockAuthenticators(ctrl)
+	throttleMock := services.NewMockThrottle(ctrl)
+
+	worker := NewSmartIdWorker(auditMock, authenticatorsMock, provisioningMock, sessionsMock, throttleMock, workerMock, log)
 
 	id := uuid.MustParse("8fdb516d-1a82-43ba-b82d-be63df569b86")
 	sessionId := id.String()
@@ -59,12 +65,12 @@ func Test_SmartIdWorker_Perform(t *testing.T) {
 
 				workerMock.
 					EXPECT().
-					Process(ctx, sessionId).
+					Process(gomock.Any(), sessionId).
 					Return(resultChan)
 
-				usersMock.
+				provisioningMock.
 					EXPECT().
-					Create(ctx, &models.User{
+					Provision(gomock.Any(), &models.User{
 						IdentityNumber: "PNOEE-30303039914",
 						PersonalCode:   "30303039914",
 						FirstName:      "TESTNUMBER",
@@ -78,23 +84,48 @@ func Test_SmartIdWorker_Perform(t *testing.T) {
 						LastName:       "OK",
 					}, nil)
 
+				authenticatorsMock.
+					EXPECT().
+					Authorize(gomock.Any(), userId, models.AuthMethodSmartId).
+					Return(nil)
+
+				authenticatorsMock.
+					EXPECT().
+					Record(gomock.Any(), &models.Authenticator{
+						UserID:     userId,
+						Method:     models.AuthMethodSmartId,
+						Identifier: "PNOEE-30303039914",
+						Metadata:   map[string]string{"personal_code": "30303039914"},
+					}).
+					Return(nil)
+
 				sessionsMock.
 					EXPECT().
-					Update(ctx, &models.UpdateSessionParams{
+					Update(gomock.Any(), &models.UpdateSessionParams{
 						ID:     id,
 						UserId: userId,
-						Status: Success,
+						Status: models.SessionSuccess,
 					}).
 					Return(&models.Session{
 						ID:     id,
 						UserId: userId,
-						Status: Success,
+						Status: models.SessionSuccess,
 					}, nil)
+
+				auditMock.EXPECT().Record(gomock.Any(), &models.AuditEvent{
+					Action:     models.AuditLoginSucceeded,
+					ActorID:    userId,
+					TargetType: "user",
+					TargetID:   userId.String(),
+					Status:     models.AuditSuccess,
+					Metadata:   map[string]string{"provider": models.AuditProviderSmartId},
+					TraceID:    traceId,
+				})
 			},
 			expected: &models.Session{
 				ID:     id,
 				UserId: userId,
-				Status: Success,
+				Status: models.SessionSuccess,
 			},
 		},
 		{
@@ -108,29 +139,137 @@ func Test_SmartIdWorker_Perform(t *testing.T) {
 
 				workerMock.
 					EXPECT().
-					Process(ctx, sessionId).
+					Process(gomock.Any(), sessionId).
 					Return(resultChan)
 
 				sessionsMock.
 					EXPECT().
-					Update(ctx, &models.UpdateSessionParams{
+					Update(gomock.Any(), &models.UpdateSessionParams{
 						ID:     id,
-						Status: Error,
+						Status: models.SessionError,
 						Error:  assert.AnError.Error(),
 					}).Return(&models.Session{
 					ID:     id,
-					Status: Error,
+					Status: models.SessionError,
 					Error:  assert.AnError.Error(),
 				}, nil)
+
+				auditMock.EXPECT().Record(gomock.Any(), &models.AuditEvent{
+					Action:     models.AuditLoginFailed,
+					TargetType: "user",
+					Status:     models.AuditFailure,
+					Metadata: map[string]string{
+						"provider": models.AuditProviderSmartId,
+						"error":    assert.AnError.Error(),
+					},
+					TraceID: traceId,
+				})
 			},
 			expected: &models.Session{
 				ID:     id,
-				Status: Error,
+				Status: models.SessionError,
 				Error:  assert.AnError.Error(),
 			},
 		},
 		{
-			name: "Failed to create user",
+			name: "User refused",
+			before: func() {
+				resultChan := make(chan smartid.Result, 1)
+				resultChan <- smartid.Result{
+					Err: &smartid.Error{Code: smartid.USER_REFUSED},
+				}
+				close(resultChan)
+
+				workerMock.
+					EXPECT().
+					Process(gomock.Any(), sessionId).
+					Return(resultChan)
+
+				sessionsMock.
+					EXPECT().
+					Update(gomock.Any(), &models.UpdateSessionParams{
+						ID:     id,
+						Status: models.SessionCancelled,
+						Error:  "authentication failed: USER_REFUSED",
+					}).Return(&models.Session{
+					ID:           id,
+					Status:       models.SessionCancelled,
+					Error:        "authentication failed: USER_REFUSED",
+					PersonalCode: "30303039914",
+				}, nil)
+
+				throttleMock.
+					EXPECT().
+					Refused(gomock.Any(), &models.ThrottleParams{
+						Endpoint:     models.AuthMethodSmartId,
+						PersonalCode: "30303039914",
+					})
+
+				auditMock.EXPECT().Record(gomock.Any(), &models.AuditEvent{
+					Action:     models.AuditLoginFailed,
+					TargetType: "user",
+					Status:     models.AuditFailure,
+					Metadata: map[string]string{
+						"provider": models.AuditProviderSmartId,
+						"error":    (&smartid.Error{Code: smartid.USER_REFUSED}).Error(),
+						"code":     smartid.USER_REFUSED,
+					},
+					TraceID: traceId,
+				})
+			},
+			expected: &models.Session{
+				ID:           id,
+				Status:       models.SessionCancelled,
+				Error:        "authentication failed: USER_REFUSED",
+				PersonalCode: "30303039914",
+			},
+		},
+		{
+			name: "Timeout",
+			before: func() {
+				resultChan := make(chan smartid.Result, 1)
+				resultChan <- smartid.Result{
+					Err: &smartid.Error{Code: smartid.TIMEOUT},
+				}
+				close(resultChan)
+
+				workerMock.
+					EXPECT().
+					Process(gomock.Any(), sessionId).
+					Return(resultChan)
+
+				sessionsMock.
+					EXPECT().
+					Update(gomock.Any(), &models.UpdateSessionParams{
+						ID:     id,
+						Status: models.SessionExpired,
+						Error:  "authentication failed: TIMEOUT",
+					}).Return(&models.Session{
+					ID:     id,
+					Status: models.SessionExpired,
+					Error:  "authentication failed: TIMEOUT",
+				}, nil)
+
+				auditMock.EXPECT().Record(gomock.Any(), &models.AuditEvent{
+					Action:     models.AuditLoginFailed,
+					TargetType: "user",
+					Status:     models.AuditFailure,
+					Metadata: map[string]string{
+						"provider": models.AuditProviderSmartId,
+						"error":    (&smartid.Error{Code: smartid.TIMEOUT}).Error(),
+						"code":     smartid.TIMEOUT,
+					},
+					TraceID: traceId,
+				})
+			},
+			expected: &models.Session{
+				ID:     id,
+				Status: models.SessionExpired,
+				Error:  "authentication failed: TIMEOUT",
+			},
+		},
+		{
+			name: "Failed to provision user",
 			before: func() {
 				resultChan := make(chan smartid.Result, 1)
 				resultChan <- smartid.Result{
@@ -145,12 +284,12 @@ func Test_SmartIdWorker_Perform(t *testing.T) {
 
 				workerMock.
 					EXPECT().
-					Process(ctx, sessionId).
+					Process(gomock.Any(), sessionId).
 					Return(resultChan)
 
-				usersMock.
+				provisioningMock.
 					EXPECT().
-					Create(ctx, &models.User{
+					Provision(gomock.Any(), &models.User{
 						IdentityNumber: "PNOEE-30303039914",
 						PersonalCode:   "30303039914",
 						FirstName:      "TESTNUMBER",
@@ -160,23 +299,215 @@ func Test_SmartIdWorker_Perform(t *testing.T) {
 
 				sessionsMock.
 					EXPECT().
-					Update(ctx, &models.UpdateSessionParams{
+					Update(gomock.Any(), &models.UpdateSessionParams{
 						ID:     id,
-						Status: Error,
+						Status: models.SessionError,
 						Error:  assert.AnError.Error(),
 					}).
 					Return(&models.Session{
 						ID:     id,
-						Status: Error,
+						Status: models.SessionError,
 						Error:  assert.AnError.Error(),
 					}, nil)
+
+				auditMock.EXPECT().Record(gomock.Any(), &models.AuditEvent{
+					Action:     models.AuditLoginFailed,
+					TargetType: "user",
+					Status:     models.AuditFailure,
+					Metadata: map[string]string{
+						"provider": models.AuditProviderSmartId,
+						"error":    assert.AnError.Error(),
+					},
+					TraceID: traceId,
+				})
 			},
 			expected: &models.Session{
 				ID:     id,
-				Status: Error,
+				Status: models.SessionError,
 				Error:  assert.AnError.Error(),
 			},
 		},
+		{
+			name: "Provisioning rejected",
+			before: func() {
+				resultChan := make(chan smartid.Result, 1)
+				resultChan <- smartid.Result{
+					Person: &smartid.Person{
+						IdentityNumber: "PNOLV-030403-10075",
+						PersonalCode:   "030403-10075",
+						FirstName:      "TESTNUMBER",
+						LastName:       "OK",
+					},
+				}
+				close(resultChan)
+
+				rejected := fmt.Errorf("%w: country not allowed", errors.ErrProvisioningRejected)
+
+				workerMock.
+					EXPECT().
+					Process(gomock.Any(), sessionId).
+					Return(resultChan)
+
+				provisioningMock.
+					EXPECT().
+					Provision(gomock.Any(), gomock.Any()).
+					Return(nil, rejected)
+
+				sessionsMock.
+					EXPECT().
+					Update(gomock.Any(), &models.UpdateSessionParams{
+						ID:     id,
+						Status: models.SessionRejected,
+						Error:  rejected.Error(),
+					}).
+					Return(&models.Session{
+						ID:     id,
+						Status: models.SessionRejected,
+						Error:  rejected.Error(),
+					}, nil)
+
+				auditMock.EXPECT().Record(gomock.Any(), &models.AuditEvent{
+					Action:     models.AuditLoginFailed,
+					TargetType: "user",
+					Status:     models.AuditFailure,
+					Metadata: map[string]string{
+						"provider": models.AuditProviderSmartId,
+						"error":    rejected.Error(),
+					},
+					TraceID: traceId,
+				})
+			},
+			expected: &models.Session{
+				ID:     id,
+				Status: models.SessionRejected,
+				Error:  "registration not allowed: country not allowed",
+			},
+		},
+		{
+			name: "Suspended user",
+			before: func() {
+				resultChan := make(chan smartid.Result, 1)
+				resultChan <- smartid.Result{
+					Person: &smartid.Person{
+						IdentityNumber: "PNOEE-30303039914",
+						PersonalCode:   "30303039914",
+						FirstName:      "TESTNUMBER",
+						LastName:       "OK",
+					},
+				}
+				close(resultChan)
+
+				workerMock.
+					EXPECT().
+					Process(gomock.Any(), sessionId).
+					Return(resultChan)
+
+				provisioningMock.
+					EXPECT().
+					Provision(gomock.Any(), gomock.Any()).
+					Return(&models.User{
+						ID:             userId,
+						IdentityNumber: "PNOEE-30303039914",
+						Status:         models.UserSuspended,
+					}, nil)
+
+				sessionsMock.
+					EXPECT().
+					Update(gomock.Any(), &models.UpdateSessionParams{
+						ID:     id,
+						Status: models.SessionRejected,
+						Error:  errors.ErrUserInactive.Error(),
+					}).
+					Return(&models.Session{
+						ID:     id,
+						Status: models.SessionRejected,
+						Error:  errors.ErrUserInactive.Error(),
+					}, nil)
+
+				auditMock.EXPECT().Record(gomock.Any(), &models.AuditEvent{
+					Action:     models.AuditLoginFailed,
+					ActorID:    userId,
+					TargetType: "user",
+					TargetID:   userId.String(),
+					Status:     models.AuditFailure,
+					Metadata: map[string]string{
+						"provider": models.AuditProviderSmartId,
+						"error":    errors.ErrUserInactive.Error(),
+					},
+					TraceID: traceId,
+				})
+			},
+			expected: &models.Session{
+				ID:     id,
+				Status: models.SessionRejected,
+				Error:  errors.ErrUserInactive.Error(),
+			},
+		},
+		{
+			name: "Method not allowed",
+			before: func() {
+				resultChan := make(chan smartid.Result, 1)
+				resultChan <- smartid.Result{
+					Person: &smartid.Person{
+						IdentityNumber: "PNOEE-30303039914",
+						PersonalCode:   "30303039914",
+						FirstName:      "TESTNUMBER",
+						LastName:       "OK",
+					},
+				}
+				close(resultChan)
+
+				workerMock.
+					EXPECT().
+					Process(gomock.Any(), sessionId).
+					Return(resultChan)
+
+				provisioningMock.
+					EXPECT().
+					Provision(gomock.Any(), gomock.Any()).
+					Return(&models.User{
+						ID:             userId,
+						IdentityNumber: "PNOEE-30303039914",
+						Status:         models.UserActive,
+					}, nil)
+
+				authenticatorsMock.
+					EXPECT().
+					Authorize(gomock.Any(), userId, models.AuthMethodSmartId).
+					Return(errors.ErrAuthenticationMethodNotAllowed)
+
+				sessionsMock.
+					EXPECT().
+					Update(gomock.Any(), &models.UpdateSessionParams{
+						ID:     id,
+						Status: models.SessionRejected,
+						Error:  errors.ErrAuthenticationMethodNotAllowed.Error(),
+					}).
+					Return(&models.Session{
+						ID:     id,
+						Status: models.SessionRejected,
+						Error:  errors.ErrAuthenticationMethodNotAllowed.Error(),
+					}, nil)
+
+				auditMock.EXPECT().Record(gomock.Any(), &models.AuditEvent{
+					Action:     models.AuditLoginFailed,
+					ActorID:    userId,
+					TargetType: "user",
+					TargetID:   userId.String(),
+					Status:     models.AuditFailure,
+					Metadata: map[string]string{
+						"provider": models.AuditProviderSmartId,
+						"error":    errors.ErrAuthenticationMethodNotAllowed.Error(),
+					},
+					TraceID: traceId,
+				})
+			},
+			expected: &models.Session{
+				ID:     id,
+				Status: models.SessionRejected,
+				Error:  errors.ErrAuthenticationMethodNotAllowed.Error(),
+			},
+		},
 		{
 			name: "Failed to update session",
 			before: func() {
@@ -193,12 +524,12 @@ func Test_SmartIdWorker_Perform(t *testing.T) {
 
 				workerMock.
 					EXPECT().
-					Process(ctx, sessionId).
+					Process(gomock.Any(), sessionId).
 					Return(resultChan)
 
-				usersMock.
+				provisioningMock.
 					EXPECT().
-					Create(ctx, &models.User{
+					Provision(gomock.Any(), &models.User{
 						IdentityNumber: "PNOEE-30303039914",
 						PersonalCode:   "30303039914",
 						FirstName:      "TESTNUMBER",
@@ -212,14 +543,39 @@ func Test_SmartIdWorker_Perform(t *testing.T) {
 						LastName:       "OK",
 					}, nil)
 
+				authenticatorsMock.
+					EXPECT().
+					Authorize(gomock.Any(), userId, models.AuthMethodSmartId).
+					Return(nil)
+
+				authenticatorsMock.
+					EXPECT().
+					Record(gomock.Any(), &models.Authenticator{
+						UserID:     userId,
+						Method:     models.AuthMethodSmartId,
+						Identifier: "PNOEE-30303039914",
+						Metadata:   map[string]string{"personal_code": "30303039914"},
+					}).
+					Return(nil)
+
 				sessionsMock.
 					EXPECT().
-					Update(ctx, &models.UpdateSessionParams{
+					Update(gomock.Any(), &models.UpdateSessionParams{
 						ID:     id,
 						UserId: userId,
-						Status: Success,
+						Status: models.SessionSuccess,
 					}).
 					Return(nil, assert.AnError)
+
+				auditMock.EXPECT().Record(gomock.Any(), &models.AuditEvent{
+					Action:     models.AuditLoginSucceeded,
+					ActorID:    userId,
+					TargetType: "user",
+					TargetID:   userId.String(),
+					Status:     models.AuditSuccess,
+					Metadata:   map[string]string{"provider": models.AuditProviderSmartId},
+					TraceID:    traceId,
+				})
 			},
 			expected: nil,
 		},
@@ -4,15 +4,43 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 const (
-	AppAddr    = "0.0.0.0:8080"
-	GrpcAddr   = "0.0.0.0:50051"
-	ClientURL  = "http://localhost:3000"
-	DebugLevel = "debug"
+	AppAddr     = "0.0.0.0:8080"
+	GrpcAddr    = "0.0.0.0:50051"
+	MetricsAddr = "0.0.0.0:9090"
+	ClientURL   = "http://localhost:3000"
+	DebugLevel  = "debug"
+
+	ProvisioningOpen   = "open"
+	ProvisioningInvite = "invite"
+
+	ProvisioningDefaultRoles  = "user"
+	ProvisioningDefaultScopes = "self-service"
+
+	MailerFile = "file"
+	MailerSMTP = "smtp"
+
+	MailerFrom = "no-reply@localhost"
+	MailerPath = "tmp/mail"
+
+	RateLimitWindow   = 10 * time.Minute
+	RateLimitIP       = 20
+	RateLimitPerson   = 5
+	RateLimitGrpcIP   = 600
+	RateLimitRefusals = 3
+	RateLimitCooldown = 30 * time.Minute
+
+	RiskReauthenticateScore = 50
+	RiskBlockScore          = 90
+	RiskRefreshLimit        = 30
+	RiskRefreshWindow       = time.Hour
 )
 
 type SmartId struct {
@@ -36,19 +64,78 @@ type MobileId struct {
 	Language string
 }
 
+// Provisioning decides who may be registered on their first Smart-ID or Mobile-ID login
+type Provisioning struct {
+	Mode                string
+	Countries           []string
+	PersonalCodePattern string
+	DefaultRoles        []string
+	DefaultScopes       []string
+}
+
+// Mailer selects how outgoing emails are delivered, the file driver stores them as .eml files for local development
+type Mailer struct {
+	Driver       string
+	From         string
+	Path         string
+	SMTPAddr     string
+	SMTPUsername string
+	SMTPPassword string
+}
+
+// RateLimit caps the calls of an endpoint within the window per client address, personal code and phone number, zero disables a counter
+type RateLimit struct {
+	IP           int
+	PersonalCode int
+	PhoneNumber  int
+}
+
+// Throttling holds the sliding window limits and the cooldown after a person has repeatedly refused to authenticate,
+// GrpcMethods overrides the per address limit of Grpc for single methods
+type Throttling struct {
+	Window      time.Duration
+	SmartId     RateLimit
+	MobileId    RateLimit
+	Grpc        RateLimit
+	GrpcMethods map[string]int
+	Refusals    int
+	Cooldown    time.Duration
+}
+
+// Risk configures the evaluation of logins and token refreshes against the login history of the user,
+// a zero score disables the action
+type Risk struct {
+	GeoIPPath           string
+	ReauthenticateScore int
+	BlockScore          int
+	RefreshLimit        int
+	RefreshWindow       time.Duration
+	WebhookURL          string
+}
+
 type Config struct {
-	AppEnv       string
-	AppName      string
-	AppAddr      string
-	GrpcAddr     string
-	ClientURL    string
-	CertPath     string
-	DatabaseDSN  string
-	RedisURI     string
-	TelemetryURI string
-	SmartId      SmartId
-	MobileId     MobileId
-	LogLevel     string
+	AppEnv         string
+	AppName        string
+	AppAddr        string
+	GrpcAddr       string
+	MetricsAddr    string
+	ClientURL      string
+	CertPath       string
+	LocalesPath    string
+	PoliciesPath   string
+	AnchorsPath    string
+	DatabaseDSN    string
+	RedisURI       string
+	TelemetryURI   string
+	TrustedProxies []string
+	SmartId        SmartId
+	MobileId       MobileId
+	Provisioning   Provisioning
+	Mailer         Mailer
+	Throttling     Throttling
+	Risk           Risk
+	ProfileClaims  bool
+	LogLevel       string
 }
 
 func LoadConfig() *Config {
@@ -68,6 +155,7 @@ func LoadConfig() *Config {
 
 	flagAppAddr := flag.String("b", AppAddr, "server address")
 	flagGrpcAddr := flag.String("g", GrpcAddr, "gRPC server address")
+	flagMetricsAddr := flag.String("m", MetricsAddr, "metrics server address")
 	flagClientURL := flag.String("c", ClientURL, "client address")
 	flagCertPath := flag.String("p", "", "certificate path")
 	flagDatabaseDSN := flag.String("d", "", "database DSN")
@@ -76,18 +164,24 @@ func LoadConfig() *Config {
 	flag.Parse()
 
 	return &Config{
-		AppEnv:    env,
-		AppName:   getEnvString("APP_NAME"),
-		AppAddr:   getFlagOrEnvString(*flagAppAddr, "APP_ADDRESS", AppAddr),
-		GrpcAddr:  getFlagOrEnvString(*flagGrpcAddr, "GRPC_ADDRESS", GrpcAddr),
-		ClientURL: getFlagOrEnvString(*flagClientURL, "CLIENT_URL", ClientURL),
+		AppEnv:      env,
+		AppName:     getEnvString("APP_NAME"),
+		AppAddr:     getFlagOrEnvString(*flagAppAddr, "APP_ADDRESS", AppAddr),
+		GrpcAddr:    getFlagOrEnvString(*flagGrpcAddr, "GRPC_ADDRESS", GrpcAddr),
+		MetricsAddr: getFlagOrEnvString(*flagMetricsAddr, "METRICS_ADDRESS", MetricsAddr),
+		ClientURL:   getFlagOrEnvString(*flagClientURL, "CLIENT_URL", ClientURL),
 
-		CertPath: getFlagOrEnvString(*flagCertPath, "CERT_PATH", ""),
+		CertPath:     getFlagOrEnvString(*flagCertPath, "CERT_PATH", ""),
+		LocalesPath:  getEnvString("LOCALES_PATH"),
+		PoliciesPath: getEnvString("POLICIES_PATH"),
+		AnchorsPath:  getEnvString("TRUST_ANCHORS_PATH"),
 
 		DatabaseDSN:  getFlagOrEnvString(*flagDatabaseDSN, "DATABASE_DSN", ""),
 		RedisURI:     getFlagOrEnvString(*flagRedisURI, "REDIS_URI", ""),
 		TelemetryURI: getFlagOrEnvString(*flagTelemetryURI, "TELEMETRY_URI", ""),
 
+		TrustedProxies: getEnvList("TRUSTED_PROXIES", ""),
+
 		SmartId: SmartId{
 			BaseURL:          getEnvString("SMART_ID_API_URL"),
 			RelyingPartyName: getEnvString("RELYING_PARTY_NAME"),
@@ -103,6 +197,50 @@ func LoadConfig() *Config {
 			Language:         getEnvString("MOBILE_ID_LANGUAGE"),
 		},
 
+		Provisioning: Provisioning{
+			Mode:                getFlagOrEnvString("", "PROVISIONING_MODE", ProvisioningOpen),
+			Countries:           getEnvList("PROVISIONING_COUNTRIES", ""),
+			PersonalCodePattern: getEnvString("PROVISIONING_PERSONAL_CODE_PATTERN"),
+			DefaultRoles:        getEnvList("PROVISIONING_DEFAULT_ROLES", ProvisioningDefaultRoles),
+			DefaultScopes:       getEnvList("PROVISIONING_DEFAULT_SCOPES", ProvisioningDefaultScopes),
+		},
+
+		Mailer: Mailer{
+			Driver:       getFlagOrEnvString("", "MAILER_DRIVER", MailerFile),
+			From:         getFlagOrEnvString("", "MAILER_FROM", MailerFrom),
+			Path:         getFlagOrEnvString("", "MAILER_PATH", MailerPath),
+			SMTPAddr:     getEnvString("SMTP_ADDRESS"),
+			SMTPUsername: getEnvString("SMTP_USERNAME"),
+			SMTPPassword: getEnvString("SMTP_PASSWORD"),
+		},
+		Throttling: Throttling{
+			Window: getEnvDuration("RATE_LIMIT_WINDOW", RateLimitWindow),
+			SmartId: RateLimit{
+				IP:           getEnvInt("RATE_LIMIT_SMART_ID_IP", RateLimitIP),
+				PersonalCode: getEnvInt("RATE_LIMIT_SMART_ID_PERSONAL_CODE", RateLimitPerson),
+			},
+			MobileId: RateLimit{
+				IP:           getEnvInt("RATE_LIMIT_MOBILE_ID_IP", RateLimitIP),
+				PersonalCode: getEnvInt("RATE_LIMIT_MOBILE_ID_PERSONAL_CODE", RateLimitPerson),
+				PhoneNumber:  getEnvInt("RATE_LIMIT_MOBILE_ID_PHONE_NUMBER", RateLimitPerson),
+			},
+			Grpc: RateLimit{
+				IP: getEnvInt("RATE_LIMIT_GRPC_IP", RateLimitGrpcIP),
+			},
+			GrpcMethods: getEnvLimits("RATE_LIMIT_GRPC_METHODS"),
+			Refusals:    getEnvInt("RATE_LIMIT_REFUSALS", RateLimitRefusals),
+			Cooldown:    getEnvDuration("RATE_LIMIT_REFUSAL_COOLDOWN", RateLimitCooldown),
+		},
+		Risk: Risk{
+			GeoIPPath:           getEnvString("GEOIP_DATABASE_PATH"),
+			ReauthenticateScore: getEnvInt("RISK_REAUTHENTICATE_SCORE", RiskReauthenticateScore),
+			BlockScore:          getEnvInt("RISK_BLOCK_SCORE", RiskBlockScore),
+			RefreshLimit:        getEnvInt("RISK_REFRESH_LIMIT", RiskRefreshLimit),
+			RefreshWindow:       getEnvDuration("RISK_REFRESH_WINDOW", RiskRefreshWindow),
+			WebhookURL:          getEnvString("RISK_WEBHOOK_URL"),
+		},
+		ProfileClaims: getEnvBool("TOKEN_PROFILE_CLAIMS"),
+
 		LogLevel: getEnvString("LOG_LEVEL"),
 	}
 }
@@ -126,3 +264,67 @@ func getEnvString(envVar string) string {
 
 	return ""
 }
+
+// getEnvBool reports whether the variable is set to a true value such as '1' or 'true'
+func getEnvBool(envVar string) bool {
+	value, err := strconv.ParseBool(getEnvString(envVar))
+	if err != nil {
+		return false
+	}
+
+	return value
+}
+
+// getEnvInt parses a whole number, a missing or malformed value falls back to the default
+func getEnvInt(envVar string, defaultValue int) int {
+	value, err := strconv.Atoi(getEnvString(envVar))
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+// getEnvDuration parses a duration such as '10m', a missing or malformed value falls back to the default
+func getEnvDuration(envVar string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(getEnvString(envVar))
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+// getEnvList splits a comma separated variable, an empty value falls back to the default list
+func getEnvList(envVar, defaultValue string) []string {
+	value := getFlagOrEnvString("", envVar, defaultValue)
+
+	result := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// getEnvLimits parses comma separated 'name=limit' pairs, malformed pairs are skipped
+func getEnvLimits(envVar string) map[string]int {
+	result := make(map[string]int)
+	for _, item := range getEnvList(envVar, "") {
+		name, value, ok := strings.Cut(item, "=")
+		if !ok {
+			continue
+		}
+
+		limit, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+
+		result[strings.TrimSpace(name)] = limit
+	}
+
+	return result
+}
@@ -0,0 +1,195 @@
+package locales
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+const (
+	DefaultPurpose = "login"
+
+	Gsm7 = "GSM-7"
+	Ucs2 = "UCS-2"
+
+	MaxSmartIdTextLength      = 60
+	MaxConfirmationTextLength = 200
+	MaxMobileIdGsm7TextLength = 40
+	MaxMobileIdUcs2TextLength = 20
+)
+
+var mobileIdLanguages = []string{"EST", "ENG", "RUS", "LIT"}
+
+// gsm7 holds the GSM 03.38 basic character set and its extension table
+const gsm7 = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà^{}\\[~]|€"
+
+// gsm7Extension holds the extension table characters, each is sent as an escape followed by the character
+const gsm7Extension = "^{}\\[~]|€"
+
+type Locale struct {
+	MobileIdLanguage string            `json:"mobile_id_language"`
+	Messages         map[string]string `json:"messages"`
+}
+
+type Translation struct {
+	Locale             string
+	SmartIdText        string
+	MobileIdText       string
+	MobileIdTextFormat string
+	MobileIdLanguage   string
+}
+
+type Catalogue interface {
+	Resolve(locale, purpose string) (*Translation, error)
+}
+
+type catalogue struct {
+	fallback *Translation
+	locales  map[string]Locale
+}
+
+// DefaultLocales is used when no translation catalogue file is configured
+var DefaultLocales = map[string]Locale{
+	"en": {MobileIdLanguage: "ENG", Messages: map[string]string{DefaultPurpose: "Enter PIN1"}},
+	"et": {MobileIdLanguage: "EST", Messages: map[string]string{DefaultPurpose: "Sisesta PIN1"}},
+	"lt": {MobileIdLanguage: "LIT", Messages: map[string]string{DefaultPurpose: "Įveskite PIN1"}},
+	"lv": {MobileIdLanguage: "ENG", Messages: map[string]string{DefaultPurpose: "Ievadiet PIN1"}},
+	"ru": {MobileIdLanguage: "RUS", Messages: map[string]string{DefaultPurpose: "Введите PIN1"}},
+}
+
+func NewCatalogue(cfg *config.Config) (Catalogue, error) {
+	locales := DefaultLocales
+
+	if cfg.LocalesPath != "" {
+		data, err := os.ReadFile(cfg.LocalesPath)
+		if err != nil {
+			return nil, err
+		}
+
+		locales = make(map[string]Locale)
+		if err = json.Unmarshal(data, &locales); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, locale := range locales {
+		if !isMobileIdLanguage(locale.MobileIdLanguage) {
+			return nil, errors.ErrInvalidMobileIdLanguage
+		}
+
+		for _, text := range locale.Messages {
+			if err := validateText(text); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &catalogue{
+		fallback: &Translation{
+			SmartIdText:        cfg.SmartId.Text,
+			MobileIdText:       cfg.MobileId.Text,
+			MobileIdTextFormat: cfg.MobileId.TextFormat,
+			MobileIdLanguage:   cfg.MobileId.Language,
+		},
+		locales: locales,
+	}, nil
+}
+
+// Resolve returns display texts for the given locale and purpose,
+// an empty locale falls back to the texts from the environment configuration
+func (c *catalogue) Resolve(locale, purpose string) (*Translation, error) {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	purpose = strings.ToLower(strings.TrimSpace(purpose))
+
+	if purpose == "" {
+		purpose = DefaultPurpose
+	}
+
+	if locale == "" {
+		if purpose != DefaultPurpose {
+			return nil, errors.ErrUnsupportedPurpose
+		}
+		return c.fallback, nil
+	}
+
+	entry, ok := c.locales[locale]
+	if !ok {
+		return nil, errors.ErrUnsupportedLocale
+	}
+
+	text, ok := entry.Messages[purpose]
+	if !ok {
+		return nil, errors.ErrUnsupportedPurpose
+	}
+
+	return &Translation{
+		Locale:             locale,
+		SmartIdText:        text,
+		MobileIdText:       text,
+		MobileIdTextFormat: TextFormat(text),
+		MobileIdLanguage:   entry.MobileIdLanguage,
+	}, nil
+}
+
+func validateText(text string) error {
+	length := utf8.RuneCountInString(text)
+
+	if length == 0 || length > MaxSmartIdTextLength {
+		return errors.ErrInvalidDisplayText
+	}
+
+	switch TextFormat(text) {
+	case Gsm7:
+		if TextLength(text) > MaxMobileIdGsm7TextLength {
+			return errors.ErrInvalidDisplayText
+		}
+	case Ucs2:
+		if length > MaxMobileIdUcs2TextLength {
+			return errors.ErrInvalidDisplayText
+		}
+	}
+
+	return nil
+}
+
+// TextFormat returns GSM-7 when every character is in the GSM 03.38 alphabet and UCS-2 otherwise
+func TextFormat(text string) string {
+	for _, r := range text {
+		if !strings.ContainsRune(gsm7, r) {
+			return Ucs2
+		}
+	}
+
+	return Gsm7
+}
+
+// TextLength returns the number of Mobile-ID characters of the text, a GSM-7 extension character counts as two
+func TextLength(text string) int {
+	length := utf8.RuneCountInString(text)
+	if TextFormat(text) != Gsm7 {
+		return length
+	}
+
+	for _, r := range text {
+		if strings.ContainsRune(gsm7Extension, r) {
+			length++
+		}
+	}
+
+	return length
+}
+
+func isMobileIdLanguage(language string) bool {
+	for _, l := range mobileIdLanguages {
+		if l == language {
+			return true
+		}
+	}
+
+	return false
+}
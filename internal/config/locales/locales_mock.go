@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/config/locales/locales.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/config/locales/locales.go -destination=internal/config/locales/locales_mock.go -package=locales
+//
+
+// Package locales is a generated GoMock package.
+package locales
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCatalogue is a mock of Catalogue interface.
+type MockCatalogue struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogueMockRecorder
+	isgomock struct{}
+}
+
+// MockCatalogueMockRecorder is the mock recorder for MockCatalogue.
+type MockCatalogueMockRecorder struct {
+	mock *MockCatalogue
+}
+
+// NewMockCatalogue creates a new mock instance.
+func NewMockCatalogue(ctrl *gomock.Controller) *MockCatalogue {
+	mock := &MockCatalogue{ctrl: ctrl}
+	mock.recorder = &MockCatalogueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogue) EXPECT() *MockCatalogueMockRecorder {
+	return m.recorder
+}
+
+// Resolve mocks base method.
+func (m *MockCatalogue) Resolve(locale, purpose string) (*Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", locale, purpose)
+	ret0, _ := ret[0].(*Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockCatalogueMockRecorder) Resolve(locale, purpose any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockCatalogue)(nil).Resolve), locale, purpose)
+}
@@ -0,0 +1,223 @@
+package locales
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+func Test_NewCatalogue(t *testing.T) {
+	dir := t.TempDir()
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	tests := []struct {
+		name  string
+		path  string
+		error error
+	}{
+		{
+			name:  "Success with default locales",
+			path:  "",
+			error: nil,
+		},
+		{
+			name:  "Success with locales file",
+			path:  write("valid.json", `{"fi": {"mobile_id_language": "ENG", "messages": {"login": "Syötä PIN1"}}}`),
+			error: nil,
+		},
+		{
+			name:  "Error invalid mobile-id language",
+			path:  write("language.json", `{"fi": {"mobile_id_language": "FIN", "messages": {"login": "Syötä PIN1"}}}`),
+			error: errors.ErrInvalidMobileIdLanguage,
+		},
+		{
+			name:  "Error empty display text",
+			path:  write("empty.json", `{"en": {"mobile_id_language": "ENG", "messages": {"login": ""}}}`),
+			error: errors.ErrInvalidDisplayText,
+		},
+		{
+			name:  "Error too long GSM-7 display text",
+			path:  write("gsm7.json", `{"en": {"mobile_id_language": "ENG", "messages": {"login": "Please enter your PIN1 code to log in to the service"}}}`),
+			error: errors.ErrInvalidDisplayText,
+		},
+		{
+			name:  "Success with GSM-7 extension characters",
+			path:  write("extension.json", `{"en": {"mobile_id_language": "ENG", "messages": {"login": "Enter PIN1 {code} [login] ~ now ok"}}}`),
+			error: nil,
+		},
+		{
+			name:  "Error too long GSM-7 display text with extension characters",
+			path:  write("gsm7-extension.json", `{"en": {"mobile_id_language": "ENG", "messages": {"login": "Enter PIN1 to log in [service] {web} ~ok"}}}`),
+			error: errors.ErrInvalidDisplayText,
+		},
+		{
+			name:  "Error too long UCS-2 display text",
+			path:  write("ucs2.json", `{"ru": {"mobile_id_language": "RUS", "messages": {"login": "Введите PIN1 для входа"}}}`),
+			error: errors.ErrInvalidDisplayText,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewCatalogue(&config.Config{LocalesPath: tt.path})
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+
+	t.Run("Error missing locales file", func(t *testing.T) {
+		result, err := NewCatalogue(&config.Config{LocalesPath: filepath.Join(dir, "missing.json")})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func Test_Catalogue_Resolve(t *testing.T) {
+	cfg := &config.Config{
+		SmartId: config.SmartId{
+			Text: "Enter PIN1",
+		},
+		MobileId: config.MobileId{
+			Text:       "Enter PIN1",
+			TextFormat: "GSM-7",
+			Language:   "ENG",
+		},
+	}
+
+	c, err := NewCatalogue(cfg)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		locale   string
+		purpose  string
+		expected *Translation
+		error    error
+	}{
+		{
+			name:    "Success with fallback",
+			locale:  "",
+			purpose: "",
+			expected: &Translation{
+				SmartIdText:        "Enter PIN1",
+				MobileIdText:       "Enter PIN1",
+				MobileIdTextFormat: Gsm7,
+				MobileIdLanguage:   "ENG",
+			},
+			error: nil,
+		},
+		{
+			name:    "Success with GSM-7 locale",
+			locale:  "et",
+			purpose: "login",
+			expected: &Translation{
+				Locale:             "et",
+				SmartIdText:        "Sisesta PIN1",
+				MobileIdText:       "Sisesta PIN1",
+				MobileIdTextFormat: Gsm7,
+				MobileIdLanguage:   "EST",
+			},
+			error: nil,
+		},
+		{
+			name:    "Success with UCS-2 locale",
+			locale:  " RU ",
+			purpose: "",
+			expected: &Translation{
+				Locale:             "ru",
+				SmartIdText:        "Введите PIN1",
+				MobileIdText:       "Введите PIN1",
+				MobileIdTextFormat: Ucs2,
+				MobileIdLanguage:   "RUS",
+			},
+			error: nil,
+		},
+		{
+			name:     "Error unsupported locale",
+			locale:   "fi",
+			purpose:  "login",
+			expected: nil,
+			error:    errors.ErrUnsupportedLocale,
+		},
+		{
+			name:     "Error unsupported purpose",
+			locale:   "en",
+			purpose:  "sign",
+			expected: nil,
+			error:    errors.ErrUnsupportedPurpose,
+		},
+		{
+			name:     "Error unsupported purpose without locale",
+			locale:   "",
+			purpose:  "sign",
+			expected: nil,
+			error:    errors.ErrUnsupportedPurpose,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := c.Resolve(tt.locale, tt.purpose)
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func Test_TextLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected int
+	}{
+		{
+			name:     "GSM-7 basic characters",
+			text:     "Enter PIN1",
+			expected: 10,
+		},
+		{
+			name:     "GSM-7 extension characters",
+			text:     "^{}\\[~]|€",
+			expected: 18,
+		},
+		{
+			name:     "GSM-7 mixed characters",
+			text:     "Pay €100.00",
+			expected: 12,
+		},
+		{
+			name:     "UCS-2 characters",
+			text:     "Введите PIN1 {код}",
+			expected: 18,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, TextLength(tt.text))
+		})
+	}
+}
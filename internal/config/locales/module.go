@@ -0,0 +1,7 @@
+package locales
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewCatalogue),
+)
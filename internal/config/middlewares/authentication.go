@@ -2,9 +2,13 @@ package middlewares
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
 	"loki/internal/app/serializers"
 	"loki/internal/app/services"
 	"loki/internal/config/logger"
@@ -13,6 +17,7 @@ import (
 
 type AuthenticationMiddleware interface {
 	Authenticate(next http.Handler) http.Handler
+	RequireStepUp(maxAge time.Duration, acr string) func(http.Handler) http.Handler
 }
 
 type authenticationMiddleware struct {
@@ -54,7 +59,15 @@ func (m *authenticationMiddleware) Authenticate(next http.Handler) http.Handler
 			return
 		}
 
+		if !user.CanAuthenticate() {
+			m.log.Warn().Msgf("User %s is %s", user.ID, user.Status)
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUserInactive.Error()})
+			return
+		}
+
 		ctx := NewContextModifier(r.Context()).
+			WithClaim(claims).
 			WithCurrentUser(user).
 			Context()
 
@@ -62,6 +75,46 @@ func (m *authenticationMiddleware) Authenticate(next http.Handler) http.Handler
 	})
 }
 
+// RequireStepUp refuses tokens authenticated longer than maxAge ago or issued below the acr level,
+// a zero maxAge or an empty acr skips the check, the challenge follows RFC 9470
+func (m *authenticationMiddleware) RequireStepUp(maxAge time.Duration, acr string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claim, ok := CurrentClaimFromContext(r.Context())
+			if !ok {
+				m.log.Error().Msg("No claims found in context")
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUnauthorized.Error()})
+				return
+			}
+
+			fresh := maxAge <= 0 || (claim.AuthTime > 0 && time.Since(time.Unix(claim.AuthTime, 0)) <= maxAge)
+			if !fresh || !models.AcrSatisfies(claim.ACR, acr) {
+				m.log.Warn().Msgf("User %s requires step-up authentication", claim.ID)
+				w.Header().Set(WWWAuthenticate, stepUpChallenge(maxAge, acr))
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrStepUpRequired.Error()})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// stepUpChallenge builds the WWW-Authenticate value telling the client how to re-authenticate
+func stepUpChallenge(maxAge time.Duration, acr string) string {
+	challenge := fmt.Sprintf(`Bearer error="insufficient_user_authentication", error_description=%q`, errors.ErrStepUpRequired.Error())
+	if maxAge > 0 {
+		challenge += fmt.Sprintf(", max_age=%d", int64(maxAge.Seconds()))
+	}
+	if acr != "" {
+		challenge += fmt.Sprintf(", acr_values=%q", acr)
+	}
+
+	return challenge
+}
+
 func extractBearerToken(r *http.Request) (string, bool) {
 	authHeader := r.Header.Get(Authorization)
 	if authHeader == "" {
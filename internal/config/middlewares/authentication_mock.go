@@ -12,6 +12,7 @@ package middlewares
 import (
 	http "net/http"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -53,3 +54,17 @@ func (mr *MockAuthenticationMiddlewareMockRecorder) Authenticate(next any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAuthenticationMiddleware)(nil).Authenticate), next)
 }
+
+// RequireStepUp mocks base method.
+func (m *MockAuthenticationMiddleware) RequireStepUp(maxAge time.Duration, acr string) func(http.Handler) http.Handler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequireStepUp", maxAge, acr)
+	ret0, _ := ret[0].(func(http.Handler) http.Handler)
+	return ret0
+}
+
+// RequireStepUp indicates an expected call of RequireStepUp.
+func (mr *MockAuthenticationMiddlewareMockRecorder) RequireStepUp(maxAge, acr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequireStepUp", reflect.TypeOf((*MockAuthenticationMiddleware)(nil).RequireStepUp), maxAge, acr)
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -89,6 +90,22 @@ func Test_AuthenticationMiddleware_Authenticate(t *testing.T) {
 				code:   http.StatusUnauthorized,
 			},
 		},
+		{
+			name: "Suspended user",
+			before: func() {
+				jwtService.EXPECT().Decode("valid-token").Return(&jwt.Payload{ID: identityNumber}, nil)
+				users.EXPECT().FindByIdentityNumber(gomock.Any(), identityNumber).Return(&models.User{
+					ID:             id,
+					IdentityNumber: identityNumber,
+					Status:         models.UserSuspended,
+				}, nil)
+			},
+			header: "Bearer valid-token",
+			expected: result{
+				status: "401 Unauthorized",
+				code:   http.StatusUnauthorized,
+			},
+		},
 		{
 			name: "Unauthorized",
 			before: func() {
@@ -113,6 +130,10 @@ func Test_AuthenticationMiddleware_Authenticate(t *testing.T) {
 					w.WriteHeader(http.StatusUnauthorized)
 					return
 				}
+				if _, ok = CurrentClaimFromContext(r.Context()); !ok {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
 				_ = json.NewEncoder(w).Encode(serializers.UserSerializer{ID: user.ID})
 			})
 
@@ -134,3 +155,103 @@ func Test_AuthenticationMiddleware_Authenticate(t *testing.T) {
 		})
 	}
 }
+
+func Test_AuthenticationMiddleware_RequireStepUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	middleware := NewAuthenticationMiddleware(jwt.NewMockJwt(ctrl), services.NewMockUsers(ctrl), log)
+
+	recent := time.Now().Add(-time.Minute).Unix()
+	stale := time.Now().Add(-time.Hour).Unix()
+
+	tests := []struct {
+		name      string
+		maxAge    time.Duration
+		acr       string
+		claim     *jwt.Payload
+		code      int
+		challenge string
+	}{
+		{
+			name:   "Recent step-up",
+			maxAge: 5 * time.Minute,
+			acr:    models.AcrStepUp,
+			claim:  &jwt.Payload{ID: "PNOEE-123456789", AuthTime: recent, ACR: models.AcrStepUp},
+			code:   http.StatusOK,
+		},
+		{
+			name:   "Recent login without acr requirement",
+			maxAge: 5 * time.Minute,
+			claim:  &jwt.Payload{ID: "PNOEE-123456789", AuthTime: recent, ACR: models.AcrLogin},
+			code:   http.StatusOK,
+		},
+		{
+			name:  "Login without any requirement",
+			claim: &jwt.Payload{ID: "PNOEE-123456789"},
+			code:  http.StatusOK,
+		},
+		{
+			name:      "Stale authentication",
+			maxAge:    5 * time.Minute,
+			claim:     &jwt.Payload{ID: "PNOEE-123456789", AuthTime: stale, ACR: models.AcrStepUp},
+			code:      http.StatusUnauthorized,
+			challenge: `Bearer error="insufficient_user_authentication", error_description="step-up authentication required", max_age=300`,
+		},
+		{
+			name:      "Without auth time",
+			maxAge:    5 * time.Minute,
+			claim:     &jwt.Payload{ID: "PNOEE-123456789"},
+			code:      http.StatusUnauthorized,
+			challenge: `Bearer error="insufficient_user_authentication", error_description="step-up authentication required", max_age=300`,
+		},
+		{
+			name:      "Insufficient acr",
+			acr:       models.AcrStepUp,
+			claim:     &jwt.Payload{ID: "PNOEE-123456789", AuthTime: recent, ACR: models.AcrLogin},
+			code:      http.StatusUnauthorized,
+			challenge: `Bearer error="insufficient_user_authentication", error_description="step-up authentication required", acr_values="step_up"`,
+		},
+		{
+			name:      "Unknown acr",
+			acr:       models.AcrLogin,
+			claim:     &jwt.Payload{ID: "PNOEE-123456789", ACR: "password"},
+			code:      http.StatusUnauthorized,
+			challenge: `Bearer error="insufficient_user_authentication", error_description="step-up authentication required", acr_values="login"`,
+		},
+		{
+			name: "Without claims",
+			acr:  models.AcrStepUp,
+			code: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req, _ := http.NewRequest("GET", "/", nil)
+			if tt.claim != nil {
+				req = req.WithContext(NewContextModifier(req.Context()).WithClaim(tt.claim).Context())
+			}
+			rw := httptest.NewRecorder()
+
+			middleware.RequireStepUp(tt.maxAge, tt.acr)(handler).ServeHTTP(rw, req)
+
+			res := rw.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tt.code, res.StatusCode)
+			assert.Equal(t, tt.challenge, res.Header.Get(WWWAuthenticate))
+		})
+	}
+}
@@ -3,12 +3,16 @@ package middlewares
 import (
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
 
 	"loki/internal/app/errors"
 	"loki/internal/app/serializers"
 	"loki/internal/app/services"
 	"loki/internal/config/logger"
 	"loki/pkg/jwt"
+	"loki/pkg/policy"
 	"loki/pkg/rbac"
 )
 
@@ -18,16 +22,23 @@ type AuthorizationMiddleware interface {
 }
 
 type authorizationMiddleware struct {
-	jwt   jwt.Jwt
-	users services.Users
-	log   *logger.Logger
+	jwt      jwt.Jwt
+	users    services.Users
+	policies services.Policies
+	log      *logger.Logger
 }
 
-func NewAuthorizationMiddleware(jwt jwt.Jwt, users services.Users, log *logger.Logger) AuthorizationMiddleware {
+func NewAuthorizationMiddleware(
+	jwt jwt.Jwt,
+	users services.Users,
+	policies services.Policies,
+	log *logger.Logger,
+) AuthorizationMiddleware {
 	return &authorizationMiddleware{
-		jwt:   jwt,
-		users: users,
-		log:   log,
+		jwt:      jwt,
+		users:    users,
+		policies: policies,
+		log:      log,
 	}
 }
 
@@ -56,6 +67,13 @@ func (m *authorizationMiddleware) Authorize(next http.Handler) http.Handler {
 			return
 		}
 
+		if !user.CanAuthenticate() {
+			m.log.Warn().Msgf("User %s is %s", user.ID, user.Status)
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrUserInactive.Error()})
+			return
+		}
+
 		if !rbac.HasScope(claim.Scope) {
 			m.log.Warn().Msgf("User %s does not have %s scope", claim.ID, rbac.SsoServiceType)
 			w.WriteHeader(http.StatusForbidden)
@@ -82,14 +100,55 @@ func (m *authorizationMiddleware) Check(permission string) func(http.Handler) ht
 				return
 			}
 
-			if !rbac.HasPermission(claim.Permissions, permission) {
+			if !rbac.HasPermission(claim, rbac.GlobalResource, permission) {
 				m.log.Warn().Msgf("User %s does not have required permission: %s", claim.ID, permission)
 				w.WriteHeader(http.StatusForbidden)
 				_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrForbidden.Error()})
 				return
 			}
 
+			resource := policy.Resource{
+				Type: routePattern(r),
+				ID:   chi.URLParam(r, "id"),
+			}
+
+			// the HTTP API acts on the current user, its status and organisations describe the resource
+			if user, ok := CurrentUserFromContext(r.Context()); ok {
+				attributes, err := m.users.FindPolicyAttributes(r.Context(), user.ID)
+				if err != nil {
+					m.log.Error().Err(err).Msgf("Failed to describe user %s for policies", user.ID)
+					w.WriteHeader(http.StatusForbidden)
+					_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrForbidden.Error()})
+					return
+				}
+				resource.Attributes = attributes
+			}
+
+			allowed, err := m.policies.Evaluate(r.Context(), &policy.Request{
+				Principal: claim,
+				Action:    permission,
+				Resource:  resource,
+				Context: map[string]interface{}{
+					"time":   time.Now(),
+					"method": r.Method,
+				},
+			})
+			if err != nil || !allowed {
+				m.log.Warn().Err(err).Msgf("User %s is denied by policy for permission: %s", claim.ID, permission)
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(serializers.ErrorSerializer{Error: errors.ErrForbidden.Error()})
+				return
+			}
+
 			next.ServeHTTP(w, r)
 		})
 	}
 }
+
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		return rctx.RoutePattern()
+	}
+
+	return r.URL.Path
+}
@@ -0,0 +1,225 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"loki/internal/app/errors"
+	"loki/internal/app/models"
+	"loki/internal/app/services"
+	"loki/internal/config"
+	"loki/internal/config/logger"
+	"loki/pkg/jwt"
+	"loki/pkg/policy"
+	"loki/pkg/rbac"
+)
+
+func Test_AuthorizationMiddleware_Check(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	policies := services.NewMockPolicies(ctrl)
+	users := services.NewMockUsers(ctrl)
+	middleware := NewAuthorizationMiddleware(jwt.NewMockJwt(ctrl), users, policies, log)
+
+	withClaim := func(permissions ...string) context.Context {
+		return NewContextModifier(context.Background()).
+			WithClaim(&jwt.Payload{ID: "PNOEE-123456789", Permissions: permissions}).
+			Context()
+	}
+
+	currentUser := &models.User{ID: uuid.MustParse("10000000-1000-1000-5000-000000000001")}
+	withCurrentUser := NewContextModifier(withClaim(rbac.ReadUsers)).WithCurrentUser(currentUser).Context()
+
+	tests := []struct {
+		name     string
+		before   func()
+		ctx      context.Context
+		expected int
+	}{
+		{
+			name: "Success",
+			before: func() {
+				policies.EXPECT().Evaluate(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *policy.Request) (bool, error) {
+					assert.Equal(t, rbac.ReadUsers, req.Action)
+					assert.Equal(t, http.MethodGet, req.Context["method"])
+					return true, nil
+				})
+			},
+			ctx:      withClaim(rbac.ReadUsers),
+			expected: http.StatusOK,
+		},
+		{
+			name: "Success with current user attributes",
+			before: func() {
+				users.EXPECT().FindPolicyAttributes(gomock.Any(), currentUser.ID).Return(map[string]interface{}{
+					"status":        models.UserActive,
+					"organisations": []string{"10000000-1000-1000-4000-000000000001"},
+				}, nil)
+				policies.EXPECT().Evaluate(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *policy.Request) (bool, error) {
+					assert.Equal(t, models.UserActive, req.Resource.Attributes["status"])
+					assert.Equal(t, []string{"10000000-1000-1000-4000-000000000001"}, req.Resource.Attributes["organisations"])
+					return true, nil
+				})
+			},
+			ctx:      withCurrentUser,
+			expected: http.StatusOK,
+		},
+		{
+			name: "Failed to describe current user",
+			before: func() {
+				users.EXPECT().FindPolicyAttributes(gomock.Any(), currentUser.ID).Return(nil, errors.ErrFailedToFetchResults)
+			},
+			ctx:      withCurrentUser,
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "Missing claims",
+			before:   func() {},
+			ctx:      context.Background(),
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name:     "Missing required permission",
+			before:   func() {},
+			ctx:      withClaim(rbac.ReadTokens),
+			expected: http.StatusForbidden,
+		},
+		{
+			name: "Denied by policy",
+			before: func() {
+				policies.EXPECT().Evaluate(gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			ctx:      withClaim(rbac.ReadUsers),
+			expected: http.StatusForbidden,
+		},
+		{
+			name: "Policy evaluation error",
+			before: func() {
+				policies.EXPECT().Evaluate(gomock.Any(), gomock.Any()).Return(false, errors.ErrPolicyEvaluation)
+			},
+			ctx:      withClaim(rbac.ReadUsers),
+			expected: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			handler := middleware.Check(rbac.ReadUsers)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users", nil).WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+func Test_AuthorizationMiddleware_Authorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:   "test",
+		AppAddr:  "localhost:8080",
+		LogLevel: "info",
+	}
+	log := logger.NewLogger(cfg)
+
+	jwtService := jwt.NewMockJwt(ctrl)
+	users := services.NewMockUsers(ctrl)
+	middleware := NewAuthorizationMiddleware(jwtService, users, services.NewMockPolicies(ctrl), log)
+
+	identityNumber := "PNOEE-123456789"
+	id := uuid.MustParse("10000000-1000-1000-5000-000000000001")
+
+	tests := []struct {
+		name     string
+		before   func()
+		header   string
+		expected int
+	}{
+		{
+			name: "Success",
+			before: func() {
+				jwtService.EXPECT().Decode("valid-token").Return(&jwt.Payload{ID: identityNumber, Scope: []string{rbac.SsoServiceType}}, nil)
+				users.EXPECT().FindByIdentityNumber(gomock.Any(), identityNumber).Return(&models.User{ID: id, IdentityNumber: identityNumber}, nil)
+			},
+			header:   "Bearer valid-token",
+			expected: http.StatusOK,
+		},
+		{
+			name:     "Invalid header",
+			before:   func() {},
+			header:   "Bearer",
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name: "Invalid token",
+			before: func() {
+				jwtService.EXPECT().Decode("invalid-token").Return(nil, errors.ErrInvalidToken)
+			},
+			header:   "Bearer invalid-token",
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name: "Suspended user",
+			before: func() {
+				jwtService.EXPECT().Decode("valid-token").Return(&jwt.Payload{ID: identityNumber, Scope: []string{rbac.SsoServiceType}}, nil)
+				users.EXPECT().FindByIdentityNumber(gomock.Any(), identityNumber).Return(&models.User{
+					ID:             id,
+					IdentityNumber: identityNumber,
+					Status:         models.UserSuspended,
+				}, nil)
+			},
+			header:   "Bearer valid-token",
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name: "Missing scope",
+			before: func() {
+				jwtService.EXPECT().Decode("valid-token").Return(&jwt.Payload{ID: identityNumber}, nil)
+				users.EXPECT().FindByIdentityNumber(gomock.Any(), identityNumber).Return(&models.User{ID: id, IdentityNumber: identityNumber}, nil)
+			},
+			header:   "Bearer valid-token",
+			expected: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+
+			handler := middleware.Authorize(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users", nil)
+			req.Header.Set(Authorization, tt.header)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
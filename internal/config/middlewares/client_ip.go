@@ -0,0 +1,114 @@
+package middlewares
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"loki/internal/config"
+)
+
+const XForwardedFor = "X-Forwarded-For"
+
+// TrustedProxies are the reverse proxies whose X-Forwarded-For header is believed
+type TrustedProxies []netip.Prefix
+
+// NewTrustedProxies parses the configured addresses and ranges, a bare address trusts that single host
+func NewTrustedProxies(cfg *config.Config) TrustedProxies {
+	result := make(TrustedProxies, 0, len(cfg.TrustedProxies))
+	for _, value := range cfg.TrustedProxies {
+		if prefix, err := netip.ParsePrefix(value); err == nil {
+			result = append(result, prefix.Masked())
+			continue
+		}
+
+		if addr, err := netip.ParseAddr(value); err == nil {
+			result = append(result, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+		}
+	}
+
+	return result
+}
+
+// ClientIP walks the forwarded hops from the right and returns the first address that is not a trusted proxy,
+// the header is ignored unless the direct peer is trusted
+func (t TrustedProxies) ClientIP(remoteAddr string, forwardedFor []string) string {
+	ip := hostOf(remoteAddr)
+	if !t.contains(ip) {
+		return ip
+	}
+
+	hops := make([]string, 0)
+	for _, value := range forwardedFor {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := hostOf(hops[i])
+		if _, err := netip.ParseAddr(hop); err != nil {
+			return ip
+		}
+
+		ip = hop
+		if !t.contains(ip) {
+			return ip
+		}
+	}
+
+	return ip
+}
+
+func (t TrustedProxies) contains(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	for _, prefix := range t {
+		if prefix.Contains(addr.Unmap()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type ClientIPMiddleware interface {
+	Resolve(next http.Handler) http.Handler
+}
+
+type clientIPMiddleware struct {
+	proxies TrustedProxies
+}
+
+func NewClientIPMiddleware(proxies TrustedProxies) ClientIPMiddleware {
+	return &clientIPMiddleware{
+		proxies: proxies,
+	}
+}
+
+// Resolve replaces the remote address of a request forwarded by a trusted proxy with the address of the client
+func (m *clientIPMiddleware) Resolve(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(m.proxies) > 0 {
+			r.RemoteAddr = m.proxies.ClientIP(r.RemoteAddr, r.Header.Values(XForwardedFor))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// hostOf strips the port from an address, a value without a port is returned as is
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return strings.Trim(addr, "[]")
+	}
+
+	return host
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/config/middlewares/client_ip.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/config/middlewares/client_ip.go -destination=internal/config/middlewares/client_ip_mock.go -package=middlewares
+//
+
+// Package middlewares is a generated GoMock package.
+package middlewares
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClientIPMiddleware is a mock of ClientIPMiddleware interface.
+type MockClientIPMiddleware struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientIPMiddlewareMockRecorder
+	isgomock struct{}
+}
+
+// MockClientIPMiddlewareMockRecorder is the mock recorder for MockClientIPMiddleware.
+type MockClientIPMiddlewareMockRecorder struct {
+	mock *MockClientIPMiddleware
+}
+
+// NewMockClientIPMiddleware creates a new mock instance.
+func NewMockClientIPMiddleware(ctrl *gomock.Controller) *MockClientIPMiddleware {
+	mock := &MockClientIPMiddleware{ctrl: ctrl}
+	mock.recorder = &MockClientIPMiddlewareMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientIPMiddleware) EXPECT() *MockClientIPMiddlewareMockRecorder {
+	return m.recorder
+}
+
+// Resolve mocks base method.
+func (m *MockClientIPMiddleware) Resolve(next http.Handler) http.Handler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", next)
+	ret0, _ := ret[0].(http.Handler)
+	return ret0
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockClientIPMiddlewareMockRecorder) Resolve(next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockClientIPMiddleware)(nil).Resolve), next)
+}
@@ -0,0 +1,100 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/config"
+)
+
+func Test_NewTrustedProxies(t *testing.T) {
+	proxies := NewTrustedProxies(&config.Config{
+		TrustedProxies: []string{"10.0.0.0/8", "192.0.2.10", "invalid", "2001:db8::/32"},
+	})
+
+	assert.Len(t, proxies, 3)
+	assert.True(t, proxies.contains("10.1.2.3"))
+	assert.True(t, proxies.contains("192.0.2.10"))
+	assert.True(t, proxies.contains("2001:db8::1"))
+	assert.False(t, proxies.contains("192.0.2.11"))
+}
+
+func Test_ClientIPMiddleware_Resolve(t *testing.T) {
+	proxies := NewTrustedProxies(&config.Config{
+		TrustedProxies: []string{"10.0.0.0/8"},
+	})
+
+	tests := []struct {
+		name         string
+		proxies      TrustedProxies
+		remoteAddr   string
+		forwardedFor []string
+		expected     string
+	}{
+		{
+			name:       "Direct request",
+			proxies:    proxies,
+			remoteAddr: "192.0.2.1:51234",
+			expected:   "192.0.2.1",
+		},
+		{
+			name:         "Forwarded by a trusted proxy",
+			proxies:      proxies,
+			remoteAddr:   "10.0.0.5:51234",
+			forwardedFor: []string{"198.51.100.7"},
+			expected:     "198.51.100.7",
+		},
+		{
+			name:         "Spoofed hops are ignored",
+			proxies:      proxies,
+			remoteAddr:   "10.0.0.5:51234",
+			forwardedFor: []string{"203.0.113.9, 198.51.100.7", "10.0.0.6"},
+			expected:     "198.51.100.7",
+		},
+		{
+			name:         "Untrusted peer",
+			proxies:      proxies,
+			remoteAddr:   "192.0.2.1:51234",
+			forwardedFor: []string{"198.51.100.7"},
+			expected:     "192.0.2.1",
+		},
+		{
+			name:         "Malformed hop",
+			proxies:      proxies,
+			remoteAddr:   "10.0.0.5:51234",
+			forwardedFor: []string{"unknown"},
+			expected:     "10.0.0.5",
+		},
+		{
+			name:         "No trusted proxies",
+			remoteAddr:   "10.0.0.5:51234",
+			forwardedFor: []string{"198.51.100.7"},
+			expected:     "10.0.0.5:51234",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var remoteAddr string
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				remoteAddr = r.RemoteAddr
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwardedFor {
+				req.Header.Add(XForwardedFor, value)
+			}
+
+			rr := httptest.NewRecorder()
+			NewClientIPMiddleware(tt.proxies).Resolve(handler).ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, tt.expected, remoteAddr)
+		})
+	}
+}
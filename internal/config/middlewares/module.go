@@ -7,4 +7,6 @@ var Module = fx.Options(
 	fx.Provide(NewAuthorizationMiddleware),
 	fx.Provide(NewTelemetryMiddleware),
 	fx.Provide(NewLoggerMiddleware),
+	fx.Provide(NewTrustedProxies),
+	fx.Provide(NewClientIPMiddleware),
 )
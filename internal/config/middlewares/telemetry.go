@@ -3,20 +3,30 @@ package middlewares
 import (
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
+
+	"loki/internal/config/telemetry"
 )
 
 const (
-	TraceKey                = "X-Trace-ID"
+	TraceKey                = telemetry.TraceIdHeader
 	AuthenticationTraceName = "authentication"
+
+	// UnmatchedRoute labels requests that did not match a route, keeping unknown paths out of the metrics
+	UnmatchedRoute = "unmatched"
 )
 
 type TelemetryMiddleware interface {
 	Trace(next http.Handler) http.Handler
+	Measure(next http.Handler) http.Handler
 }
 
 type telemetryMiddleware struct{}
@@ -25,28 +35,13 @@ func NewTelemetryMiddleware() TelemetryMiddleware {
 	return &telemetryMiddleware{}
 }
 
+// Trace continues the trace of the incoming traceparent, or of the X-Trace-ID header when the caller sends none
 func (m *telemetryMiddleware) Trace(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		tracer := otel.Tracer(AuthenticationTraceName)
-		traceId := r.Header.Get(TraceKey)
-
-		if traceId == "" {
-			traceId = uuid.New().String()
-			r.Header.Set(TraceKey, traceId)
-		}
-
-		ctx := NewContextModifier(r.Context()).
-			WithTraceId(traceId).
-			Context()
 
-		id, _ := trace.TraceIDFromHex(formatToTraceID(traceId))
-		spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
-			TraceID: id,
-			Remote:  true,
-		})
-
-		ctx = trace.ContextWithSpanContext(ctx, spanCtx)
-		ctx, span := tracer.Start(ctx, formatToOperationName(r.URL.Path))
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracer.Start(ctx, formatToOperationName(r.URL.Path), trace.WithSpanKind(trace.SpanKindServer))
 		defer span.End()
 
 		span.SetAttributes(
@@ -57,10 +52,36 @@ func (m *telemetryMiddleware) Trace(next http.Handler) http.Handler {
 			attribute.String("http.remote_addr", r.RemoteAddr),
 		)
 
+		ctx = NewContextModifier(ctx).
+			WithTraceId(traceIdFromSpan(span)).
+			Context()
+
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// Measure records the duration and status of the request by its route pattern
+func (m *telemetryMiddleware) Measure(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r)
+
+		route := UnmatchedRoute
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			route = rctx.RoutePattern()
+		}
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		telemetry.RecordRequest(r.Context(), r.Method, route, status, time.Since(start))
+	})
+}
+
 func formatToOperationName(path string) string {
 	parts := strings.Split(path, "/")
 
@@ -73,6 +94,16 @@ func formatToOperationName(path string) string {
 	return strings.Join(parts, "/")
 }
 
+// traceIdFromSpan returns the trace id logged and audited for the request, generating one when tracing is disabled
+func traceIdFromSpan(span trace.Span) string {
+	spanCtx := span.SpanContext()
+	if spanCtx.HasTraceID() {
+		return spanCtx.TraceID().String()
+	}
+
+	return formatToTraceID(uuid.New().String())
+}
+
 func formatToTraceID(uuid string) string {
 	return strings.ReplaceAll(uuid, "-", "")
 }
@@ -40,6 +40,20 @@ func (m *MockTelemetryMiddleware) EXPECT() *MockTelemetryMiddlewareMockRecorder
 	return m.recorder
 }
 
+// Measure mocks base method.
+func (m *MockTelemetryMiddleware) Measure(next http.Handler) http.Handler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Measure", next)
+	ret0, _ := ret[0].(http.Handler)
+	return ret0
+}
+
+// Measure indicates an expected call of Measure.
+func (mr *MockTelemetryMiddlewareMockRecorder) Measure(next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Measure", reflect.TypeOf((*MockTelemetryMiddleware)(nil).Measure), next)
+}
+
 // Trace mocks base method.
 func (m *MockTelemetryMiddleware) Trace(next http.Handler) http.Handler {
 	m.ctrl.T.Helper()
@@ -6,46 +6,64 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
-	"go.uber.org/mock/gomock"
+	"go.opentelemetry.io/otel"
+
+	"loki/internal/config/telemetry"
 )
 
 func Test_TelemetryMiddleware_Trace(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+	otel.SetTextMapPropagator(telemetry.NewPropagator())
 
 	middleware := NewTelemetryMiddleware()
 
-	type result struct {
-		status string
-		code   int
-	}
-
 	tests := []struct {
 		name     string
-		header   string
-		expected result
-		error    error
+		headers  map[string]string
+		expected string
 	}{
 		{
-			name:   "Success",
-			header: "8f963243-726d-4603-af9c-271eeb15c4a2",
-			expected: result{
-				status: "200 OK",
-				code:   http.StatusOK,
+			name: "Traceparent",
+			headers: map[string]string{
+				"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			},
+			expected: "4bf92f3577b34da6a3ce929d0e0e4736",
+		},
+		{
+			name: "Traceparent takes precedence over X-Trace-ID",
+			headers: map[string]string{
+				"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				"X-Trace-ID":  "8f963243-726d-4603-af9c-271eeb15c4a2",
 			},
-			error: nil,
+			expected: "4bf92f3577b34da6a3ce929d0e0e4736",
+		},
+		{
+			name: "X-Trace-ID fallback",
+			headers: map[string]string{
+				"X-Trace-ID": "8f963243-726d-4603-af9c-271eeb15c4a2",
+			},
+			expected: "8f963243726d4603af9c271eeb15c4a2",
+		},
+		{
+			name:     "No trace headers",
+			headers:  map[string]string{},
+			expected: "",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			var traceId string
 			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				traceId, _ = CurrentTraceIdFromContext(r.Context())
 				_ = json.NewEncoder(w).Encode("ok")
 			})
 
 			req, _ := http.NewRequest("GET", "/", nil)
-			req.Header.Set("X-Trace-ID", tt.header)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
 			rw := httptest.NewRecorder()
 
 			middleware.Trace(handler).ServeHTTP(rw, req)
@@ -53,12 +71,52 @@ func Test_TelemetryMiddleware_Trace(t *testing.T) {
 			res := rw.Result()
 			defer res.Body.Close()
 
-			if tt.error != nil {
-				assert.Error(t, tt.error)
-			} else {
-				assert.Equal(t, tt.expected.code, res.StatusCode)
-				assert.Equal(t, tt.expected.status, res.Status)
+			assert.Equal(t, http.StatusOK, res.StatusCode)
+			assert.Len(t, traceId, 32)
+			if tt.expected != "" {
+				assert.Equal(t, tt.expected, traceId)
 			}
 		})
 	}
 }
+
+func Test_TelemetryMiddleware_Measure(t *testing.T) {
+	middleware := NewTelemetryMiddleware()
+
+	tests := []struct {
+		name     string
+		path     string
+		expected int
+	}{
+		{
+			name:     "Matched route",
+			path:     "/api/sessions/8f963243-726d-4603-af9c-271eeb15c4a2",
+			expected: http.StatusAccepted,
+		},
+		{
+			name:     "Unmatched route",
+			path:     "/unknown",
+			expected: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := chi.NewRouter()
+			r.Use(middleware.Measure)
+			r.Get("/api/sessions/{id}", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			rw := httptest.NewRecorder()
+
+			r.ServeHTTP(rw, req)
+
+			res := rw.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tt.expected, res.StatusCode)
+		})
+	}
+}
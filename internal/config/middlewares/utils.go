@@ -8,8 +8,9 @@ import (
 )
 
 const (
-	Authorization = "Authorization"
-	bearerScheme  = "Bearer "
+	Authorization   = "Authorization"
+	WWWAuthenticate = "WWW-Authenticate"
+	bearerScheme    = "Bearer "
 )
 
 func CurrentUserFromContext(ctx context.Context) (*models.User, bool) {
@@ -10,14 +10,17 @@ import (
 	"loki/internal/app/controllers"
 	"loki/internal/config"
 	"loki/internal/config/middlewares"
+	"loki/pkg/rbac"
 )
 
 func NewRouter(
 	cfg *config.Config,
 
 	authentication middlewares.AuthenticationMiddleware,
+	authorization middlewares.AuthorizationMiddleware,
 	telemetry middlewares.TelemetryMiddleware,
 	logger middlewares.LoggerMiddleware,
+	clientIP middlewares.ClientIPMiddleware,
 
 	health controllers.HealthController,
 	smartId controllers.SmartIdController,
@@ -25,14 +28,20 @@ func NewRouter(
 	sessions controllers.SessionsController,
 	tokens controllers.TokensController,
 	users controllers.UsersController,
+	authenticators controllers.AuthenticatorsController,
+	loginSessions controllers.LoginSessionsController,
+	stepUp controllers.StepUpController,
+	confirmations controllers.ConfirmationsController,
 ) http.Handler {
 	r := chi.NewRouter()
 
 	r.Use(telemetry.Trace)
 	r.Use(middleware.RequestID)
+	r.Use(clientIP.Resolve)
 	r.Use(logger.Log)
 	r.Use(middleware.Compress(5))
 	r.Use(middleware.Heartbeat("/health"))
+	r.Use(telemetry.Measure)
 	r.Use(
 		cors.Handler(cors.Options{
 			AllowedOrigins: []string{"http://*", cfg.ClientURL},
@@ -57,7 +66,25 @@ func NewRouter(
 
 	r.Group(func(r chi.Router) {
 		r.Use(authentication.Authenticate)
-		r.Get("/api/me", users.Me)
+
+		r.Group(func(r chi.Router) {
+			r.Use(authorization.Check(rbac.ReadSelf))
+			r.Get("/api/me", users.Me)
+			r.Get("/api/me/authenticators", authenticators.List)
+			r.Get("/api/me/sessions", loginSessions.List)
+			r.Get("/api/me/confirmations/{id}", confirmations.Get)
+		})
+
+		r.Group(func(r chi.Router) {
+			r.Use(authorization.Check(rbac.WriteSelf))
+			r.Patch("/api/me", users.UpdateMe)
+			r.Post("/api/me/email/verify", users.VerifyEmail)
+			r.Put("/api/me/authenticators/allowed_methods", authenticators.SetAllowedMethods)
+			r.Delete("/api/me/sessions/{id}", loginSessions.Revoke)
+			r.Post("/api/me/step_up", stepUp.CreateSession)
+			r.Post("/api/me/step_up/{id}", stepUp.Complete)
+			r.Post("/api/me/confirmations", confirmations.Create)
+		})
 	})
 
 	return r
@@ -11,6 +11,7 @@ import (
 	"loki/internal/app/controllers"
 	"loki/internal/config"
 	"loki/internal/config/middlewares"
+	"loki/pkg/rbac"
 )
 
 func Test_HealthCheck(t *testing.T) {
@@ -23,8 +24,10 @@ func Test_HealthCheck(t *testing.T) {
 	}
 
 	mockAuthenticationMiddleware := middlewares.NewMockAuthenticationMiddleware(ctrl)
+	mockAuthorizationMiddleware := middlewares.NewMockAuthorizationMiddleware(ctrl)
 	mockTelemetryMiddleware := middlewares.NewMockTelemetryMiddleware(ctrl)
 	mockLoggerMiddleware := middlewares.NewMockLoggerMiddleware(ctrl)
+	mockClientIPMiddleware := middlewares.NewMockClientIPMiddleware(ctrl)
 
 	mockHealthController := controllers.NewMockHealthController(ctrl)
 	mockSmartIdController := controllers.NewMockSmartIdController(ctrl)
@@ -32,6 +35,10 @@ func Test_HealthCheck(t *testing.T) {
 	mockSessionsController := controllers.NewMockSessionsController(ctrl)
 	mockTokensController := controllers.NewMockTokensController(ctrl)
 	mockUsersController := controllers.NewMockUsersController(ctrl)
+	mockAuthenticatorsController := controllers.NewMockAuthenticatorsController(ctrl)
+	mockLoginSessionsController := controllers.NewMockLoginSessionsController(ctrl)
+	mockStepUpController := controllers.NewMockStepUpController(ctrl)
+	mockConfirmationsController := controllers.NewMockConfirmationsController(ctrl)
 
 	mockAuthenticationMiddleware.EXPECT().
 		Authenticate(gomock.Any()).
@@ -39,30 +46,56 @@ func Test_HealthCheck(t *testing.T) {
 		DoAndReturn(func(next http.Handler) http.Handler {
 			return next
 		})
+	mockAuthorizationMiddleware.EXPECT().
+		Check(gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(string) func(http.Handler) http.Handler {
+			return func(next http.Handler) http.Handler {
+				return next
+			}
+		})
 	mockTelemetryMiddleware.EXPECT().
 		Trace(gomock.Any()).
 		AnyTimes().
 		DoAndReturn(func(next http.Handler) http.Handler {
 			return next
 		})
+	mockTelemetryMiddleware.EXPECT().
+		Measure(gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(next http.Handler) http.Handler {
+			return next
+		})
 	mockLoggerMiddleware.EXPECT().
 		Log(gomock.Any()).
 		AnyTimes().
 		DoAndReturn(func(next http.Handler) http.Handler {
 			return next
 		})
+	mockClientIPMiddleware.EXPECT().
+		Resolve(gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(next http.Handler) http.Handler {
+			return next
+		})
 
 	router := NewRouter(
 		cfg,
 		mockAuthenticationMiddleware,
+		mockAuthorizationMiddleware,
 		mockTelemetryMiddleware,
 		mockLoggerMiddleware,
+		mockClientIPMiddleware,
 		mockHealthController,
 		mockSmartIdController,
 		mockMobileIdController,
 		mockSessionsController,
 		mockTokensController,
 		mockUsersController,
+		mockAuthenticatorsController,
+		mockLoginSessionsController,
+		mockStepUpController,
+		mockConfirmationsController,
 	)
 
 	req := httptest.NewRequest(http.MethodHead, "/health", nil)
@@ -74,3 +107,93 @@ func Test_HealthCheck(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func Test_ProtectedRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AppEnv:  "test",
+		AppAddr: "localhost:8080",
+	}
+
+	mockAuthenticationMiddleware := middlewares.NewMockAuthenticationMiddleware(ctrl)
+	mockAuthorizationMiddleware := middlewares.NewMockAuthorizationMiddleware(ctrl)
+	mockTelemetryMiddleware := middlewares.NewMockTelemetryMiddleware(ctrl)
+	mockLoggerMiddleware := middlewares.NewMockLoggerMiddleware(ctrl)
+	mockClientIPMiddleware := middlewares.NewMockClientIPMiddleware(ctrl)
+
+	passThrough := func(next http.Handler) http.Handler {
+		return next
+	}
+
+	mockAuthenticationMiddleware.EXPECT().Authenticate(gomock.Any()).AnyTimes().DoAndReturn(passThrough)
+	mockTelemetryMiddleware.EXPECT().Trace(gomock.Any()).AnyTimes().DoAndReturn(passThrough)
+	mockTelemetryMiddleware.EXPECT().Measure(gomock.Any()).AnyTimes().DoAndReturn(passThrough)
+	mockLoggerMiddleware.EXPECT().Log(gomock.Any()).AnyTimes().DoAndReturn(passThrough)
+	mockClientIPMiddleware.EXPECT().Resolve(gomock.Any()).AnyTimes().DoAndReturn(passThrough)
+
+	// every check denies, so no controller is reached and the response names the checked permission
+	mockAuthorizationMiddleware.EXPECT().
+		Check(gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(permission string) func(http.Handler) http.Handler {
+			return func(http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("X-Permission", permission)
+					w.WriteHeader(http.StatusForbidden)
+				})
+			}
+		})
+
+	router := NewRouter(
+		cfg,
+		mockAuthenticationMiddleware,
+		mockAuthorizationMiddleware,
+		mockTelemetryMiddleware,
+		mockLoggerMiddleware,
+		mockClientIPMiddleware,
+		controllers.NewMockHealthController(ctrl),
+		controllers.NewMockSmartIdController(ctrl),
+		controllers.NewMockMobileIdController(ctrl),
+		controllers.NewMockSessionsController(ctrl),
+		controllers.NewMockTokensController(ctrl),
+		controllers.NewMockUsersController(ctrl),
+		controllers.NewMockAuthenticatorsController(ctrl),
+		controllers.NewMockLoginSessionsController(ctrl),
+		controllers.NewMockStepUpController(ctrl),
+		controllers.NewMockConfirmationsController(ctrl),
+	)
+
+	tests := []struct {
+		method     string
+		path       string
+		permission string
+	}{
+		{method: http.MethodGet, path: "/api/me", permission: rbac.ReadSelf},
+		{method: http.MethodPatch, path: "/api/me", permission: rbac.WriteSelf},
+		{method: http.MethodPost, path: "/api/me/email/verify", permission: rbac.WriteSelf},
+		{method: http.MethodGet, path: "/api/me/authenticators", permission: rbac.ReadSelf},
+		{method: http.MethodPut, path: "/api/me/authenticators/allowed_methods", permission: rbac.WriteSelf},
+		{method: http.MethodGet, path: "/api/me/sessions", permission: rbac.ReadSelf},
+		{method: http.MethodDelete, path: "/api/me/sessions/10000000-1000-1000-1000-000000000001", permission: rbac.WriteSelf},
+		{method: http.MethodPost, path: "/api/me/step_up", permission: rbac.WriteSelf},
+		{method: http.MethodPost, path: "/api/me/step_up/10000000-1000-1000-1000-000000000001", permission: rbac.WriteSelf},
+		{method: http.MethodPost, path: "/api/me/confirmations", permission: rbac.WriteSelf},
+		{method: http.MethodGet, path: "/api/me/confirmations/10000000-1000-1000-1000-000000000001", permission: rbac.ReadSelf},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+			assert.Equal(t, tt.permission, resp.Header.Get("X-Permission"))
+		})
+	}
+}
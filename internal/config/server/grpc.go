@@ -48,7 +48,10 @@ type grpcServer struct {
 func NewGrpcServer(
 	cfg *config.Config,
 	registry *rpcs.Registry,
+	auditInterceptor interceptors.AuditInterceptor,
 	authenticationInterceptor interceptors.AuthenticationInterceptor,
+	authorizationInterceptor interceptors.AuthorizationInterceptor,
+	rateLimitInterceptor interceptors.RateLimitInterceptor,
 	traceInterceptor interceptors.TraceInterceptor,
 	loggerInterceptor interceptors.LoggerInterceptor,
 	log *logger.Logger,
@@ -69,13 +72,27 @@ func NewGrpcServer(
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		traceInterceptor.Trace(),
 		loggerInterceptor.Log(),
+		rateLimitInterceptor.Limit(),
 		auth.UnaryServerInterceptor(authenticationInterceptor.Authenticate),
+		// denied calls are logged by the authorization interceptor and never reach the audit log
+		authorizationInterceptor.Authorize(registry.Permissions()),
+		auditInterceptor.Audit(registry.Permissions(), registry.Snapshots()),
+	}
+
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		traceInterceptor.TraceStream(),
+		loggerInterceptor.LogStream(),
+		rateLimitInterceptor.LimitStream(),
+		auth.StreamServerInterceptor(authenticationInterceptor.Authenticate),
+		authorizationInterceptor.AuthorizeStream(registry.Permissions()),
+		auditInterceptor.AuditStream(registry.Permissions()),
 	}
 
 	server := grpc.NewServer(
 		grpc.Creds(credentials.NewTLS(tlsConfig)),
 		grpc.KeepaliveParams(options),
 		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	)
 	registry.RegisterAll(server)
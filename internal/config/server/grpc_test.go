@@ -38,17 +38,28 @@ func Test_NewGrpcServer(t *testing.T) {
 	}
 	log := logger.NewLogger(cfg)
 
+	auditInterceptor := interceptors.NewMockAuditInterceptor(ctrl)
 	authInterceptor := interceptors.NewMockAuthenticationInterceptor(ctrl)
+	authorizationInterceptor := interceptors.NewMockAuthorizationInterceptor(ctrl)
+	rateLimitInterceptor := interceptors.NewMockRateLimitInterceptor(ctrl)
 	traceInterceptor := interceptors.NewMockTraceInterceptor(ctrl)
 	loggerInterceptor := interceptors.NewMockLoggerInterceptor(ctrl)
 
+	auditInterceptor.EXPECT().Audit(gomock.Any(), gomock.Any()).AnyTimes()
+	auditInterceptor.EXPECT().AuditStream(gomock.Any()).AnyTimes()
 	authInterceptor.EXPECT().Authenticate(gomock.Any()).AnyTimes()
+	authorizationInterceptor.EXPECT().Authorize(gomock.Any()).AnyTimes()
+	authorizationInterceptor.EXPECT().AuthorizeStream(gomock.Any()).AnyTimes()
+	rateLimitInterceptor.EXPECT().Limit().AnyTimes()
+	rateLimitInterceptor.EXPECT().LimitStream().AnyTimes()
 	traceInterceptor.EXPECT().Trace().AnyTimes()
+	traceInterceptor.EXPECT().TraceStream().AnyTimes()
 	loggerInterceptor.EXPECT().Log().AnyTimes()
+	loggerInterceptor.EXPECT().LogStream().AnyTimes()
 
 	registry := &rpcs.Registry{}
 
-	srv := NewGrpcServer(cfg, registry, authInterceptor, traceInterceptor, loggerInterceptor, log)
+	srv := NewGrpcServer(cfg, registry, auditInterceptor, authInterceptor, authorizationInterceptor, rateLimitInterceptor, traceInterceptor, loggerInterceptor, log)
 	assert.NotNil(t, srv)
 
 	s, ok := srv.(*grpcServer)
@@ -72,17 +83,28 @@ func Test_GrpcServer_RunAndShutdown(t *testing.T) {
 	}
 	log := logger.NewLogger(cfg)
 
+	auditInterceptor := interceptors.NewMockAuditInterceptor(ctrl)
 	authInterceptor := interceptors.NewMockAuthenticationInterceptor(ctrl)
+	authorizationInterceptor := interceptors.NewMockAuthorizationInterceptor(ctrl)
+	rateLimitInterceptor := interceptors.NewMockRateLimitInterceptor(ctrl)
 	traceInterceptor := interceptors.NewMockTraceInterceptor(ctrl)
 	loggerInterceptor := interceptors.NewMockLoggerInterceptor(ctrl)
 
+	auditInterceptor.EXPECT().Audit(gomock.Any(), gomock.Any()).AnyTimes()
+	auditInterceptor.EXPECT().AuditStream(gomock.Any()).AnyTimes()
 	authInterceptor.EXPECT().Authenticate(gomock.Any()).AnyTimes()
+	authorizationInterceptor.EXPECT().Authorize(gomock.Any()).AnyTimes()
+	authorizationInterceptor.EXPECT().AuthorizeStream(gomock.Any()).AnyTimes()
+	rateLimitInterceptor.EXPECT().Limit().AnyTimes()
+	rateLimitInterceptor.EXPECT().LimitStream().AnyTimes()
 	traceInterceptor.EXPECT().Trace().AnyTimes()
+	traceInterceptor.EXPECT().TraceStream().AnyTimes()
 	loggerInterceptor.EXPECT().Log().AnyTimes()
+	loggerInterceptor.EXPECT().LogStream().AnyTimes()
 
 	registry := &rpcs.Registry{}
 
-	srv := NewGrpcServer(cfg, registry, authInterceptor, traceInterceptor, loggerInterceptor, log)
+	srv := NewGrpcServer(cfg, registry, auditInterceptor, authInterceptor, authorizationInterceptor, rateLimitInterceptor, traceInterceptor, loggerInterceptor, log)
 	assert.NotNil(t, srv)
 
 	runErrCh := make(chan error, 1)
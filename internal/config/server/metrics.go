@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"loki/internal/config"
+	"loki/internal/config/telemetry"
+)
+
+const MetricsPath = "/metrics"
+
+type MetricsServer interface {
+	Run() error
+	Shutdown(ctx context.Context) error
+}
+
+type metricsServer struct {
+	httpServer *http.Server
+}
+
+// NewMetricsServer serves the Prometheus scrape endpoint on the admin address, apart from the public API
+func NewMetricsServer(cfg *config.Config, metrics telemetry.Metrics) MetricsServer {
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, metrics.Handler())
+
+	return &metricsServer{
+		httpServer: &http.Server{
+			Addr:         cfg.MetricsAddr,
+			Handler:      mux,
+			ReadTimeout:  5 * time.Second,
+			WriteTimeout: 10 * time.Second,
+			IdleTimeout:  120 * time.Second,
+		},
+	}
+}
+
+func (s *metricsServer) Run() error {
+	return s.httpServer.ListenAndServe()
+}
+
+func (s *metricsServer) Shutdown(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/config/server/metrics.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/config/server/metrics.go -destination=internal/config/server/metrics_mock.go -package=server
+//
+
+// Package server is a generated GoMock package.
+package server
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMetricsServer is a mock of MetricsServer interface.
+type MockMetricsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricsServerMockRecorder
+	isgomock struct{}
+}
+
+// MockMetricsServerMockRecorder is the mock recorder for MockMetricsServer.
+type MockMetricsServerMockRecorder struct {
+	mock *MockMetricsServer
+}
+
+// NewMockMetricsServer creates a new mock instance.
+func NewMockMetricsServer(ctrl *gomock.Controller) *MockMetricsServer {
+	mock := &MockMetricsServer{ctrl: ctrl}
+	mock.recorder = &MockMetricsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetricsServer) EXPECT() *MockMetricsServerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockMetricsServer) Run() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockMetricsServerMockRecorder) Run() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockMetricsServer)(nil).Run))
+}
+
+// Shutdown mocks base method.
+func (m *MockMetricsServer) Shutdown(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shutdown", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Shutdown indicates an expected call of Shutdown.
+func (mr *MockMetricsServerMockRecorder) Shutdown(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockMetricsServer)(nil).Shutdown), ctx)
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"loki/internal/config"
+	"loki/internal/config/telemetry"
+)
+
+func Test_MetricsServer_RunAndShutdown(t *testing.T) {
+	cfg := &config.Config{
+		AppEnv:      "test",
+		AppName:     "loki",
+		MetricsAddr: "localhost:5090",
+	}
+
+	metrics, err := telemetry.NewMetrics(cfg)
+	require.NoError(t, err)
+
+	srv := NewMetricsServer(cfg, metrics)
+
+	runErrCh := make(chan error, 1)
+	go func() {
+		err := srv.Run()
+		if err != nil && err != http.ErrServerClosed {
+			runErrCh <- err
+		}
+		close(runErrCh)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+
+	resp, err := http.Get("http://" + cfg.MetricsAddr + MetricsPath)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "go_goroutines")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err = srv.Shutdown(ctx)
+	assert.NoError(t, err)
+
+	err = <-runErrCh
+	assert.NoError(t, err)
+}
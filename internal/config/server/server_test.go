@@ -25,8 +25,10 @@ func Test_NewWebServer(t *testing.T) {
 	}
 
 	mockAuthenticationMiddleware := middlewares.NewMockAuthenticationMiddleware(ctrl)
+	mockAuthorizationMiddleware := middlewares.NewMockAuthorizationMiddleware(ctrl)
 	mockTelemetryMiddleware := middlewares.NewMockTelemetryMiddleware(ctrl)
 	mockLoggerMiddleware := middlewares.NewMockLoggerMiddleware(ctrl)
+	mockClientIPMiddleware := middlewares.NewMockClientIPMiddleware(ctrl)
 
 	mockHealthController := controllers.NewMockHealthController(ctrl)
 	mockSmartIdController := controllers.NewMockSmartIdController(ctrl)
@@ -34,6 +36,10 @@ func Test_NewWebServer(t *testing.T) {
 	mockSessionsController := controllers.NewMockSessionsController(ctrl)
 	mockTokensController := controllers.NewMockTokensController(ctrl)
 	mockUsersController := controllers.NewMockUsersController(ctrl)
+	mockAuthenticatorsController := controllers.NewMockAuthenticatorsController(ctrl)
+	mockLoginSessionsController := controllers.NewMockLoginSessionsController(ctrl)
+	mockStepUpController := controllers.NewMockStepUpController(ctrl)
+	mockConfirmationsController := controllers.NewMockConfirmationsController(ctrl)
 
 	mockAuthenticationMiddleware.EXPECT().
 		Authenticate(gomock.Any()).
@@ -41,30 +47,56 @@ func Test_NewWebServer(t *testing.T) {
 		DoAndReturn(func(next http.Handler) http.Handler {
 			return next
 		})
+	mockAuthorizationMiddleware.EXPECT().
+		Check(gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(string) func(http.Handler) http.Handler {
+			return func(next http.Handler) http.Handler {
+				return next
+			}
+		})
 	mockTelemetryMiddleware.EXPECT().
 		Trace(gomock.Any()).
 		AnyTimes().
 		DoAndReturn(func(next http.Handler) http.Handler {
 			return next
 		})
+	mockTelemetryMiddleware.EXPECT().
+		Measure(gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(next http.Handler) http.Handler {
+			return next
+		})
 	mockLoggerMiddleware.EXPECT().
 		Log(gomock.Any()).
 		AnyTimes().
 		DoAndReturn(func(next http.Handler) http.Handler {
 			return next
 		})
+	mockClientIPMiddleware.EXPECT().
+		Resolve(gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(next http.Handler) http.Handler {
+			return next
+		})
 
 	appRouter := router.NewRouter(
 		cfg,
 		mockAuthenticationMiddleware,
+		mockAuthorizationMiddleware,
 		mockTelemetryMiddleware,
 		mockLoggerMiddleware,
+		mockClientIPMiddleware,
 		mockHealthController,
 		mockSmartIdController,
 		mockMobileIdController,
 		mockSessionsController,
 		mockTokensController,
 		mockUsersController,
+		mockAuthenticatorsController,
+		mockLoginSessionsController,
+		mockStepUpController,
+		mockConfirmationsController,
 	)
 
 	srv := NewWebServer(cfg, appRouter)
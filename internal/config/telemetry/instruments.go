@@ -0,0 +1,144 @@
+package telemetry
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	MeterName = "loki"
+
+	SessionSucceeded = "succeeded"
+	SessionFailed    = "failed"
+
+	TokenIssued    = "issued"
+	TokenRefreshed = "refreshed"
+	TokenStepUp    = "step_up"
+)
+
+// Instruments are created on the global meter, they record nothing until NewMetrics installs the provider
+var (
+	meter = otel.Meter(MeterName)
+
+	requestDuration, _ = meter.Float64Histogram(
+		"http.server.request.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of HTTP requests by route, method and status code"),
+	)
+	sessionsStarted, _ = meter.Int64Counter(
+		"loki.authentication.sessions.started",
+		metric.WithDescription("Smart-ID and Mobile-ID authentication sessions started by provider"),
+	)
+	sessionsFinished, _ = meter.Int64Counter(
+		"loki.authentication.sessions.finished",
+		metric.WithDescription("Authentication sessions finished by provider, result and SK error code"),
+	)
+	tokens, _ = meter.Int64Counter(
+		"loki.tokens",
+		metric.WithDescription("Access tokens issued by operation"),
+	)
+)
+
+// RecordRequest records the duration of an HTTP request, the route is the pattern such as '/api/sessions/{id}'
+func RecordRequest(ctx context.Context, method, route string, status int, duration time.Duration) {
+	requestDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(
+		attribute.String("http.request.method", method),
+		attribute.String("http.route", route),
+		attribute.String("http.response.status_code", strconv.Itoa(status)),
+	))
+}
+
+// RecordSessionStarted counts an authentication session accepted by the provider
+func RecordSessionStarted(ctx context.Context, provider string) {
+	sessionsStarted.Add(ctx, 1, metric.WithAttributes(attribute.String("provider", provider)))
+}
+
+// RecordSessionFinished counts the outcome of an authentication session, the code is empty unless SK has refused it
+func RecordSessionFinished(ctx context.Context, provider string, err error, code string) {
+	result := SessionSucceeded
+	if err != nil {
+		result = SessionFailed
+	}
+
+	sessionsFinished.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("provider", provider),
+		attribute.String("result", result),
+		attribute.String("code", code),
+	))
+}
+
+// RecordToken counts an access token issued by login, refresh or step-up
+func RecordToken(ctx context.Context, operation string) {
+	tokens.Add(ctx, 1, metric.WithAttributes(attribute.String("operation", operation)))
+}
+
+// workerUsage is the number of jobs handed to an SK session worker and its configured limits
+type workerUsage struct {
+	concurrency int64
+	queueSize   int64
+	jobs        int64
+}
+
+var (
+	workersMu sync.Mutex
+	workers   = make(map[string]*workerUsage)
+)
+
+func init() {
+	active, _ := meter.Int64ObservableGauge(
+		"loki.worker.jobs.active",
+		metric.WithDescription("Jobs a worker is polling, at most its concurrency"),
+	)
+	queued, _ := meter.Int64ObservableGauge(
+		"loki.worker.jobs.queued",
+		metric.WithDescription("Jobs waiting for a free worker goroutine"),
+	)
+	concurrency, _ := meter.Int64ObservableGauge(
+		"loki.worker.concurrency",
+		metric.WithDescription("Configured number of worker goroutines"),
+	)
+	capacity, _ := meter.Int64ObservableGauge(
+		"loki.worker.queue.capacity",
+		metric.WithDescription("Configured size of the worker queue"),
+	)
+
+	_, _ = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		workersMu.Lock()
+		defer workersMu.Unlock()
+
+		for name, usage := range workers {
+			attrs := metric.WithAttributes(attribute.String("worker", name))
+
+			o.ObserveInt64(active, min(usage.jobs, usage.concurrency), attrs)
+			o.ObserveInt64(queued, max(usage.jobs-usage.concurrency, 0), attrs)
+			o.ObserveInt64(concurrency, usage.concurrency, attrs)
+			o.ObserveInt64(capacity, usage.queueSize, attrs)
+		}
+
+		return nil
+	}, active, queued, concurrency, capacity)
+}
+
+// RegisterWorker reports the limits of a worker, jobs beyond its concurrency are counted as queued
+func RegisterWorker(name string, concurrency, queueSize int) {
+	workersMu.Lock()
+	defer workersMu.Unlock()
+
+	workers[name] = &workerUsage{concurrency: int64(concurrency), queueSize: int64(queueSize)}
+}
+
+// RecordWorkerJob adds delta to the jobs handed to the worker, +1 when a session is queued and -1 once it has a result
+func RecordWorkerJob(name string, delta int64) {
+	workersMu.Lock()
+	defer workersMu.Unlock()
+
+	if usage, ok := workers[name]; ok {
+		usage.jobs += delta
+	}
+}
@@ -0,0 +1,65 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	exporter "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/semconv/v1.17.0"
+
+	"loki/internal/config"
+)
+
+// Metrics exposes the recorded instruments in the Prometheus text format
+type Metrics interface {
+	Handler() http.Handler
+	Shutdown(ctx context.Context) error
+}
+
+type metrics struct {
+	provider *metric.MeterProvider
+	handler  http.Handler
+}
+
+// NewMetrics installs the global meter provider, the Go runtime and process collectors are exported alongside
+func NewMetrics(cfg *config.Config) (Metrics, error) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	reader, err := exporter.New(exporter.WithRegisterer(registry))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := metric.NewMeterProvider(
+		metric.WithReader(reader),
+		metric.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(cfg.AppName),
+		)),
+	)
+
+	otel.SetMeterProvider(provider)
+
+	return &metrics{
+		provider: provider,
+		handler:  promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+	}, nil
+}
+
+func (m *metrics) Handler() http.Handler {
+	return m.handler
+}
+
+func (m *metrics) Shutdown(ctx context.Context) error {
+	return m.provider.Shutdown(ctx)
+}
@@ -0,0 +1,70 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+func Test_NewMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	metrics, err := NewMetrics(&config.Config{AppName: "loki"})
+	require.NoError(t, err)
+	defer metrics.Shutdown(ctx)
+
+	RegisterWorker("SmartId::Worker", 5, 15)
+	RecordWorkerJob("SmartId::Worker", 7)
+	RecordRequest(ctx, http.MethodGet, "/api/sessions/{id}", http.StatusOK, 25*time.Millisecond)
+	RecordSessionStarted(ctx, "smart_id")
+	RecordSessionFinished(ctx, "smart_id", errors.ErrSessionCancelled, "USER_REFUSED")
+	RecordToken(ctx, TokenRefreshed)
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "Request duration",
+			expected: `http_server_request_duration_seconds_count{http_request_method="GET",http_response_status_code="200",http_route="/api/sessions/{id}"`,
+		},
+		{
+			name:     "Sessions started",
+			expected: `loki_authentication_sessions_started_total{otel_scope_name="loki",otel_scope_version="",provider="smart_id"} 1`,
+		},
+		{
+			name:     "Sessions finished",
+			expected: `loki_authentication_sessions_finished_total{code="USER_REFUSED",otel_scope_name="loki",otel_scope_version="",provider="smart_id",result="failed"} 1`,
+		},
+		{
+			name:     "Tokens",
+			expected: `loki_tokens_total{operation="refreshed",otel_scope_name="loki",otel_scope_version=""} 1`,
+		},
+		{
+			name:     "Active jobs",
+			expected: `loki_worker_jobs_active{otel_scope_name="loki",otel_scope_version="",worker="SmartId::Worker"} 5`,
+		},
+		{
+			name:     "Queued jobs",
+			expected: `loki_worker_jobs_queued{otel_scope_name="loki",otel_scope_version="",worker="SmartId::Worker"} 2`,
+		},
+	}
+
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := w.Body.String()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Contains(t, body, tt.expected)
+		})
+	}
+}
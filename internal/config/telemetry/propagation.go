@@ -0,0 +1,53 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceIdHeader is the legacy correlation header, read only when the request has no traceparent
+const TraceIdHeader = "X-Trace-ID"
+
+// NewPropagator reads and writes W3C trace context and baggage, falling back to the X-Trace-ID header
+func NewPropagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+		traceIdPropagator{},
+	)
+}
+
+// traceIdPropagator continues the trace of a X-Trace-ID uuid for clients that do not send traceparent yet
+type traceIdPropagator struct{}
+
+func (traceIdPropagator) Inject(context.Context, propagation.TextMapCarrier) {}
+
+func (traceIdPropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+
+	traceId, err := trace.TraceIDFromHex(strings.ToLower(strings.ReplaceAll(carrier.Get(TraceIdHeader), "-", "")))
+	if err != nil {
+		return ctx
+	}
+
+	// the caller has no span of its own, a random parent span id keeps the span context valid
+	var spanId trace.SpanID
+	_, _ = rand.Read(spanId[:])
+
+	return trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceId,
+		SpanID:     spanId,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	}))
+}
+
+func (traceIdPropagator) Fields() []string {
+	return []string{TraceIdHeader}
+}
@@ -0,0 +1,100 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Test_Propagator_Extract(t *testing.T) {
+	propagator := NewPropagator()
+
+	type result struct {
+		valid   bool
+		traceId string
+		spanId  string
+	}
+
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		expected result
+	}{
+		{
+			name: "Traceparent",
+			headers: map[string]string{
+				"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			},
+			expected: result{
+				valid:   true,
+				traceId: "4bf92f3577b34da6a3ce929d0e0e4736",
+				spanId:  "00f067aa0ba902b7",
+			},
+		},
+		{
+			name: "Traceparent takes precedence over X-Trace-ID",
+			headers: map[string]string{
+				"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				TraceIdHeader: "8f963243-726d-4603-af9c-271eeb15c4a2",
+			},
+			expected: result{
+				valid:   true,
+				traceId: "4bf92f3577b34da6a3ce929d0e0e4736",
+				spanId:  "00f067aa0ba902b7",
+			},
+		},
+		{
+			name: "X-Trace-ID fallback",
+			headers: map[string]string{
+				TraceIdHeader: "8F963243-726D-4603-AF9C-271EEB15C4A2",
+			},
+			expected: result{
+				valid:   true,
+				traceId: "8f963243726d4603af9c271eeb15c4a2",
+			},
+		},
+		{
+			name: "Invalid X-Trace-ID",
+			headers: map[string]string{
+				TraceIdHeader: "invalid",
+			},
+			expected: result{
+				valid: false,
+			},
+		},
+		{
+			name:    "No headers",
+			headers: map[string]string{},
+			expected: result{
+				valid: false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for key, value := range tt.headers {
+				header.Set(key, value)
+			}
+
+			ctx := propagator.Extract(context.Background(), propagation.HeaderCarrier(header))
+			spanCtx := trace.SpanContextFromContext(ctx)
+
+			assert.Equal(t, tt.expected.valid, spanCtx.IsValid())
+			if !tt.expected.valid {
+				return
+			}
+
+			assert.True(t, spanCtx.IsRemote())
+			assert.Equal(t, tt.expected.traceId, spanCtx.TraceID().String())
+			if tt.expected.spanId != "" {
+				assert.Equal(t, tt.expected.spanId, spanCtx.SpanID().String())
+			}
+		})
+	}
+}
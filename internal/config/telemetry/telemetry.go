@@ -18,6 +18,8 @@ type TraceProvider interface {
 }
 
 func NewTelemetry(ctx context.Context, cfg *config.Config) (*trace.TracerProvider, error) {
+	otel.SetTextMapPropagator(NewPropagator())
+
 	exporter, err := otlptrace.New(ctx, otlptracegrpc.NewClient(
 		otlptracegrpc.WithInsecure(),
 		otlptracegrpc.WithEndpoint(cfg.TelemetryURI),
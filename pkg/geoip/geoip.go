@@ -0,0 +1,169 @@
+package geoip
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+// EarthRadius is the mean radius of the Earth in kilometres
+const EarthRadius = 6371.0
+
+// Location is the country and the coordinates an address is registered to
+type Location struct {
+	Country   string
+	Latitude  float64
+	Longitude float64
+}
+
+// Distance returns the great-circle distance to the other location in kilometres
+func (l *Location) Distance(other *Location) float64 {
+	lat1, lat2 := radians(l.Latitude), radians(other.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(other.Longitude - l.Longitude)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+type Database interface {
+	Lookup(ip string) (*Location, bool)
+}
+
+// block is a network of the database, blocks are sorted by their first address and do not overlap
+type block struct {
+	from     netip.Addr
+	to       netip.Addr
+	location Location
+}
+
+type database struct {
+	blocks []block
+}
+
+// NewDatabase loads the CSV file configured in GEOIP_DATABASE_PATH, without it every lookup misses
+func NewDatabase(cfg *config.Config) (Database, error) {
+	if cfg.Risk.GeoIPPath == "" {
+		return &database{}, nil
+	}
+
+	file, err := os.Open(cfg.Risk.GeoIPPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Load(file)
+}
+
+// Load reads 'network,country,latitude,longitude' rows, a header row and comments starting with '#' are skipped
+func Load(r io.Reader) (Database, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	blocks := make([]block, 0)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrInvalidGeoIPDatabase, err)
+		}
+
+		if line == 1 && record[0] == "network" {
+			continue
+		}
+
+		b, err := parseBlock(record)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d", errors.ErrInvalidGeoIPDatabase, line)
+		}
+		blocks = append(blocks, b)
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].from.Less(blocks[j].from)
+	})
+
+	return &database{blocks: blocks}, nil
+}
+
+// Lookup finds the network the address belongs to
+func (d *database) Lookup(ip string) (*Location, bool) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil, false
+	}
+	addr = addr.Unmap()
+
+	i := sort.Search(len(d.blocks), func(i int) bool {
+		return addr.Less(d.blocks[i].from)
+	})
+	if i == 0 {
+		return nil, false
+	}
+
+	b := d.blocks[i-1]
+	if b.to.Less(addr) || b.from.BitLen() != addr.BitLen() {
+		return nil, false
+	}
+
+	location := b.location
+	return &location, true
+}
+
+func parseBlock(record []string) (block, error) {
+	prefix, err := netip.ParsePrefix(record[0])
+	if err != nil {
+		return block{}, err
+	}
+	prefix = prefix.Masked()
+
+	latitude, err := strconv.ParseFloat(record[2], 64)
+	if err != nil {
+		return block{}, err
+	}
+
+	longitude, err := strconv.ParseFloat(record[3], 64)
+	if err != nil {
+		return block{}, err
+	}
+
+	return block{
+		from: prefix.Addr(),
+		to:   lastAddr(prefix),
+		location: Location{
+			Country:   strings.ToUpper(record[1]),
+			Latitude:  latitude,
+			Longitude: longitude,
+		},
+	}, nil
+}
+
+// lastAddr returns the broadcast address of the network
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	bytes := prefix.Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 0x80 >> (bit % 8)
+	}
+
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
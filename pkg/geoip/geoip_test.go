@@ -0,0 +1,165 @@
+package geoip
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+const testDatabase = `network,country,latitude,longitude
+# documentation ranges
+192.0.2.0/24,ee,59.437,24.7536
+198.51.100.0/25,LV,56.9496,24.1052
+203.0.113.0/24,US,40.7128,-74.006
+2001:db8::/32,FI,60.1699,24.9384
+`
+
+func Test_NewDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "geoip.csv")
+	require.NoError(t, os.WriteFile(path, []byte(testDatabase), 0o600))
+
+	tests := []struct {
+		name  string
+		path  string
+		found bool
+		error bool
+	}{
+		{
+			name:  "Success",
+			path:  path,
+			found: true,
+		},
+		{
+			name:  "Without database",
+			path:  "",
+			found: false,
+		},
+		{
+			name:  "Missing file",
+			path:  filepath.Join(t.TempDir(), "missing.csv"),
+			error: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewDatabase(&config.Config{Risk: config.Risk{GeoIPPath: tt.path}})
+
+			if tt.error {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			_, found := result.Lookup("192.0.2.10")
+			assert.Equal(t, tt.found, found)
+		})
+	}
+}
+
+func Test_Load(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		error error
+	}{
+		{
+			name:  "Success",
+			input: testDatabase,
+		},
+		{
+			name:  "Invalid network",
+			input: "192.0.2.0/33,EE,59.437,24.7536\n",
+			error: errors.ErrInvalidGeoIPDatabase,
+		},
+		{
+			name:  "Invalid coordinates",
+			input: "192.0.2.0/24,EE,north,24.7536\n",
+			error: errors.ErrInvalidGeoIPDatabase,
+		},
+		{
+			name:  "Missing columns",
+			input: "192.0.2.0/24,EE\n",
+			error: errors.ErrInvalidGeoIPDatabase,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Load(strings.NewReader(tt.input))
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+}
+
+func Test_Database_Lookup(t *testing.T) {
+	db, err := Load(strings.NewReader(testDatabase))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		ip       string
+		expected *Location
+	}{
+		{
+			name:     "IPv4",
+			ip:       "192.0.2.255",
+			expected: &Location{Country: "EE", Latitude: 59.437, Longitude: 24.7536},
+		},
+		{
+			name:     "IPv4 mapped IPv6",
+			ip:       "::ffff:203.0.113.7",
+			expected: &Location{Country: "US", Latitude: 40.7128, Longitude: -74.006},
+		},
+		{
+			name:     "IPv6",
+			ip:       "2001:db8::1",
+			expected: &Location{Country: "FI", Latitude: 60.1699, Longitude: 24.9384},
+		},
+		{
+			name: "Outside of network",
+			ip:   "198.51.100.200",
+		},
+		{
+			name: "Unknown address",
+			ip:   "10.0.0.1",
+		},
+		{
+			name: "Invalid address",
+			ip:   "localhost",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, found := db.Lookup(tt.ip)
+
+			assert.Equal(t, tt.expected != nil, found)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Location_Distance(t *testing.T) {
+	tallinn := &Location{Latitude: 59.437, Longitude: 24.7536}
+	newYork := &Location{Latitude: 40.7128, Longitude: -74.006}
+
+	assert.InDelta(t, 0, tallinn.Distance(tallinn), 0.001)
+	assert.InDelta(t, 6650, tallinn.Distance(newYork), 10)
+	assert.InDelta(t, tallinn.Distance(newYork), newYork.Distance(tallinn), 0.001)
+}
@@ -0,0 +1,7 @@
+package geoip
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewDatabase),
+)
@@ -2,6 +2,7 @@ package jwt
 
 import (
 	"crypto/rsa"
+	"encoding/base64"
 	"os"
 	"path/filepath"
 	"time"
@@ -19,16 +20,35 @@ const (
 )
 
 type Payload struct {
-	ID          string   `json:"id"`
+	ID            string                  `json:"id"`
+	SessionID     string                  `json:"sid,omitempty"`
+	Roles         []string                `json:"roles,omitempty"`
+	Permissions   []string                `json:"permissions,omitempty"`
+	Scope         []string                `json:"scope,omitempty"`
+	Organisations map[string]Organisation `json:"organisations,omitempty"`
+
+	AuthTime int64    `json:"auth_time,omitempty"`
+	AMR      []string `json:"amr,omitempty"`
+	ACR      string   `json:"acr,omitempty"`
+
+	Email         string `json:"email,omitempty"`
+	EmailVerified bool   `json:"email_verified,omitempty"`
+	PhoneNumber   string `json:"phone_number,omitempty"`
+	Locale        string `json:"locale,omitempty"`
+}
+
+// Organisation holds the roles and permissions granted within a single organisation
+type Organisation struct {
 	Roles       []string `json:"roles,omitempty"`
 	Permissions []string `json:"permissions,omitempty"`
-	Scope       []string `json:"scope,omitempty"`
 }
 
 type Jwt interface {
 	Generate(payload Payload, duration time.Duration) (string, error)
 	Verify(token string) (bool, error)
 	Decode(token string) (*Payload, error)
+	Sign(data []byte) (string, error)
+	VerifySignature(data []byte, signature string) error
 }
 
 type jwtService struct {
@@ -39,9 +59,25 @@ type jwtService struct {
 
 type Claims struct {
 	jwt.RegisteredClaims
-	Roles       []string `json:"roles,omitempty"`
-	Permissions []string `json:"permissions,omitempty"`
-	Scope       []string `json:"scope,omitempty"`
+	// SessionID identifies the login session the token family belongs to
+	SessionID     string                  `json:"sid,omitempty"`
+	Roles         []string                `json:"roles,omitempty"`
+	Permissions   []string                `json:"permissions,omitempty"`
+	Scope         []string                `json:"scope,omitempty"`
+	Organisations map[string]Organisation `json:"organisations,omitempty"`
+
+	// AuthTime is the unix time the user last authenticated with Smart-ID or Mobile-ID
+	AuthTime int64 `json:"auth_time,omitempty"`
+	// AMR lists the authentication methods used, e.g. 'smart_id'
+	AMR []string `json:"amr,omitempty"`
+	// ACR is the authentication context class the token was issued at, e.g. 'step_up'
+	ACR string `json:"acr,omitempty"`
+
+	// profile claims, present only when enabled in the configuration
+	Email         string `json:"email,omitempty"`
+	EmailVerified bool   `json:"email_verified,omitempty"`
+	PhoneNumber   string `json:"phone_number,omitempty"`
+	Locale        string `json:"locale,omitempty"`
 }
 
 func NewJWT(cfg *config.Config) (Jwt, error) {
@@ -63,9 +99,18 @@ func (j *jwtService) Generate(payload Payload, duration time.Duration) (string,
 			ID:        payload.ID,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
 		},
-		Roles:       payload.Roles,
-		Permissions: payload.Permissions,
-		Scope:       payload.Scope,
+		SessionID:     payload.SessionID,
+		Roles:         payload.Roles,
+		Permissions:   payload.Permissions,
+		Scope:         payload.Scope,
+		Organisations: payload.Organisations,
+		AuthTime:      payload.AuthTime,
+		AMR:           payload.AMR,
+		ACR:           payload.ACR,
+		Email:         payload.Email,
+		EmailVerified: payload.EmailVerified,
+		PhoneNumber:   payload.PhoneNumber,
+		Locale:        payload.Locale,
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
@@ -120,13 +165,42 @@ func (j *jwtService) Decode(token string) (*Payload, error) {
 	}
 
 	return &Payload{
-		ID:          claims.ID,
-		Roles:       claims.Roles,
-		Permissions: claims.Permissions,
-		Scope:       claims.Scope,
+		ID:            claims.ID,
+		SessionID:     claims.SessionID,
+		Roles:         claims.Roles,
+		Permissions:   claims.Permissions,
+		Scope:         claims.Scope,
+		Organisations: claims.Organisations,
+		AuthTime:      claims.AuthTime,
+		AMR:           claims.AMR,
+		ACR:           claims.ACR,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		PhoneNumber:   claims.PhoneNumber,
+		Locale:        claims.Locale,
 	}, nil
 }
 
+// Sign returns the RS256 signature of the data made with the token signing key
+func (j *jwtService) Sign(data []byte) (string, error) {
+	signature, err := jwt.SigningMethodRS256.Sign(string(data), j.privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// VerifySignature checks a signature created by Sign against the public key
+func (j *jwtService) VerifySignature(data []byte, signature string) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return err
+	}
+
+	return jwt.SigningMethodRS256.Verify(string(data), decoded, j.publicKey)
+}
+
 func loadKeys(cfg *config.Config) (*rsa.PrivateKey, *rsa.PublicKey, error) {
 	privateKey, err := loadPrivateKey(cfg)
 	if err != nil {
@@ -70,6 +70,21 @@ func (mr *MockJwtMockRecorder) Generate(payload, duration any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockJwt)(nil).Generate), payload, duration)
 }
 
+// Sign mocks base method.
+func (m *MockJwt) Sign(data []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockJwtMockRecorder) Sign(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockJwt)(nil).Sign), data)
+}
+
 // Verify mocks base method.
 func (m *MockJwt) Verify(token string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -84,3 +99,17 @@ func (mr *MockJwtMockRecorder) Verify(token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockJwt)(nil).Verify), token)
 }
+
+// VerifySignature mocks base method.
+func (m *MockJwt) VerifySignature(data []byte, signature string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySignature", data, signature)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifySignature indicates an expected call of VerifySignature.
+func (mr *MockJwtMockRecorder) VerifySignature(data, signature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySignature", reflect.TypeOf((*MockJwt)(nil).VerifySignature), data, signature)
+}
@@ -119,6 +119,57 @@ func Test_JWT_Verify(t *testing.T) {
 	}
 }
 
+func Test_JWT_SignAndVerifySignature(t *testing.T) {
+	tempDir := generateTestKeys(t)
+
+	cfg := &config.Config{
+		CertPath: tempDir,
+	}
+	service, err := NewJWT(cfg)
+	require.NoError(t, err)
+
+	signature, err := service.Sign([]byte("42:checkpoint"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		data      []byte
+		signature string
+		error     bool
+	}{
+		{
+			name:      "Success",
+			data:      []byte("42:checkpoint"),
+			signature: signature,
+			error:     false,
+		},
+		{
+			name:      "Tampered data",
+			data:      []byte("43:checkpoint"),
+			signature: signature,
+			error:     true,
+		},
+		{
+			name:      "Malformed signature",
+			data:      []byte("42:checkpoint"),
+			signature: "not base64!",
+			error:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.VerifySignature(tt.data, tt.signature)
+
+			if tt.error {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_JWT_Verify_Mocked(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -215,6 +266,49 @@ func Test_JWT_Decode(t *testing.T) {
 				Scope:       []string{"service-name"},
 			},
 		},
+		{
+			name: "Profile claims",
+			payload: Payload{
+				ID:            "PNOEE-30303039914",
+				Email:         "john.doe@example.com",
+				EmailVerified: true,
+				PhoneNumber:   "+37268000769",
+				Locale:        "et",
+			},
+			expected: &Payload{
+				ID:            "PNOEE-30303039914",
+				Email:         "john.doe@example.com",
+				EmailVerified: true,
+				PhoneNumber:   "+37268000769",
+				Locale:        "et",
+			},
+		},
+		{
+			name: "Session claim",
+			payload: Payload{
+				ID:        "PNOEE-30303039914",
+				SessionID: "f4c8d7d2-2b4e-4c5e-9a4d-6a0f3f5a1b2c",
+			},
+			expected: &Payload{
+				ID:        "PNOEE-30303039914",
+				SessionID: "f4c8d7d2-2b4e-4c5e-9a4d-6a0f3f5a1b2c",
+			},
+		},
+		{
+			name: "Authentication claims",
+			payload: Payload{
+				ID:       "PNOEE-30303039914",
+				AuthTime: 1700000000,
+				AMR:      []string{"smart_id"},
+				ACR:      "step_up",
+			},
+			expected: &Payload{
+				ID:       "PNOEE-30303039914",
+				AuthTime: 1700000000,
+				AMR:      []string{"smart_id"},
+				ACR:      "step_up",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,104 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"time"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+// Message is a plain text email to a single recipient
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// NewMailer returns the delivery driver selected in the configuration
+func NewMailer(cfg *config.Config) (Mailer, error) {
+	switch cfg.Mailer.Driver {
+	case config.MailerFile:
+		return &fileMailer{from: cfg.Mailer.From, path: cfg.Mailer.Path}, nil
+	case config.MailerSMTP:
+		return &smtpMailer{
+			from:     cfg.Mailer.From,
+			addr:     cfg.Mailer.SMTPAddr,
+			username: cfg.Mailer.SMTPUsername,
+			password: cfg.Mailer.SMTPPassword,
+		}, nil
+	default:
+		return nil, errors.ErrInvalidMailerDriver
+	}
+}
+
+// fileMailer stores every message as an .eml file instead of delivering it
+type fileMailer struct {
+	from string
+	path string
+}
+
+func (m *fileMailer) Send(_ context.Context, message Message) error {
+	if err := os.MkdirAll(m.path, 0o750); err != nil {
+		return err
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102150405"), hex.EncodeToString(suffix))
+
+	return os.WriteFile(filepath.Join(m.path, name), compose(m.from, message), 0o600)
+}
+
+type smtpMailer struct {
+	from     string
+	addr     string
+	username string
+	password string
+}
+
+func (m *smtpMailer) Send(_ context.Context, message Message) error {
+	var auth smtp.Auth
+	if m.username != "" {
+		host, _, err := net.SplitHostPort(m.addr)
+		if err != nil {
+			return err
+		}
+
+		auth = smtp.PlainAuth("", m.username, m.password, host)
+	}
+
+	return smtp.SendMail(m.addr, auth, m.from, []string{message.To}, compose(m.from, message))
+}
+
+// compose renders the message with the headers of a UTF-8 plain text email
+func compose(from string, message Message) []byte {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", message.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(message.Body)
+
+	return buf.Bytes()
+}
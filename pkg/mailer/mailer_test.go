@@ -0,0 +1,146 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+func Test_NewMailer(t *testing.T) {
+	tests := []struct {
+		name   string
+		driver string
+		error  error
+	}{
+		{
+			name:   "File",
+			driver: config.MailerFile,
+		},
+		{
+			name:   "SMTP",
+			driver: config.MailerSMTP,
+		},
+		{
+			name:   "Unknown driver",
+			driver: "sendmail",
+			error:  errors.ErrInvalidMailerDriver,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewMailer(&config.Config{Mailer: config.Mailer{Driver: tt.driver}})
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+}
+
+func Test_FileMailer_Send(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+
+	service, err := NewMailer(&config.Config{
+		Mailer: config.Mailer{Driver: config.MailerFile, From: "sso@example.com", Path: dir},
+	})
+	require.NoError(t, err)
+
+	err = service.Send(context.Background(), Message{
+		To:      "john.doe@example.com",
+		Subject: "Kinnituskood",
+		Body:    "123456",
+	})
+	assert.NoError(t, err)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.True(t, strings.HasSuffix(files[0].Name(), ".eml"))
+
+	content, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "From: sso@example.com\r\n")
+	assert.Contains(t, string(content), "To: john.doe@example.com\r\n")
+	assert.Contains(t, string(content), "Subject: Kinnituskood\r\n")
+	assert.True(t, strings.HasSuffix(string(content), "\r\n\r\n123456"))
+}
+
+func Test_SmtpMailer_Send(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	received := make(chan string, 1)
+	go serveSMTP(listener, received)
+
+	service, err := NewMailer(&config.Config{
+		Mailer: config.Mailer{Driver: config.MailerSMTP, From: "sso@example.com", SMTPAddr: listener.Addr().String()},
+	})
+	require.NoError(t, err)
+
+	err = service.Send(context.Background(), Message{
+		To:      "john.doe@example.com",
+		Subject: "Verify your email",
+		Body:    "123456",
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, <-received, "To: john.doe@example.com")
+}
+
+// serveSMTP accepts a single unauthenticated delivery and reports the message data
+func serveSMTP(listener net.Listener, received chan<- string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		switch command := strings.ToUpper(strings.TrimSpace(line)); {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "DATA"):
+			reply("354 end with <CRLF>.<CRLF>")
+
+			var data strings.Builder
+			for {
+				line, err = reader.ReadString('\n')
+				if err != nil || line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			received <- data.String()
+			reply("250 OK")
+		case strings.HasPrefix(command, "QUIT"):
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
@@ -0,0 +1,7 @@
+package mailer
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewMailer),
+)
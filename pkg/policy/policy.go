@@ -0,0 +1,251 @@
+package policy
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+
+	"loki/internal/app/errors"
+	"loki/pkg/jwt"
+)
+
+type Effect string
+
+const (
+	Allow Effect = "allow"
+	Deny  Effect = "deny"
+
+	// AnyAction matches every action
+	AnyAction = "*"
+)
+
+// Policy is a CEL condition applied to the listed actions
+type Policy struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Actions     []string `json:"actions"`
+	Effect      Effect   `json:"effect"`
+	Condition   string   `json:"condition"`
+}
+
+type Resource struct {
+	Type           string
+	ID             string
+	OrganisationID string
+	Attributes     map[string]interface{}
+}
+
+type Request struct {
+	Principal *jwt.Payload
+	Action    string
+	Resource  Resource
+	Context   map[string]interface{}
+}
+
+// Engine decides whether a request is allowed: a matching deny policy always wins,
+// and when allow policies exist for the action at least one of them must match
+type Engine interface {
+	Evaluate(req *Request) (bool, error)
+	Load(policies []Policy) error
+}
+
+type compiled struct {
+	policy  Policy
+	program cel.Program
+}
+
+type engine struct {
+	env      *cel.Env
+	mu       sync.RWMutex
+	policies []compiled
+}
+
+func NewEngine(policies []Policy) (Engine, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("principal", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("action", cel.StringType),
+		cel.Variable("resource", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("context", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &engine{env: env}
+	if err = e.Load(policies); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Load compiles the policies and replaces the current set only if all of them are valid
+func (e *engine) Load(policies []Policy) error {
+	result := make([]compiled, 0, len(policies))
+
+	for _, p := range policies {
+		if p.Effect != Allow && p.Effect != Deny {
+			return errors.ErrInvalidPolicyEffect
+		}
+
+		if len(p.Actions) == 0 || p.Condition == "" {
+			return errors.ErrInvalidPolicy
+		}
+
+		ast, issues := e.env.Compile(p.Condition)
+		if issues != nil && issues.Err() != nil {
+			return errors.ErrInvalidPolicy
+		}
+
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return errors.ErrInvalidPolicy
+		}
+
+		program, err := e.env.Program(ast)
+		if err != nil {
+			return errors.ErrInvalidPolicy
+		}
+
+		result = append(result, compiled{policy: p, program: program})
+	}
+
+	e.mu.Lock()
+	e.policies = result
+	e.mu.Unlock()
+
+	return nil
+}
+
+func (e *engine) Evaluate(req *Request) (bool, error) {
+	e.mu.RLock()
+	policies := e.policies
+	e.mu.RUnlock()
+
+	vars := map[string]interface{}{
+		"principal": principal(req.Principal),
+		"action":    req.Action,
+		"resource":  resource(req.Resource),
+		"context":   contextValues(req.Context),
+	}
+
+	var hasAllow, allowed bool
+
+	for _, p := range policies {
+		if !matches(p.policy.Actions, req.Action) {
+			continue
+		}
+
+		if p.policy.Effect == Allow {
+			hasAllow = true
+		}
+
+		value, _, err := p.program.Eval(vars)
+		if err != nil {
+			return false, errors.ErrPolicyEvaluation
+		}
+
+		result, ok := value.(types.Bool)
+		if !ok {
+			return false, errors.ErrPolicyEvaluation
+		}
+
+		if !result {
+			continue
+		}
+
+		if p.policy.Effect == Deny {
+			return false, nil
+		}
+
+		allowed = true
+	}
+
+	return !hasAllow || allowed, nil
+}
+
+// LoadFile reads policies from a JSON file
+func LoadFile(path string) ([]Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []Policy
+	if err = json.Unmarshal(data, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func matches(actions []string, action string) bool {
+	for _, a := range actions {
+		if a == AnyAction || a == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+func principal(claim *jwt.Payload) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":            "",
+		"roles":         []string{},
+		"permissions":   []string{},
+		"scope":         []string{},
+		"organisations": map[string]interface{}{},
+	}
+
+	if claim == nil {
+		return result
+	}
+
+	organisations := make(map[string]interface{}, len(claim.Organisations))
+	for id, organisation := range claim.Organisations {
+		organisations[id] = map[string]interface{}{
+			"roles":       nonNil(organisation.Roles),
+			"permissions": nonNil(organisation.Permissions),
+		}
+	}
+
+	result["id"] = claim.ID
+	result["roles"] = nonNil(claim.Roles)
+	result["permissions"] = nonNil(claim.Permissions)
+	result["scope"] = nonNil(claim.Scope)
+	result["organisations"] = organisations
+
+	return result
+}
+
+func resource(r Resource) map[string]interface{} {
+	result := make(map[string]interface{}, len(r.Attributes)+3)
+	for key, value := range r.Attributes {
+		result[key] = value
+	}
+
+	result["type"] = r.Type
+	result["id"] = r.ID
+	result["organisation_id"] = r.OrganisationID
+
+	return result
+}
+
+func contextValues(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return map[string]interface{}{}
+	}
+
+	return values
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
@@ -0,0 +1,229 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+	"loki/pkg/jwt"
+)
+
+const (
+	ownOrganisation   = "10000000-1000-1000-4000-000000000001"
+	otherOrganisation = "10000000-1000-1000-4000-000000000002"
+)
+
+var examplePolicies = []Policy{
+	{
+		Name:      "managers-own-organisation",
+		Actions:   []string{"write:users"},
+		Effect:    Deny,
+		Condition: `"manager" in principal.roles && !("admin" in principal.roles) && !(resource.organisation_id in principal.organisations)`,
+	},
+	{
+		Name:      "token-deletion-business-hours",
+		Actions:   []string{"write:tokens"},
+		Effect:    Allow,
+		Condition: `"admin" in principal.roles || (context.time.getHours("Europe/Tallinn") >= 9 && context.time.getHours("Europe/Tallinn") < 18)`,
+	},
+}
+
+func Test_NewEngine(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies []Policy
+		error    error
+	}{
+		{
+			name:     "Success",
+			policies: examplePolicies,
+			error:    nil,
+		},
+		{
+			name:     "Success without policies",
+			policies: nil,
+			error:    nil,
+		},
+		{
+			name:     "Error invalid effect",
+			policies: []Policy{{Name: "invalid", Actions: []string{AnyAction}, Effect: "maybe", Condition: "true"}},
+			error:    errors.ErrInvalidPolicyEffect,
+		},
+		{
+			name:     "Error without actions",
+			policies: []Policy{{Name: "invalid", Effect: Allow, Condition: "true"}},
+			error:    errors.ErrInvalidPolicy,
+		},
+		{
+			name:     "Error invalid syntax",
+			policies: []Policy{{Name: "invalid", Actions: []string{AnyAction}, Effect: Allow, Condition: "principal.roles &&"}},
+			error:    errors.ErrInvalidPolicy,
+		},
+		{
+			name:     "Error non-boolean condition",
+			policies: []Policy{{Name: "invalid", Actions: []string{AnyAction}, Effect: Allow, Condition: "action + 'x'"}},
+			error:    errors.ErrInvalidPolicy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewEngine(tt.policies)
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+}
+
+func Test_Engine_Evaluate(t *testing.T) {
+	engine, err := NewEngine(examplePolicies)
+	assert.NoError(t, err)
+
+	location, err := time.LoadLocation("Europe/Tallinn")
+	assert.NoError(t, err)
+
+	businessHours := time.Date(2026, 10, 19, 10, 0, 0, 0, location)
+	night := time.Date(2026, 10, 19, 23, 0, 0, 0, location)
+
+	manager := &jwt.Payload{
+		ID:          "PNOEE-60001017869",
+		Roles:       []string{"manager", "user"},
+		Permissions: []string{"read:users", "write:users", "write:tokens"},
+		Organisations: map[string]jwt.Organisation{
+			ownOrganisation: {Roles: []string{"manager"}},
+		},
+	}
+	admin := &jwt.Payload{
+		ID:          "PNOEE-50001029996",
+		Roles:       []string{"admin"},
+		Permissions: []string{"write:users", "write:tokens"},
+	}
+
+	tests := []struct {
+		name     string
+		request  *Request
+		expected bool
+	}{
+		{
+			name: "Manager edits user in own organisation",
+			request: &Request{
+				Principal: manager,
+				Action:    "write:users",
+				Resource:  Resource{Type: "users", OrganisationID: ownOrganisation},
+			},
+			expected: true,
+		},
+		{
+			name: "Manager edits user in other organisation",
+			request: &Request{
+				Principal: manager,
+				Action:    "write:users",
+				Resource:  Resource{Type: "users", OrganisationID: otherOrganisation},
+			},
+			expected: false,
+		},
+		{
+			name: "Admin edits user in other organisation",
+			request: &Request{
+				Principal: admin,
+				Action:    "write:users",
+				Resource:  Resource{Type: "users", OrganisationID: otherOrganisation},
+			},
+			expected: true,
+		},
+		{
+			name: "Manager deletes token during business hours",
+			request: &Request{
+				Principal: manager,
+				Action:    "write:tokens",
+				Context:   map[string]interface{}{"time": businessHours},
+			},
+			expected: true,
+		},
+		{
+			name: "Manager deletes token at night",
+			request: &Request{
+				Principal: manager,
+				Action:    "write:tokens",
+				Context:   map[string]interface{}{"time": night},
+			},
+			expected: false,
+		},
+		{
+			name: "Admin deletes token at night",
+			request: &Request{
+				Principal: admin,
+				Action:    "write:tokens",
+				Context:   map[string]interface{}{"time": night},
+			},
+			expected: true,
+		},
+		{
+			name: "Action without policies",
+			request: &Request{
+				Principal: manager,
+				Action:    "read:users",
+			},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := engine.Evaluate(tt.request)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("Error missing context attribute", func(t *testing.T) {
+		result, err := engine.Evaluate(&Request{Principal: manager, Action: "write:tokens"})
+
+		assert.ErrorIs(t, err, errors.ErrPolicyEvaluation)
+		assert.False(t, result)
+	})
+
+	t.Run("Load replaces policies", func(t *testing.T) {
+		e, err := NewEngine(examplePolicies)
+		assert.NoError(t, err)
+
+		err = e.Load([]Policy{{Name: "deny-all", Actions: []string{AnyAction}, Effect: Deny, Condition: "true"}})
+		assert.NoError(t, err)
+
+		result, err := e.Evaluate(&Request{Principal: admin, Action: "read:users"})
+		assert.NoError(t, err)
+		assert.False(t, result)
+
+		err = e.Load([]Policy{{Name: "invalid", Effect: Deny, Condition: "true"}})
+		assert.ErrorIs(t, err, errors.ErrInvalidPolicy)
+
+		result, err = e.Evaluate(&Request{Principal: admin, Action: "read:users"})
+		assert.NoError(t, err)
+		assert.False(t, result)
+	})
+}
+
+func Test_LoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "policies.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`[{"name": "deny-all", "actions": ["*"], "effect": "deny", "condition": "true"}]`), 0600))
+
+	result, err := LoadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []Policy{{Name: "deny-all", Actions: []string{AnyAction}, Effect: Deny, Condition: "true"}}, result)
+
+	_, err = LoadFile(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
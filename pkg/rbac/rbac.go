@@ -1,8 +1,12 @@
 package rbac
 
+import "loki/pkg/jwt"
+
 const (
 	SsoServiceType = "sso-service"
 
+	ReadSelf         = "read:self"
+	WriteSelf        = "write:self"
 	ReadUsers        = "read:users"
 	WriteUsers       = "write:users"
 	ReadTokens       = "read:tokens"
@@ -13,10 +17,34 @@ const (
 	WriteRoles       = "write:roles"
 	ReadScopes       = "read:scopes"
 	WriteScopes      = "write:scopes"
+
+	ReadOrganisations  = "read:organisations"
+	WriteOrganisations = "write:organisations"
+
+	ReadAudit = "read:audit"
+
+	// GlobalResource is used for checks that are not scoped to an organisation
+	GlobalResource = ""
 )
 
-func HasPermission(claimPermissions []string, requiredPermission string) bool {
-	return has(claimPermissions, requiredPermission)
+// HasPermission reports whether the claim grants the permission on the resource,
+// global permissions apply to every organisation
+func HasPermission(claim *jwt.Payload, resource, requiredPermission string) bool {
+	if claim == nil {
+		return false
+	}
+
+	if has(claim.Permissions, requiredPermission) {
+		return true
+	}
+
+	if resource == GlobalResource {
+		return false
+	}
+
+	organisation, ok := claim.Organisations[resource]
+
+	return ok && has(organisation.Permissions, requiredPermission)
 }
 
 func HasScope(claimScope []string) bool {
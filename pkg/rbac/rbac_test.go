@@ -4,38 +4,93 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"loki/pkg/jwt"
 )
 
 func Test_RBAC_HasPermission(t *testing.T) {
+	organisationId := "10000000-1000-1000-4000-000000000001"
+
 	tests := []struct {
 		name               string
-		claimPermissions   []string
+		claim              *jwt.Payload
+		resource           string
 		requiredPermission string
 		expected           bool
 	}{
 		{
 			name:               "Success",
-			claimPermissions:   []string{"read:users", "write:users"},
+			claim:              &jwt.Payload{Permissions: []string{"read:users", "write:users"}},
+			resource:           GlobalResource,
 			requiredPermission: "read:users",
 			expected:           true,
 		},
 		{
 			name:               "Fail",
-			claimPermissions:   []string{"read:users", "write:users"},
+			claim:              &jwt.Payload{Permissions: []string{"read:users", "write:users"}},
+			resource:           GlobalResource,
 			requiredPermission: "read:tokens",
 			expected:           false,
 		},
 		{
 			name:               "Empty",
-			claimPermissions:   []string{},
+			claim:              &jwt.Payload{Permissions: []string{}},
+			resource:           GlobalResource,
 			requiredPermission: "read:users",
 			expected:           false,
 		},
+		{
+			name:               "Nil claim",
+			claim:              nil,
+			resource:           GlobalResource,
+			requiredPermission: "read:users",
+			expected:           false,
+		},
+		{
+			name:               "Success with global permission on organisation",
+			claim:              &jwt.Payload{Permissions: []string{"write:organisations"}},
+			resource:           organisationId,
+			requiredPermission: "write:organisations",
+			expected:           true,
+		},
+		{
+			name: "Success with organisation permission",
+			claim: &jwt.Payload{
+				Organisations: map[string]jwt.Organisation{
+					organisationId: {Permissions: []string{"write:organisations"}},
+				},
+			},
+			resource:           organisationId,
+			requiredPermission: "write:organisations",
+			expected:           true,
+		},
+		{
+			name: "Fail with permission in another organisation",
+			claim: &jwt.Payload{
+				Organisations: map[string]jwt.Organisation{
+					"10000000-1000-1000-4000-000000000002": {Permissions: []string{"write:organisations"}},
+				},
+			},
+			resource:           organisationId,
+			requiredPermission: "write:organisations",
+			expected:           false,
+		},
+		{
+			name: "Fail with organisation permission on global resource",
+			claim: &jwt.Payload{
+				Organisations: map[string]jwt.Organisation{
+					organisationId: {Permissions: []string{"write:organisations"}},
+				},
+			},
+			resource:           GlobalResource,
+			requiredPermission: "write:organisations",
+			expected:           false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := HasPermission(tt.claimPermissions, tt.requiredPermission)
+			result := HasPermission(tt.claim, tt.resource, tt.requiredPermission)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
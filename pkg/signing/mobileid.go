@@ -0,0 +1,129 @@
+package signing
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"loki/internal/config"
+)
+
+type MobileId interface {
+	Client
+}
+
+type mobileIdClient struct {
+	cfg    *config.Config
+	client *http.Client
+}
+
+type mobileIdCertificateRequest struct {
+	RelyingPartyUUID       string `json:"relyingPartyUUID"`
+	RelyingPartyName       string `json:"relyingPartyName"`
+	PhoneNumber            string `json:"phoneNumber"`
+	NationalIdentityNumber string `json:"nationalIdentityNumber"`
+}
+
+type mobileIdSignatureRequest struct {
+	RelyingPartyUUID       string `json:"relyingPartyUUID"`
+	RelyingPartyName       string `json:"relyingPartyName"`
+	PhoneNumber            string `json:"phoneNumber"`
+	NationalIdentityNumber string `json:"nationalIdentityNumber"`
+	Hash                   string `json:"hash"`
+	HashType               string `json:"hashType"`
+	Language               string `json:"language"`
+	DisplayText            string `json:"displayText,omitempty"`
+	DisplayTextFormat      string `json:"displayTextFormat,omitempty"`
+}
+
+type mobileIdSessionResponse struct {
+	State     string `json:"state"`
+	Result    string `json:"result"`
+	Signature struct {
+		Value     string `json:"value"`
+		Algorithm string `json:"algorithm"`
+	} `json:"signature"`
+}
+
+// NewMobileId creates a client of the Mobile-ID REST API signature endpoints
+func NewMobileId(cfg *config.Config) MobileId {
+	return &mobileIdClient{
+		cfg:    cfg,
+		client: httpClient(),
+	}
+}
+
+// CreateSession fetches the signing certificate of the person and starts a signature session,
+// the certificate is returned with the session since the status response does not contain it
+func (c *mobileIdClient) CreateSession(ctx context.Context, params *Request) (*Session, error) {
+	var certificate struct {
+		Result string `json:"result"`
+		Cert   string `json:"cert"`
+	}
+	err := do(ctx, c.client, http.MethodPost, c.cfg.MobileId.BaseURL+"/certificate", mobileIdCertificateRequest{
+		RelyingPartyUUID:       c.cfg.MobileId.RelyingPartyUUID,
+		RelyingPartyName:       c.cfg.MobileId.RelyingPartyName,
+		PhoneNumber:            params.PhoneNumber,
+		NationalIdentityNumber: params.PersonalCode,
+	}, &certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	if certificate.Result != "OK" {
+		return nil, &Error{Code: certificate.Result}
+	}
+
+	language := params.Language
+	if language == "" {
+		language = c.cfg.MobileId.Language
+	}
+
+	var response struct {
+		SessionID string `json:"sessionID"`
+	}
+	err = do(ctx, c.client, http.MethodPost, c.cfg.MobileId.BaseURL+"/signature", mobileIdSignatureRequest{
+		RelyingPartyUUID:       c.cfg.MobileId.RelyingPartyUUID,
+		RelyingPartyName:       c.cfg.MobileId.RelyingPartyName,
+		PhoneNumber:            params.PhoneNumber,
+		NationalIdentityNumber: params.PersonalCode,
+		Hash:                   base64.StdEncoding.EncodeToString(params.Hash),
+		HashType:               HashType,
+		Language:               language,
+		DisplayText:            params.DisplayText,
+		DisplayTextFormat:      params.TextFormat,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Session{
+		ID:          response.SessionID,
+		Code:        MobileIdVerificationCode(params.Hash),
+		Certificate: certificate.Cert,
+	}, nil
+}
+
+// FetchSession long polls the session, a result other than OK is returned as *Error
+func (c *mobileIdClient) FetchSession(ctx context.Context, sessionId string) (*Result, error) {
+	var response mobileIdSessionResponse
+	endpoint := fmt.Sprintf("%s/signature/session/%s?timeoutMs=%d", c.cfg.MobileId.BaseURL, sessionId, PollTimeout.Milliseconds())
+	if err := do(ctx, c.client, http.MethodGet, endpoint, nil, &response); err != nil {
+		return nil, err
+	}
+
+	if response.State != StateComplete {
+		return &Result{State: response.State}, nil
+	}
+
+	if response.Result != "OK" {
+		return nil, &Error{Code: response.Result}
+	}
+
+	return &Result{
+		State:     response.State,
+		Signature: response.Signature.Value,
+		Algorithm: response.Signature.Algorithm,
+	}, nil
+}
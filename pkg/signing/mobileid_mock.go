@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/signing/mobileid.go
+//
+// Generated by this command:
+//
+//	mockgen -source=pkg/signing/mobileid.go -destination=pkg/signing/mobileid_mock.go -package=signing
+//
+
+// Package signing is a generated GoMock package.
+package signing
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMobileId is a mock of MobileId interface.
+type MockMobileId struct {
+	ctrl     *gomock.Controller
+	recorder *MockMobileIdMockRecorder
+	isgomock struct{}
+}
+
+// MockMobileIdMockRecorder is the mock recorder for MockMobileId.
+type MockMobileIdMockRecorder struct {
+	mock *MockMobileId
+}
+
+// NewMockMobileId creates a new mock instance.
+func NewMockMobileId(ctrl *gomock.Controller) *MockMobileId {
+	mock := &MockMobileId{ctrl: ctrl}
+	mock.recorder = &MockMobileIdMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMobileId) EXPECT() *MockMobileIdMockRecorder {
+	return m.recorder
+}
+
+// CreateSession mocks base method.
+func (m *MockMobileId) CreateSession(ctx context.Context, params *Request) (*Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, params)
+	ret0, _ := ret[0].(*Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockMobileIdMockRecorder) CreateSession(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockMobileId)(nil).CreateSession), ctx, params)
+}
+
+// FetchSession mocks base method.
+func (m *MockMobileId) FetchSession(ctx context.Context, sessionId string) (*Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchSession", ctx, sessionId)
+	ret0, _ := ret[0].(*Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchSession indicates an expected call of FetchSession.
+func (mr *MockMobileIdMockRecorder) FetchSession(ctx, sessionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSession", reflect.TypeOf((*MockMobileId)(nil).FetchSession), ctx, sessionId)
+}
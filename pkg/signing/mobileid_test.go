@@ -0,0 +1,183 @@
+package signing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+func Test_MobileId_CreateSession(t *testing.T) {
+	var received mobileIdSignatureRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		switch r.URL.Path {
+		case "/certificate":
+			var request mobileIdCertificateRequest
+			_ = json.NewDecoder(r.Body).Decode(&request)
+
+			if request.NationalIdentityNumber == "60001019906" {
+				_, _ = w.Write([]byte(`{"result": "NOT_FOUND"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"result": "OK", "cert": "Y2VydGlmaWNhdGU="}`))
+		case "/signature":
+			_ = json.NewDecoder(r.Body).Decode(&received)
+			_, _ = w.Write([]byte(`{"sessionID": "de305d54-75b4-431b-adb2-eb6b9e546015"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		MobileId: config.MobileId{
+			BaseURL:          server.URL,
+			RelyingPartyName: "DEMO",
+			RelyingPartyUUID: "00000000-0000-0000-0000-000000000000",
+			Language:         "ENG",
+		},
+	}
+	client := NewMobileId(cfg)
+	hash := Hash([]byte(testPayload))
+
+	tests := []struct {
+		name         string
+		personalCode string
+		expected     *Session
+		error        error
+	}{
+		{
+			name:         "Success",
+			personalCode: "60001019896",
+			expected: &Session{
+				ID:          "de305d54-75b4-431b-adb2-eb6b9e546015",
+				Code:        "1821",
+				Certificate: "Y2VydGlmaWNhdGU=",
+			},
+		},
+		{
+			name:         "Certificate not found",
+			personalCode: "60001019906",
+			error:        &Error{Code: "NOT_FOUND"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := client.CreateSession(context.Background(), &Request{
+				PersonalCode: tt.personalCode,
+				PhoneNumber:  "+37268000769",
+				Hash:         hash,
+				DisplayText:  "Pay 100.00 EUR",
+				TextFormat:   "GSM-7",
+			})
+
+			if tt.error != nil {
+				assert.Equal(t, tt.error, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, mobileIdSignatureRequest{
+				RelyingPartyUUID:       "00000000-0000-0000-0000-000000000000",
+				RelyingPartyName:       "DEMO",
+				PhoneNumber:            "+37268000769",
+				NationalIdentityNumber: tt.personalCode,
+				Hash:                   "OmuIu6KwsnD+7RIjAIITAbrNFh5yfjWHllWr5C+anhRfvAV9h7gFDGNG3HW0MAAMglHNiTtU3Z1umQBrH4z6nQ==",
+				HashType:               HashType,
+				Language:               "ENG",
+				DisplayText:            "Pay 100.00 EUR",
+				DisplayTextFormat:      "GSM-7",
+			}, received)
+		})
+	}
+}
+
+func Test_MobileId_FetchSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "30000", r.URL.Query().Get("timeoutMs"))
+
+		switch r.URL.Path {
+		case "/signature/session/running":
+			_, _ = w.Write([]byte(`{"state": "RUNNING"}`))
+		case "/signature/session/complete":
+			_, _ = w.Write([]byte(`{
+				"state": "COMPLETE",
+				"result": "OK",
+				"signature": {"value": "c2lnbmF0dXJl", "algorithm": "SHA512WithECEncryption"}
+			}`))
+		case "/signature/session/cancelled":
+			_, _ = w.Write([]byte(`{"state": "COMPLETE", "result": "USER_CANCELLED"}`))
+		case "/signature/session/failure":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewMobileId(&config.Config{MobileId: config.MobileId{BaseURL: server.URL}})
+
+	tests := []struct {
+		name      string
+		sessionId string
+		expected  *Result
+		error     error
+	}{
+		{
+			name:      "Running",
+			sessionId: "running",
+			expected:  &Result{State: StateRunning},
+		},
+		{
+			name:      "Complete",
+			sessionId: "complete",
+			expected: &Result{
+				State:     StateComplete,
+				Signature: "c2lnbmF0dXJl",
+				Algorithm: "SHA512WithECEncryption",
+			},
+		},
+		{
+			name:      "Cancelled",
+			sessionId: "cancelled",
+			error:     &Error{Code: "USER_CANCELLED"},
+		},
+		{
+			name:      "Provider failure",
+			sessionId: "failure",
+			error:     errors.ErrSigningProviderError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := client.FetchSession(context.Background(), tt.sessionId)
+
+			if tt.error != nil {
+				var providerErr *Error
+				if errors.As(tt.error, &providerErr) {
+					assert.Equal(t, tt.error, err)
+				} else {
+					assert.ErrorIs(t, err, tt.error)
+				}
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
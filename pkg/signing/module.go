@@ -0,0 +1,22 @@
+package signing
+
+import (
+	"github.com/tab/smartid"
+	"go.uber.org/fx"
+
+	"loki/internal/config"
+)
+
+var Module = fx.Options(
+	fx.Provide(
+		func(cfg *config.Config) (SmartId, error) {
+			certManager, err := smartid.NewCertificateManager(cfg.CertPath)
+			if err != nil {
+				return nil, err
+			}
+			return NewSmartId(cfg, certManager.TLSConfig()), nil
+		},
+	),
+	fx.Provide(NewMobileId),
+	fx.Provide(NewVerifier),
+)
@@ -0,0 +1,123 @@
+package signing
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"loki/internal/app/errors"
+)
+
+const (
+	HashType = "SHA512"
+
+	StateRunning  = "RUNNING"
+	StateComplete = "COMPLETE"
+
+	// PollTimeout is how long a status request waits for the session to complete
+	PollTimeout = 30 * time.Second
+	// RequestTimeout bounds a single request including the long poll
+	RequestTimeout = PollTimeout + 10*time.Second
+)
+
+// Request describes the payload the person confirms with a qualified signature
+type Request struct {
+	// IdentityNumber is the ETSI semantics identifier, e.g. 'PNOEE-30303039914'
+	IdentityNumber string
+	PersonalCode   string
+	PhoneNumber    string
+	Hash           []byte
+	DisplayText    string
+	TextFormat     string
+	Language       string
+}
+
+// Session is a started signature session and the verification code shown on the device
+type Session struct {
+	ID          string
+	Code        string
+	Certificate string
+}
+
+// Result is the state of a signature session, the signature is set once the state is COMPLETE
+type Result struct {
+	State       string
+	Signature   string
+	Algorithm   string
+	Certificate string
+}
+
+// Error is an end result of the provider other than OK, e.g. 'USER_REFUSED' or 'TIMEOUT'
+type Error struct {
+	Code string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("signature session failed: %s", e.Code)
+}
+
+type Client interface {
+	CreateSession(ctx context.Context, params *Request) (*Session, error)
+	FetchSession(ctx context.Context, sessionId string) (*Result, error)
+}
+
+// Hash returns the SHA-512 digest of the payload that is signed
+func Hash(payload []byte) []byte {
+	sum := sha512.Sum512(payload)
+	return sum[:]
+}
+
+// SmartIdVerificationCode is the last two bytes of the SHA-256 of the hash modulo 10000
+func SmartIdVerificationCode(hash []byte) string {
+	sum := sha256.Sum256(hash)
+	return fmt.Sprintf("%04d", binary.BigEndian.Uint16(sum[len(sum)-2:])%10000)
+}
+
+// MobileIdVerificationCode combines six bits of the first and seven bits of the last byte of the hash
+func MobileIdVerificationCode(hash []byte) string {
+	code := int(hash[0]&0xFC)<<5 | int(hash[len(hash)-1]&0x7F)
+	return fmt.Sprintf("%04d", code)
+}
+
+// do sends the JSON body and decodes a successful response into result
+func do(ctx context.Context, client *http.Client, method, endpoint string, body, result any) error {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return errors.ErrSigningSessionNotFound
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return fmt.Errorf("%w: status %d", errors.ErrSigningProviderError, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+func httpClient() *http.Client {
+	return &http.Client{Timeout: RequestTimeout}
+}
@@ -0,0 +1,32 @@
+package signing
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPayload = "Pay 100.00 EUR to Example OU"
+
+func Test_Hash(t *testing.T) {
+	hash := Hash([]byte(testPayload))
+
+	assert.Len(t, hash, 64)
+	assert.Equal(t,
+		"OmuIu6KwsnD+7RIjAIITAbrNFh5yfjWHllWr5C+anhRfvAV9h7gFDGNG3HW0MAAMglHNiTtU3Z1umQBrH4z6nQ==",
+		base64.StdEncoding.EncodeToString(hash))
+}
+
+func Test_VerificationCode(t *testing.T) {
+	hash := Hash([]byte(testPayload))
+
+	assert.Equal(t, "5463", SmartIdVerificationCode(hash))
+	assert.Equal(t, "1821", MobileIdVerificationCode(hash))
+}
+
+func Test_Error(t *testing.T) {
+	err := &Error{Code: "USER_REFUSED"}
+
+	assert.Equal(t, "signature session failed: USER_REFUSED", err.Error())
+}
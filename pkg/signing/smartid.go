@@ -0,0 +1,115 @@
+package signing
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"loki/internal/config"
+)
+
+const (
+	SmartIdCertificateLevel = "QUALIFIED"
+	SmartIdInteractionType  = "confirmationMessage"
+)
+
+type SmartId interface {
+	Client
+}
+
+type smartIdClient struct {
+	cfg    *config.Config
+	client *http.Client
+}
+
+type smartIdInteraction struct {
+	Type           string `json:"type"`
+	DisplayText200 string `json:"displayText200"`
+}
+
+type smartIdSignatureRequest struct {
+	RelyingPartyUUID         string               `json:"relyingPartyUUID"`
+	RelyingPartyName         string               `json:"relyingPartyName"`
+	CertificateLevel         string               `json:"certificateLevel"`
+	Hash                     string               `json:"hash"`
+	HashType                 string               `json:"hashType"`
+	AllowedInteractionsOrder []smartIdInteraction `json:"allowedInteractionsOrder"`
+}
+
+type smartIdSessionResponse struct {
+	State  string `json:"state"`
+	Result struct {
+		EndResult string `json:"endResult"`
+	} `json:"result"`
+	Signature struct {
+		Value     string `json:"value"`
+		Algorithm string `json:"algorithm"`
+	} `json:"signature"`
+	Cert struct {
+		Value string `json:"value"`
+	} `json:"cert"`
+}
+
+// NewSmartId creates a client of the Smart-ID RP API v2 signature endpoints
+func NewSmartId(cfg *config.Config, tlsConfig *tls.Config) SmartId {
+	client := httpClient()
+	client.Transport = &http.Transport{TLSClientConfig: tlsConfig}
+
+	return &smartIdClient{
+		cfg:    cfg,
+		client: client,
+	}
+}
+
+// CreateSession starts a signature session for the ETSI identifier with the confirmation message
+func (c *smartIdClient) CreateSession(ctx context.Context, params *Request) (*Session, error) {
+	body := smartIdSignatureRequest{
+		RelyingPartyUUID: c.cfg.SmartId.RelyingPartyUUID,
+		RelyingPartyName: c.cfg.SmartId.RelyingPartyName,
+		CertificateLevel: SmartIdCertificateLevel,
+		Hash:             base64.StdEncoding.EncodeToString(params.Hash),
+		HashType:         HashType,
+		AllowedInteractionsOrder: []smartIdInteraction{
+			{Type: SmartIdInteractionType, DisplayText200: params.DisplayText},
+		},
+	}
+
+	var response struct {
+		SessionID string `json:"sessionID"`
+	}
+	endpoint := fmt.Sprintf("%s/signature/etsi/%s", c.cfg.SmartId.BaseURL, params.IdentityNumber)
+	if err := do(ctx, c.client, http.MethodPost, endpoint, body, &response); err != nil {
+		return nil, err
+	}
+
+	return &Session{
+		ID:   response.SessionID,
+		Code: SmartIdVerificationCode(params.Hash),
+	}, nil
+}
+
+// FetchSession long polls the session, an end result other than OK is returned as *Error
+func (c *smartIdClient) FetchSession(ctx context.Context, sessionId string) (*Result, error) {
+	var response smartIdSessionResponse
+	endpoint := fmt.Sprintf("%s/session/%s?timeoutMs=%d", c.cfg.SmartId.BaseURL, sessionId, PollTimeout.Milliseconds())
+	if err := do(ctx, c.client, http.MethodGet, endpoint, nil, &response); err != nil {
+		return nil, err
+	}
+
+	if response.State != StateComplete {
+		return &Result{State: response.State}, nil
+	}
+
+	if response.Result.EndResult != "OK" {
+		return nil, &Error{Code: response.Result.EndResult}
+	}
+
+	return &Result{
+		State:       response.State,
+		Signature:   response.Signature.Value,
+		Algorithm:   response.Signature.Algorithm,
+		Certificate: response.Cert.Value,
+	}, nil
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/signing/smartid.go
+//
+// Generated by this command:
+//
+//	mockgen -source=pkg/signing/smartid.go -destination=pkg/signing/smartid_mock.go -package=signing
+//
+
+// Package signing is a generated GoMock package.
+package signing
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSmartId is a mock of SmartId interface.
+type MockSmartId struct {
+	ctrl     *gomock.Controller
+	recorder *MockSmartIdMockRecorder
+	isgomock struct{}
+}
+
+// MockSmartIdMockRecorder is the mock recorder for MockSmartId.
+type MockSmartIdMockRecorder struct {
+	mock *MockSmartId
+}
+
+// NewMockSmartId creates a new mock instance.
+func NewMockSmartId(ctrl *gomock.Controller) *MockSmartId {
+	mock := &MockSmartId{ctrl: ctrl}
+	mock.recorder = &MockSmartIdMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSmartId) EXPECT() *MockSmartIdMockRecorder {
+	return m.recorder
+}
+
+// CreateSession mocks base method.
+func (m *MockSmartId) CreateSession(ctx context.Context, params *Request) (*Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, params)
+	ret0, _ := ret[0].(*Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockSmartIdMockRecorder) CreateSession(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSmartId)(nil).CreateSession), ctx, params)
+}
+
+// FetchSession mocks base method.
+func (m *MockSmartId) FetchSession(ctx context.Context, sessionId string) (*Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchSession", ctx, sessionId)
+	ret0, _ := ret[0].(*Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchSession indicates an expected call of FetchSession.
+func (mr *MockSmartIdMockRecorder) FetchSession(ctx, sessionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSession", reflect.TypeOf((*MockSmartId)(nil).FetchSession), ctx, sessionId)
+}
@@ -0,0 +1,168 @@
+package signing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+func Test_SmartId_CreateSession(t *testing.T) {
+	var received smartIdSignatureRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&received)
+
+		switch r.URL.Path {
+		case "/signature/etsi/PNOEE-30303039914":
+			_, _ = w.Write([]byte(`{"sessionID": "de305d54-75b4-431b-adb2-eb6b9e546014"}`))
+		case "/signature/etsi/PNOEE-30303039903":
+			w.WriteHeader(471)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		SmartId: config.SmartId{
+			BaseURL:          server.URL,
+			RelyingPartyName: "DEMO",
+			RelyingPartyUUID: "00000000-0000-0000-0000-000000000000",
+		},
+	}
+	client := NewSmartId(cfg, nil)
+	hash := Hash([]byte(testPayload))
+
+	tests := []struct {
+		name     string
+		identity string
+		expected *Session
+		error    error
+	}{
+		{
+			name:     "Success",
+			identity: "PNOEE-30303039914",
+			expected: &Session{ID: "de305d54-75b4-431b-adb2-eb6b9e546014", Code: "5463"},
+		},
+		{
+			name:     "No suitable account",
+			identity: "PNOEE-30303039903",
+			error:    errors.ErrSigningProviderError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := client.CreateSession(context.Background(), &Request{
+				IdentityNumber: tt.identity,
+				Hash:           hash,
+				DisplayText:    testPayload,
+			})
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, smartIdSignatureRequest{
+				RelyingPartyUUID: "00000000-0000-0000-0000-000000000000",
+				RelyingPartyName: "DEMO",
+				CertificateLevel: SmartIdCertificateLevel,
+				Hash:             "OmuIu6KwsnD+7RIjAIITAbrNFh5yfjWHllWr5C+anhRfvAV9h7gFDGNG3HW0MAAMglHNiTtU3Z1umQBrH4z6nQ==",
+				HashType:         HashType,
+				AllowedInteractionsOrder: []smartIdInteraction{
+					{Type: SmartIdInteractionType, DisplayText200: testPayload},
+				},
+			}, received)
+		})
+	}
+}
+
+func Test_SmartId_FetchSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "30000", r.URL.Query().Get("timeoutMs"))
+
+		switch r.URL.Path {
+		case "/session/running":
+			_, _ = w.Write([]byte(`{"state": "RUNNING"}`))
+		case "/session/complete":
+			_, _ = w.Write([]byte(`{
+				"state": "COMPLETE",
+				"result": {"endResult": "OK", "documentNumber": "PNOEE-30303039914-MOCK-Q"},
+				"signature": {"value": "c2lnbmF0dXJl", "algorithm": "sha512WithRSAEncryption"},
+				"cert": {"value": "Y2VydGlmaWNhdGU=", "certificateLevel": "QUALIFIED"}
+			}`))
+		case "/session/refused":
+			_, _ = w.Write([]byte(`{"state": "COMPLETE", "result": {"endResult": "USER_REFUSED"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewSmartId(&config.Config{SmartId: config.SmartId{BaseURL: server.URL}}, nil)
+
+	tests := []struct {
+		name      string
+		sessionId string
+		expected  *Result
+		error     error
+	}{
+		{
+			name:      "Running",
+			sessionId: "running",
+			expected:  &Result{State: StateRunning},
+		},
+		{
+			name:      "Complete",
+			sessionId: "complete",
+			expected: &Result{
+				State:       StateComplete,
+				Signature:   "c2lnbmF0dXJl",
+				Algorithm:   "sha512WithRSAEncryption",
+				Certificate: "Y2VydGlmaWNhdGU=",
+			},
+		},
+		{
+			name:      "Refused",
+			sessionId: "refused",
+			error:     &Error{Code: "USER_REFUSED"},
+		},
+		{
+			name:      "Not found",
+			sessionId: "unknown",
+			error:     errors.ErrSigningSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := client.FetchSession(context.Background(), tt.sessionId)
+
+			if tt.error != nil {
+				var providerErr *Error
+				if errors.As(tt.error, &providerErr) {
+					assert.Equal(t, tt.error, err)
+				} else {
+					assert.ErrorIs(t, err, tt.error)
+				}
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
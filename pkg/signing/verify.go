@@ -0,0 +1,112 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+type Verifier interface {
+	Verify(hash []byte, result *Result, identityNumber string) error
+}
+
+type verifier struct {
+	anchors *x509.CertPool
+}
+
+// NewVerifier loads the PEM encoded SK certificates of TRUST_ANCHORS_PATH, without anchors every signature is rejected
+func NewVerifier(cfg *config.Config) (Verifier, error) {
+	if cfg.AnchorsPath == "" {
+		return &verifier{}, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(cfg.AnchorsPath, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	anchors := x509.NewCertPool()
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		anchors.AppendCertsFromPEM(data)
+	}
+
+	return &verifier{anchors: anchors}, nil
+}
+
+// Verify checks that the signer certificate chains to a trust anchor, was issued to the identity number
+// and that the signature verifies against the SHA-512 hash
+func (v *verifier) Verify(hash []byte, result *Result, identityNumber string) error {
+	der, err := base64.StdEncoding.DecodeString(result.Certificate)
+	if err != nil {
+		return errors.ErrInvalidSigningCertificate
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return errors.ErrInvalidSigningCertificate
+	}
+
+	if v.anchors == nil {
+		return errors.ErrUntrustedSigningCertificate
+	}
+
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:     v.anchors,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return errors.ErrUntrustedSigningCertificate
+	}
+
+	if cert.Subject.SerialNumber == "" || cert.Subject.SerialNumber != identityNumber {
+		return errors.ErrSignerMismatch
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(result.Signature)
+	if err != nil {
+		return errors.ErrInvalidSignature
+	}
+
+	if !verifySignature(cert.PublicKey, result.Algorithm, hash, signature) {
+		return errors.ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// verifySignature checks the signature over the digest, Smart-ID signs with RSA and Mobile-ID with RSA or
+// ECDSA as the concatenated r and s values
+func verifySignature(key any, algorithm string, hash, signature []byte) bool {
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if strings.Contains(strings.ToUpper(algorithm), "PSS") {
+			return rsa.VerifyPSS(key, crypto.SHA512, hash, signature, nil) == nil
+		}
+		return rsa.VerifyPKCS1v15(key, crypto.SHA512, hash, signature) == nil
+	case *ecdsa.PublicKey:
+		if len(signature)%2 == 0 {
+			size := len(signature) / 2
+			r := new(big.Int).SetBytes(signature[:size])
+			s := new(big.Int).SetBytes(signature[size:])
+			if ecdsa.Verify(key, hash, r, s) {
+				return true
+			}
+		}
+		return ecdsa.VerifyASN1(key, hash, signature)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,178 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+const testIdentityNumber = "PNOEE-30303039914"
+
+// issue creates a certificate for the key signed by the parent, a nil parent creates a self-signed CA
+func issue(t *testing.T, key crypto.Signer, serialNumber string, parent *x509.Certificate, parentKey crypto.Signer) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "TEST", SerialNumber: serialNumber},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageContentCommitment,
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	assert.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return cert
+}
+
+func anchorsPath(t *testing.T, cert *x509.Certificate) string {
+	dir := t.TempDir()
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "sk.pem"), data, 0o600))
+	return dir
+}
+
+func Test_Verifier_Verify(t *testing.T) {
+	hash := Hash([]byte(testPayload))
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	ca := issue(t, caKey, "", nil, nil)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	other := issue(t, otherKey, "", nil, nil)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	rsaCert := issue(t, rsaKey, testIdentityNumber, ca, caKey)
+	rsaSignature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA512, hash)
+	assert.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	assert.NoError(t, err)
+	ecCert := issue(t, ecKey, testIdentityNumber, ca, caKey)
+	r, s, err := ecdsa.Sign(rand.Reader, ecKey, hash)
+	assert.NoError(t, err)
+	ecSignature := append(r.FillBytes(make([]byte, 48)), s.FillBytes(make([]byte, 48))...)
+
+	encode := base64.StdEncoding.EncodeToString
+
+	tests := []struct {
+		name           string
+		anchors        string
+		result         *Result
+		identityNumber string
+		expected       error
+	}{
+		{
+			name:    "RSA signature",
+			anchors: anchorsPath(t, ca),
+			result: &Result{
+				Signature:   encode(rsaSignature),
+				Algorithm:   "sha512WithRSAEncryption",
+				Certificate: encode(rsaCert.Raw),
+			},
+			identityNumber: testIdentityNumber,
+			expected:       nil,
+		},
+		{
+			name:    "ECDSA signature",
+			anchors: anchorsPath(t, ca),
+			result: &Result{
+				Signature:   encode(ecSignature),
+				Algorithm:   "SHA512WithECEncryption",
+				Certificate: encode(ecCert.Raw),
+			},
+			identityNumber: testIdentityNumber,
+			expected:       nil,
+		},
+		{
+			name:    "Signature of another hash",
+			anchors: anchorsPath(t, ca),
+			result: &Result{
+				Signature:   encode(rsaSignature[:len(rsaSignature)-1]),
+				Algorithm:   "sha512WithRSAEncryption",
+				Certificate: encode(rsaCert.Raw),
+			},
+			identityNumber: testIdentityNumber,
+			expected:       errors.ErrInvalidSignature,
+		},
+		{
+			name:    "Another signer",
+			anchors: anchorsPath(t, ca),
+			result: &Result{
+				Signature:   encode(rsaSignature),
+				Algorithm:   "sha512WithRSAEncryption",
+				Certificate: encode(rsaCert.Raw),
+			},
+			identityNumber: "PNOEE-60001019906",
+			expected:       errors.ErrSignerMismatch,
+		},
+		{
+			name:    "Untrusted issuer",
+			anchors: anchorsPath(t, other),
+			result: &Result{
+				Signature:   encode(rsaSignature),
+				Algorithm:   "sha512WithRSAEncryption",
+				Certificate: encode(rsaCert.Raw),
+			},
+			identityNumber: testIdentityNumber,
+			expected:       errors.ErrUntrustedSigningCertificate,
+		},
+		{
+			name: "No trust anchors",
+			result: &Result{
+				Signature:   encode(rsaSignature),
+				Algorithm:   "sha512WithRSAEncryption",
+				Certificate: encode(rsaCert.Raw),
+			},
+			identityNumber: testIdentityNumber,
+			expected:       errors.ErrUntrustedSigningCertificate,
+		},
+		{
+			name:    "Invalid certificate",
+			anchors: anchorsPath(t, ca),
+			result: &Result{
+				Signature:   encode(rsaSignature),
+				Algorithm:   "sha512WithRSAEncryption",
+				Certificate: "MIIC",
+			},
+			identityNumber: testIdentityNumber,
+			expected:       errors.ErrInvalidSigningCertificate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier, err := NewVerifier(&config.Config{AnchorsPath: tt.anchors})
+			assert.NoError(t, err)
+
+			err = verifier.Verify(hash, tt.result, tt.identityNumber)
+			assert.Equal(t, tt.expected, err)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package validator
+
+import (
+	"net/mail"
+
+	"loki/internal/app/errors"
+)
+
+const emailMaxLength = 255
+
+// Email validates a single plain address without a display name
+func Email(email string) error {
+	if email == "" || len(email) > emailMaxLength {
+		return errors.ErrInvalidEmail
+	}
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email || address.Name != "" {
+		return errors.ErrInvalidEmail
+	}
+
+	return nil
+}
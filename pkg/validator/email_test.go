@@ -0,0 +1,55 @@
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+)
+
+func Test_Email(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		expected error
+	}{
+		{
+			name:     "Valid",
+			email:    "john.doe@example.com",
+			expected: nil,
+		},
+		{
+			name:     "Empty",
+			email:    "",
+			expected: errors.ErrInvalidEmail,
+		},
+		{
+			name:     "Missing domain",
+			email:    "john.doe@",
+			expected: errors.ErrInvalidEmail,
+		},
+		{
+			name:     "Display name",
+			email:    "John Doe <john.doe@example.com>",
+			expected: errors.ErrInvalidEmail,
+		},
+		{
+			name:     "Multiple addresses",
+			email:    "john@example.com, jane@example.com",
+			expected: errors.ErrInvalidEmail,
+		},
+		{
+			name:     "Too long",
+			email:    strings.Repeat("a", 250) + "@example.com",
+			expected: errors.ErrInvalidEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Email(tt.email))
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package validator
+
+import (
+	"strings"
+
+	"loki/internal/app/errors"
+)
+
+// identityNumberPrefix is the ETSI semantics identifier type of national personal numbers
+const identityNumberPrefix = "PNO"
+
+// IdentityNumber validates the ETSI semantics identifier, e.g. 'PNOEE-30303039914', and returns its personal code
+func IdentityNumber(identityNumber string) (string, error) {
+	if identityNumber == "" {
+		return "", errors.ErrEmptyIdentityNumber
+	}
+
+	prefix, code, ok := strings.Cut(identityNumber, "-")
+	country, hasType := strings.CutPrefix(prefix, identityNumberPrefix)
+	if !ok || !hasType || Country(country) != nil {
+		return "", errors.ErrInvalidIdentityNumber
+	}
+
+	if err := PersonalCode(country, code); err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
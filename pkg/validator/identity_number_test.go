@@ -0,0 +1,63 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+)
+
+func Test_IdentityNumber(t *testing.T) {
+	tests := []struct {
+		name           string
+		identityNumber string
+		expected       string
+		err            error
+	}{
+		{
+			name:           "Estonian",
+			identityNumber: "PNOEE-30303039914",
+			expected:       "30303039914",
+		},
+		{
+			name:           "Latvian with hyphen",
+			identityNumber: "PNOLV-030403-10075",
+			expected:       "030403-10075",
+		},
+		{
+			name:           "Empty",
+			identityNumber: "",
+			err:            errors.ErrEmptyIdentityNumber,
+		},
+		{
+			name:           "Missing separator",
+			identityNumber: "PNOEE30303039914",
+			err:            errors.ErrInvalidIdentityNumber,
+		},
+		{
+			name:           "Unsupported type",
+			identityNumber: "IDCEE-30303039914",
+			err:            errors.ErrInvalidIdentityNumber,
+		},
+		{
+			name:           "Unsupported country",
+			identityNumber: "PNOFI-30303039914",
+			err:            errors.ErrInvalidIdentityNumber,
+		},
+		{
+			name:           "Invalid personal code",
+			identityNumber: "PNOEE-30303039915",
+			err:            errors.ErrInvalidPersonalCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := IdentityNumber(tt.identityNumber)
+
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expected, code)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package validator
+
+import (
+	"regexp"
+
+	"loki/internal/app/errors"
+)
+
+// localePattern accepts a language with an optional region, e.g. 'et', 'en-GB' or 'es-419'
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-([A-Z]{2}|[0-9]{3}))?$`)
+
+// Locale validates the preferred language tag of a user
+func Locale(locale string) error {
+	if !localePattern.MatchString(locale) {
+		return errors.ErrInvalidLocale
+	}
+
+	return nil
+}
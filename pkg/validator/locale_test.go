@@ -0,0 +1,54 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+)
+
+func Test_Locale(t *testing.T) {
+	tests := []struct {
+		name     string
+		locale   string
+		expected error
+	}{
+		{
+			name:     "Language",
+			locale:   "et",
+			expected: nil,
+		},
+		{
+			name:     "Language and region",
+			locale:   "en-GB",
+			expected: nil,
+		},
+		{
+			name:     "Numeric region",
+			locale:   "es-419",
+			expected: nil,
+		},
+		{
+			name:     "Empty",
+			locale:   "",
+			expected: errors.ErrInvalidLocale,
+		},
+		{
+			name:     "Underscore separator",
+			locale:   "en_GB",
+			expected: errors.ErrInvalidLocale,
+		},
+		{
+			name:     "Uppercase language",
+			locale:   "EN",
+			expected: errors.ErrInvalidLocale,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Locale(tt.locale))
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package validator
+
+import (
+	"time"
+
+	"loki/internal/app/errors"
+)
+
+const (
+	Estonia   = "EE"
+	Latvia    = "LV"
+	Lithuania = "LT"
+
+	personalCodeLength = 11
+)
+
+var (
+	firstWeights   = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 1}
+	secondWeights  = []int{3, 4, 5, 6, 7, 8, 9, 1, 2, 3}
+	latvianWeights = []int{1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+)
+
+// Country validates that the country is one of the supported Baltic countries
+func Country(country string) error {
+	switch country {
+	case "":
+		return errors.ErrEmptyCountry
+	case Estonia, Latvia, Lithuania:
+		return nil
+	default:
+		return errors.ErrInvalidCountry
+	}
+}
+
+// PersonalCode validates format, birth date and checksum of the personal code for the given country
+func PersonalCode(country, code string) error {
+	if code == "" {
+		return errors.ErrEmptyPersonalCode
+	}
+
+	switch country {
+	case Estonia, Lithuania:
+		return baltic(code)
+	case Latvia:
+		return latvian(code)
+	default:
+		return errors.ErrInvalidCountry
+	}
+}
+
+// baltic validates Estonian and Lithuanian personal codes in GYYMMDDSSSC format
+func baltic(code string) error {
+	digits, ok := parseDigits(code)
+	if !ok {
+		return errors.ErrInvalidPersonalCode
+	}
+
+	var century int
+	switch digits[0] {
+	case 1, 2:
+		century = 1800
+	case 3, 4:
+		century = 1900
+	case 5, 6:
+		century = 2000
+	default:
+		return errors.ErrInvalidPersonalCode
+	}
+
+	year := century + digits[1]*10 + digits[2]
+	month := digits[3]*10 + digits[4]
+	day := digits[5]*10 + digits[6]
+
+	if !isBirthDate(year, month, day) {
+		return errors.ErrInvalidPersonalCode
+	}
+
+	checksum := weightedSum(digits, firstWeights) % 11
+	if checksum == 10 {
+		checksum = weightedSum(digits, secondWeights) % 11
+		if checksum == 10 {
+			checksum = 0
+		}
+	}
+
+	if checksum != digits[10] {
+		return errors.ErrInvalidPersonalCode
+	}
+
+	return nil
+}
+
+// latvian validates Latvian personal codes in DDMMYY-CNNNX format and
+// the new format issued since 2017, which starts with 32 and carries no birth date
+func latvian(code string) error {
+	if len(code) == personalCodeLength+1 && code[6] == '-' {
+		code = code[:6] + code[7:]
+	}
+
+	digits, ok := parseDigits(code)
+	if !ok {
+		return errors.ErrInvalidPersonalCode
+	}
+
+	if digits[0] != 3 || digits[1] != 2 {
+		var century int
+		switch digits[6] {
+		case 0:
+			century = 1800
+		case 1:
+			century = 1900
+		case 2:
+			century = 2000
+		default:
+			return errors.ErrInvalidPersonalCode
+		}
+
+		year := century + digits[4]*10 + digits[5]
+		month := digits[2]*10 + digits[3]
+		day := digits[0]*10 + digits[1]
+
+		if !isBirthDate(year, month, day) {
+			return errors.ErrInvalidPersonalCode
+		}
+	}
+
+	// a remainder of 10 is written as the check digit 0
+	checksum := (1101 - weightedSum(digits, latvianWeights)) % 11
+	if checksum%10 != digits[10] {
+		return errors.ErrInvalidPersonalCode
+	}
+
+	return nil
+}
+
+func parseDigits(code string) ([]int, bool) {
+	if len(code) != personalCodeLength {
+		return nil, false
+	}
+
+	digits := make([]int, 0, personalCodeLength)
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return nil, false
+		}
+		digits = append(digits, int(r-'0'))
+	}
+
+	return digits, true
+}
+
+func weightedSum(digits, weights []int) int {
+	sum := 0
+	for i, weight := range weights {
+		sum += digits[i] * weight
+	}
+
+	return sum
+}
+
+func isBirthDate(year, month, day int) bool {
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+
+	if date.Year() != year || int(date.Month()) != month || date.Day() != day {
+		return false
+	}
+
+	return !date.After(time.Now().UTC())
+}
@@ -0,0 +1,173 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+)
+
+func Test_Country(t *testing.T) {
+	tests := []struct {
+		name     string
+		country  string
+		expected error
+	}{
+		{
+			name:     "Estonia",
+			country:  "EE",
+			expected: nil,
+		},
+		{
+			name:     "Latvia",
+			country:  "LV",
+			expected: nil,
+		},
+		{
+			name:     "Lithuania",
+			country:  "LT",
+			expected: nil,
+		},
+		{
+			name:     "Empty",
+			country:  "",
+			expected: errors.ErrEmptyCountry,
+		},
+		{
+			name:     "Unsupported",
+			country:  "FI",
+			expected: errors.ErrInvalidCountry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Country(tt.country))
+		})
+	}
+}
+
+func Test_PersonalCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		country  string
+		code     string
+		expected error
+	}{
+		{
+			name:     "Estonian",
+			country:  "EE",
+			code:     "30303039914",
+			expected: nil,
+		},
+		{
+			name:     "Estonian born in 2000s",
+			country:  "EE",
+			code:     "60001017869",
+			expected: nil,
+		},
+		{
+			name:     "Estonian with second checksum pass",
+			country:  "EE",
+			code:     "39001010110",
+			expected: nil,
+		},
+		{
+			name:     "Lithuanian",
+			country:  "LT",
+			code:     "38703181745",
+			expected: nil,
+		},
+		{
+			name:     "Latvian old format",
+			country:  "LV",
+			code:     "03040310075",
+			expected: nil,
+		},
+		{
+			name:     "Latvian old format with hyphen",
+			country:  "LV",
+			code:     "030403-10075",
+			expected: nil,
+		},
+		{
+			name:     "Latvian check digit for remainder 10",
+			country:  "LV",
+			code:     "01019011080",
+			expected: nil,
+		},
+		{
+			name:     "Latvian new format",
+			country:  "LV",
+			code:     "32999999901",
+			expected: nil,
+		},
+		{
+			name:     "Empty",
+			country:  "EE",
+			code:     "",
+			expected: errors.ErrEmptyPersonalCode,
+		},
+		{
+			name:     "Unsupported country",
+			country:  "FI",
+			code:     "30303039914",
+			expected: errors.ErrInvalidCountry,
+		},
+		{
+			name:     "Invalid length",
+			country:  "EE",
+			code:     "3030303991",
+			expected: errors.ErrInvalidPersonalCode,
+		},
+		{
+			name:     "Non numeric",
+			country:  "EE",
+			code:     "3030303991A",
+			expected: errors.ErrInvalidPersonalCode,
+		},
+		{
+			name:     "Invalid checksum",
+			country:  "EE",
+			code:     "30303039915",
+			expected: errors.ErrInvalidPersonalCode,
+		},
+		{
+			name:     "Invalid century",
+			country:  "LT",
+			code:     "78703181749",
+			expected: errors.ErrInvalidPersonalCode,
+		},
+		{
+			name:     "Invalid birth date",
+			country:  "LT",
+			code:     "38702301740",
+			expected: errors.ErrInvalidPersonalCode,
+		},
+		{
+			name:     "Birth date in the future",
+			country:  "EE",
+			code:     "66012310002",
+			expected: errors.ErrInvalidPersonalCode,
+		},
+		{
+			name:     "Latvian invalid birth date",
+			country:  "LV",
+			code:     "290299-12345",
+			expected: errors.ErrInvalidPersonalCode,
+		},
+		{
+			name:     "Latvian invalid checksum",
+			country:  "LV",
+			code:     "32999999902",
+			expected: errors.ErrInvalidPersonalCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, PersonalCode(tt.country, tt.code))
+		})
+	}
+}
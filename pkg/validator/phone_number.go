@@ -0,0 +1,53 @@
+package validator
+
+import (
+	"strings"
+
+	"loki/internal/app/errors"
+)
+
+type phonePrefix struct {
+	country   string
+	code      string
+	minLength int
+	maxLength int
+}
+
+// mobileIdPrefixes lists calling codes of countries where Mobile-ID is available,
+// along with the allowed subscriber number lengths
+var mobileIdPrefixes = []phonePrefix{
+	{country: Estonia, code: "+372", minLength: 7, maxLength: 8},
+	{country: Lithuania, code: "+370", minLength: 8, maxLength: 8},
+}
+
+// PhoneNumber validates the E.164 phone number against Mobile-ID countries and returns its country
+func PhoneNumber(phone string) (string, error) {
+	if phone == "" {
+		return "", errors.ErrEmptyPhoneNumber
+	}
+
+	for _, prefix := range mobileIdPrefixes {
+		subscriber, ok := strings.CutPrefix(phone, prefix.code)
+		if !ok {
+			continue
+		}
+
+		if len(subscriber) < prefix.minLength || len(subscriber) > prefix.maxLength || !isNumeric(subscriber) {
+			return "", errors.ErrInvalidPhoneNumber
+		}
+
+		return prefix.country, nil
+	}
+
+	return "", errors.ErrInvalidPhoneNumber
+}
+
+func isNumeric(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,76 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+)
+
+func Test_PhoneNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		phone    string
+		country  string
+		expected error
+	}{
+		{
+			name:     "Estonian",
+			phone:    "+37268000769",
+			country:  "EE",
+			expected: nil,
+		},
+		{
+			name:     "Estonian short number",
+			phone:    "+3725123456",
+			country:  "EE",
+			expected: nil,
+		},
+		{
+			name:     "Lithuanian",
+			phone:    "+37060000666",
+			country:  "LT",
+			expected: nil,
+		},
+		{
+			name:     "Empty",
+			phone:    "",
+			expected: errors.ErrEmptyPhoneNumber,
+		},
+		{
+			name:     "Missing plus sign",
+			phone:    "37268000769",
+			expected: errors.ErrInvalidPhoneNumber,
+		},
+		{
+			name:     "Unsupported country",
+			phone:    "+37168000769",
+			expected: errors.ErrInvalidPhoneNumber,
+		},
+		{
+			name:     "Too short",
+			phone:    "+370600006",
+			expected: errors.ErrInvalidPhoneNumber,
+		},
+		{
+			name:     "Too long",
+			phone:    "+372680007691",
+			expected: errors.ErrInvalidPhoneNumber,
+		},
+		{
+			name:     "Non numeric",
+			phone:    "+372 6800076",
+			expected: errors.ErrInvalidPhoneNumber,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			country, err := PhoneNumber(tt.phone)
+
+			assert.Equal(t, tt.expected, err)
+			assert.Equal(t, tt.country, country)
+		})
+	}
+}
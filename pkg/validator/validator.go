@@ -0,0 +1,76 @@
+package validator
+
+import (
+	"strings"
+
+	"loki/internal/app/errors"
+)
+
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Errors collects field-level validation errors of a single request
+type Errors []FieldError
+
+// Add appends the error for the given field, nil errors are ignored
+func (e *Errors) Add(field string, err error) {
+	if err == nil {
+		return
+	}
+
+	*e = append(*e, FieldError{Field: field, Err: err})
+}
+
+// Err returns nil when no errors were collected
+func (e Errors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func (e Errors) Unwrap() []error {
+	result := make([]error, 0, len(e))
+	for _, err := range e {
+		result = append(result, err.Err)
+	}
+
+	return result
+}
+
+// Fields returns error messages keyed by field name, or nil for non-validation errors
+func Fields(err error) map[string]string {
+	var errs Errors
+	if !errors.As(err, &errs) {
+		return nil
+	}
+
+	result := make(map[string]string, len(errs))
+	for _, e := range errs {
+		if _, ok := result[e.Field]; !ok {
+			result[e.Field] = e.Error()
+		}
+	}
+
+	return result
+}
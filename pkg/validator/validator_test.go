@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+)
+
+func Test_Errors(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		var errs Errors
+		errs.Add("country", nil)
+
+		assert.NoError(t, errs.Err())
+	})
+
+	t.Run("With field errors", func(t *testing.T) {
+		var errs Errors
+		errs.Add("country", errors.ErrEmptyCountry)
+		errs.Add("personal_code", errors.ErrEmptyPersonalCode)
+
+		err := errs.Err()
+
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, errors.ErrEmptyCountry)
+		assert.ErrorIs(t, err, errors.ErrEmptyPersonalCode)
+		assert.Equal(t, "empty country, should be 'EE', 'LV' or 'LT'; empty personal code", err.Error())
+	})
+}
+
+func Test_Fields(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected map[string]string
+	}{
+		{
+			name: "Validation errors",
+			err: Errors{
+				{Field: "phone_number", Err: errors.ErrInvalidPhoneNumber},
+				{Field: "personal_code", Err: errors.ErrInvalidPersonalCode},
+			},
+			expected: map[string]string{
+				"phone_number":  "invalid phone number, should be in E.164 format with '+372' or '+370' prefix",
+				"personal_code": "invalid personal code",
+			},
+		},
+		{
+			name:     "Other error",
+			err:      assert.AnError,
+			expected: nil,
+		},
+		{
+			name:     "Nil error",
+			err:      nil,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Fields(tt.err))
+		})
+	}
+}
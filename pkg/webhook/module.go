@@ -0,0 +1,7 @@
+package webhook
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewWebhook),
+)
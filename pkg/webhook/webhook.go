@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+// Timeout bounds a single delivery so that a slow endpoint does not hold up the login
+const Timeout = 5 * time.Second
+
+type Webhook interface {
+	Send(ctx context.Context, event any) error
+}
+
+type webhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook posts events to RISK_WEBHOOK_URL, without it events are dropped
+func NewWebhook(cfg *config.Config) Webhook {
+	return &webhook{
+		url:    cfg.Risk.WebhookURL,
+		client: &http.Client{Timeout: Timeout},
+	}
+}
+
+// Send delivers the event as a JSON body, any status other than 2xx is an error
+func (w *webhook) Send(ctx context.Context, event any) error {
+	if w.url == "" {
+		return nil
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%w: %s", errors.ErrWebhookFailed, resp.Status)
+	}
+
+	return nil
+}
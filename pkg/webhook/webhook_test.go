@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"loki/internal/app/errors"
+	"loki/internal/config"
+)
+
+func Test_Webhook_Send(t *testing.T) {
+	var received map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		received = nil
+		_ = json.NewDecoder(r.Body).Decode(&received)
+
+		if r.URL.Path == "/failure" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	event := map[string]string{"action": "login_risk_detected"}
+
+	tests := []struct {
+		name     string
+		url      string
+		expected map[string]string
+		error    error
+	}{
+		{
+			name:     "Success",
+			url:      server.URL + "/success",
+			expected: event,
+		},
+		{
+			name:     "Endpoint failure",
+			url:      server.URL + "/failure",
+			expected: event,
+			error:    errors.ErrWebhookFailed,
+		},
+		{
+			name: "Without URL",
+			url:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = nil
+			service := NewWebhook(&config.Config{Risk: config.Risk{WebhookURL: tt.url}})
+
+			err := service.Send(context.Background(), event)
+
+			if tt.error != nil {
+				assert.ErrorIs(t, err, tt.error)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, received)
+		})
+	}
+}